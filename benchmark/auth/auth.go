@@ -0,0 +1,142 @@
+// Package auth supplies the credentials benchmark clients connect to Temporal
+// with. Built-in providers cover no authentication, mTLS, static API keys,
+// OAuth/OIDC client credentials, AWS SigV4 signing and sidecar-managed token
+// files; gateways with other auth models register their own provider instead
+// of changing how clients are dialed.
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// Built-in provider names accepted by BENCHMARK_AUTH_PROVIDER.
+const (
+	ProviderNone         = "none"
+	ProviderMTLS         = "mtls"
+	ProviderAPIKey       = "api-key"
+	ProviderOAuth        = "oauth"
+	ProviderSigV4        = "aws-sigv4"
+	ProviderSidecarToken = "sidecar-token"
+)
+
+// Provider configures how a Temporal client authenticates. A provider is
+// applied to every client the benchmark dials, so it must be safe for
+// concurrent use and share any token state between them.
+type Provider interface {
+	// Name identifies the provider in logs
+	Name() string
+
+	// Apply sets the provider's credentials and transport security on opts
+	Apply(opts *client.Options) error
+}
+
+// Factory builds a provider from the benchmark configuration.
+type Factory func(cfg config.BenchmarkConfig) (Provider, error)
+
+var (
+	mu        sync.RWMutex
+	factories = map[string]Factory{
+		ProviderNone:         func(config.BenchmarkConfig) (Provider, error) { return None(), nil },
+		ProviderMTLS:         newMTLSFromConfig,
+		ProviderAPIKey:       newAPIKeyFromConfig,
+		ProviderOAuth:        newOAuthFromConfig,
+		ProviderSigV4:        newSigV4FromConfig,
+		ProviderSidecarToken: newSidecarTokenFromConfig,
+	}
+)
+
+// Register makes a provider selectable by name with BENCHMARK_AUTH_PROVIDER,
+// replacing any provider of that name. Call it from an init function of a
+// package linked into the benchmark binary.
+func Register(name string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	factories[name] = factory
+}
+
+// FromConfig builds the provider selected by cfg.AuthProvider.
+func FromConfig(cfg config.BenchmarkConfig) (Provider, error) {
+	mu.RLock()
+	factory, ok := factories[cfg.AuthProvider]
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	mu.RUnlock()
+
+	if !ok {
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown auth provider %q: must be one of: %s", cfg.AuthProvider, strings.Join(names, ", "))
+	}
+	p, err := factory(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%s auth: %w", cfg.AuthProvider, err)
+	}
+	return p, nil
+}
+
+// noneProvider connects without credentials or TLS.
+type noneProvider struct{}
+
+// None returns a provider that leaves client options untouched.
+func None() Provider {
+	return noneProvider{}
+}
+
+func (noneProvider) Name() string { return ProviderNone }
+
+func (noneProvider) Apply(*client.Options) error { return nil }
+
+// tlsSettings is the transport security shared by the built-in providers.
+type tlsSettings struct {
+	disabled   bool
+	serverName string
+	rootCAs    *x509.CertPool // nil uses the system roots
+}
+
+func newTLSSettings(cfg config.BenchmarkConfig) (tlsSettings, error) {
+	s := tlsSettings{disabled: cfg.TLSDisabled, serverName: cfg.TLSServerName}
+	if cfg.TLSCAFile != "" || cfg.TLSCAPEM != nil {
+		pem, err := readPEM(cfg.TLSCAPEM, cfg.TLSCAFile)
+		if err != nil {
+			return s, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		s.rootCAs = x509.NewCertPool()
+		if !s.rootCAs.AppendCertsFromPEM(pem) {
+			return s, errors.New("no certificates found in the CA bundle")
+		}
+	}
+	return s, nil
+}
+
+// readPEM returns content if set (PEM resolved from a secret reference) and
+// otherwise reads the file at path.
+func readPEM(content []byte, path string) ([]byte, error) {
+	if content != nil {
+		return content, nil
+	}
+	return os.ReadFile(path)
+}
+
+// apply enables TLS on opts unless disabled.
+func (s tlsSettings) apply(opts *client.Options) {
+	if s.disabled {
+		return
+	}
+	opts.ConnectionOptions.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: s.serverName,
+		RootCAs:    s.rootCAs,
+	}
+}
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func TestFromConfig(t *testing.T) {
+	cfg := config.DefaultConfig()
+	p, err := FromConfig(cfg)
+	require.NoError(t, err)
+	require.Equal(t, ProviderNone, p.Name())
+
+	cfg.AuthProvider = "kerberos"
+	_, err = FromConfig(cfg)
+	require.ErrorContains(t, err, `unknown auth provider "kerberos": must be one of: api-key, aws-sigv4, mtls, none, oauth, sidecar-token`)
+
+	// Built-in providers check their own settings
+	cfg.AuthProvider = ProviderAPIKey
+	_, err = FromConfig(cfg)
+	require.ErrorContains(t, err, "api-key auth: an API key is required")
+	cfg.AuthProvider = ProviderMTLS
+	_, err = FromConfig(cfg)
+	require.ErrorContains(t, err, "a client certificate and key file are required")
+	cfg.AuthProvider = ProviderOAuth
+	_, err = FromConfig(cfg)
+	require.ErrorContains(t, err, "a token URL, client ID and client secret are required")
+	cfg.AuthProvider = ProviderSidecarToken
+	_, err = FromConfig(cfg)
+	require.ErrorContains(t, err, "a token file is required")
+}
+
+func TestFileToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+	f := &fileToken{path: path}
+
+	token, err := f.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "first", token)
+
+	// A sidecar rotating the token is picked up on the next call
+	require.NoError(t, os.WriteFile(path, []byte("second"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	token, err = f.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "second", token)
+
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(2*time.Minute)))
+	_, err = f.Token(context.Background())
+	require.ErrorContains(t, err, "is empty")
+}
+
+func TestAPIKeyProvider(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.AuthProvider = ProviderAPIKey
+	cfg.APIKey = "secret"
+	cfg.TLSServerName = "temporal.internal"
+	p, err := FromConfig(cfg)
+	require.NoError(t, err)
+
+	var opts client.Options
+	require.NoError(t, p.Apply(&opts))
+	require.NotNil(t, opts.Credentials)
+	require.Equal(t, "temporal.internal", opts.ConnectionOptions.TLS.ServerName)
+
+	// Behind a TLS-terminating sidecar the key is sent in plaintext
+	cfg.TLSDisabled = true
+	p, err = FromConfig(cfg)
+	require.NoError(t, err)
+	opts = client.Options{}
+	require.NoError(t, p.Apply(&opts))
+	require.Nil(t, opts.ConnectionOptions.TLS)
+}
+
+// selfSigned returns a self-signed certificate and its key as PEM.
+func selfSigned(t *testing.T) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "benchmark"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestMTLSProvider_PEM(t *testing.T) {
+	certPEM, keyPEM := selfSigned(t)
+	cfg := config.DefaultConfig()
+	cfg.AuthProvider = ProviderMTLS
+	cfg.TLSCertPEM, cfg.TLSKeyPEM, cfg.TLSCAPEM = certPEM, keyPEM, certPEM
+	p, err := FromConfig(cfg)
+	require.NoError(t, err)
+
+	var opts client.Options
+	require.NoError(t, p.Apply(&opts))
+	require.NotNil(t, opts.Credentials)
+	require.NotNil(t, opts.ConnectionOptions.TLS.RootCAs)
+
+	// Resolved PEM and files mix: the key is read from disk
+	cfg.TLSKeyPEM = nil
+	cfg.TLSKeyFile = filepath.Join(t.TempDir(), "client.key")
+	require.NoError(t, os.WriteFile(cfg.TLSKeyFile, keyPEM, 0o600))
+	_, err = FromConfig(cfg)
+	require.NoError(t, err)
+
+	cfg.TLSCAPEM = []byte("not a certificate")
+	_, err = FromConfig(cfg)
+	require.ErrorContains(t, err, "no certificates found in the CA bundle")
+}
+
+type headerProvider struct{ value string }
+
+func (headerProvider) Name() string { return "gateway" }
+
+func (p headerProvider) Apply(opts *client.Options) error {
+	opts.HeadersProvider = staticHeaders{"x-gateway-token": p.value}
+	return nil
+}
+
+type staticHeaders map[string]string
+
+func (h staticHeaders) GetHeaders(context.Context) (map[string]string, error) { return h, nil }
+
+func TestRegister(t *testing.T) {
+	Register("gateway", func(cfg config.BenchmarkConfig) (Provider, error) {
+		return headerProvider{value: cfg.APIKey}, nil
+	})
+	t.Cleanup(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		delete(factories, "gateway")
+	})
+
+	cfg := config.DefaultConfig()
+	cfg.AuthProvider = "gateway"
+	cfg.APIKey = "token"
+	p, err := FromConfig(cfg)
+	require.NoError(t, err)
+
+	var opts client.Options
+	require.NoError(t, p.Apply(&opts))
+	headers, err := opts.HeadersProvider.GetHeaders(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "token", headers["x-gateway-token"])
+}
@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+const (
+	// tokenRefreshMargin is how long before expiry a token is replaced, so
+	// calls never carry a token that expires in flight
+	tokenRefreshMargin = time.Minute
+
+	// tokenRequestTimeout bounds a single token request
+	tokenRequestTimeout = 10 * time.Second
+
+	// defaultTokenLifetime is assumed when the token response has no expiry
+	defaultTokenLifetime = 5 * time.Minute
+)
+
+// oauthProvider sends an access token from the OAuth client credentials
+// grant as a bearer token, fetching a new one shortly before it expires.
+type oauthProvider struct {
+	tls    tlsSettings
+	tokens *tokenSource
+}
+
+func newOAuthFromConfig(cfg config.BenchmarkConfig) (Provider, error) {
+	if cfg.OAuthTokenURL == "" || cfg.OAuthClientID == "" || cfg.OAuthClientSecret == "" {
+		return nil, errors.New("a token URL, client ID and client secret are required")
+	}
+	settings, err := newTLSSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &oauthProvider{
+		tls: settings,
+		tokens: &tokenSource{
+			tokenURL:     cfg.OAuthTokenURL,
+			clientID:     cfg.OAuthClientID,
+			clientSecret: cfg.OAuthClientSecret,
+			scopes:       cfg.OAuthScopes,
+			audience:     cfg.OAuthAudience,
+			httpClient:   &http.Client{Timeout: tokenRequestTimeout},
+			now:          time.Now,
+		},
+	}, nil
+}
+
+func (p *oauthProvider) Name() string { return ProviderOAuth }
+
+func (p *oauthProvider) Apply(opts *client.Options) error {
+	p.tls.apply(opts)
+	opts.Credentials = client.NewAPIKeyDynamicCredentials(p.tokens.Token)
+	return nil
+}
+
+// tokenSource caches an access token and fetches a new one when it is about
+// to expire. It is shared by every client the provider is applied to.
+type tokenSource struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	scopes       []string
+	audience     string
+	httpClient   *http.Client
+	now          func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// tokenResponse is the token endpoint's reply (RFC 6749 section 5.1).
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Token returns a valid access token, fetching one if the cached token is
+// missing or within tokenRefreshMargin of expiry.
+func (s *tokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && s.now().Add(tokenRefreshMargin).Before(s.expires) {
+		return s.token, nil
+	}
+
+	resp, err := s.fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch OAuth token: %w", err)
+	}
+	lifetime := time.Duration(resp.ExpiresIn) * time.Second
+	if lifetime <= 0 {
+		lifetime = defaultTokenLifetime
+	}
+	s.token, s.expires = resp.AccessToken, s.now().Add(lifetime)
+	return s.token, nil
+}
+
+// fetch requests a token with the client credentials grant.
+func (s *tokenSource) fetch(ctx context.Context) (*tokenResponse, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.scopes) > 0 {
+		form.Set("scope", strings.Join(s.scopes, " "))
+	}
+	if s.audience != "" {
+		form.Set("audience", s.audience)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(s.clientID), url.QueryEscape(s.clientSecret))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("token endpoint returned no access token")
+	}
+	return &token, nil
+}
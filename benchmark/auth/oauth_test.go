@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenSource(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "benchmark" || secret != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.ParseForm() != nil || r.PostForm.Get("grant_type") != "client_credentials" ||
+			r.PostForm.Get("scope") != "temporal:read temporal:write" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		n := requests.Add(1)
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":300}`, n)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	s := &tokenSource{
+		tokenURL:     server.URL,
+		clientID:     "benchmark",
+		clientSecret: "s3cret",
+		scopes:       []string{"temporal:read", "temporal:write"},
+		httpClient:   server.Client(),
+		now:          func() time.Time { return now },
+	}
+
+	token, err := s.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token)
+
+	// The cached token is reused until it is within the refresh margin of expiry
+	now = now.Add(3 * time.Minute)
+	token, err = s.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "token-1", token)
+
+	now = now.Add(90 * time.Second)
+	token, err = s.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "token-2", token)
+
+	s.clientSecret = "wrong"
+	now = now.Add(time.Hour)
+	_, err = s.Token(context.Background())
+	require.ErrorContains(t, err, "token endpoint returned 401 Unauthorized")
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// sidecarTokenProvider sends the token a sidecar keeps in a file as a bearer
+// token, re-reading the file whenever it changes.
+type sidecarTokenProvider struct {
+	tls    tlsSettings
+	tokens *fileToken
+}
+
+func newSidecarTokenFromConfig(cfg config.BenchmarkConfig) (Provider, error) {
+	if cfg.AuthTokenFile == "" {
+		return nil, errors.New("a token file is required")
+	}
+	settings, err := newTLSSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tokens := &fileToken{path: cfg.AuthTokenFile}
+	if _, err := tokens.Token(context.Background()); err != nil {
+		return nil, err
+	}
+	return &sidecarTokenProvider{tls: settings, tokens: tokens}, nil
+}
+
+func (p *sidecarTokenProvider) Name() string { return ProviderSidecarToken }
+
+func (p *sidecarTokenProvider) Apply(opts *client.Options) error {
+	p.tls.apply(opts)
+	opts.Credentials = client.NewAPIKeyDynamicCredentials(p.tokens.Token)
+	return nil
+}
+
+// fileToken caches a token file's contents until its modification time changes.
+type fileToken struct {
+	path string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+}
+
+// Token returns the file's trimmed contents, re-reading it if it changed.
+func (f *fileToken) Token(context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	if f.token != "" && info.ModTime().Equal(f.modTime) {
+		return f.token, nil
+	}
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", f.path)
+	}
+	f.token, f.modTime = token, info.ModTime()
+	return f.token, nil
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// unsignedPayload is the payload hash of requests whose body is not signed;
+// gRPC bodies are only framed once the call is on the wire.
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// sigV4Headers are the signing headers copied onto each call's metadata.
+var sigV4Headers = []string{"Authorization", "X-Amz-Date", "X-Amz-Security-Token", "X-Amz-Content-Sha256"}
+
+// sigV4Provider signs every call with AWS SigV4, for frontends behind an
+// IAM-authenticated proxy such as VPC Lattice.
+type sigV4Provider struct {
+	tls         tlsSettings
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	region      string
+	service     string
+	now         func() time.Time
+}
+
+func newSigV4FromConfig(cfg config.BenchmarkConfig) (Provider, error) {
+	if cfg.SigV4Service == "" {
+		return nil, errors.New("a signing service name is required")
+	}
+	settings, err := newTLSSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	region := cfg.SigV4Region
+	if region == "" {
+		region = awsCfg.Region
+	}
+	if region == "" {
+		return nil, errors.New("a signing region is required")
+	}
+	return &sigV4Provider{
+		tls:         settings,
+		credentials: awsCfg.Credentials,
+		signer:      v4.NewSigner(),
+		region:      region,
+		service:     cfg.SigV4Service,
+		now:         time.Now,
+	}, nil
+}
+
+func (p *sigV4Provider) Name() string { return ProviderSigV4 }
+
+func (p *sigV4Provider) Apply(opts *client.Options) error {
+	p.tls.apply(opts)
+	opts.ConnectionOptions.DialOptions = append(opts.ConnectionOptions.DialOptions,
+		grpc.WithChainUnaryInterceptor(p.intercept))
+	return nil
+}
+
+// intercept signs a POST of the call's method to the connection's authority,
+// as the proxy sees it, and sends the signature as call metadata.
+func (p *sigV4Provider) intercept(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	headers, err := p.sign(ctx, authority(cc.Target()), method)
+	if err != nil {
+		return err
+	}
+	return invoker(metadata.AppendToOutgoingContext(ctx, headers...), method, req, reply, cc, opts...)
+}
+
+// sign returns the SigV4 headers for a call of method on host as metadata
+// key/value pairs.
+func (p *sigV4Provider) sign(ctx context.Context, host, method string) ([]string, error) {
+	creds, err := p.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+host+method, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signing request: %w", err)
+	}
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+	if err := p.signer.SignHTTP(ctx, creds, req, unsignedPayload, p.service, p.region, p.now()); err != nil {
+		return nil, fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	var kv []string
+	for _, name := range sigV4Headers {
+		if v := req.Header.Get(name); v != "" {
+			kv = append(kv, strings.ToLower(name), v)
+		}
+	}
+	return kv, nil
+}
+
+// authority strips any resolver scheme from a gRPC dial target, leaving the
+// host[:port] sent as the call's :authority.
+func authority(target string) string {
+	if i := strings.Index(target, ":///"); i >= 0 {
+		return target[i+len(":///"):]
+	}
+	return target
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigV4Sign(t *testing.T) {
+	p := &sigV4Provider{
+		credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "secret", SessionToken: "session"}, nil
+		}),
+		signer:  v4.NewSigner(),
+		region:  "us-east-1",
+		service: "vpc-lattice-svcs",
+		now:     func() time.Time { return time.Date(2026, 1, 13, 20, 0, 0, 0, time.UTC) },
+	}
+
+	kv, err := p.sign(context.Background(), "temporal.internal:7233", "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution")
+	require.NoError(t, err)
+	headers := map[string]string{}
+	for i := 0; i < len(kv); i += 2 {
+		headers[kv[i]] = kv[i+1]
+	}
+	require.Equal(t, "20260113T200000Z", headers["x-amz-date"])
+	require.Equal(t, "session", headers["x-amz-security-token"])
+	require.Equal(t, unsignedPayload, headers["x-amz-content-sha256"])
+	require.True(t, strings.HasPrefix(headers["authorization"],
+		"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20260113/us-east-1/vpc-lattice-svcs/aws4_request"))
+}
+
+func TestAuthority(t *testing.T) {
+	require.Equal(t, "temporal.internal:7233", authority("temporal.internal:7233"))
+	require.Equal(t, "temporal.internal:7233", authority("dns:///temporal.internal:7233"))
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// mtlsProvider presents a client certificate.
+type mtlsProvider struct {
+	tls  tlsSettings
+	cert tls.Certificate
+}
+
+func newMTLSFromConfig(cfg config.BenchmarkConfig) (Provider, error) {
+	if (cfg.TLSCertFile == "" && cfg.TLSCertPEM == nil) || (cfg.TLSKeyFile == "" && cfg.TLSKeyPEM == nil) {
+		return nil, errors.New("a client certificate and key file are required")
+	}
+	if cfg.TLSDisabled {
+		return nil, errors.New("mTLS cannot be used with TLS disabled")
+	}
+	settings, err := newTLSSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	certPEM, err := readPEM(cfg.TLSCertPEM, cfg.TLSCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client certificate: %w", err)
+	}
+	keyPEM, err := readPEM(cfg.TLSKeyPEM, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client key: %w", err)
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	return &mtlsProvider{tls: settings, cert: cert}, nil
+}
+
+func (p *mtlsProvider) Name() string { return ProviderMTLS }
+
+func (p *mtlsProvider) Apply(opts *client.Options) error {
+	p.tls.apply(opts)
+	opts.Credentials = client.NewMTLSCredentials(p.cert)
+	return nil
+}
+
+// apiKeyProvider sends a static API key as a bearer token.
+type apiKeyProvider struct {
+	tls tlsSettings
+	key string
+}
+
+func newAPIKeyFromConfig(cfg config.BenchmarkConfig) (Provider, error) {
+	if cfg.APIKey == "" {
+		return nil, errors.New("an API key is required")
+	}
+	settings, err := newTLSSettings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &apiKeyProvider{tls: settings, key: cfg.APIKey}, nil
+}
+
+func (p *apiKeyProvider) Name() string { return ProviderAPIKey }
+
+func (p *apiKeyProvider) Apply(opts *client.Options) error {
+	p.tls.apply(opts)
+	opts.Credentials = client.NewAPIKeyStaticCredentials(p.key)
+	return nil
+}
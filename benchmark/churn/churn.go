@@ -0,0 +1,54 @@
+// Package churn restarts history service tasks on a schedule during a
+// benchmark run. The shards a stopped task owned move to the remaining history
+// hosts, which must re-acquire them (taking over their range IDs in DSQL), and
+// move again when the replacement task joins the ring.
+package churn
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// Restarter stops running tasks of the history service, which its
+// scheduler replaces.
+type Restarter interface {
+	// StopTasks stops count running tasks and returns their IDs
+	StopTasks(ctx context.Context, count int) ([]string, error)
+}
+
+// Experiment restarts Tasks history tasks at each offset from the start of
+// the run.
+type Experiment struct {
+	Offsets   []time.Duration
+	Tasks     int
+	Restarter Restarter
+}
+
+// Run applies each restart at its offset from start until the schedule is
+// done or ctx is cancelled, and returns one restart per offset reached. The
+// latency spike of each is measured by the runner.
+func (e *Experiment) Run(ctx context.Context, start time.Time) []results.ShardRestart {
+	var restarts []results.ShardRestart
+	for _, offset := range e.Offsets {
+		if !generator.SleepUntil(ctx, start.Add(offset)) {
+			break
+		}
+
+		restart := results.ShardRestart{Time: time.Now(), Offset: offset.String()}
+		slog.Info("Restarting history tasks", "offset", offset, "tasks", e.Tasks)
+		tasks, err := e.Restarter.StopTasks(ctx, e.Tasks)
+		restart.Tasks = tasks
+		if err != nil {
+			slog.Warn("Failed to restart history tasks", "offset", offset, "stopped", len(tasks), "error", err)
+			restart.Error = err.Error()
+		} else {
+			slog.Info("Stopped history tasks", "offset", offset, "tasks", tasks)
+		}
+		restarts = append(restarts, restart)
+	}
+	return restarts
+}
@@ -0,0 +1,59 @@
+package churn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRestarter names the tasks it stops after the restart they belong to,
+// failing the restarts listed in fail.
+type fakeRestarter struct {
+	restarts int
+	fail     map[int]bool
+}
+
+func (r *fakeRestarter) StopTasks(_ context.Context, count int) ([]string, error) {
+	r.restarts++
+	if r.fail[r.restarts] {
+		return nil, errors.New("service has 1 running task")
+	}
+	tasks := make([]string, count)
+	for i := range tasks {
+		tasks[i] = fmt.Sprintf("restart-%d-task-%d", r.restarts, i)
+	}
+	return tasks, nil
+}
+
+func TestExperiment_RunRestartsOnSchedule(t *testing.T) {
+	exp := &Experiment{
+		Offsets:   []time.Duration{0, 20 * time.Millisecond, 40 * time.Millisecond},
+		Tasks:     2,
+		Restarter: &fakeRestarter{fail: map[int]bool{2: true}},
+	}
+
+	start := time.Now()
+	restarts := exp.Run(context.Background(), start)
+
+	require.Len(t, restarts, 3)
+	require.Equal(t, []string{"restart-1-task-0", "restart-1-task-1"}, restarts[0].Tasks)
+	require.Equal(t, "20ms", restarts[1].Offset)
+	require.Empty(t, restarts[1].Tasks)
+	require.Equal(t, "service has 1 running task", restarts[1].Error)
+	require.Len(t, restarts[2].Tasks, 2)
+	require.False(t, restarts[2].Time.Before(start.Add(40*time.Millisecond)))
+}
+
+func TestExperiment_RunStopsOnCancel(t *testing.T) {
+	restarter := &fakeRestarter{}
+	exp := &Experiment{Offsets: []time.Duration{time.Hour}, Tasks: 1, Restarter: restarter}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Empty(t, exp.Run(ctx, time.Now()))
+	require.Zero(t, restarter.restarts)
+}
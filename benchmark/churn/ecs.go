@@ -0,0 +1,70 @@
+package churn
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+// stopReason is recorded on stopped tasks so they can be told apart from
+// crashes in the ECS console.
+const stopReason = "benchmark shard churn"
+
+// ecsRestarter stops tasks of an ECS service, which the service replaces.
+type ecsRestarter struct {
+	client  *ecs.Client
+	cluster string
+	service string
+}
+
+// NewECSRestarter creates a Restarter for an ECS service using the default
+// AWS credential chain (the task role when running on ECS).
+func NewECSRestarter(ctx context.Context, cluster, service string) (Restarter, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	return &ecsRestarter{
+		client:  ecs.NewFromConfig(cfg),
+		cluster: cluster,
+		service: service,
+	}, nil
+}
+
+// StopTasks stops count of the service's running tasks, chosen at random so
+// repeated restarts move different shards.
+func (r *ecsRestarter) StopTasks(ctx context.Context, count int) ([]string, error) {
+	out, err := r.client.ListTasks(ctx, &ecs.ListTasksInput{
+		Cluster:       aws.String(r.cluster),
+		ServiceName:   aws.String(r.service),
+		DesiredStatus: types.DesiredStatusRunning,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks of service %s: %w", r.service, err)
+	}
+	arns := out.TaskArns
+	if len(arns) <= count {
+		// Stopping every task would take the service down rather than move shards
+		return nil, fmt.Errorf("service %s has %d running tasks; stopping %d would leave none to take over its shards", r.service, len(arns), count)
+	}
+	rand.Shuffle(len(arns), func(i, j int) { arns[i], arns[j] = arns[j], arns[i] })
+
+	var stopped []string
+	for _, arn := range arns[:count] {
+		if _, err := r.client.StopTask(ctx, &ecs.StopTaskInput{
+			Cluster: aws.String(r.cluster),
+			Task:    aws.String(arn),
+			Reason:  aws.String(stopReason),
+		}); err != nil {
+			return stopped, fmt.Errorf("failed to stop task %s: %w", path.Base(arn), err)
+		}
+		stopped = append(stopped, path.Base(arn))
+	}
+	return stopped, nil
+}
@@ -0,0 +1,647 @@
+// Package cleanup provides workflow cleanup functionality for the benchmark runner.
+// Requirement 8.2: WHEN a benchmark completes, THE Benchmark_Runner SHALL terminate all running workflows
+// Requirement 8.4: IF cleanup fails, THEN THE Benchmark_Runner SHALL log the failure and provide manual cleanup instructions
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"golang.org/x/time/rate"
+)
+
+// CleanupError represents a cleanup operation failure with details.
+// Requirement 8.4: Provide detailed error information for cleanup failures.
+type CleanupError struct {
+	Namespace         string
+	Phase             string // "list", "terminate", "verify"
+	WorkflowsFound    int
+	WorkflowsFailed   int
+	TerminationErrors []TerminationError
+	Cause             error
+}
+
+func (e *CleanupError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("cleanup failed in %s phase for namespace %s: %v", e.Phase, e.Namespace, e.Cause)
+	}
+	return fmt.Sprintf("cleanup failed in %s phase for namespace %s: %d/%d workflows failed to terminate",
+		e.Phase, e.Namespace, e.WorkflowsFailed, e.WorkflowsFound)
+}
+
+func (e *CleanupError) Unwrap() error {
+	return e.Cause
+}
+
+// CleanupResult contains the results of a cleanup operation.
+type CleanupResult struct {
+	Namespace              string
+	WorkflowsFound         int
+	WorkflowsTerminated    int
+	WorkflowsAlreadyClosed int // Closed on their own between listing and termination
+	TerminationErrors      []TerminationError
+	SchedulesFound         int
+	SchedulesDeleted       int
+	BatchOperationsFound   int
+	BatchOperationsStopped int
+	ResourceErrors         []ResourceError
+	SweepPasses            int  // Number of list/terminate passes performed
+	WorkflowsSkipped       int  // Not attempted because the cleanup was cancelled or timed out
+	Partial                bool // True if the cleanup stopped before finishing
+	Duration               time.Duration
+	Success                bool
+}
+
+// TerminationError represents a failed workflow termination.
+type TerminationError struct {
+	WorkflowID string
+	RunID      string
+	Error      error
+}
+
+// Cleaner handles workflow cleanup operations.
+type Cleaner struct {
+	client  client.Client
+	metrics *cleanupMetrics
+	limits  Limits
+}
+
+// CleanerOption configures the cleaner.
+type CleanerOption func(*Cleaner)
+
+// WithRegistry exports cleanup progress metrics to the given Prometheus registry.
+func WithRegistry(registry prometheus.Registerer) CleanerOption {
+	return func(c *Cleaner) {
+		c.metrics = newCleanupMetrics(registry)
+	}
+}
+
+// WithLimits sets the termination concurrency and rate limit.
+// Zero fields are auto-sized from the number of workflows found.
+func WithLimits(limits Limits) CleanerOption {
+	return func(c *Cleaner) {
+		c.limits = limits
+	}
+}
+
+// NewCleaner creates a new Cleaner instance.
+func NewCleaner(c client.Client, opts ...CleanerOption) *Cleaner {
+	cleaner := &Cleaner{client: c}
+
+	for _, opt := range opts {
+		opt(cleaner)
+	}
+
+	return cleaner
+}
+
+// maxSweepPasses bounds how many list/terminate passes a cleanup performs.
+// Additional passes catch workflows started after the first listing, such as
+// continue-as-new successors and children spawned just before termination.
+const maxSweepPasses = 3
+
+// sweepPassDelay gives visibility time to reflect terminations between passes.
+const sweepPassDelay = 2 * time.Second
+
+// cleanupReason is recorded on workflows terminated by normal cleanup.
+const cleanupReason = "Benchmark cleanup - terminating workflows after benchmark completion"
+
+// CleanupNamespace removes everything a benchmark run leaves behind in the namespace:
+// schedules are deleted, running batch operations are stopped, and running workflows
+// (including the latest run of continue-as-new chains) are terminated.
+// Requirement 8.2: WHEN a benchmark completes, THE Benchmark_Runner SHALL terminate all running workflows
+// in the benchmark namespace.
+//
+// The whole cleanup is bounded by Limits.TotalTimeout. If it expires (or ctx is
+// cancelled) the cleanup stops and the result is marked Partial, with the work
+// done so far and the number of workflows skipped.
+func (c *Cleaner) CleanupNamespace(ctx context.Context, namespace string) (*CleanupResult, error) {
+	startTime := time.Now()
+	result := &CleanupResult{
+		Namespace:         namespace,
+		TerminationErrors: []TerminationError{},
+		ResourceErrors:    []ResourceError{},
+	}
+
+	timeouts := c.limits.resolve(0)
+	ctx, cancel := context.WithTimeout(ctx, timeouts.TotalTimeout)
+	defer cancel()
+
+	slog.Info("Starting cleanup", "namespace", namespace, "timeout", timeouts.TotalTimeout)
+
+	// Delete schedules first so they stop spawning new workflows
+	var resourceErrs []ResourceError
+	result.SchedulesFound, result.SchedulesDeleted, resourceErrs = c.deleteSchedules(ctx, namespace)
+	result.ResourceErrors = append(result.ResourceErrors, resourceErrs...)
+
+	// Stop batch operations so they don't act on the namespace while it is emptied
+	result.BatchOperationsFound, result.BatchOperationsStopped, resourceErrs = c.stopBatchOperations(ctx, namespace)
+	result.ResourceErrors = append(result.ResourceErrors, resourceErrs...)
+
+	// Visibility can list an execution again after it was terminated, so each
+	// pass only counts and terminates executions earlier passes did not list
+	seen := make(map[WorkflowExecution]struct{})
+
+sweep:
+	for pass := 1; pass <= maxSweepPasses; pass++ {
+		if pass > 1 {
+			if err := sleepContext(ctx, sweepPassDelay); err != nil {
+				slog.Warn("Cleanup cancelled between sweep passes", "namespace", namespace, "pass", pass)
+				break sweep
+			}
+		}
+
+		// List all running workflows in the namespace
+		workflows, err := c.listOpenWorkflows(ctx, namespace)
+		if err != nil {
+			if ctx.Err() != nil {
+				slog.Warn("Cleanup stopped while listing workflows", "namespace", namespace, "pass", pass, "error", err)
+				break
+			}
+			if pass == 1 {
+				// Requirement 8.4: IF cleanup fails, THEN THE Benchmark_Runner SHALL log the failure
+				// and provide manual cleanup instructions
+				logManualCleanupInstructions(namespace, err)
+				return result, fmt.Errorf("failed to list workflows for cleanup: %w", err)
+			}
+			slog.Warn("Failed to list workflows for follow-up sweep", "namespace", namespace, "pass", pass, "error", err)
+			break
+		}
+
+		result.SweepPasses = pass
+		workflows = slices.DeleteFunc(workflows, func(wf WorkflowExecution) bool {
+			_, ok := seen[wf]
+			seen[wf] = struct{}{}
+			return ok
+		})
+		if len(workflows) == 0 {
+			if pass == 1 && c.metrics != nil {
+				c.metrics.found.WithLabelValues(namespace).Set(0)
+			}
+			break
+		}
+
+		result.WorkflowsFound += len(workflows)
+		slog.Info("Found running workflows to terminate", "count", len(workflows), "pass", pass)
+
+		// Terminate workflows with progress logging
+		outcome := c.terminateWorkflows(ctx, namespace, workflows, cleanupReason)
+		result.WorkflowsTerminated += outcome.terminated
+		result.WorkflowsAlreadyClosed += outcome.alreadyClosed
+		result.WorkflowsSkipped += outcome.skipped
+		result.TerminationErrors = append(result.TerminationErrors, outcome.errors...)
+
+		// Retrying workflows that just failed to terminate won't help within this call
+		if len(outcome.errors) > 0 || outcome.skipped > 0 {
+			break
+		}
+	}
+
+	result.Duration = time.Since(startTime)
+	result.Partial = ctx.Err() != nil
+	result.Success = !result.Partial && len(result.TerminationErrors) == 0 && len(result.ResourceErrors) == 0
+
+	if result.Partial {
+		slog.Warn("Cleanup stopped before completion, reporting partial result",
+			"namespace", namespace,
+			"reason", context.Cause(ctx),
+			"workflows_terminated", result.WorkflowsTerminated,
+			"workflows_skipped", result.WorkflowsSkipped)
+	}
+
+	if result.Success && result.WorkflowsFound == 0 && result.SchedulesFound == 0 && result.BatchOperationsFound == 0 {
+		slog.Info("No running workflows found", "namespace", namespace)
+		return result, nil
+	}
+
+	// Log cleanup summary
+	c.logCleanupSummary(result)
+
+	// If there were errors, provide manual cleanup instructions
+	if !result.Success {
+		logManualCleanupInstructions(namespace, fmt.Errorf("%d workflows failed to terminate, %d skipped, %d other resources failed to clean up",
+			len(result.TerminationErrors), result.WorkflowsSkipped, len(result.ResourceErrors)))
+	}
+
+	return result, nil
+}
+
+// WorkflowExecution represents a workflow to be terminated.
+type WorkflowExecution struct {
+	WorkflowID string
+	RunID      string
+}
+
+// listOpenWorkflows retrieves all open workflows in the namespace.
+func (c *Cleaner) listOpenWorkflows(ctx context.Context, namespace string) ([]WorkflowExecution, error) {
+	var workflows []WorkflowExecution
+	var nextPageToken []byte
+
+	for {
+		callCtx, cancel := c.callContext(ctx)
+		resp, err := c.client.WorkflowService().ListOpenWorkflowExecutions(callCtx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+			Namespace:       namespace,
+			MaximumPageSize: 100,
+			NextPageToken:   nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list open workflows: %w", err)
+		}
+
+		for _, execution := range resp.Executions {
+			workflows = append(workflows, WorkflowExecution{
+				WorkflowID: execution.Execution.WorkflowId,
+				RunID:      execution.Execution.RunId,
+			})
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	return workflows, nil
+}
+
+// terminationOutcome summarises a batch of terminations.
+type terminationOutcome struct {
+	terminated    int
+	alreadyClosed int
+	skipped       int // Not attempted (or abandoned) because ctx was done
+	errors        []TerminationError
+}
+
+// terminateWorkflows terminates the given workflows.
+// Includes retry logic for transient failures. When ctx is done no further
+// workflows are dispatched, in-flight retries stop, and the remainder is
+// reported as skipped rather than as termination errors.
+func (c *Cleaner) terminateWorkflows(ctx context.Context, namespace string, workflows []WorkflowExecution, reason string) terminationOutcome {
+	var outcome terminationOutcome
+	var mu sync.Mutex
+
+	// Use a semaphore to limit concurrent terminations and a token bucket to
+	// cap the termination rate so large cleanups don't overload persistence
+	limits := c.limits.resolve(len(workflows))
+	const maxRetries = 3
+	sem := make(chan struct{}, limits.Concurrency)
+	limiter := rate.NewLimiter(rate.Limit(limits.RatePerSecond), limits.Concurrency)
+	var wg sync.WaitGroup
+
+	slog.Info("Terminating workflows",
+		"namespace", namespace,
+		"count", len(workflows),
+		"concurrency", limits.Concurrency,
+		"rate_per_second", limits.RatePerSecond,
+		"call_timeout", limits.CallTimeout)
+
+	// Report progress periodically so long cleanups don't look hung
+	progress := newProgressTracker(namespace, len(workflows), c.metrics)
+	progressCtx, stopProgress := context.WithCancel(ctx)
+	progressDone := make(chan struct{})
+	go func() {
+		defer close(progressDone)
+		progress.run(progressCtx, progressLogInterval)
+	}()
+	defer func() {
+		stopProgress()
+		<-progressDone
+	}()
+
+dispatch:
+	for i, wf := range workflows {
+		// Acquire semaphore, giving up on the remaining workflows once ctx is done
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			outcome.skipped += len(workflows) - i
+			mu.Unlock()
+			break dispatch
+		}
+
+		wg.Add(1)
+		go func(wf WorkflowExecution) {
+			defer wg.Done()
+			defer func() { <-sem }() // Release semaphore
+
+			// Retry logic for transient failures
+			var lastErr error
+			for attempt := 1; attempt <= maxRetries; attempt++ {
+				// Each attempt (including retries) consumes a rate limit token
+				if err := limiter.Wait(ctx); err != nil {
+					lastErr = err
+					break
+				}
+
+				err := c.terminateWorkflow(ctx, namespace, wf, reason)
+				if err == nil {
+					mu.Lock()
+					outcome.terminated++
+					mu.Unlock()
+					progress.recordTerminated()
+					return
+				}
+				if errors.Is(err, errAlreadyClosed) {
+					mu.Lock()
+					outcome.alreadyClosed++
+					mu.Unlock()
+					return
+				}
+
+				lastErr = err
+
+				// Check if error is retryable (transient) and the cleanup is still live
+				if !isRetryableError(err) || ctx.Err() != nil {
+					break
+				}
+
+				// Wait before retry with exponential backoff, abandoning on cancellation
+				if attempt < maxRetries {
+					if err := sleepContext(ctx, time.Duration(attempt*100)*time.Millisecond); err != nil {
+						break
+					}
+				}
+			}
+
+			// Attempts cut short by cancellation are skipped, not failed
+			if ctx.Err() != nil {
+				mu.Lock()
+				outcome.skipped++
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			outcome.errors = append(outcome.errors, TerminationError{
+				WorkflowID: wf.WorkflowID,
+				RunID:      wf.RunID,
+				Error:      lastErr,
+			})
+			mu.Unlock()
+			progress.recordError()
+		}(wf)
+	}
+
+	wg.Wait()
+	return outcome
+}
+
+// sleepContext waits for d or until ctx is done, returning ctx.Err() in the latter case.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// callContext derives a context bounded by the per-call timeout.
+func (c *Cleaner) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, c.limits.resolve(0).CallTimeout)
+}
+
+// errAlreadyClosed indicates the workflow (and any continue-as-new successor) had
+// already closed by the time termination was attempted.
+var errAlreadyClosed = errors.New("workflow already closed")
+
+// terminateWorkflow terminates a single workflow in the given namespace.
+// The namespace is set explicitly on the request so the cleaner works for any
+// namespace regardless of the namespace the underlying client was dialed with.
+// If the listed run has since continued-as-new, the chain's current run is terminated.
+func (c *Cleaner) terminateWorkflow(ctx context.Context, namespace string, wf WorkflowExecution, reason string) error {
+	err := c.terminateRun(ctx, namespace, wf.WorkflowID, wf.RunID, reason)
+	if isNotFound(err) && wf.RunID != "" {
+		// The listed run closed; an empty run ID targets the latest run in the chain
+		err = c.terminateRun(ctx, namespace, wf.WorkflowID, "", reason)
+	}
+	if isNotFound(err) {
+		return errAlreadyClosed
+	}
+	return err
+}
+
+// terminateRun issues a single TerminateWorkflowExecution request.
+func (c *Cleaner) terminateRun(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	callCtx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	_, err := c.client.WorkflowService().TerminateWorkflowExecution(callCtx, &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace: namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Reason:   reason,
+		Identity: cleanupIdentity,
+	})
+	return err
+}
+
+// isRetryableError determines if an error is transient and worth retrying.
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	errStr := err.Error()
+	// Retry on common transient errors
+	return strings.Contains(errStr, "unavailable") ||
+		strings.Contains(errStr, "deadline exceeded") ||
+		strings.Contains(errStr, "connection") ||
+		strings.Contains(errStr, "timeout")
+}
+
+// logCleanupSummary logs a summary of the cleanup operation.
+func (c *Cleaner) logCleanupSummary(result *CleanupResult) {
+	slog.Info("=== Cleanup Summary ===",
+		"namespace", result.Namespace,
+		"workflows_found", result.WorkflowsFound,
+		"workflows_terminated", result.WorkflowsTerminated,
+		"workflows_already_closed", result.WorkflowsAlreadyClosed,
+		"termination_errors", len(result.TerminationErrors),
+		"schedules_deleted", result.SchedulesDeleted,
+		"batch_operations_stopped", result.BatchOperationsStopped,
+		"resource_errors", len(result.ResourceErrors),
+		"sweep_passes", result.SweepPasses,
+		"workflows_skipped", result.WorkflowsSkipped,
+		"partial", result.Partial,
+		"duration", result.Duration,
+		"success", result.Success)
+
+	if !result.Success {
+		// Log first few errors for debugging
+		maxErrorsToLog := 5
+		for i, termErr := range result.TerminationErrors {
+			if i >= maxErrorsToLog {
+				slog.Warn("Additional termination errors not shown",
+					"remaining", len(result.TerminationErrors)-maxErrorsToLog)
+				break
+			}
+			slog.Error("Failed to terminate workflow",
+				"workflow_id", termErr.WorkflowID,
+				"error", termErr.Error)
+		}
+		for _, resErr := range result.ResourceErrors {
+			slog.Error("Failed to clean up resource",
+				"kind", resErr.Kind,
+				"id", resErr.ID,
+				"error", resErr.Error)
+		}
+	}
+}
+
+// logManualCleanupInstructions logs instructions for manual cleanup.
+// Requirement 8.4: IF cleanup fails, THEN THE Benchmark_Runner SHALL log the failure
+// and provide manual cleanup instructions.
+func logManualCleanupInstructions(namespace string, err error) {
+	slog.Error("=== MANUAL CLEANUP REQUIRED ===",
+		"namespace", namespace,
+		"error", err)
+	slog.Info("To manually clean up workflows, run one of the following:")
+	slog.Info("Using tctl:",
+		"command", fmt.Sprintf("tctl --namespace %s workflow terminate --query 'ExecutionStatus=\"Running\"'", namespace))
+	slog.Info("Using temporal CLI:",
+		"command", fmt.Sprintf("temporal workflow terminate --namespace %s --query 'ExecutionStatus=\"Running\"'", namespace))
+	slog.Info("To list running workflows first:",
+		"command", fmt.Sprintf("temporal workflow list --namespace %s --query 'ExecutionStatus=\"Running\"'", namespace))
+	slog.Info("To terminate a specific workflow:",
+		"command", fmt.Sprintf("temporal workflow terminate --namespace %s --workflow-id <WORKFLOW_ID>", namespace))
+}
+
+// GetRunningWorkflowCount returns the count of running workflows in a namespace.
+func (c *Cleaner) GetRunningWorkflowCount(ctx context.Context, namespace string) (int, error) {
+	workflows, err := c.listOpenWorkflows(ctx, namespace)
+	if err != nil {
+		return 0, err
+	}
+	return len(workflows), nil
+}
+
+// VerifyCleanup checks that no running workflows remain in the namespace.
+// Requirement 8.2: Verify cleanup completeness.
+func (c *Cleaner) VerifyCleanup(ctx context.Context, namespace string) error {
+	count, err := c.GetRunningWorkflowCount(ctx, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to verify cleanup: %w", err)
+	}
+
+	if count > 0 {
+		return fmt.Errorf("cleanup incomplete: %d workflows still running in namespace %s", count, namespace)
+	}
+
+	slog.Info("Cleanup verified: no running workflows", "namespace", namespace)
+	return nil
+}
+
+// GenerateCleanupScript generates a shell script for manual cleanup.
+// Requirement 8.4: Provide manual cleanup instructions.
+func GenerateCleanupScript(namespace string, failedWorkflows []TerminationError) string {
+	var sb strings.Builder
+
+	sb.WriteString("#!/bin/bash\n")
+	sb.WriteString("# Benchmark Cleanup Script\n")
+	sb.WriteString(fmt.Sprintf("# Generated for namespace: %s\n", namespace))
+	sb.WriteString(fmt.Sprintf("# Timestamp: %s\n\n", time.Now().Format(time.RFC3339)))
+
+	sb.WriteString("NAMESPACE=\"" + namespace + "\"\n\n")
+
+	sb.WriteString("echo \"Starting cleanup for namespace: $NAMESPACE\"\n\n")
+
+	// If there are specific failed workflows, terminate them individually
+	if len(failedWorkflows) > 0 {
+		sb.WriteString("# Terminate specific failed workflows\n")
+		for _, wf := range failedWorkflows {
+			sb.WriteString(fmt.Sprintf("echo \"Terminating workflow: %s\"\n", wf.WorkflowID))
+			sb.WriteString(fmt.Sprintf("temporal workflow terminate --namespace \"$NAMESPACE\" --workflow-id \"%s\" --run-id \"%s\" || true\n",
+				wf.WorkflowID, wf.RunID))
+		}
+		sb.WriteString("\n")
+	}
+
+	// Add bulk termination command
+	sb.WriteString("# Terminate all remaining running workflows\n")
+	sb.WriteString("echo \"Terminating all running workflows...\"\n")
+	sb.WriteString("temporal workflow terminate --namespace \"$NAMESPACE\" --query 'ExecutionStatus=\"Running\"' || true\n\n")
+
+	// Add verification
+	sb.WriteString("# Verify cleanup\n")
+	sb.WriteString("echo \"Verifying cleanup...\"\n")
+	sb.WriteString("REMAINING=$(temporal workflow list --namespace \"$NAMESPACE\" --query 'ExecutionStatus=\"Running\"' --limit 1 2>/dev/null | wc -l)\n")
+	sb.WriteString("if [ \"$REMAINING\" -gt 0 ]; then\n")
+	sb.WriteString("    echo \"WARNING: Some workflows may still be running\"\n")
+	sb.WriteString("    temporal workflow list --namespace \"$NAMESPACE\" --query 'ExecutionStatus=\"Running\"'\n")
+	sb.WriteString("else\n")
+	sb.WriteString("    echo \"Cleanup complete: no running workflows found\"\n")
+	sb.WriteString("fi\n")
+
+	return sb.String()
+}
+
+// CleanupWithRetry performs cleanup with configurable retry attempts.
+// Requirement 8.4: Handle cleanup failures gracefully.
+func (c *Cleaner) CleanupWithRetry(ctx context.Context, namespace string, maxAttempts int) (*CleanupResult, error) {
+	var lastResult *CleanupResult
+	var lastErr error
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if attempt > 1 {
+			slog.Info("Cleanup retry attempt", "attempt", attempt, "max_attempts", maxAttempts, "namespace", namespace)
+			// Wait before retry
+			select {
+			case <-ctx.Done():
+				return lastResult, ctx.Err()
+			case <-time.After(time.Duration(attempt) * time.Second):
+			}
+		}
+
+		result, err := c.CleanupNamespace(ctx, namespace)
+		lastResult = result
+		lastErr = err
+
+		if err == nil && result.Success {
+			return result, nil
+		}
+
+		// If we got partial success, continue to next attempt
+		if result != nil && result.WorkflowsTerminated > 0 {
+			slog.Info("Partial cleanup success, retrying remaining",
+				"terminated", result.WorkflowsTerminated,
+				"found", result.WorkflowsFound)
+		}
+	}
+
+	// All attempts failed, provide comprehensive error
+	if lastResult != nil && len(lastResult.TerminationErrors) > 0 {
+		cleanupErr := &CleanupError{
+			Namespace:         namespace,
+			Phase:             "terminate",
+			WorkflowsFound:    lastResult.WorkflowsFound,
+			WorkflowsFailed:   len(lastResult.TerminationErrors),
+			TerminationErrors: lastResult.TerminationErrors,
+		}
+		logManualCleanupInstructions(namespace, cleanupErr)
+
+		// Generate and log cleanup script
+		script := GenerateCleanupScript(namespace, lastResult.TerminationErrors)
+		slog.Info("=== CLEANUP SCRIPT ===\n" + script + "\n======================")
+
+		return lastResult, cleanupErr
+	}
+
+	return lastResult, lastErr
+}
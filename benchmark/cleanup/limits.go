@@ -0,0 +1,72 @@
+// Package cleanup provides workflow cleanup functionality for the benchmark runner.
+package cleanup
+
+import "time"
+
+// Cleanup limit bounds. Concurrency and rate are scaled with the number of
+// workflows found so small cleanups stay gentle and large ones finish in
+// reasonable time without hammering DSQL.
+const (
+	MinConcurrency   = 10
+	MaxConcurrency   = 200
+	MinRatePerSecond = 50
+	MaxRatePerSecond = 2000
+
+	// workflowsPerWorker is the number of workflows each concurrent terminator
+	// is expected to handle when concurrency is auto-sized.
+	workflowsPerWorker = 500
+
+	// targetCleanupSeconds is the cleanup duration the auto-sized rate aims for.
+	targetCleanupSeconds = 300
+)
+
+// Default cleanup timeouts.
+const (
+	// DefaultCallTimeout bounds each individual list/terminate/delete RPC.
+	DefaultCallTimeout = 10 * time.Second
+
+	// DefaultTotalTimeout bounds an entire CleanupNamespace call. When it expires the
+	// cleanup stops and reports the partial result.
+	DefaultTotalTimeout = 15 * time.Minute
+)
+
+// Limits bounds how aggressively workflows are terminated and how long cleanup may take.
+// Zero values are auto-sized from the number of workflows found or use the defaults.
+type Limits struct {
+	Concurrency   int           // Maximum in-flight termination requests (0 = auto)
+	RatePerSecond float64       // Maximum terminations per second (0 = auto)
+	CallTimeout   time.Duration // Timeout for each RPC (0 = DefaultCallTimeout)
+	TotalTimeout  time.Duration // Timeout for the whole cleanup (0 = DefaultTotalTimeout)
+}
+
+// DefaultLimits returns limits sized for a cleanup of the given number of workflows.
+// For example 1,000 workflows use 10 workers at 50/s, while 100,000 workflows
+// use 200 workers at ~333/s.
+func DefaultLimits(workflowCount int) Limits {
+	concurrency := min(max(workflowCount/workflowsPerWorker, MinConcurrency), MaxConcurrency)
+	rate := min(max(float64(workflowCount)/targetCleanupSeconds, MinRatePerSecond), MaxRatePerSecond)
+	return Limits{
+		Concurrency:   concurrency,
+		RatePerSecond: rate,
+		CallTimeout:   DefaultCallTimeout,
+		TotalTimeout:  DefaultTotalTimeout,
+	}
+}
+
+// resolve fills unset limits with defaults for the given workflow count.
+func (l Limits) resolve(workflowCount int) Limits {
+	defaults := DefaultLimits(workflowCount)
+	if l.Concurrency <= 0 {
+		l.Concurrency = defaults.Concurrency
+	}
+	if l.RatePerSecond <= 0 {
+		l.RatePerSecond = defaults.RatePerSecond
+	}
+	if l.CallTimeout <= 0 {
+		l.CallTimeout = defaults.CallTimeout
+	}
+	if l.TotalTimeout <= 0 {
+		l.TotalTimeout = defaults.TotalTimeout
+	}
+	return l
+}
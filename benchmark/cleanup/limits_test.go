@@ -0,0 +1,64 @@
+package cleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func TestDefaultTotalTimeout_MatchesConfig(t *testing.T) {
+	// The config validates TTLs against the cleanup's default budget
+	require.Equal(t, config.DefaultCleanupTimeout, DefaultTotalTimeout)
+}
+
+func TestDefaultLimits_SmallCleanup(t *testing.T) {
+	limits := DefaultLimits(100)
+	require.Equal(t, MinConcurrency, limits.Concurrency)
+	require.Equal(t, float64(MinRatePerSecond), limits.RatePerSecond)
+}
+
+func TestDefaultLimits_LargeCleanup(t *testing.T) {
+	limits := DefaultLimits(100_000)
+	require.Equal(t, MaxConcurrency, limits.Concurrency)
+	require.InDelta(t, 333.33, limits.RatePerSecond, 0.01)
+}
+
+func TestDefaultLimits_Capped(t *testing.T) {
+	limits := DefaultLimits(10_000_000)
+	require.Equal(t, MaxConcurrency, limits.Concurrency)
+	require.Equal(t, float64(MaxRatePerSecond), limits.RatePerSecond)
+}
+
+func TestLimits_ResolveKeepsExplicitValues(t *testing.T) {
+	limits := Limits{Concurrency: 3}.resolve(100_000)
+	require.Equal(t, 3, limits.Concurrency)
+	require.InDelta(t, 333.33, limits.RatePerSecond, 0.01)
+
+	limits = Limits{RatePerSecond: 25}.resolve(100_000)
+	require.Equal(t, MaxConcurrency, limits.Concurrency)
+	require.Equal(t, 25.0, limits.RatePerSecond)
+}
+
+func TestLimits_ResolveTimeouts(t *testing.T) {
+	limits := Limits{}.resolve(100)
+	require.Equal(t, DefaultCallTimeout, limits.CallTimeout)
+	require.Equal(t, DefaultTotalTimeout, limits.TotalTimeout)
+
+	limits = Limits{CallTimeout: time.Second, TotalTimeout: time.Minute}.resolve(100)
+	require.Equal(t, time.Second, limits.CallTimeout)
+	require.Equal(t, time.Minute, limits.TotalTimeout)
+}
+
+func TestSleepContext_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	err := sleepContext(ctx, time.Hour)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, time.Since(start), time.Second)
+}
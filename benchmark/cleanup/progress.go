@@ -0,0 +1,169 @@
+// Package cleanup provides workflow cleanup functionality for the benchmark runner.
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// progressLogInterval is how often cleanup progress is logged while terminations run.
+const progressLogInterval = 10 * time.Second
+
+// cleanupMetrics holds the Prometheus collectors for cleanup progress.
+type cleanupMetrics struct {
+	found      *prometheus.GaugeVec
+	terminated *prometheus.CounterVec
+	errored    *prometheus.CounterVec
+	rate       *prometheus.GaugeVec
+	inProgress *prometheus.GaugeVec
+}
+
+// newCleanupMetrics creates cleanup collectors and registers them with the registry.
+// Collectors already registered by another Cleaner sharing the registry are reused.
+func newCleanupMetrics(registry prometheus.Registerer) *cleanupMetrics {
+	m := &cleanupMetrics{
+		found: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "benchmark_cleanup_workflows_found",
+			Help: "Running workflows found by the current cleanup",
+		}, []string{"namespace"}),
+		terminated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "benchmark_cleanup_workflows_terminated_total",
+			Help: "Workflows terminated by cleanup",
+		}, []string{"namespace"}),
+		errored: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "benchmark_cleanup_termination_errors_total",
+			Help: "Workflows that cleanup failed to terminate after retries",
+		}, []string{"namespace"}),
+		rate: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "benchmark_cleanup_termination_rate_per_second",
+			Help: "Average termination rate of the current cleanup",
+		}, []string{"namespace"}),
+		inProgress: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "benchmark_cleanup_in_progress",
+			Help: "1 while a cleanup is running for the namespace",
+		}, []string{"namespace"}),
+	}
+
+	m.found = registerOrExisting(registry, m.found)
+	m.terminated = registerOrExisting(registry, m.terminated)
+	m.errored = registerOrExisting(registry, m.errored)
+	m.rate = registerOrExisting(registry, m.rate)
+	m.inProgress = registerOrExisting(registry, m.inProgress)
+
+	return m
+}
+
+// registerOrExisting registers c, returning the already-registered collector on conflict.
+func registerOrExisting[T prometheus.Collector](registry prometheus.Registerer, c T) T {
+	if err := registry.Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(T); ok {
+				return existing
+			}
+		}
+		slog.Warn("Failed to register cleanup metric", "error", err)
+	}
+	return c
+}
+
+// progressTracker counts termination outcomes for a single cleanup run.
+type progressTracker struct {
+	namespace  string
+	total      int
+	startTime  time.Time
+	terminated atomic.Int64
+	errored    atomic.Int64
+	metrics    *cleanupMetrics
+}
+
+// newProgressTracker creates a tracker for a cleanup of total workflows.
+func newProgressTracker(namespace string, total int, metrics *cleanupMetrics) *progressTracker {
+	p := &progressTracker{
+		namespace: namespace,
+		total:     total,
+		startTime: time.Now(),
+		metrics:   metrics,
+	}
+	if metrics != nil {
+		metrics.found.WithLabelValues(namespace).Set(float64(total))
+		metrics.rate.WithLabelValues(namespace).Set(0)
+	}
+	return p
+}
+
+// recordTerminated records a successful termination.
+func (p *progressTracker) recordTerminated() {
+	p.terminated.Add(1)
+	if p.metrics != nil {
+		p.metrics.terminated.WithLabelValues(p.namespace).Inc()
+	}
+}
+
+// recordError records a workflow that could not be terminated.
+func (p *progressTracker) recordError() {
+	p.errored.Add(1)
+	if p.metrics != nil {
+		p.metrics.errored.WithLabelValues(p.namespace).Inc()
+	}
+}
+
+// rateAt returns the average termination rate (per second) as of now.
+func (p *progressTracker) rateAt(now time.Time) float64 {
+	elapsed := now.Sub(p.startTime).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(p.terminated.Load()) / elapsed
+}
+
+// logProgress logs a progress line and updates the rate gauge.
+func (p *progressTracker) logProgress(now time.Time) {
+	terminated := p.terminated.Load()
+	errored := p.errored.Load()
+	rate := p.rateAt(now)
+	remaining := int64(p.total) - terminated - errored
+
+	if p.metrics != nil {
+		p.metrics.rate.WithLabelValues(p.namespace).Set(rate)
+	}
+
+	attrs := []any{
+		"namespace", p.namespace,
+		"terminated", terminated,
+		"errors", errored,
+		"remaining", remaining,
+		"total", p.total,
+		"rate_per_second", rate,
+	}
+	if rate > 0 && remaining > 0 {
+		attrs = append(attrs, "eta", (time.Duration(float64(remaining)/rate) * time.Second).String())
+	}
+	slog.Info("Cleanup progress", attrs...)
+}
+
+// run logs progress on a fixed interval until ctx is done, then logs a final line.
+func (p *progressTracker) run(ctx context.Context, interval time.Duration) {
+	if p.metrics != nil {
+		p.metrics.inProgress.WithLabelValues(p.namespace).Set(1)
+		defer p.metrics.inProgress.WithLabelValues(p.namespace).Set(0)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			p.logProgress(time.Now())
+			return
+		case now := <-ticker.C:
+			p.logProgress(now)
+		}
+	}
+}
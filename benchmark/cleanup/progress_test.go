@@ -0,0 +1,43 @@
+package cleanup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressTracker_RecordsMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m := newCleanupMetrics(registry)
+
+	p := newProgressTracker("benchmark-1", 5, m)
+	p.recordTerminated()
+	p.recordTerminated()
+	p.recordError()
+
+	require.Equal(t, 5.0, testutil.ToFloat64(m.found.WithLabelValues("benchmark-1")))
+	require.Equal(t, 2.0, testutil.ToFloat64(m.terminated.WithLabelValues("benchmark-1")))
+	require.Equal(t, 1.0, testutil.ToFloat64(m.errored.WithLabelValues("benchmark-1")))
+}
+
+func TestProgressTracker_Rate(t *testing.T) {
+	p := newProgressTracker("benchmark-1", 100, nil)
+	for range 20 {
+		p.recordTerminated()
+	}
+
+	require.InDelta(t, 2.0, p.rateAt(p.startTime.Add(10*time.Second)), 0.001)
+	require.Equal(t, 0.0, p.rateAt(p.startTime))
+}
+
+func TestNewCleanupMetrics_SharedRegistry(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	first := newCleanupMetrics(registry)
+
+	// A second cleaner on the same registry must reuse the existing collectors
+	second := newCleanupMetrics(registry)
+	require.Same(t, first.terminated, second.terminated)
+}
@@ -0,0 +1,154 @@
+// Package cleanup provides workflow cleanup functionality for the benchmark runner.
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// cleanupIdentity identifies the benchmark cleaner in server-side audit fields.
+const cleanupIdentity = "benchmark-cleanup"
+
+// Resource kinds reported in ResourceError.
+const (
+	ResourceKindSchedule       = "schedule"
+	ResourceKindBatchOperation = "batch-operation"
+)
+
+// ResourceError represents a failure to remove a non-workflow resource.
+type ResourceError struct {
+	Kind  string // "schedule" or "batch-operation"
+	ID    string
+	Error error
+}
+
+// deleteSchedules deletes every schedule in the namespace so no new workflows are
+// started while the namespace is being emptied. Returns found, deleted and errors.
+func (c *Cleaner) deleteSchedules(ctx context.Context, namespace string) (int, int, []ResourceError) {
+	var scheduleIDs []string
+	var nextPageToken []byte
+
+	for {
+		callCtx, cancel := c.callContext(ctx)
+		resp, err := c.client.WorkflowService().ListSchedules(callCtx, &workflowservice.ListSchedulesRequest{
+			Namespace:       namespace,
+			MaximumPageSize: 100,
+			NextPageToken:   nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			if isUnsupported(err) {
+				slog.Info("Schedules not supported by cluster, skipping", "namespace", namespace)
+				return 0, 0, nil
+			}
+			return 0, 0, []ResourceError{{Kind: ResourceKindSchedule, Error: fmt.Errorf("failed to list schedules: %w", err)}}
+		}
+
+		for _, entry := range resp.Schedules {
+			scheduleIDs = append(scheduleIDs, entry.ScheduleId)
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	var deleted int
+	var errs []ResourceError
+	for _, id := range scheduleIDs {
+		callCtx, cancel := c.callContext(ctx)
+		_, err := c.client.WorkflowService().DeleteSchedule(callCtx, &workflowservice.DeleteScheduleRequest{
+			Namespace:  namespace,
+			ScheduleId: id,
+			Identity:   cleanupIdentity,
+		})
+		cancel()
+		if err != nil && !isNotFound(err) {
+			errs = append(errs, ResourceError{Kind: ResourceKindSchedule, ID: id, Error: err})
+			continue
+		}
+		deleted++
+	}
+
+	if len(scheduleIDs) > 0 {
+		slog.Info("Deleted schedules", "namespace", namespace, "found", len(scheduleIDs), "deleted", deleted)
+	}
+	return len(scheduleIDs), deleted, errs
+}
+
+// stopBatchOperations stops all running batch operations in the namespace.
+// Returns found (running), stopped and errors.
+func (c *Cleaner) stopBatchOperations(ctx context.Context, namespace string) (int, int, []ResourceError) {
+	var jobIDs []string
+	var nextPageToken []byte
+
+	for {
+		callCtx, cancel := c.callContext(ctx)
+		resp, err := c.client.WorkflowService().ListBatchOperations(callCtx, &workflowservice.ListBatchOperationsRequest{
+			Namespace:     namespace,
+			PageSize:      100,
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			if isUnsupported(err) {
+				slog.Info("Batch operations not supported by cluster, skipping", "namespace", namespace)
+				return 0, 0, nil
+			}
+			return 0, 0, []ResourceError{{Kind: ResourceKindBatchOperation, Error: fmt.Errorf("failed to list batch operations: %w", err)}}
+		}
+
+		for _, info := range resp.OperationInfo {
+			if info.State == enums.BATCH_OPERATION_STATE_RUNNING {
+				jobIDs = append(jobIDs, info.JobId)
+			}
+		}
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	var stopped int
+	var errs []ResourceError
+	for _, id := range jobIDs {
+		callCtx, cancel := c.callContext(ctx)
+		_, err := c.client.WorkflowService().StopBatchOperation(callCtx, &workflowservice.StopBatchOperationRequest{
+			Namespace: namespace,
+			JobId:     id,
+			Reason:    "Benchmark cleanup - stopping batch operations after benchmark completion",
+			Identity:  cleanupIdentity,
+		})
+		cancel()
+		if err != nil && !isNotFound(err) {
+			errs = append(errs, ResourceError{Kind: ResourceKindBatchOperation, ID: id, Error: err})
+			continue
+		}
+		stopped++
+	}
+
+	if len(jobIDs) > 0 {
+		slog.Info("Stopped batch operations", "namespace", namespace, "found", len(jobIDs), "stopped", stopped)
+	}
+	return len(jobIDs), stopped, errs
+}
+
+// isNotFound reports whether err indicates the target no longer exists.
+func isNotFound(err error) bool {
+	var notFound *serviceerror.NotFound
+	return errors.As(err, &notFound)
+}
+
+// isUnsupported reports whether err indicates the API is not implemented by the cluster.
+func isUnsupported(err error) bool {
+	var unimplemented *serviceerror.Unimplemented
+	return errors.As(err, &unimplemented)
+}
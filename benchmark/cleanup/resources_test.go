@@ -0,0 +1,145 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchpb "go.temporal.io/api/batch/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+func (s *fakeWorkflowService) ListSchedules(_ context.Context, req *workflowservice.ListSchedulesRequest, _ ...grpc.CallOption) (*workflowservice.ListSchedulesResponse, error) {
+	// Serve one schedule per page to exercise pagination
+	resp := &workflowservice.ListSchedulesResponse{}
+	i := len(req.NextPageToken)
+	if i < len(s.schedules) {
+		resp.Schedules = []*schedulepb.ScheduleListEntry{{ScheduleId: s.schedules[i]}}
+		if i+1 < len(s.schedules) {
+			resp.NextPageToken = make([]byte, i+1)
+		}
+	}
+	return resp, nil
+}
+
+func (s *fakeWorkflowService) DeleteSchedule(_ context.Context, req *workflowservice.DeleteScheduleRequest, _ ...grpc.CallOption) (*workflowservice.DeleteScheduleResponse, error) {
+	if err := s.resourceErrs[req.ScheduleId]; err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleted = append(s.deleted, req.ScheduleId)
+	return &workflowservice.DeleteScheduleResponse{}, nil
+}
+
+func (s *fakeWorkflowService) ListBatchOperations(context.Context, *workflowservice.ListBatchOperationsRequest, ...grpc.CallOption) (*workflowservice.ListBatchOperationsResponse, error) {
+	resp := &workflowservice.ListBatchOperationsResponse{}
+	for id, state := range s.batchOperations {
+		resp.OperationInfo = append(resp.OperationInfo, &batchpb.BatchOperationInfo{JobId: id, State: state})
+	}
+	return resp, nil
+}
+
+func (s *fakeWorkflowService) StopBatchOperation(_ context.Context, req *workflowservice.StopBatchOperationRequest, _ ...grpc.CallOption) (*workflowservice.StopBatchOperationResponse, error) {
+	if err := s.resourceErrs[req.JobId]; err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopped = append(s.stopped, req.JobId)
+	return &workflowservice.StopBatchOperationResponse{}, nil
+}
+
+func TestDeleteSchedules(t *testing.T) {
+	service := &fakeWorkflowService{
+		schedules: []string{"hourly", "gone", "broken"},
+		resourceErrs: map[string]error{
+			"gone":   serviceerror.NewNotFound("schedule not found"),
+			"broken": errors.New("unavailable"),
+		},
+	}
+	cleaner := NewCleaner(&fakeClient{service: service})
+
+	found, deleted, errs := cleaner.deleteSchedules(context.Background(), "bench")
+	require.Equal(t, 3, found, "every page listed")
+	require.Equal(t, 2, deleted, "a schedule already gone counts as deleted")
+	require.Equal(t, []string{"hourly"}, service.deleted)
+	require.Len(t, errs, 1)
+	require.Equal(t, ResourceKindSchedule, errs[0].Kind)
+	require.Equal(t, "broken", errs[0].ID)
+}
+
+func TestDeleteSchedules_Unsupported(t *testing.T) {
+	cleaner := NewCleaner(&fakeClient{service: &unsupportedService{}})
+
+	found, deleted, errs := cleaner.deleteSchedules(context.Background(), "bench")
+	require.Zero(t, found)
+	require.Zero(t, deleted)
+	require.Empty(t, errs)
+
+	found, stopped, errs := cleaner.stopBatchOperations(context.Background(), "bench")
+	require.Zero(t, found)
+	require.Zero(t, stopped)
+	require.Empty(t, errs)
+}
+
+func TestStopBatchOperations(t *testing.T) {
+	service := &fakeWorkflowService{
+		batchOperations: map[string]enumspb.BatchOperationState{
+			"running":   enumspb.BATCH_OPERATION_STATE_RUNNING,
+			"completed": enumspb.BATCH_OPERATION_STATE_COMPLETED,
+			"stuck":     enumspb.BATCH_OPERATION_STATE_RUNNING,
+		},
+		resourceErrs: map[string]error{"stuck": errors.New("unavailable")},
+	}
+	cleaner := NewCleaner(&fakeClient{service: service})
+
+	found, stopped, errs := cleaner.stopBatchOperations(context.Background(), "bench")
+	require.Equal(t, 2, found, "only running operations")
+	require.Equal(t, 1, stopped)
+	require.Equal(t, []string{"running"}, service.stopped)
+	require.Len(t, errs, 1)
+	require.Equal(t, ResourceKindBatchOperation, errs[0].Kind)
+	require.Equal(t, "stuck", errs[0].ID)
+}
+
+func TestCleanupNamespace_DedupesSweepPasses(t *testing.T) {
+	// The fake keeps listing terminated workflows, like lagging visibility
+	service := &fakeWorkflowService{
+		lastEvents:      map[string]time.Time{"wf-1": time.Now(), "wf-2": time.Now()},
+		schedules:       []string{"hourly"},
+		batchOperations: map[string]enumspb.BatchOperationState{"job": enumspb.BATCH_OPERATION_STATE_RUNNING},
+	}
+	cleaner := NewCleaner(&fakeClient{service: service})
+
+	result, err := cleaner.CleanupNamespace(context.Background(), "bench")
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Equal(t, 2, result.SweepPasses, "the second pass listed nothing new")
+	require.Equal(t, 2, result.WorkflowsFound)
+	require.Equal(t, 2, result.WorkflowsTerminated)
+	sort.Strings(service.terminated)
+	require.Equal(t, []string{"wf-1", "wf-2"}, service.terminated, "each execution terminated once")
+	require.Equal(t, 1, result.SchedulesDeleted)
+	require.Equal(t, 1, result.BatchOperationsStopped)
+}
+
+// unsupportedService is a cluster without schedules or batch operations.
+type unsupportedService struct {
+	workflowservice.WorkflowServiceClient
+}
+
+func (unsupportedService) ListSchedules(context.Context, *workflowservice.ListSchedulesRequest, ...grpc.CallOption) (*workflowservice.ListSchedulesResponse, error) {
+	return nil, serviceerror.NewUnimplemented("schedules disabled")
+}
+
+func (unsupportedService) ListBatchOperations(context.Context, *workflowservice.ListBatchOperationsRequest, ...grpc.CallOption) (*workflowservice.ListBatchOperationsResponse, error) {
+	return nil, serviceerror.NewUnimplemented("batch operations disabled")
+}
@@ -0,0 +1,84 @@
+package cleanup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.temporal.io/sdk/converter"
+)
+
+// MaxStaleChecks bounds how many open workflows one stale-run check inspects.
+const MaxStaleChecks = 10000
+
+// staleReason is recorded on workflows of stale runs terminated by workers.
+const staleReason = "Benchmark stale run - the workflow's run is no longer registered as active"
+
+// StaleWorkflow is an open workflow of a run that is no longer active.
+type StaleWorkflow struct {
+	WorkflowID string
+	RunID      string
+	Run        string // Benchmark run that started the workflow
+}
+
+// StaleResult contains the outcome of a stale-run check.
+type StaleResult struct {
+	Checked    int // Open workflows inspected
+	Stale      []StaleWorkflow
+	Untracked  int // Open workflows without a run memo (child workflows, or unregistered generators)
+	Terminated int
+	Errors     []TerminationError
+}
+
+// FindStaleWorkflows finds open workflows in namespace started before cutoff
+// whose run, read from the memo field runMemoKey, is not active. Workflows
+// without the memo field are counted as untracked, never as stale.
+func (c *Cleaner) FindStaleWorkflows(ctx context.Context, namespace, runMemoKey string, active map[string]bool, cutoff time.Time) (*StaleResult, error) {
+	executions, err := c.listOpenExecutionsStartedBefore(ctx, namespace, cutoff, MaxStaleChecks)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StaleResult{Checked: len(executions)}
+	for _, execution := range executions {
+		payload := execution.GetMemo().GetFields()[runMemoKey]
+		var run string
+		if payload == nil || converter.GetDefaultDataConverter().FromPayload(payload, &run) != nil || run == "" {
+			result.Untracked++
+			continue
+		}
+		if !active[run] {
+			result.Stale = append(result.Stale, StaleWorkflow{
+				WorkflowID: execution.GetExecution().GetWorkflowId(),
+				RunID:      execution.GetExecution().GetRunId(),
+				Run:        run,
+			})
+		}
+	}
+
+	if len(result.Stale) > 0 {
+		slog.Warn("Found workflows of stale benchmark runs",
+			"namespace", namespace,
+			"count", len(result.Stale),
+			"checked", result.Checked,
+			"untracked", result.Untracked)
+	}
+	return result, nil
+}
+
+// TerminateStaleWorkflows terminates the stale workflows in result, recording
+// a reason that distinguishes them from normal cleanup.
+func (c *Cleaner) TerminateStaleWorkflows(ctx context.Context, namespace string, result *StaleResult) {
+	workflows := make([]WorkflowExecution, 0, len(result.Stale))
+	for _, wf := range result.Stale {
+		workflows = append(workflows, WorkflowExecution{WorkflowID: wf.WorkflowID, RunID: wf.RunID})
+	}
+	if len(workflows) == 0 {
+		return
+	}
+
+	outcome := c.terminateWorkflows(ctx, namespace, workflows, staleReason)
+	result.Terminated = outcome.terminated
+	result.Errors = outcome.errors
+	slog.Info("Terminated workflows of stale benchmark runs", "namespace", namespace, "terminated", outcome.terminated, "errors", len(outcome.errors))
+}
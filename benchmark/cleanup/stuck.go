@@ -0,0 +1,177 @@
+// Package cleanup provides workflow cleanup functionality for the benchmark runner.
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	filterpb "go.temporal.io/api/filter/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxStuckChecks bounds how many open workflows have their history inspected
+// when looking for stuck workflows, since each check is a history read.
+const MaxStuckChecks = 1000
+
+// stuckReason is recorded on stuck workflows terminated separately from cleanup.
+const stuckReason = "Benchmark stuck workflow - no history progress within the stuck threshold"
+
+// StuckWorkflow is an open workflow whose most recent history event is older
+// than the stuck threshold.
+type StuckWorkflow struct {
+	WorkflowID    string
+	RunID         string
+	LastEventTime time.Time
+	LastEventType string
+}
+
+// StuckResult contains the outcome of a stuck workflow check.
+type StuckResult struct {
+	Checked    int // Open workflows whose history was inspected
+	Stuck      []StuckWorkflow
+	Terminated int
+	Errors     []TerminationError
+}
+
+// FindStuckWorkflows finds open workflows in namespace that have made no
+// progress for threshold: visibility is queried for open workflows started
+// before the cutoff, and the latest history event of up to MaxStuckChecks of
+// them is compared against it.
+func (c *Cleaner) FindStuckWorkflows(ctx context.Context, namespace string, threshold time.Duration) (*StuckResult, error) {
+	cutoff := time.Now().Add(-threshold)
+	candidates, err := c.listOpenWorkflowsStartedBefore(ctx, namespace, cutoff)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StuckResult{}
+	for _, wf := range candidates {
+		if ctx.Err() != nil {
+			break
+		}
+		result.Checked++
+
+		event, err := c.lastEvent(ctx, namespace, wf)
+		if err != nil {
+			if !isNotFound(err) {
+				slog.Warn("Failed to read workflow history", "workflow_id", wf.WorkflowID, "error", err)
+			}
+			continue
+		}
+		if eventTime := event.GetEventTime().AsTime(); eventTime.Before(cutoff) {
+			result.Stuck = append(result.Stuck, StuckWorkflow{
+				WorkflowID:    wf.WorkflowID,
+				RunID:         wf.RunID,
+				LastEventTime: eventTime,
+				LastEventType: event.GetEventType().String(),
+			})
+		}
+	}
+
+	if len(result.Stuck) > 0 {
+		slog.Warn("Found stuck workflows",
+			"namespace", namespace,
+			"count", len(result.Stuck),
+			"checked", result.Checked,
+			"threshold", threshold)
+	}
+	return result, nil
+}
+
+// TerminateStuckWorkflows terminates the stuck workflows in result, recording
+// a reason that distinguishes them from normal cleanup.
+func (c *Cleaner) TerminateStuckWorkflows(ctx context.Context, namespace string, result *StuckResult) {
+	workflows := make([]WorkflowExecution, 0, len(result.Stuck))
+	for _, wf := range result.Stuck {
+		workflows = append(workflows, WorkflowExecution{WorkflowID: wf.WorkflowID, RunID: wf.RunID})
+	}
+	if len(workflows) == 0 {
+		return
+	}
+
+	outcome := c.terminateWorkflows(ctx, namespace, workflows, stuckReason)
+	result.Terminated = outcome.terminated
+	result.Errors = outcome.errors
+	slog.Info("Terminated stuck workflows", "namespace", namespace, "terminated", outcome.terminated, "errors", len(outcome.errors))
+}
+
+// listOpenWorkflowsStartedBefore lists up to MaxStuckChecks open workflows
+// started before cutoff.
+func (c *Cleaner) listOpenWorkflowsStartedBefore(ctx context.Context, namespace string, cutoff time.Time) ([]WorkflowExecution, error) {
+	executions, err := c.listOpenExecutionsStartedBefore(ctx, namespace, cutoff, MaxStuckChecks)
+	if err != nil {
+		return nil, err
+	}
+	workflows := make([]WorkflowExecution, 0, len(executions))
+	for _, execution := range executions {
+		workflows = append(workflows, WorkflowExecution{
+			WorkflowID: execution.Execution.WorkflowId,
+			RunID:      execution.Execution.RunId,
+		})
+	}
+	return workflows, nil
+}
+
+// listOpenExecutionsStartedBefore lists up to limit open workflows started
+// before cutoff, with their visibility records.
+func (c *Cleaner) listOpenExecutionsStartedBefore(ctx context.Context, namespace string, cutoff time.Time, limit int) ([]*workflowpb.WorkflowExecutionInfo, error) {
+	var executions []*workflowpb.WorkflowExecutionInfo
+	var nextPageToken []byte
+
+	for len(executions) < limit {
+		callCtx, cancel := c.callContext(ctx)
+		resp, err := c.client.WorkflowService().ListOpenWorkflowExecutions(callCtx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+			Namespace:       namespace,
+			MaximumPageSize: 100,
+			NextPageToken:   nextPageToken,
+			StartTimeFilter: &filterpb.StartTimeFilter{
+				EarliestTime: timestamppb.New(time.Unix(0, 0)),
+				LatestTime:   timestamppb.New(cutoff),
+			},
+		})
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list open workflows: %w", err)
+		}
+		executions = append(executions, resp.Executions...)
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	if len(executions) > limit {
+		executions = executions[:limit]
+	}
+	return executions, nil
+}
+
+// lastEvent returns the most recent history event of the workflow run.
+func (c *Cleaner) lastEvent(ctx context.Context, namespace string, wf WorkflowExecution) (*historypb.HistoryEvent, error) {
+	callCtx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	resp, err := c.client.WorkflowService().GetWorkflowExecutionHistoryReverse(callCtx, &workflowservice.GetWorkflowExecutionHistoryReverseRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wf.WorkflowID,
+			RunId:      wf.RunID,
+		},
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return nil, fmt.Errorf("workflow %s has no history events", wf.WorkflowID)
+	}
+	return events[0], nil
+}
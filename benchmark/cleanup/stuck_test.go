@@ -0,0 +1,92 @@
+package cleanup
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeWorkflowService serves open workflows with fixed last event times.
+type fakeWorkflowService struct {
+	workflowservice.WorkflowServiceClient
+
+	lastEvents      map[string]time.Time
+	schedules       []string                               // Schedule IDs ListSchedules serves
+	batchOperations map[string]enumspb.BatchOperationState // Batch operation states by job ID
+	resourceErrs    map[string]error                       // DeleteSchedule/StopBatchOperation errors by ID
+
+	mu         sync.Mutex
+	terminated []string
+	reasons    []string
+	deleted    []string
+	stopped    []string
+}
+
+func (s *fakeWorkflowService) ListOpenWorkflowExecutions(_ context.Context, req *workflowservice.ListOpenWorkflowExecutionsRequest, _ ...grpc.CallOption) (*workflowservice.ListOpenWorkflowExecutionsResponse, error) {
+	resp := &workflowservice.ListOpenWorkflowExecutionsResponse{}
+	for id := range s.lastEvents {
+		resp.Executions = append(resp.Executions, &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: id, RunId: id + "-run"},
+		})
+	}
+	return resp, nil
+}
+
+func (s *fakeWorkflowService) GetWorkflowExecutionHistoryReverse(_ context.Context, req *workflowservice.GetWorkflowExecutionHistoryReverseRequest, _ ...grpc.CallOption) (*workflowservice.GetWorkflowExecutionHistoryReverseResponse, error) {
+	return &workflowservice.GetWorkflowExecutionHistoryReverseResponse{
+		History: &historypb.History{Events: []*historypb.HistoryEvent{{
+			EventTime: timestamppb.New(s.lastEvents[req.Execution.WorkflowId]),
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+		}}},
+	}, nil
+}
+
+func (s *fakeWorkflowService) TerminateWorkflowExecution(_ context.Context, req *workflowservice.TerminateWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.TerminateWorkflowExecutionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.terminated = append(s.terminated, req.WorkflowExecution.WorkflowId)
+	s.reasons = append(s.reasons, req.Reason)
+	return &workflowservice.TerminateWorkflowExecutionResponse{}, nil
+}
+
+// fakeClient exposes a fake workflow service through client.Client.
+type fakeClient struct {
+	client.Client
+	service workflowservice.WorkflowServiceClient
+}
+
+func (c *fakeClient) WorkflowService() workflowservice.WorkflowServiceClient {
+	return c.service
+}
+
+func TestFindStuckWorkflows(t *testing.T) {
+	service := &fakeWorkflowService{lastEvents: map[string]time.Time{
+		"stuck":  time.Now().Add(-time.Hour),
+		"recent": time.Now(),
+	}}
+	cleaner := NewCleaner(&fakeClient{service: service})
+
+	result, err := cleaner.FindStuckWorkflows(context.Background(), "bench", 10*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Checked)
+	require.Len(t, result.Stuck, 1)
+	require.Equal(t, "stuck", result.Stuck[0].WorkflowID)
+	require.Equal(t, "stuck-run", result.Stuck[0].RunID)
+	require.Equal(t, "WorkflowTaskScheduled", result.Stuck[0].LastEventType)
+
+	cleaner.TerminateStuckWorkflows(context.Background(), "bench", result)
+	require.Equal(t, 1, result.Terminated)
+	require.Equal(t, []string{"stuck"}, service.terminated)
+	require.Equal(t, []string{stuckReason}, service.reasons)
+}
@@ -0,0 +1,82 @@
+// Package main provides the entry point for the Temporal benchmark runner.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/robfig/cron"
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/runner"
+)
+
+// daemonNamespace is used by daemon mode when no namespace is configured, so
+// scheduled runs reuse one namespace instead of creating a new one every run.
+const daemonNamespace = runner.NamespacePrefix + "daemon"
+
+// runDaemon runs the configured benchmark on a cron schedule until ctx is cancelled,
+// publishing every result to the sinks. A failed run is logged and the daemon waits
+// for the next scheduled run. Runs never overlap: slots missed while a run is still
+// in progress are skipped.
+func runDaemon(ctx context.Context, cfg config.BenchmarkConfig, temporalClient client.Client, metricsHandler metrics.MetricsHandler, sinks []results.Sink, control *runner.LoadControl, opts ...runner.RunnerOption) error {
+	schedule, err := cron.ParseStandard(cfg.DaemonSchedule)
+	if err != nil {
+		return fmt.Errorf("invalid daemon schedule %q: %w", cfg.DaemonSchedule, err)
+	}
+
+	if cfg.Namespace == "" {
+		cfg.Namespace = daemonNamespace
+	}
+
+	// Keep the metrics server up between runs so scrapes don't see gaps
+	if err := metricsHandler.StartServer(ctx, cfg.MetricsPort); err != nil {
+		return fmt.Errorf("failed to start metrics server: %w", err)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := metricsHandler.StopServer(shutdownCtx); err != nil {
+			slog.Warn("Failed to stop metrics server", "error", err)
+		}
+	}()
+
+	slog.Info("Starting daemon mode", "schedule", cfg.DaemonSchedule, "namespace", cfg.Namespace)
+
+	for run := 1; ; run++ {
+		next := schedule.Next(time.Now())
+		slog.Info("Next scheduled benchmark", "run", run, "at", next)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			slog.Info("Daemon stopping", "completed_runs", run-1)
+			return nil
+		case <-timer.C:
+		}
+
+		// Each run reports its own latency and throughput
+		metricsHandler.ResetStartTime()
+
+		startTime := time.Now()
+		result, _, err := runBenchmark(ctx, cfg, temporalClient, metricsHandler, sinks, control, nil, append(opts, runner.WithExternalMetricsServer())...)
+		switch {
+		case ctx.Err() != nil:
+			slog.Info("Daemon stopping: scheduled benchmark cancelled", "run", run)
+			return nil
+		case err != nil:
+			slog.Error("Scheduled benchmark failed", "run", run, "error", err)
+		default:
+			slog.Info("Scheduled benchmark completed",
+				"run", run,
+				"passed", result.Passed,
+				"duration", time.Since(startTime))
+		}
+	}
+}
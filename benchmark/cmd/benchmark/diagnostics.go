@@ -0,0 +1,152 @@
+// Package main provides the entry point for the Temporal benchmark runner.
+package main
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/redact"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+const (
+	// diagnosticsLogLines is the number of recent log lines kept for diagnostics
+	diagnosticsLogLines = 50
+
+	// diagnosticsPublishTimeout bounds delivery of diagnostics after a failure,
+	// which may happen after the run's own context has expired
+	diagnosticsPublishTimeout = 10 * time.Second
+)
+
+// logTail is an io.Writer that keeps the last n lines written to it.
+type logTail struct {
+	mu      sync.Mutex
+	n       int
+	lines   []string
+	partial []byte
+}
+
+func newLogTail(n int) *logTail {
+	return &logTail{n: n}
+}
+
+func (t *logTail) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.partial = append(t.partial, p...)
+	for {
+		i := bytes.IndexByte(t.partial, '\n')
+		if i < 0 {
+			break
+		}
+		t.lines = append(t.lines, string(t.partial[:i]))
+		t.partial = t.partial[i+1:]
+	}
+	if over := len(t.lines) - t.n; over > 0 {
+		t.lines = append([]string(nil), t.lines[over:]...)
+	}
+	return len(p), nil
+}
+
+// Lines returns a copy of the retained lines, oldest first.
+func (t *logTail) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string{}, t.lines...)
+}
+
+// failureReport tracks what the process is doing so a failure can be
+// published as diagnostics to the result sinks.
+type failureReport struct {
+	start   time.Time
+	logTail *logTail
+
+	// Updated as the run progresses
+	phase          results.Phase
+	mode           string
+	namespace      string
+	sinks          []results.Sink
+	bundle         bool // Also publish diagnostics as an artifact bundle
+	metricsHandler metrics.MetricsHandler
+	redactor       *redact.Redactor // Scrubs diagnostics before publishing (nil = no redaction)
+}
+
+func newFailureReport(logTail *logTail) *failureReport {
+	return &failureReport{
+		start:   time.Now(),
+		logTail: logTail,
+		phase:   results.PhaseStartup,
+	}
+}
+
+// publish delivers diagnostics for err to the configured sinks, or to stdout
+// if the sinks were never parsed.
+func (f *failureReport) publish(ctx context.Context, err error) {
+	d := results.NewDiagnostics(err, f.phase, time.Since(f.start))
+	d.Mode = f.mode
+	d.Namespace = f.namespace
+	if f.logTail != nil {
+		d.LogTail = f.logTail.Lines()
+	}
+	if f.metricsHandler != nil {
+		d.PartialStats = partialStats(f.metricsHandler)
+	}
+	f.redactor.Value(d)
+
+	sinks := f.sinks
+	if len(sinks) == 0 {
+		sinks = []results.Sink{results.NewStdoutSink(os.Stdout)}
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), diagnosticsPublishTimeout)
+	defer cancel()
+	if err := results.PublishDiagnosticsAll(ctx, sinks, d); err != nil {
+		slog.Warn("Failed to publish diagnostics", "error", err)
+	}
+	if f.bundle {
+		bundle, err := results.NewDiagnosticsBundle(d, f.start)
+		if err == nil {
+			err = results.PublishBundleAll(ctx, sinks, bundle)
+		}
+		if err != nil {
+			slog.Warn("Failed to publish artifact bundle", "error", err)
+		}
+	}
+}
+
+// partialStats reads the workflow counts and latencies recorded so far.
+func partialStats(h metrics.MetricsHandler) *results.PartialStats {
+	stats := &results.PartialStats{ActualRate: h.GetThroughput()}
+	p := h.GetLatencyPercentiles()
+	stats.Latency = results.ResultLatency{P50: p.P50, P95: p.P95, P99: p.P99, Max: p.Max}
+
+	families, err := h.Registry().Gather()
+	if err != nil {
+		return stats
+	}
+	for _, mf := range families {
+		if mf.GetName() != "benchmark_workflows_total" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() != "result" {
+					continue
+				}
+				switch label.GetValue() {
+				case "success":
+					stats.WorkflowsCompleted = int64(m.GetCounter().GetValue())
+				case "failure":
+					stats.WorkflowsFailed = int64(m.GetCounter().GetValue())
+				}
+			}
+		}
+	}
+	return stats
+}
@@ -3,28 +3,54 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"maps"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 
-	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/config"
-	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/metrics"
-	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/runner"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/auth"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/churn"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/cleanup"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/dialer"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/dynconfig"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/grafana"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/admin"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/fingerprint"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/redact"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/retention"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/simulate"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/tui"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/runner"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scaling"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/secrets"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/stream"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/topology"
 	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
 )
 
 func main() {
-	// Setup structured JSON logging
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+	// Setup structured JSON logging, keeping recent lines for failure diagnostics
+	logTail := newLogTail(diagnosticsLogLines)
+	logHandler := slog.NewJSONHandler(io.MultiWriter(os.Stdout, logTail), &slog.HandlerOptions{
 		Level: slog.LevelInfo,
-	}))
-	slog.SetDefault(logger)
+	})
+	slog.SetDefault(slog.New(startupRedactor().Handler(logHandler)))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -38,45 +64,394 @@ func main() {
 		cancel()
 	}()
 
-	if err := run(ctx); err != nil {
+	if err := run(ctx, logTail, logHandler); err != nil {
 		slog.Error("Benchmark failed", "error", err)
 		os.Exit(1)
 	}
 }
 
-func run(ctx context.Context) error {
+// errMaxTotalRuntime is the context cause when BENCHMARK_MAX_TOTAL_RUNTIME expires.
+var errMaxTotalRuntime = errors.New("max total runtime exceeded")
+
+// firehoseCloseTimeout bounds how long queued workflow records may take to
+// deliver at exit; the wait never extends past BENCHMARK_MAX_TOTAL_RUNTIME.
+const firehoseCloseTimeout = 10 * time.Second
+
+// startupRedactor scrubs the logs written before the configuration is loaded
+// when BENCHMARK_REDACT is set (or unparsable, so a typo fails closed). Only
+// the generic patterns apply until the configured values are known.
+func startupRedactor() *redact.Redactor {
+	v := os.Getenv("BENCHMARK_REDACT")
+	if v == "" {
+		return nil
+	}
+	if enabled, err := strconv.ParseBool(v); err == nil && !enabled {
+		return nil
+	}
+	return redact.New()
+}
+
+// run runs the process. logHandler is the unredacted log handler, which is
+// wrapped again once the configured values to redact are known.
+func run(ctx context.Context, logTail *logTail, logHandler slog.Handler) (err error) {
 	slog.Info("Temporal Benchmark Runner starting")
 
+	// On failure, publish diagnostics so failed ECS tasks can be debugged from artifacts
+	report := newFailureReport(logTail)
+	report.redactor = startupRedactor()
+	defer func() {
+		if err != nil {
+			report.publish(ctx, err)
+		}
+	}()
+
 	// Parse configuration from environment variables
 	cfg, err := config.LoadFromEnv()
 	if err != nil {
-		return fmt.Errorf("failed to load configuration: %w", err)
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("failed to load configuration: %w", err))
+	}
+
+	// Arguments select the role and run mode, e.g. "benchmark work" or "benchmark smoke"
+	if err := cfg.ApplyArgs(os.Args[1:]); err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+	}
+
+	// Load measured transition costs, unless this run measures them
+	if cfg.CalibrationFile != "" && cfg.Mode != config.ModeCalibrate {
+		table, err := config.LoadCalibrationTable(cfg.CalibrationFile)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("failed to load calibration table: %w", err))
+		}
+		cfg.Calibration = table
+	}
+
+	// Replace Secrets Manager/SSM references with their values; the raw sink
+	// spec is kept for logging since resolved webhook URLs may embed tokens
+	sinkSpec := cfg.ResultSinks
+	if err := secrets.ResolveConfig(ctx, &cfg); err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("failed to resolve secrets: %w", err))
+	}
+
+	// Scrub deployment internals, now including the configured values, from
+	// logs, diagnostics and printed results, so the output can be shared
+	// externally; results are scrubbed when published
+	var redactor *redact.Redactor
+	if cfg.Redact {
+		redactor = redact.New(cfg.SensitiveValues()...)
 	}
+	slog.SetDefault(slog.New(redactor.Handler(logHandler)))
+	report.redactor = redactor
 
 	// Validate configuration
 	if err := cfg.Validate(); err != nil {
-		return fmt.Errorf("invalid configuration: %w", err)
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: %w", err))
+	}
+
+	// Bound the whole process (connect, run, drain, cleanup) so it finishes
+	// before the ECS task's stop timeout instead of being killed mid-cleanup
+	if cfg.MaxTotalRuntime > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, cfg.MaxTotalRuntime, errMaxTotalRuntime)
+		defer cancel()
+		defer func() {
+			if !errors.Is(context.Cause(ctx), errMaxTotalRuntime) {
+				return
+			}
+			if err == nil {
+				err = fmt.Errorf("%w (%s)", errMaxTotalRuntime, cfg.MaxTotalRuntime)
+			} else if !errors.Is(err, errMaxTotalRuntime) {
+				err = fmt.Errorf("%w (%s): %w", errMaxTotalRuntime, cfg.MaxTotalRuntime, err)
+			}
+			err = results.NewRunError(results.CategoryTimeout, report.phase, err)
+		}()
+	}
+
+	// Let operators pause/resume load and reload thresholds via signals
+	control := runner.NewLoadControl()
+	stopControlSignals := handleControlSignals(cfg, control)
+	defer stopControlSignals()
+
+	// Build the credentials every Temporal client connects with
+	authProvider, err := auth.FromConfig(cfg)
+	if err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+	}
+
+	// Dial the frontend over the preferred address family
+	netDialer := dialer.New(cfg)
+
+	// Simulation mode: every client connects to an in-process fake frontend
+	if cfg.Simulate {
+		sim, err := startSimulation(cfg)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+		}
+		defer sim.Stop()
+		cfg.TemporalAddress = sim.Addr()
+		authProvider = auth.None()
+
+		// The fake frontend keeps no histories or cluster-wide state to
+		// measure, and completes workflows without their outcomes
+		cfg.ClockSkewCanaries = 0
+		cfg.HistorySizeSamples = 0
+		cfg.IntegritySamples = 0
+		cfg.OutcomeSampleRate = 0
+		cfg.ClusterSnapshot = false
+	}
+	runnerOpts := []runner.RunnerOption{runner.WithAuth(authProvider), runner.WithDialer(netDialer)}
+
+	// Load the phased scenario, if any, so invalid phases fail before the run
+	scenarioName := cfg.WorkflowType
+	if cfg.HasScenario() {
+		sc, err := loadScenario(cfg)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: %w", err))
+		}
+		if cfg, err = sc.ApplyThresholdProfile(cfg); err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: %w", err))
+		}
+		if sc.Ceiling != nil && len(cfg.ServerMetricsURLs) == 0 {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: a ceiling search scenario requires BENCHMARK_SERVER_METRICS_URLS"))
+		}
+		if sc.ShardChurn != nil {
+			if cfg.Simulate {
+				return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: BENCHMARK_SIMULATE replaces the cluster whose history tasks a shard churn scenario restarts"))
+			}
+			if cfg.HistoryCluster == "" || cfg.HistoryService == "" {
+				return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: a shard churn scenario requires BENCHMARK_HISTORY_CLUSTER and BENCHMARK_HISTORY_SERVICE"))
+			}
+			restarter, err := churn.NewECSRestarter(ctx, cfg.HistoryCluster, cfg.HistoryService)
+			if err != nil {
+				return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+			}
+			runnerOpts = append(runnerOpts, runner.WithShardChurn(&churn.Experiment{
+				Offsets:   sc.ShardChurn.Offsets(),
+				Tasks:     sc.ShardChurn.TaskCount(),
+				Restarter: restarter,
+			}))
+			slog.Info("Shard churn enabled",
+				"cluster", cfg.HistoryCluster,
+				"service", cfg.HistoryService,
+				"restarts", len(sc.ShardChurn.RestartAt),
+				"tasks", sc.ShardChurn.TaskCount())
+		}
+		if len(sc.DynamicConfig) > 0 {
+			if cfg.Simulate {
+				return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: BENCHMARK_SIMULATE replaces the cluster whose services dynamic config overrides redeploy"))
+			}
+			if len(cfg.DynamicConfigServices) == 0 {
+				return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: scenario dynamic config overrides require BENCHMARK_DYNAMIC_CONFIG_CLUSTER and BENCHMARK_DYNAMIC_CONFIG_SERVICES"))
+			}
+			deployer, err := dynconfig.NewECSDeployer(ctx, cfg.DynamicConfigCluster)
+			if err != nil {
+				return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+			}
+			overrides := &dynconfig.Overrides{
+				Values:   sc.DynamicConfig,
+				Services: cfg.DynamicConfigServices,
+				Deployer: deployer,
+			}
+			runnerOpts = append(runnerOpts, runner.WithDynamicConfig(overrides))
+
+			// The overrides are reverted after the run ends, so end the run
+			// early enough for the revert to finish within BENCHMARK_MAX_TOTAL_RUNTIME
+			if deadline, ok := ctx.Deadline(); ok {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithDeadlineCause(ctx, deadline.Add(-overrides.RevertTimeout()), errMaxTotalRuntime)
+				defer cancel()
+			}
+			slog.Info("Dynamic config overrides enabled",
+				"cluster", cfg.DynamicConfigCluster,
+				"services", cfg.DynamicConfigServices,
+				"keys", len(sc.DynamicConfig))
+		}
+		runnerOpts = append(runnerOpts, runner.WithScenario(sc))
+		if sc.Name != "" {
+			scenarioName = sc.Name
+		}
+		slog.Info("Loaded scenario", "name", sc.Name, "phases", len(sc.Phases), "total_duration", sc.TotalDuration(), "threshold_profile", cfg.ThresholdProfile)
+	}
+
+	// Snapshot the Grafana dashboard of each run, if credentials are configured
+	if cfg.GrafanaURL != "" {
+		runnerOpts = append(runnerOpts, runner.WithGrafanaSnapshots(grafana.New(cfg.GrafanaURL, cfg.GrafanaToken)))
+		slog.Info("Grafana snapshots enabled", "url", cfg.GrafanaURL, "dashboard", cfg.GrafanaDashboard, "expiry", cfg.GrafanaSnapshotExpiry)
+	}
+
+	// Build the custom threshold evaluators, if any
+	if len(cfg.ThresholdEvaluators) > 0 {
+		evaluators, err := results.ThresholdEvaluatorsFromConfig(cfg)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: %w", err))
+		}
+		runnerOpts = append(runnerOpts, runner.WithThresholdEvaluators(evaluators...))
+		slog.Info("Loaded threshold evaluators", "evaluators", cfg.ThresholdEvaluators)
+	}
+
+	// Load the baseline for percent-of-baseline thresholds, if any
+	if cfg.BaselineFile != "" {
+		baseline, err := results.LoadBaseline(cfg.BaselineFile)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: %w", err))
+		}
+		runnerOpts = append(runnerOpts, runner.WithBaseline(baseline))
+		slog.Info("Loaded baseline", "file", cfg.BaselineFile, "timestamp", baseline.Timestamp,
+			"max_p99_percent", cfg.BaselineMaxP99Percent, "min_throughput_percent", cfg.BaselineMinThroughputPercent)
+		if cfg.BaselineMaxP99Percent == 0 && cfg.BaselineMinThroughputPercent == 0 {
+			slog.Warn("Baseline loaded but no percent-of-baseline thresholds are set")
+		}
+	}
+
+	// Load the expected environment fingerprint, checked once connected
+	var expectations fingerprint.Expectations
+	if cfg.ExpectationsFile != "" {
+		if expectations, err = fingerprint.Load(cfg.ExpectationsFile); err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: %w", err))
+		}
+	}
+
+	// Set up the worker scaling experiment, if any
+	if cfg.WorkerScalingSchedule != "" {
+		steps, err := scaling.ParseSchedule(cfg.WorkerScalingSchedule)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: %w", err))
+		}
+		scaler, err := scaling.NewECSScaler(ctx, cfg.WorkerScalingCluster, cfg.WorkerScalingService)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+		}
+		runnerOpts = append(runnerOpts, runner.WithWorkerScaling(&scaling.Experiment{Steps: steps, Scaler: scaler}))
+		slog.Info("Worker scaling experiment enabled",
+			"cluster", cfg.WorkerScalingCluster,
+			"service", cfg.WorkerScalingService,
+			"steps", len(steps))
+	}
+
+	// Parse result sinks up front so misconfiguration fails before the run
+	sinks, err := results.ParseSinks(cfg.ResultSinks, results.WithFormat(cfg.ResultsFormat))
+	if err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("invalid configuration: %w", err))
+	}
+	if cfg.HistoryFile != "" {
+		sinks = append(sinks, results.NewHistorySink(cfg.HistoryFile, int64(cfg.HistoryMaxMB)<<20, cfg.HistoryKeep))
+	}
+	report.sinks = sinks
+	report.bundle = cfg.ArtifactBundle
+
+	// Record each run's raw events for later replay
+	if cfg.RecordFile != "" {
+		recorder, err := runner.NewEventRecorder(cfg.RecordFile)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+		}
+		defer func() {
+			if err := recorder.Close(); err != nil {
+				slog.Warn("Failed to close event log", "file", cfg.RecordFile, "error", err)
+			}
+		}()
+		runnerOpts = append(runnerOpts, runner.WithEventRecorder(recorder))
+	}
+
+	// Stream per-workflow records for analysis during long runs
+	if cfg.FirehoseStream != "" && cfg.ReplayFile == "" {
+		firehose, err := stream.NewFirehose(ctx, cfg.FirehoseStream)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+		}
+		defer func() {
+			// Deliver the queue after a shutdown signal too, but only until
+			// the run deadline, which ECS enforces with its stop timeout
+			timeout := firehoseCloseTimeout
+			if deadline, ok := ctx.Deadline(); ok {
+				timeout = min(timeout, time.Until(deadline))
+			}
+			closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+			defer cancel()
+			if err := firehose.Close(closeCtx); err != nil {
+				slog.Warn("Failed to deliver streamed workflow records", "error", err)
+			}
+		}()
+		runnerOpts = append(runnerOpts, runner.WithWorkflowStream(firehose))
+		slog.Info("Streaming workflow records to Firehose", "stream", cfg.FirehoseStream)
+	}
+
+	// Record a machine-parsable timeline of each run's lifecycle
+	if cfg.Timeline != "" {
+		timeline, err := runner.OpenTimeline(cfg.Timeline, cfg.TimelineInterval)
+		if err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+		}
+		defer func() {
+			if err := timeline.Close(); err != nil {
+				slog.Warn("Failed to close timeline", "timeline", cfg.Timeline, "error", err)
+			}
+		}()
+		runnerOpts = append(runnerOpts, runner.WithTimeline(timeline))
+	} else if cfg.ArtifactBundle {
+		// Keep the timeline for the artifact bundle only
+		runnerOpts = append(runnerOpts, runner.WithTimeline(runner.NewTimeline(io.Discard, cfg.TimelineInterval)))
+	}
+
+	// Show a terminal progress view for local runs, if asked for
+	var view *progressView
+	if cfg.TUI {
+		if tui.IsTerminal(os.Stdout) {
+			progress := runner.NewProgress()
+			runnerOpts = append(runnerOpts, runner.WithProgress(progress))
+			view = newProgressView(progress, logTail, redactor)
+		} else {
+			slog.Info("Progress view disabled: stdout is not a terminal")
+		}
 	}
 
 	// Determine mode
 	mode := "full"
-	if cfg.GeneratorOnly {
+	if cfg.Mode == config.ModeSmoke || cfg.Mode == config.ModeVerify || cfg.Mode == config.ModeVisibility || cfg.Mode == config.ModeCalibrate || cfg.Mode == config.ModeRuns || cfg.Mode == config.ModeExport {
+		mode = cfg.Mode
+	} else if cfg.DaemonSchedule != "" {
+		mode = "daemon"
+	} else if cfg.Role == config.RoleGenerate {
 		mode = "generator-only"
-	} else if cfg.WorkerOnly {
+	} else if cfg.Role == config.RoleWork {
 		mode = "worker-only"
+	} else if cfg.RetentionResultsFile != "" {
+		mode = "verify-retention"
+	} else if cfg.ReplayFile != "" {
+		mode = "replay"
 	}
+	report.mode = mode
+	report.namespace = cfg.Namespace
 
 	slog.Info("Configuration loaded",
 		"mode", mode,
 		"workflow_type", cfg.WorkflowType,
-		"target_rate", cfg.TargetRate,
+		"target_rate", cfg.EffectiveTargetRate(),
+		"target_state_transitions", cfg.TargetStateTransitions,
 		"duration", cfg.Duration.String(),
 		"ramp_up", cfg.RampUpDuration.String(),
 		"worker_count", cfg.WorkerCount,
 		"iterations", cfg.Iterations,
+		"metrics_port", cfg.MetricsPort,
+		"worker_metrics_port", cfg.WorkerMetricsPort,
+		"metrics_prefix", cfg.MetricsPrefix,
+		"metrics_labels", cfg.MetricsLabels,
 		"temporal_address", cfg.TemporalAddress,
+		"result_sinks", sinkSpec,
+		"memo", cfg.Memo,
+		"history_file", cfg.HistoryFile,
+		"max_total_runtime", cfg.MaxTotalRuntime.String(),
 	)
 
+	// Replay mode: report recorded runs without connecting to Temporal
+	if cfg.ReplayFile != "" {
+		return runReplay(ctx, cfg, sinks, runnerOpts...)
+	}
+
+	// Export mode: publish an anonymized saved result without connecting to Temporal
+	if cfg.Mode == config.ModeExport {
+		return runExport(ctx, cfg, sinks)
+	}
+
 	// Check for early cancellation before connecting
 	select {
 	case <-ctx.Done():
@@ -85,14 +460,33 @@ func run(ctx context.Context) error {
 	default:
 	}
 
+	// Detect the client's and the frontend's availability zones, and label
+	// every series with them so several generators can be compared by AZ
+	topo := detectTopology(ctx, cfg)
+	runnerOpts = append(runnerOpts, runner.WithTopology(topo))
+
 	// Create metrics handler with SDK metrics integration
-	metricsHandler := metrics.NewHandler()
+	metricsHandler := metrics.NewHandler(
+		metrics.WithPrefix(cfg.MetricsPrefix),
+		metrics.WithConstLabels(topologyLabels(cfg.MetricsLabels, topo)),
+		metrics.WithLatencyMemoryBudget(int64(cfg.LatencyMemoryBudgetMB)<<20),
+		metrics.WithHistogramBuckets(cfg.HistogramBuckets),
+		metrics.WithNativeHistograms(cfg.NativeHistograms),
+		metrics.WithCardinalityLimit(cfg.CardinalityLimit),
+	)
+	report.metricsHandler = metricsHandler
 
 	// Create SDK metrics handler once - will be reused for all clients
-	sdkMetricsHandler := metrics.SDKMetricsHandler(metricsHandler.Registry())
+	sdkMetricsHandler := metrics.SDKMetricsHandler(metricsHandler.Registry(),
+		metrics.WithSDKHistogramBuckets(cfg.HistogramBuckets),
+		metrics.WithSDKNativeHistograms(cfg.NativeHistograms),
+		metrics.WithSDKNamespaceLabel(namespaceLabel(cfg, scenarioName)),
+	)
+	runnerOpts = append(runnerOpts, runner.WithSDKMetricsHandler(sdkMetricsHandler))
 
 	// Create Temporal client with SDK metrics and retry logic
-	slog.Info("Connecting to Temporal", "address", cfg.TemporalAddress)
+	report.phase = results.PhaseConnect
+	slog.Info("Connecting to Temporal", "address", cfg.TemporalAddress, "auth", authProvider.Name(), "address_family", cfg.AddressFamily)
 
 	var temporalClient client.Client
 	maxRetries := 30
@@ -106,10 +500,15 @@ func run(ctx context.Context) error {
 		default:
 		}
 
-		temporalClient, err = client.Dial(client.Options{
+		clientOptions := client.Options{
 			HostPort:       cfg.TemporalAddress,
 			MetricsHandler: sdkMetricsHandler,
-		})
+		}
+		netDialer.Apply(&clientOptions)
+		if err = authProvider.Apply(&clientOptions); err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseConnect, fmt.Errorf("failed to apply %s credentials: %w", authProvider.Name(), err))
+		}
+		temporalClient, err = client.Dial(clientOptions)
 		if err == nil {
 			break
 		}
@@ -121,12 +520,17 @@ func run(ctx context.Context) error {
 				"error", err,
 				"retry_delay", retryDelay.String(),
 			)
-			time.Sleep(retryDelay)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("shutdown requested during connection retry")
+			case <-time.After(retryDelay):
+			}
 		}
 	}
 
 	if err != nil {
-		return fmt.Errorf("failed to connect to Temporal cluster at %s after %d attempts: %w", cfg.TemporalAddress, maxRetries, err)
+		return results.NewRunError(results.CategoryConnection, results.PhaseConnect,
+			fmt.Errorf("failed to connect to Temporal cluster at %s after %d attempts: %w", cfg.TemporalAddress, maxRetries, err))
 	}
 	defer temporalClient.Close()
 
@@ -134,9 +538,17 @@ func run(ctx context.Context) error {
 	slog.Info("Verifying Temporal cluster health")
 	_, err = temporalClient.CheckHealth(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("Temporal cluster health check failed: %w", err)
+		return results.NewRunError(results.CategoryConnection, results.PhaseConnect, fmt.Errorf("Temporal cluster health check failed: %w", err))
 	}
 	slog.Info("Temporal cluster is healthy")
+	report.phase = results.PhaseSetup
+
+	// Fail fast if the cluster is not the deployment the run expects
+	if cfg.ExpectationsFile != "" {
+		if err := checkEnvironment(ctx, expectations, temporalClient); err != nil {
+			return results.NewRunError(results.CategoryConfig, results.PhaseSetup, err)
+		}
+	}
 
 	// Check for cancellation after health check
 	select {
@@ -146,35 +558,137 @@ func run(ctx context.Context) error {
 	default:
 	}
 
-	// Worker-only mode: just run workers, no benchmark execution
-	if cfg.WorkerOnly {
-		return runWorkerOnly(ctx, cfg, temporalClient, metricsHandler, sdkMetricsHandler)
+	// Start the admin endpoint if a token is configured
+	if cfg.AdminToken != "" {
+		adminServer := admin.NewServer(cfg.AdminToken,
+			cleanup.NewCleaner(temporalClient,
+				cleanup.WithRegistry(metricsHandler.Registry()),
+				cleanup.WithLimits(cleanupLimits(cfg)),
+			),
+			admin.WithNamespaceFilter(func(namespace string) bool {
+				return isBenchmarkNamespace(cfg, namespace)
+			}),
+			admin.WithConfig(func() any {
+				return processConfig{Role: cfg.Role, Workers: runner.StartedWorkers()}
+			}),
+		)
+		if err := adminServer.Start(cfg.AdminPort); err != nil {
+			return fmt.Errorf("failed to start admin server: %w", err)
+		}
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := adminServer.Stop(shutdownCtx); err != nil {
+				slog.Warn("Failed to stop admin server", "error", err)
+			}
+		}()
+	}
+
+	// Work role: just run workers, no benchmark execution
+	if cfg.Role == config.RoleWork {
+		return runWorkerOnly(ctx, cfg, temporalClient, authProvider, netDialer, metricsHandler, sdkMetricsHandler)
+	}
+
+	// Verify-retention mode: check a previous run's data was removed, no benchmark execution
+	if cfg.RetentionResultsFile != "" {
+		return runRetentionVerification(ctx, cfg, redactor, temporalClient)
+	}
+
+	// Smoke mode: run the fixed smoke scenario and fail fast on any error
+	if cfg.Mode == config.ModeSmoke {
+		return runSmoke(ctx, cfg, redactor, temporalClient, authProvider, netDialer, metricsHandler)
+	}
+
+	// Verify mode: run one workflow of every type before any load run
+	if cfg.Mode == config.ModeVerify {
+		return runVerify(ctx, cfg, redactor, temporalClient, authProvider, netDialer, metricsHandler)
+	}
+
+	// Visibility mode: benchmark visibility queries against an existing namespace
+	if cfg.Mode == config.ModeVisibility {
+		return runVisibility(ctx, cfg, redactor, temporalClient)
+	}
+
+	// Runs mode: list the run catalog
+	if cfg.Mode == config.ModeRuns {
+		return runRunsList(ctx, cfg, redactor, authProvider, netDialer)
+	}
+
+	// Calibrate mode: measure each workflow type's state transitions
+	if cfg.Mode == config.ModeCalibrate {
+		return runCalibrate(ctx, cfg, redactor, temporalClient, authProvider, netDialer, metricsHandler)
+	}
+
+	// Daemon mode: keep running the benchmark on a schedule until shutdown
+	if cfg.DaemonSchedule != "" {
+		return runDaemon(ctx, cfg, temporalClient, metricsHandler, sinks, control, runnerOpts...)
+	}
+
+	report.phase = results.PhaseRun
+	result, namespace, err := runBenchmark(ctx, cfg, temporalClient, metricsHandler, sinks, control, view, runnerOpts...)
+	report.namespace = namespace
+	if err != nil {
+		// Check if it was a cancellation
+		if ctx.Err() != nil {
+			slog.Info("Benchmark was cancelled")
+			return nil
+		}
+		return err
+	}
+
+	// Optionally wait for retention to expire and verify the run's data was removed
+	if cfg.RetentionVerifyDelay > 0 {
+		target := retention.Target{
+			Namespace:   namespace,
+			StartTime:   result.StartTime,
+			EndTime:     result.EndTime,
+			WorkflowIDs: result.WorkflowIDs,
+		}
+		slog.Info("Waiting before retention verification", "delay", cfg.RetentionVerifyDelay, "namespace", namespace)
+		select {
+		case <-ctx.Done():
+			slog.Info("Retention verification cancelled")
+			return nil
+		case <-time.After(cfg.RetentionVerifyDelay):
+		}
+		if err := verifyRetention(ctx, cfg, redactor, temporalClient, target); err != nil {
+			slog.Warn("Retention verification failed", "error", err, "namespace", namespace)
+		}
 	}
 
+	slog.Info("Benchmark runner completed")
+	return nil
+}
+
+// runBenchmark runs the benchmark once, publishes the result to the sinks and
+// cleans up the namespace. It returns the result and the namespace used. The
+// progress view, if any, is shown while the benchmark runs.
+func runBenchmark(ctx context.Context, cfg config.BenchmarkConfig, temporalClient client.Client, metricsHandler metrics.MetricsHandler, sinks []results.Sink, control *runner.LoadControl, view *progressView, opts ...runner.RunnerOption) (*runner.BenchmarkResult, string, error) {
 	// Create benchmark runner with metrics handler and host port
 	benchmarkRunner := runner.NewRunner(
 		temporalClient,
-		runner.WithMetricsHandler(metricsHandler),
-		runner.WithHostPort(cfg.TemporalAddress),
+		append([]runner.RunnerOption{
+			runner.WithMetricsHandler(metricsHandler),
+			runner.WithHostPort(cfg.TemporalAddress),
+			runner.WithCleanupLimits(cleanupLimits(cfg)),
+			runner.WithLoadControl(control),
+		}, opts...)...,
 	)
 
 	// Run the benchmark
 	slog.Info("Starting benchmark execution")
+	view.start()
 	result, err := benchmarkRunner.Run(ctx, cfg)
+	view.stop()
 	if err != nil {
-		// Check if it was a cancellation
-		if ctx.Err() != nil {
-			slog.Info("Benchmark was cancelled")
-			return nil
-		}
-		return fmt.Errorf("benchmark execution failed: %w", err)
+		return nil, benchmarkRunner.GetNamespace(), fmt.Errorf("benchmark execution failed: %w", err)
 	}
 
 	// Get the namespace used for cleanup
 	namespace := benchmarkRunner.GetNamespace()
 
 	// Output results
-	if err := runner.OutputResults(result, cfg, namespace); err != nil {
+	if err := runner.PublishResults(ctx, sinks, result, control.ApplyThresholds(cfg), namespace); err != nil {
 		slog.Warn("Failed to output results", "error", err)
 	}
 
@@ -186,13 +700,425 @@ func run(ctx context.Context) error {
 		slog.Info("Cleanup completed successfully")
 	}
 
-	slog.Info("Benchmark runner completed")
+	return result, namespace, nil
+}
+
+// runReplay replays the recorded runs of cfg.ReplayFile and publishes their
+// results to the sinks.
+func runReplay(ctx context.Context, cfg config.BenchmarkConfig, sinks []results.Sink, opts ...runner.RunnerOption) error {
+	f, err := os.Open(cfg.ReplayFile)
+	if err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("failed to open event log: %w", err))
+	}
+	defer f.Close()
+
+	runs, err := runner.Replay(f, cfg, opts...)
+	if err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, err)
+	}
+	slog.Info("Replaying recorded runs", "file", cfg.ReplayFile, "runs", len(runs))
+	for _, run := range runs {
+		if err := runner.PublishResults(ctx, sinks, run.Result, cfg, run.Namespace); err != nil {
+			slog.Warn("Failed to output results", "error", err, "namespace", run.Namespace)
+		}
+	}
+	return nil
+}
+
+// runExport publishes an anonymized copy of the saved result in
+// cfg.ExportFile to the sinks, for publishing outside the deployment.
+func runExport(ctx context.Context, cfg config.BenchmarkConfig, sinks []results.Sink) error {
+	data, err := os.ReadFile(cfg.ExportFile)
+	if err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("failed to read results file: %w", err))
+	}
+	result, err := results.FromJSON(data)
+	if err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseStartup, fmt.Errorf("results file %s: %w", cfg.ExportFile, err))
+	}
+	exported, err := results.Anonymize(result)
+	if err != nil {
+		return results.NewRunError(results.CategoryInternal, results.PhaseReport, err)
+	}
+	if err := results.PublishAll(ctx, sinks, exported); err != nil {
+		return results.NewRunError(results.CategoryInternal, results.PhaseReport, err)
+	}
+	slog.Info("Anonymized result exported", "file", cfg.ExportFile, "workflow_type", exported.Config.WorkflowType)
+	return nil
+}
+
+// runSmoke runs the smoke test, prints its result and cleans up the namespace.
+// It returns an error when the smoke test does not pass so the process exits non-zero.
+func runSmoke(ctx context.Context, cfg config.BenchmarkConfig, redactor *redact.Redactor, temporalClient client.Client, authProvider auth.Provider, netDialer *dialer.Dialer, metricsHandler metrics.MetricsHandler) error {
+	smokeRunner := runner.NewRunner(
+		temporalClient,
+		runner.WithMetricsHandler(metricsHandler),
+		runner.WithHostPort(cfg.TemporalAddress),
+		runner.WithAuth(authProvider),
+		runner.WithDialer(netDialer),
+		runner.WithCleanupLimits(cleanupLimits(cfg)),
+	)
+
+	result, err := smokeRunner.Smoke(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("smoke test failed: %w", err)
+	}
+
+	printResult(redactor, "Smoke Result", result)
+
+	// Cleanup is bounded by the cleanup limits, not by the smoke budget
+	if err := smokeRunner.Cleanup(ctx, result.Namespace); err != nil {
+		slog.Warn("Cleanup failed", "error", err, "namespace", result.Namespace)
+	}
+
+	if !result.Passed {
+		return results.NewRunError(results.CategoryExecution, results.PhaseRun,
+			fmt.Errorf("smoke test failed: %s", strings.Join(result.FailureReasons, "; ")))
+	}
+	slog.Info("Smoke test passed", "namespace", result.Namespace, "duration", result.Duration)
+	return nil
+}
+
+// printResult prints result's summary and JSON to stdout, scrubbed by
+// redactor (nil = no redaction). The result itself is left unchanged, since
+// callers still clean up its namespace.
+func printResult(redactor *redact.Redactor, title string, result interface {
+	PrintSummary(w io.Writer)
+	ToJSON() ([]byte, error)
+}) {
+	var out strings.Builder
+	result.PrintSummary(&out)
+	if jsonBytes, err := result.ToJSON(); err != nil {
+		slog.Warn("Failed to serialize result", "result", title, "error", err)
+	} else {
+		fmt.Fprintf(&out, "\n%s JSON:\n%s\n", title, jsonBytes)
+	}
+	fmt.Print(redactor.String(out.String()))
+}
+
+// runVerify runs one workflow of every type, prints the per-type results and
+// cleans up the namespace. It returns an error when any type fails.
+func runVerify(ctx context.Context, cfg config.BenchmarkConfig, redactor *redact.Redactor, temporalClient client.Client, authProvider auth.Provider, netDialer *dialer.Dialer, metricsHandler metrics.MetricsHandler) error {
+	verifyRunner := runner.NewRunner(
+		temporalClient,
+		runner.WithMetricsHandler(metricsHandler),
+		runner.WithHostPort(cfg.TemporalAddress),
+		runner.WithAuth(authProvider),
+		runner.WithDialer(netDialer),
+		runner.WithCleanupLimits(cleanupLimits(cfg)),
+	)
+
+	result, err := verifyRunner.Verify(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("workflow verification failed: %w", err)
+	}
+
+	printResult(redactor, "Verification Result", result)
+
+	if err := verifyRunner.Cleanup(ctx, result.Namespace); err != nil {
+		slog.Warn("Cleanup failed", "error", err, "namespace", result.Namespace)
+	}
+
+	if !result.Passed {
+		return results.NewRunError(results.CategoryExecution, results.PhaseRun,
+			fmt.Errorf("workflow verification failed for: %s", strings.Join(result.FailedTypes(), ", ")))
+	}
+	slog.Info("Workflow verification passed", "namespace", result.Namespace, "duration", result.Duration)
+	return nil
+}
+
+// runVisibility runs the visibility query benchmark and prints its result. The
+// namespace is left untouched. It returns an error when any query fails.
+func runVisibility(ctx context.Context, cfg config.BenchmarkConfig, redactor *redact.Redactor, temporalClient client.Client) error {
+	result, err := runner.RunVisibility(ctx, temporalClient.WorkflowService(), cfg, runner.DefaultVisibilityQueries(cfg))
+	if err != nil {
+		return fmt.Errorf("visibility benchmark failed: %w", err)
+	}
+
+	printResult(redactor, "Visibility Result", result)
+
+	if !result.Passed {
+		return results.NewRunError(results.CategoryExecution, results.PhaseRun,
+			fmt.Errorf("visibility benchmark failed: %s", strings.Join(result.FailureReasons, "; ")))
+	}
+	slog.Info("Visibility benchmark passed", "namespace", result.Namespace, "actual_qps", result.ActualQPS)
+	return nil
+}
+
+// runRunsList prints the runs in the run catalog, newest first.
+func runRunsList(ctx context.Context, cfg config.BenchmarkConfig, redactor *redact.Redactor, authProvider auth.Provider, netDialer *dialer.Dialer) error {
+	clientOptions := client.Options{
+		HostPort:  cfg.TemporalAddress,
+		Namespace: cfg.RunCatalogNamespace,
+	}
+	netDialer.Apply(&clientOptions)
+	if err := authProvider.Apply(&clientOptions); err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseConnect, fmt.Errorf("failed to apply %s credentials: %w", authProvider.Name(), err))
+	}
+	catalogClient, err := client.Dial(clientOptions)
+	if err != nil {
+		return results.NewRunError(results.CategoryConnection, results.PhaseConnect, fmt.Errorf("failed to connect to run catalog namespace %s: %w", cfg.RunCatalogNamespace, err))
+	}
+	defer catalogClient.Close()
+
+	runs, err := runner.ListRuns(ctx, catalogClient)
+	if err != nil {
+		return err
+	}
+	redactor.Value(&runs)
+	if len(runs) == 0 {
+		fmt.Printf("No runs recorded in %s\n", cfg.RunCatalogNamespace)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STARTED\tSTATUS\tNAMESPACE\tWORKFLOW\tRATE\tDURATION\tTHROUGHPUT\tP99 MS\tHOST\tAZ\tRESULTS")
+	for _, run := range runs {
+		workload := run.WorkflowType
+		if run.Scenario != "" {
+			workload = "scenario " + run.Scenario
+		}
+		az := run.ClientAZ
+		if az == "" {
+			az = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.1f/s\t%s\t%.1f/s\t%.0f\t%s\t%s\t%s\n",
+			run.Started.Format(time.RFC3339), run.Status, run.Namespace, workload, run.TargetRate, run.Duration,
+			run.Throughput, run.LatencyP99Ms, run.Host, az, run.ResultLocation)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	// Concurrent generators in different AZs, compared by AZ
+	breakdown := runner.LatencyByAZ(runs)
+	if len(breakdown) == 0 {
+		return nil
+	}
+	fmt.Println()
+	fmt.Println("LATENCY BY AZ (concurrent runs)")
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STARTED\tNAMESPACE\tAZ\tRUNS\tTHROUGHPUT\tP99 MS")
+	for _, az := range breakdown {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%.1f/s\t%.0f\n",
+			az.Started.Format(time.RFC3339), az.Namespace, az.AZ, az.Runs, az.Throughput, az.LatencyP99Ms)
+	}
+	return w.Flush()
+}
+
+// runCalibrate measures the transition cost of every workflow type, writes
+// the calibration table and cleans up the namespace. It returns an error when
+// any type could not be measured, leaving an existing table in place.
+func runCalibrate(ctx context.Context, cfg config.BenchmarkConfig, redactor *redact.Redactor, temporalClient client.Client, authProvider auth.Provider, netDialer *dialer.Dialer, metricsHandler metrics.MetricsHandler) error {
+	calibrateRunner := runner.NewRunner(
+		temporalClient,
+		runner.WithMetricsHandler(metricsHandler),
+		runner.WithHostPort(cfg.TemporalAddress),
+		runner.WithAuth(authProvider),
+		runner.WithDialer(netDialer),
+		runner.WithCleanupLimits(cleanupLimits(cfg)),
+	)
+	calibrator, ok := calibrateRunner.(runner.Calibrator)
+	if !ok {
+		return fmt.Errorf("runner does not support calibration")
+	}
+
+	result, err := calibrator.Calibrate(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("calibration failed: %w", err)
+	}
+
+	printResult(redactor, "Calibration Result", result)
+
+	if err := calibrateRunner.Cleanup(ctx, result.Namespace); err != nil {
+		slog.Warn("Cleanup failed", "error", err, "namespace", result.Namespace)
+	}
+
+	if !result.Passed {
+		return results.NewRunError(results.CategoryExecution, results.PhaseRun,
+			fmt.Errorf("calibration failed for: %s", strings.Join(result.FailedTypes(), ", ")))
+	}
+	if err := config.WriteCalibrationTable(cfg.CalibrationFile, result.Table); err != nil {
+		return fmt.Errorf("failed to write calibration table: %w", err)
+	}
+	slog.Info("Calibration table written", "file", cfg.CalibrationFile, "namespace", result.Namespace, "duration", result.Duration)
+	return nil
+}
+
+// runRetentionVerification verifies retention for the run recorded in a saved results file.
+func runRetentionVerification(ctx context.Context, cfg config.BenchmarkConfig, redactor *redact.Redactor, temporalClient client.Client) error {
+	data, err := os.ReadFile(cfg.RetentionResultsFile)
+	if err != nil {
+		return fmt.Errorf("failed to read results file: %w", err)
+	}
+	result, err := results.FromJSON(data)
+	if err != nil {
+		return err
+	}
+	target, err := retention.TargetFromResult(result)
+	if err != nil {
+		return fmt.Errorf("results file %s: %w", cfg.RetentionResultsFile, err)
+	}
+	return verifyRetention(ctx, cfg, redactor, temporalClient, target)
+}
+
+// verifyRetention runs the retention check and outputs the report.
+func verifyRetention(ctx context.Context, cfg config.BenchmarkConfig, redactor *redact.Redactor, temporalClient client.Client, target retention.Target) error {
+	verifier := retention.NewVerifier(temporalClient, retention.WithSampleSize(cfg.RetentionSampleSize))
+	report, err := verifier.Verify(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	report.PrintSummary(&out)
+	jsonBytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize retention report: %w", err)
+	}
+	fmt.Fprintf(&out, "\nRetention Report JSON:\n%s\n", jsonBytes)
+	fmt.Print(redactor.String(out.String()))
+
+	if report.Status == retention.StatusResidual {
+		slog.Warn("Residual data found after retention",
+			"namespace", report.Namespace,
+			"visibility_records", report.VisibilityRecords,
+			"estimated_residual_executions", report.EstimatedResidualExecutions)
+	}
 	return nil
 }
 
+// startSimulation starts the fake frontend simulation mode connects to.
+func startSimulation(cfg config.BenchmarkConfig) (*simulate.Server, error) {
+	latency, err := simulate.ParseLatency(cfg.SimulateLatency)
+	if err != nil {
+		return nil, err
+	}
+	sim := simulate.NewServer(simulate.Options{
+		Latency:          latency,
+		FailureRate:      cfg.SimulateFailureRate,
+		StartFailureRate: cfg.SimulateStartFailureRate,
+	})
+	if err := sim.Start("127.0.0.1:0"); err != nil {
+		return nil, fmt.Errorf("failed to start simulated frontend: %w", err)
+	}
+	return sim, nil
+}
+
+// loadScenario reads the scenario from the scenario file or the inline scenario.
+func loadScenario(cfg config.BenchmarkConfig) (*scenario.Scenario, error) {
+	if cfg.Scenario != "" {
+		return scenario.Parse([]byte(cfg.Scenario), cfg)
+	}
+	return scenario.Load(cfg.ScenarioFile, cfg)
+}
+
+// detectTopology returns the availability zones of this task and of the
+// frontend tasks cfg.TemporalAddress reaches, or nil if there are none to
+// detect (off ECS without a frontend service, or in simulation mode).
+func detectTopology(ctx context.Context, cfg config.BenchmarkConfig) *results.Topology {
+	if cfg.Simulate {
+		return nil
+	}
+	var frontend topology.TaskSource
+	if cfg.FrontendService != "" {
+		var err error
+		if frontend, err = topology.NewECSTaskSource(ctx, cfg.FrontendCluster, cfg.FrontendService); err != nil {
+			slog.Warn("Frontend availability zones not resolvable", "service", cfg.FrontendService, "error", err)
+		}
+	}
+	t := topology.Detect(ctx, cfg.TemporalAddress, frontend)
+	if t != nil {
+		slog.Info("Detected topology", "client_az", t.ClientAZ, "frontend_azs", t.FrontendAZs, "placement", t.Placement)
+	}
+	return t
+}
+
+// topologyLabels returns labels with the client's availability zone and its
+// placement relative to the frontend added, unless labels already set them.
+func topologyLabels(labels map[string]string, t *results.Topology) map[string]string {
+	if t == nil {
+		return labels
+	}
+	merged := map[string]string{
+		"client_az":    t.ClientAZ,
+		"az_placement": t.Placement,
+	}
+	for name, value := range merged {
+		if value == "" {
+			delete(merged, name)
+		}
+	}
+	maps.Copy(merged, labels)
+	return merged
+}
+
+// checkEnvironment compares the live cluster with expectations, returning an
+// error listing every mismatch.
+func checkEnvironment(ctx context.Context, expectations fingerprint.Expectations, temporalClient client.Client) error {
+	var endpoints fingerprint.EndpointSource
+	if expectations.DSQLEndpoint != "" {
+		var err error
+		if endpoints, err = fingerprint.NewECSEndpointSource(ctx, expectations.ECSCluster, expectations.ECSService); err != nil {
+			return err
+		}
+	}
+	live, err := fingerprint.Collect(ctx, temporalClient.WorkflowService(), endpoints)
+	if err != nil {
+		return fmt.Errorf("failed to fingerprint environment: %w", err)
+	}
+	slog.Info("Environment fingerprint",
+		"server_version", live.ServerVersion,
+		"history_shard_count", live.HistoryShardCount,
+		"cluster_name", live.ClusterName,
+		"dsql_endpoint", live.DSQLEndpoint,
+	)
+	return fingerprint.Error(expectations.Diff(live))
+}
+
+// namespaceLabel returns the namespace metric label rewrite: generated
+// per-run namespaces become scenarioName if cfg normalizes them, so each run
+// doesn't add a new set of series. It returns nil if labels are kept as is.
+func namespaceLabel(cfg config.BenchmarkConfig, scenarioName string) func(string) string {
+	if !cfg.NormalizeNamespaceLabel {
+		return nil
+	}
+	return func(namespace string) string {
+		if runner.IsGeneratedNamespace(namespace) {
+			return scenarioName
+		}
+		return namespace
+	}
+}
+
+// cleanupLimits returns the cleanup concurrency, rate and timeout limits from config.
+func cleanupLimits(cfg config.BenchmarkConfig) cleanup.Limits {
+	return cleanup.Limits{
+		Concurrency:   cfg.CleanupConcurrency,
+		RatePerSecond: cfg.CleanupRate,
+		CallTimeout:   cfg.CleanupCallTimeout,
+		TotalTimeout:  cfg.CleanupTimeout,
+	}
+}
+
+// processConfig is served by the admin /config endpoint: the process's role
+// and the benchmark workers it runs, with their effective options.
+type processConfig struct {
+	Role    string              `json:"role"`
+	Workers []runner.WorkerInfo `json:"workers"`
+}
+
+// isBenchmarkNamespace reports whether the admin endpoint may operate on the namespace.
+// Requirement 8.3: THE Benchmark_Runner SHALL NOT interfere with workflows in other namespaces
+func isBenchmarkNamespace(cfg config.BenchmarkConfig, namespace string) bool {
+	return namespace == cfg.Namespace ||
+		namespace == "benchmark" ||
+		strings.HasPrefix(namespace, runner.NamespacePrefix)
+}
+
 // runWorkerOnly runs only the worker without generating workflows.
 // This is used when running separate worker services to process benchmark workflows.
-func runWorkerOnly(ctx context.Context, cfg config.BenchmarkConfig, temporalClient client.Client, metricsHandler metrics.MetricsHandler, sdkMetricsHandler client.MetricsHandler) error {
+func runWorkerOnly(ctx context.Context, cfg config.BenchmarkConfig, temporalClient client.Client, authProvider auth.Provider, netDialer *dialer.Dialer, metricsHandler metrics.MetricsHandler, sdkMetricsHandler client.MetricsHandler) error {
 	namespace := cfg.Namespace
 	if namespace == "" {
 		namespace = "benchmark"
@@ -201,10 +1127,18 @@ func runWorkerOnly(ctx context.Context, cfg config.BenchmarkConfig, temporalClie
 	slog.Info("Starting worker-only mode",
 		"namespace", namespace,
 		"task_queue", runner.DefaultTaskQueue,
+		"tasks", cfg.WorkerTasks,
 	)
+	runner.ConfigureWorkflowCache(cfg)
 
-	// Start metrics server for worker metrics
-	if err := metricsHandler.StartServer(ctx, runner.MetricsPort); err != nil {
+	// Export the duplicate execution counts for the generator to aggregate
+	if err := runner.RegisterExecutionMetrics(metricsHandler.Registry()); err != nil {
+		return fmt.Errorf("failed to register execution metrics: %w", err)
+	}
+
+	// Start metrics server for worker metrics on the worker-specific port so
+	// worker and generator containers can share a task network namespace
+	if err := metricsHandler.StartServer(ctx, cfg.WorkerMetricsPort); err != nil {
 		return fmt.Errorf("failed to start metrics server: %w", err)
 	}
 	defer func() {
@@ -216,11 +1150,16 @@ func runWorkerOnly(ctx context.Context, cfg config.BenchmarkConfig, temporalClie
 	}()
 
 	// Create namespace-specific client (reuse the SDK metrics handler)
-	nsClient, err := client.Dial(client.Options{
+	nsClientOptions := client.Options{
 		HostPort:       cfg.TemporalAddress,
 		Namespace:      namespace,
 		MetricsHandler: sdkMetricsHandler, // Reuse the same metrics handler
-	})
+	}
+	netDialer.Apply(&nsClientOptions)
+	if err := authProvider.Apply(&nsClientOptions); err != nil {
+		return fmt.Errorf("failed to apply %s credentials: %w", authProvider.Name(), err)
+	}
+	nsClient, err := client.Dial(nsClientOptions)
 	if err != nil {
 		return fmt.Errorf("failed to create namespace client: %w", err)
 	}
@@ -238,22 +1177,52 @@ func runWorkerOnly(ctx context.Context, cfg config.BenchmarkConfig, temporalClie
 		DisableEagerActivities:                  false,
 		MaxConcurrentEagerActivityExecutionSize: 100,
 		StickyScheduleToStartTimeout:            5 * time.Second,
+		MaxHeartbeatThrottleInterval:            workflows.HeartbeatThrottleInterval,
+		WorkerActivitiesPerSecond:               cfg.WorkerActivitiesPerSecond,
+		TaskQueueActivitiesPerSecond:            cfg.TaskQueueActivitiesPerSecond,
 	}
+	runner.ConfigureWorkerTasks(&workerOptions, cfg.WorkerTasks)
 
 	w := worker.New(nsClient, runner.DefaultTaskQueue, workerOptions)
-	workflows.RegisterAll(w)
+	runner.RegisterWorkerTasks(w, cfg.WorkerTasks)
 
 	// Start the worker
 	if err := w.Start(); err != nil {
 		return fmt.Errorf("failed to start worker: %w", err)
 	}
 	slog.Info("Worker started, waiting for tasks")
+	untrack := runner.TrackWorker(runner.NewWorkerInfo(runner.WorkerKindWorkerOnly, namespace, runner.DefaultTaskQueue, workerOptions, cfg))
+
+	// Keep the shared namespace free of workflows left behind by abandoned runs
+	if cfg.StaleRuns != config.StaleRunsOff {
+		go runner.WatchStaleRuns(ctx, temporalClient, cfg, namespace)
+	}
+
+	// Delete the namespaces of expired runs even if their generator crashed
+	if cfg.NamespaceTTL > 0 {
+		janitorOptions := client.Options{
+			HostPort:       cfg.TemporalAddress,
+			Namespace:      cfg.JanitorNamespace,
+			MetricsHandler: sdkMetricsHandler,
+		}
+		netDialer.Apply(&janitorOptions)
+		if err := authProvider.Apply(&janitorOptions); err != nil {
+			return fmt.Errorf("failed to apply %s credentials: %w", authProvider.Name(), err)
+		}
+		janitorClient, err := client.Dial(janitorOptions)
+		if err != nil {
+			return fmt.Errorf("failed to create janitor namespace client: %w", err)
+		}
+		defer janitorClient.Close()
+		go runner.HostNamespaceJanitor(ctx, janitorClient)
+	}
 
 	// Wait for shutdown signal
 	<-ctx.Done()
 	slog.Info("Shutdown signal received, stopping worker")
 
 	w.Stop()
+	untrack()
 	slog.Info("Worker stopped")
 
 	return nil
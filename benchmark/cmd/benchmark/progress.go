@@ -0,0 +1,49 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/redact"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/tui"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/runner"
+)
+
+// progressView shows the terminal progress view while a benchmark runs. Logs
+// go only to the log tail meanwhile, so they don't tear the display; the view
+// shows the most recent of them instead.
+type progressView struct {
+	view     *tui.View
+	logTail  *logTail
+	redactor *redact.Redactor // Scrubs the logs shown meanwhile (nil = no redaction)
+	logger   *slog.Logger     // Restored when the view stops
+}
+
+func newProgressView(progress *runner.Progress, logTail *logTail, redactor *redact.Redactor) *progressView {
+	return &progressView{
+		view:     tui.New(os.Stdout, progress, tui.WithLogLines(logTail.Lines)),
+		logTail:  logTail,
+		redactor: redactor,
+	}
+}
+
+// start shows the view. It is a no-op on a nil progressView.
+func (v *progressView) start() {
+	if v == nil {
+		return
+	}
+	v.logger = slog.Default()
+	handler := slog.NewJSONHandler(v.logTail, &slog.HandlerOptions{Level: slog.LevelInfo})
+	slog.SetDefault(slog.New(v.redactor.Handler(handler)))
+	v.view.Start()
+}
+
+// stop restores the screen and logging. It is a no-op on a nil progressView.
+func (v *progressView) stop() {
+	if v == nil || v.logger == nil {
+		return
+	}
+	v.view.Stop()
+	slog.SetDefault(v.logger)
+	v.logger = nil
+}
@@ -0,0 +1,64 @@
+// Package main provides the entry point for the Temporal benchmark runner.
+package main
+
+import (
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/runner"
+)
+
+// handleControlSignals routes operator signals to the load control:
+//
+//	SIGUSR1  pause workflow generation
+//	SIGUSR2  resume workflow generation
+//	SIGHUP   reload thresholds from BENCHMARK_THRESHOLDS_FILE
+//
+// It returns a function that stops signal handling.
+func handleControlSignals(cfg config.BenchmarkConfig, control *runner.LoadControl) func() {
+	sigCh := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(sigCh, syscall.SIGUSR1, syscall.SIGUSR2, syscall.SIGHUP)
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case sig := <-sigCh:
+				slog.Info("Received control signal", "signal", sig.String())
+				switch sig {
+				case syscall.SIGUSR1:
+					control.Pause()
+				case syscall.SIGUSR2:
+					control.Resume()
+				case syscall.SIGHUP:
+					reloadThresholds(cfg, control)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(sigCh)
+		close(done)
+	}
+}
+
+// reloadThresholds re-reads the thresholds file. On error the current thresholds are kept.
+func reloadThresholds(cfg config.BenchmarkConfig, control *runner.LoadControl) {
+	if cfg.ThresholdsFile == "" {
+		slog.Warn("Ignoring SIGHUP: BENCHMARK_THRESHOLDS_FILE is not set")
+		return
+	}
+
+	maxP99Latency, minThroughput, err := config.LoadThresholdsFile(cfg.ThresholdsFile)
+	if err != nil {
+		slog.Error("Failed to reload thresholds, keeping current values", "file", cfg.ThresholdsFile, "error", err)
+		return
+	}
+	control.SetThresholds(maxP99Latency, minThroughput)
+}
@@ -0,0 +1,83 @@
+// Command taskdef renders an ECS task definition that runs a benchmark
+// scenario, sized for the scenario's peak load.
+//
+// Usage:
+//
+//	taskdef -scenario scenario.json -image <repo>/benchmark:latest [flags] > taskdef.json
+//	aws ecs register-task-definition --cli-input-json file://taskdef.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/taskdef"
+)
+
+// envFlags collects repeated -env NAME=VALUE flags.
+type envFlags map[string]string
+
+func (e envFlags) String() string { return fmt.Sprint(map[string]string(e)) }
+
+func (e envFlags) Set(v string) error {
+	name, value, ok := strings.Cut(v, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("expected NAME=VALUE, got %q", v)
+	}
+	e[name] = value
+	return nil
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "taskdef:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("taskdef", flag.ContinueOnError)
+	scenarioFile := fs.String("scenario", "", "scenario JSON file (required)")
+	output := fs.String("o", "", "write the task definition to this file instead of stdout")
+	env := envFlags{}
+	var opts taskdef.Options
+	fs.StringVar(&opts.Image, "image", "", "benchmark image (required)")
+	fs.StringVar(&opts.Family, "family", "", "task definition family (default benchmark-<scenario name>)")
+	fs.StringVar(&opts.ExecutionRoleARN, "execution-role-arn", "", "task execution role ARN")
+	fs.StringVar(&opts.TaskRoleARN, "task-role-arn", "", "task role ARN")
+	fs.StringVar(&opts.TemporalAddress, "temporal-address", "", "Temporal frontend address (default "+config.DefaultConfig().TemporalAddress+")")
+	fs.StringVar(&opts.ThresholdProfile, "threshold-profile", "", "scenario threshold profile to gate the run with")
+	fs.Var(env, "env", "extra environment variable NAME=VALUE (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *scenarioFile == "" {
+		return fmt.Errorf("-scenario is required")
+	}
+	opts.Environment = env
+
+	sc, err := scenario.Load(*scenarioFile, config.DefaultConfig())
+	if err != nil {
+		return err
+	}
+	def, err := taskdef.Render(sc, opts)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(def, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize task definition: %w", err)
+	}
+	data = append(data, '\n')
+
+	if *output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*output, data, 0o644)
+}
@@ -0,0 +1,3261 @@
+// Package config provides configuration parsing for the benchmark runner.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/simulate"
+)
+
+// Valid workflow types
+const (
+	WorkflowTypeSimple           = "simple"
+	WorkflowTypeMultiActivity    = "multi-activity"
+	WorkflowTypeTimer            = "timer"
+	WorkflowTypeChildWorkflow    = "child-workflow"
+	WorkflowTypeStateTransitions = "state-transitions"
+	WorkflowTypeContention       = "contention"
+	WorkflowTypeHeartbeat        = "heartbeat"
+	WorkflowTypeFlaky            = "flaky"
+	WorkflowTypeSearchAttributes = "search-attributes"
+	WorkflowTypeSideEffects      = "side-effects"
+	WorkflowTypeLongHistory      = "long-history"
+	WorkflowTypeFanout           = "fanout"
+
+	// WorkflowTypeMixed starts each workflow as one of the types of
+	// WorkflowMix, chosen by weight
+	WorkflowTypeMixed = "mixed"
+)
+
+// Join strategies of the fanout workflow, selected with BENCHMARK_FANOUT_JOIN:
+// how many of a stage's parallel activities it waits for before the next
+// stage starts. The rest are cancelled.
+const (
+	FanoutJoinAll    = "all"    // Every activity of the stage (default)
+	FanoutJoinQuorum = "quorum" // A majority of the stage's activities
+	FanoutJoinAny    = "any"    // The first activity to complete
+)
+
+// Work the generic activities of the multi-activity, side-effects and fanout
+// workflows perform, selected with BENCHMARK_ACTIVITY_WORK. Sleeping
+// activities hold a worker slot but no CPU, understating worker CPU pressure
+// and the scheduling delays it causes.
+const (
+	ActivityWorkSleep = "sleep" // Sleep 100-600ms (default)
+	ActivityWorkCPU   = "cpu"   // Hash BENCHMARK_ACTIVITY_CPU_ITERATIONS times
+)
+
+// DefaultActivityCPUIterations is the default SHA-256 iterations of a CPU
+// activity, a few tens of milliseconds of one core.
+const DefaultActivityCPUIterations = 100000
+
+// Latency semantics selected with BENCHMARK_LATENCY_SEMANTICS: what a
+// workflow's recorded latency measures.
+const (
+	LatencySubmitToComplete      = "submit-to-complete"       // Client start request to result received (default)
+	LatencyServerStartToComplete = "server-start-to-complete" // Server start time to server close time
+	LatencyScheduleToFirstWFT    = "schedule-to-first-wft"    // Server start time to first workflow task started
+)
+
+// Histogram families whose buckets can be set with BENCHMARK_HISTOGRAM_BUCKETS.
+// An individual SDK timer (e.g. temporal_activity_execution_latency) can also
+// be set by name, which takes precedence over its family.
+const (
+	HistogramWorkflowLatency = "workflow" // benchmark_workflow_latency_seconds
+	HistogramSDKLatency      = "sdk"      // SDK request, workflow task and activity latencies
+	HistogramSDKLongLatency  = "sdk-long" // SDK long poll, end-to-end and unrecognized timer latencies
+)
+
+// Run modes selected with BENCHMARK_MODE or the first command-line argument.
+const (
+	ModeBenchmark  = "benchmark"  // Run the configured benchmark (default)
+	ModeSmoke      = "smoke"      // Run the fixed smoke test and exit
+	ModeVerify     = "verify"     // Run one workflow of every type and exit
+	ModeVisibility = "visibility" // Benchmark visibility queries against a pre-populated namespace and exit
+	ModeCalibrate  = "calibrate"  // Measure state transitions per workflow type and write the calibration table
+	ModeRuns       = "runs"       // List the runs in the run catalog ("benchmark runs list") and exit
+	ModeExport     = "export"     // Publish an anonymized copy of a saved results JSON and exit
+)
+
+// Result encodings selected with BENCHMARK_RESULTS_FORMAT for file and HTTP sinks.
+const (
+	ResultsFormatJSON  = "json"  // JSON, one line per result in files (default)
+	ResultsFormatProto = "proto" // Protobuf (results/results.proto), length-delimited in files
+)
+
+// Process roles selected with the first command-line argument (e.g.
+// "benchmark work") or BENCHMARK_ROLE.
+const (
+	RoleAll      = "all"      // Generate workflows and process them with an embedded worker (default)
+	RoleGenerate = "generate" // Generate workflows for external workers; no embedded worker
+	RoleWork     = "work"     // Only process workflows, e.g. as the benchmark worker service
+)
+
+// Task kinds a work role process handles, selected with BENCHMARK_WORKER_TASKS.
+// Splitting them over separate processes matches production topologies where
+// workflow and activity workers scale independently, and keeps activity
+// pollers from competing with workflow task pollers in one process.
+const (
+	WorkerTasksAll      = "all"      // Workflow and activity tasks (default)
+	WorkerTasksWorkflow = "workflow" // Workflow tasks and local activities only
+	WorkerTasksActivity = "activity" // Activity tasks only
+)
+
+// Address families the Temporal frontend is dialed over, selected with
+// BENCHMARK_ADDRESS_FAMILY. The dual-stack preferences dial happy-eyeballs
+// style (RFC 8305): the preferred family's addresses first, and the other
+// family's once the preferred attempt fails or has not connected within
+// BENCHMARK_DIAL_FALLBACK_DELAY. The -only preferences suit single-stack
+// networking, such as IPv6-only ECS tasks.
+const (
+	AddressFamilyAuto     = "auto"      // Both, the resolver's first address's family first (default)
+	AddressFamilyIPv6     = "ipv6"      // Both, IPv6 first
+	AddressFamilyIPv4     = "ipv4"      // Both, IPv4 first
+	AddressFamilyIPv6Only = "ipv6-only" // IPv6 addresses only
+	AddressFamilyIPv4Only = "ipv4-only" // IPv4 addresses only
+)
+
+// DefaultDialFallbackDelay is the preferred address family's head start
+// before the other family is dialed, Go's own happy-eyeballs delay.
+const DefaultDialFallbackDelay = 300 * time.Millisecond
+
+// Concurrent-run lock behaviors selected with BENCHMARK_RUN_LOCK.
+const (
+	RunLockOff   = "off"   // Run without the lock (default)
+	RunLockFail  = "fail"  // Fail if another run holds the lock
+	RunLockQueue = "queue" // Wait up to RunLockWait for the lock
+)
+
+// Run lock defaults.
+const (
+	DefaultRunLockNamespace = "temporal-benchmark-lock" // Outside the benchmark- prefix so admin cleanup never releases locks
+	DefaultRunLockWait      = time.Hour
+	DefaultRunLockTTL       = 6 * time.Hour
+)
+
+// Stale-run actions of worker services.
+const (
+	StaleRunsOff       = "off"       // Don't look for workflows of stale runs (default)
+	StaleRunsReport    = "report"    // Log workflows of stale runs
+	StaleRunsTerminate = "terminate" // Log and terminate workflows of stale runs
+)
+
+// Stale-run detection defaults.
+const (
+	DefaultStaleRunInterval = time.Minute
+	DefaultStaleRunGrace    = 10 * time.Minute // Longer than a run takes to register
+)
+
+// Grafana snapshot defaults.
+const (
+	DefaultGrafanaDashboard      = "temporal-benchmark" // The provisioned Benchmark Analysis dashboard
+	DefaultGrafanaSnapshotExpiry = 30 * 24 * time.Hour
+)
+
+// DefaultLatencyHeatmapWindow is the width of each time window of the latency
+// heatmap in the results.
+const DefaultLatencyHeatmapWindow = 10 * time.Second
+
+// DefaultWorkflowCacheSize is the SDK's default workflow cache size: the
+// workflows a worker process keeps in memory between workflow tasks.
+const DefaultWorkflowCacheSize = 10000
+
+// Default heartbeat workflow settings: a 30s activity heartbeating every 5s.
+const (
+	DefaultHeartbeatDuration = 30 * time.Second
+	DefaultHeartbeatInterval = 5 * time.Second
+)
+
+// Default flaky workflow settings: a fifth of activity attempts fail, with up
+// to 3 attempts per activity, retried after 1s, then 2s.
+const (
+	DefaultFailureRate             = 0.2
+	DefaultRetryMaxAttempts        = 3
+	DefaultRetryInitialInterval    = time.Second
+	DefaultRetryBackoffCoefficient = 2.0
+)
+
+// DefaultSearchAttributeUpserts is the search attribute workflow's default
+// upserts per workflow.
+const DefaultSearchAttributeUpserts = 5
+
+// DefaultSideEffects is the side effect workflow's default workflow.SideEffect
+// calls per workflow.
+const DefaultSideEffects = 20
+
+// DefaultLongHistoryEvents is the long-history workflow's default history
+// events per workflow.
+const DefaultLongHistoryEvents = 1000
+
+// Default shape of the fanout workflow: stages of parallel activities, run
+// one after another.
+const (
+	DefaultFanoutWidth = 4
+	DefaultFanoutDepth = 3
+	DefaultFanoutJoin  = FanoutJoinAll
+)
+
+// DefaultRunCatalogNamespace holds the run catalog workflow, outside the
+// benchmark- prefix so admin cleanup never deletes the catalog.
+const DefaultRunCatalogNamespace = "temporal-benchmark-control"
+
+// DefaultJanitorNamespace holds the namespace janitor workflows. It is the
+// control namespace shared with the run catalog, which must outlive the
+// namespaces the janitors delete.
+const DefaultJanitorNamespace = DefaultRunCatalogNamespace
+
+// DefaultTimelineInterval is how often the lifecycle timeline records an
+// interval snapshot while workflows are generated.
+const DefaultTimelineInterval = 10 * time.Second
+
+// Defaults of the latency SLA burn-rate alert: it fires when workflows slower
+// than the p99 threshold consume its 1% error budget at twice the rate the run
+// can afford, over the last 5 minutes and the last 25 seconds.
+const (
+	DefaultBurnRateWindow    = 5 * time.Minute
+	DefaultBurnRateThreshold = 2.0
+)
+
+// MinBurnRateWindow keeps the short burn-rate window at least 5 seconds long.
+const MinBurnRateWindow = time.Minute
+
+// DefaultCancelAfter is how long after its start the generator cancels a
+// workflow selected for cancellation.
+const DefaultCancelAfter = 5 * time.Second
+
+// MaxStartPaddingBytes caps the memo and header padding of generated
+// workflow starts at the server's default 2 MB memo and blob size limit.
+const MaxStartPaddingBytes = 2 << 20
+
+// DefaultWorkflowIDTemplate yields the workflow ID prefixes used before
+// templates were configurable, e.g. "simple-20260113-200000".
+const DefaultWorkflowIDTemplate = "{type}-{run}"
+
+// WorkflowIDPlaceholders are the placeholders a workflow ID template may use:
+// workflow type, generation start time, scenario and phase name, generator
+// index (scenario phase or iteration, from 1) and host name.
+var WorkflowIDPlaceholders = []string{"{type}", "{run}", "{scenario}", "{phase}", "{index}", "{host}"}
+
+// Configuration limits
+const (
+	MinActivityCount = 1
+	MaxActivityCount = 100
+	MinTargetRate    = 1
+	MaxTargetRate    = 1000
+	MinDuration      = 1 * time.Minute
+	MaxDuration      = 60 * time.Minute
+	MinWorkerCount   = 1
+	MaxWorkerCount   = 100
+	MinIterations    = 1
+	MaxIterations    = 100
+	MinChildCount    = 1
+	MaxChildCount    = 100
+	MinChildDepth    = 1
+	MaxChildDepth    = 10
+	MinFanIn         = 1
+	MaxFanIn         = 100000
+	MinMetricsPort   = 0 // 0 binds an ephemeral port chosen by the OS
+	MaxMetricsPort   = 65535
+
+	// MinHeartbeatInterval matches the heartbeat throttle of the benchmark
+	// workers, so every heartbeat reaches the server
+	MinHeartbeatInterval = time.Second
+
+	MinRetryMaxAttempts = 1
+	MaxRetryMaxAttempts = 100
+
+	MinSearchAttributeUpserts = 1
+	MaxSearchAttributeUpserts = 1000
+
+	MinSideEffects = 1
+	MaxSideEffects = 10000
+
+	MinLongHistoryEvents = 10
+	MaxLongHistoryEvents = 50000 // Below the server's 51200-event history limit
+
+	MinFanoutWidth = 1
+	MaxFanoutWidth = 1000 // Below the server's 2000 pending activities per workflow
+	MinFanoutDepth = 1
+	MaxFanoutDepth = 1000
+
+	// MaxFanoutActivities bounds a fanout workflow's width times depth, keeping
+	// its history well below the server's 51200-event limit
+	MaxFanoutActivities = 5000
+
+	MinActivityCPUIterations = 1
+	MaxActivityCPUIterations = 100000000 // Tens of seconds, within the activities' one-minute timeout
+
+	// MaxChildTreeChildren bounds the child workflows a child-workflow tree
+	// starts across all its levels
+	MaxChildTreeChildren = 1000
+
+	MaxCleanupConcurrency = 500
+	MaxCleanupRate        = 5000
+
+	MaxRetentionSampleSize = 10000
+
+	MaxClockSkewCanaries = 20
+
+	MaxHistorySizeSamples = 1000
+	MaxIntegritySamples   = 1000
+
+	MaxVisibilityQPS      = 1000
+	MaxReadQPS            = 1000
+	MaxControlRPS         = 1000
+	MaxVisibilityPageSize = 1000 // Matches the server's default visibility max page size
+)
+
+// DefaultDrainMaxTimeout bounds the adaptive drain when DrainMaxTimeout is unset.
+const DefaultDrainMaxTimeout = 30 * time.Minute
+
+// DefaultCleanupTimeout bounds the cleanup when CleanupTimeout is unset (the
+// cleanup package's DefaultTotalTimeout).
+const DefaultCleanupTimeout = 15 * time.Minute
+
+// DefaultMetricsPort is the default Prometheus metrics port for all roles.
+const DefaultMetricsPort = 9090
+
+// DefaultCardinalityLimit is the default number of series a metric family
+// may have before the scrape guard warns.
+const DefaultCardinalityLimit = 2000
+
+// DefaultAdminPort is the default port for the admin HTTP endpoint.
+const DefaultAdminPort = 9091
+
+// DefaultSimulateLatency is the default simulated workflow latency distribution.
+const DefaultSimulateLatency = "lognormal:200ms:1s"
+
+// BenchmarkConfig defines the benchmark parameters.
+type BenchmarkConfig struct {
+	// Workflow configuration
+	WorkflowType  string        // "simple", "multi-activity", "timer", "child-workflow", "state-transitions", "contention", "heartbeat", "flaky", "search-attributes", "side-effects", "long-history", "fanout", "mixed"
+	ActivityCount int           // Number of activities (for multi-activity, flaky and side-effects types)
+	TimerDuration time.Duration // Timer duration (for timer type)
+	ChildCount    int           // Number of child workflows (for child-workflow type)
+	ChildDepth    int           // Levels of child workflows, each child starting ChildCount of its own (for child-workflow type)
+	FanIn         int           // Workflows signalling each aggregator (for contention type)
+
+	HeartbeatDuration time.Duration // Activity run time (for heartbeat type)
+	HeartbeatInterval time.Duration // Time between activity heartbeats (for heartbeat type)
+
+	// Injected activity failures and their retry policy (for flaky type)
+	FailureRate             float64       // Probability that an activity attempt fails, in [0, 1)
+	RetryMaxAttempts        int           // Attempts per activity; the last one always succeeds
+	RetryInitialInterval    time.Duration // Delay before the first retry
+	RetryBackoffCoefficient float64       // Growth of the delay between retries
+
+	SearchAttributeUpserts int // Search attribute upserts per workflow (for search-attributes type)
+
+	SideEffects int // workflow.SideEffect calls per workflow (for side-effects type)
+
+	LongHistoryEvents int // Approximate history events per workflow (for long-history type)
+
+	// Stages of parallel activities (for fanout type)
+	FanoutWidth int    // Activities started in parallel per stage
+	FanoutDepth int    // Stages run one after another
+	FanoutJoin  string // Activities a stage waits for: "all", "quorum" or "any"
+
+	WorkflowMix map[string]int // Relative weight of each workflow type started (for mixed type), e.g. simple=50,timer=30
+
+	// Work of the generic activities (for multi-activity, side-effects and fanout types)
+	ActivityWork          string // "sleep" or "cpu"
+	ActivityCPUIterations int    // SHA-256 iterations of each CPU activity
+
+	// Load configuration
+	TargetRate     float64       // Workflows per second
+	Duration       time.Duration // Test duration
+	RampUpDuration time.Duration // Ramp-up period
+	WorkerCount    int           // Number of parallel workers
+
+	// Target state transitions per second; when set it replaces TargetRate,
+	// translated with the per-type cost model (see TransitionCost)
+	TargetStateTransitions float64
+
+	// Calibration table written by calibrate mode and read by other modes
+	CalibrationFile string
+	Calibration     *CalibrationTable // Loaded from CalibrationFile (nil uses the built-in cost model)
+
+	// Measurement configuration
+	LatencySemantics      string        // What latency measures: "submit-to-complete", "server-start-to-complete" or "schedule-to-first-wft"
+	LatencyMemoryBudgetMB int           // Memory for raw latency samples, shared by all of a run's collectors, before switching to histogram-only percentiles (0 = unlimited)
+	LatencyHeatmapWindow  time.Duration // Width of each latency heatmap window in the results (0 = no heatmap)
+	// If true, starts rejected because the workflow ID was already started
+	// count as completed workflows for throughput (they never have a latency)
+	AlreadyStartedAsSuccess bool
+
+	// Execution configuration
+	Mode              string        // Run mode: "benchmark", "smoke", "verify", "visibility" or "calibrate"
+	Namespace         string        // Benchmark namespace (auto-generated if empty)
+	Iterations        int           // Number of test iterations
+	CompletionTimeout time.Duration // Timeout for waiting for workflows to complete after test ends (0 = adaptive drain)
+	DrainStallTimeout time.Duration // Adaptive drain aborts after this long without a workflow finishing (0 = never)
+	DrainMaxTimeout   time.Duration // Upper bound on the adaptive drain (0 = DefaultDrainMaxTimeout)
+	MaxTotalRuntime   time.Duration // Hard deadline for the whole process, including connect, drain and cleanup (0 = unbounded)
+	ScenarioFile      string        // JSON scenario with sequential load phases (overrides the single load phase)
+	Scenario          string        // Inline JSON scenario, e.g. rendered into a task definition (alternative to ScenarioFile)
+	Role              string        // Process role: "all", "generate" or "work"
+	WorkerTasks       string        // Tasks the work role handles: "all", "workflow" or "activity"
+
+	// Disable sticky execution on this process's workers, so every workflow
+	// task replays the workflow's history. In the generate role it records
+	// the setting of the separate worker service in the results.
+	DisableSticky bool
+
+	// Workflows this process's workers cache between workflow tasks; a
+	// workflow evicted from a full cache replays its history on its next task
+	WorkflowCacheSize int
+
+	// Activity rate limits of this process's workers, emulating rate-limited
+	// production workers: activities per second each worker starts, and
+	// across all workers of the task queue (enforced by the server). 0
+	// leaves the SDK defaults (100,000 per worker, unlimited per task queue).
+	// In the generate role they record the worker service's settings.
+	WorkerActivitiesPerSecond    float64
+	TaskQueueActivitiesPerSecond float64
+
+	// Template for workflow ID prefixes (IDs are "<prefix>-<n>"); see WorkflowIDPlaceholders
+	WorkflowIDTemplate string
+
+	// Memo entries attached to every generated workflow, e.g. team, cost-center
+	// and experiment ID for cost attribution (the same keys as the ECS task tags)
+	Memo map[string]string
+
+	// Padding attached to every generated workflow start, to measure the cost
+	// of large starts: a memo entry (written to mutable state and visibility)
+	// and a header (written to the start event) of this many bytes (0 = none)
+	MemoSizeBytes   int
+	HeaderSizeBytes int
+
+	// Visibility mode configuration
+	VisibilityQPS      float64 // Target ListWorkflowExecutions queries per second (0 = no list queries)
+	VisibilityCountQPS float64 // Target CountWorkflowExecutions queries per second (0 = no count queries)
+	VisibilityPageSize int     // Page size of each list query
+
+	// Backpressure configuration
+	BackpressureThreshold float64 // Worker slot utilization (0-1] at which workers count as saturated
+	BackpressureHold      bool    // If true, hold the generator's rate while workers are saturated
+
+	// Latency SLA burn-rate alerting configuration
+	BurnRateWindow    time.Duration // Long window of the p99 latency burn rate; the short one is 1/12 of it (0 disables alerting)
+	BurnRateThreshold float64       // Burn rate of both windows that raises the alert
+
+	// Worker scaling experiment configuration
+	WorkerScalingSchedule string // Worker counts by offset from run start, e.g. "0s=2,5m=4,10m=8" (empty disables scaling)
+	WorkerScalingCluster  string // ECS cluster of the worker service
+	WorkerScalingService  string // ECS worker service to scale
+
+	// History service whose tasks a shard churn scenario restarts
+	HistoryCluster string // ECS cluster of the history service
+	HistoryService string // ECS history service
+
+	// Frontend service whose task availability zones are recorded in the topology
+	FrontendCluster string // ECS cluster of the frontend service
+	FrontendService string // ECS frontend service (empty leaves the frontend's AZs unresolved)
+
+	// Temporal services a scenario's dynamic config overrides redeploy
+	DynamicConfigCluster  string   // ECS cluster of the Temporal services
+	DynamicConfigServices []string // ECS services of the Temporal server, e.g. frontend, history, matching and worker
+
+	// Stuck workflow detection configuration
+	StuckWorkflowThreshold time.Duration // Open workflows with no history event for this long after drain count as stuck (0 = disabled)
+	StuckWorkflowTerminate bool          // If true, terminate stuck workflows separately from normal cleanup
+
+	// History size accounting configuration
+	HistorySizeSamples int // Completed workflows per type whose history length and size are read after the run (0 = disabled)
+
+	// History integrity audit configuration
+	IntegritySamples int // Completed workflows per type whose full history is audited after the run (0 = disabled)
+
+	// Workflow outcome verification configuration
+	OutcomeSampleRate float64 // Fraction of completed workflows whose outcome checksum is verified, in [0, 1] (0 = disabled)
+
+	// Workflow cancellation configuration
+	CancelRate  float64       // Fraction of started workflows the generator cancels mid-flight, in [0, 1] (0 = disabled)
+	CancelAfter time.Duration // Time after a workflow's start at which it is cancelled
+
+	// Cluster warm-state snapshot configuration
+	ClusterSnapshot bool // If true, snapshot workflow counts and backlogs across namespaces before and after the run
+
+	// Clock skew detection configuration
+	ClockSkewCanaries int // Canary workflows used to measure client/server clock skew before the run (0 = disabled)
+
+	// Background read workload configuration
+	ReadQPS float64 // DescribeWorkflowExecution/GetWorkflowExecutionHistory calls per second on completed workflows during the run (0 = disabled)
+
+	// Control-plane client configuration
+	ControlRPS       float64 // Rate limit of a dedicated connection for control operations (0 = they share the main client)
+	ControlNamespace string  // Namespace for control workflows such as clock skew canaries (empty = the run namespace)
+
+	// Metrics configuration
+	MetricsPort       int                  // Prometheus metrics port for generator/full mode (0 = ephemeral)
+	WorkerMetricsPort int                  // Prometheus metrics port for worker-only mode (0 = ephemeral)
+	MetricsPrefix     string               // Prefix prepended to all exported metric names (empty = none)
+	MetricsLabels     map[string]string    // Constant labels added to all exported series, e.g. scenario, run_id, role
+	HistogramBuckets  map[string][]float64 // Bucket upper bounds in seconds by histogram family or SDK timer name
+	NativeHistograms  bool                 // Also expose latency histograms as Prometheus native histograms
+	CardinalityLimit  int                  // Series per metric family before the scrape guard warns (0 = no guard)
+
+	// Report generated per-run namespaces (benchmark-<nanos>) in the SDK
+	// metrics' namespace label as the scenario name (or the workflow type
+	// without a scenario); results keep the real namespace
+	NormalizeNamespaceLabel bool
+
+	// Server metrics configuration
+	ServerMetricsURLs []string // Temporal server Prometheus endpoints scraped at the start and end of a run (empty disables scraping)
+	WorkerMetricsURLs []string // Worker service Prometheus endpoints whose execution counters the generate role scrapes at the start and end of a run (empty = no duplicate detection)
+
+	// Admin endpoint configuration
+	AdminPort  int    // Port for the admin HTTP endpoint (0 = ephemeral)
+	AdminToken string // Bearer token for admin requests (admin endpoint disabled if empty)
+
+	// Cleanup configuration
+	CleanupConcurrency int           // Maximum concurrent terminations (0 = auto-size from workflow count)
+	CleanupRate        float64       // Maximum terminations per second (0 = auto-size from workflow count)
+	CleanupTimeout     time.Duration // Maximum total cleanup duration (0 = default)
+	CleanupCallTimeout time.Duration // Timeout for each cleanup RPC (0 = default)
+
+	// Retention verification configuration
+	RetentionVerifyDelay time.Duration // Wait after cleanup before verifying retention (0 = disabled)
+	RetentionResultsFile string        // Verify retention for a saved results JSON instead of running a benchmark
+	RetentionSampleSize  int           // Workflow IDs probed to estimate residual executions
+
+	// Output configuration
+	ResultSinks    string // Comma-separated result sinks: stdout, file:<path>, http(s)://<url>
+	ResultsFormat  string // Encoding for file and HTTP sinks: "json" or "proto"
+	ArtifactBundle bool   // Also publish a tar.gz of all run outputs to sinks that support it
+	Redact         bool   // Scrub addresses, tokens and TLS paths from logs and results so they can be shared externally
+
+	// Export mode input: a saved results JSON whose anonymized copy is
+	// published to the result sinks
+	ExportFile string
+
+	// Local results history (newline-delimited JSON, rotated by size)
+	HistoryFile  string // Append each result to this file (disabled if empty)
+	HistoryMaxMB int    // Rotate the history file when it would exceed this size
+	HistoryKeep  int    // Rotated history files to keep (history.1 is the newest)
+
+	// Grafana snapshot of each run's time window, linked from the results
+	GrafanaURL            string        // Grafana base URL (snapshots disabled if empty)
+	GrafanaToken          string        // Service account token with dashboard read and snapshot create permissions
+	GrafanaDashboard      string        // UID of the dashboard to snapshot
+	GrafanaSnapshotExpiry time.Duration // Snapshots are deleted after this long (0 = never)
+
+	// Daemon configuration
+	DaemonSchedule string // Cron schedule for continuous benchmarking (e.g. "@hourly"); empty runs once
+
+	// Thresholds for pass/fail
+	MaxP99Latency  time.Duration // Maximum acceptable p99 latency
+	MinThroughput  float64       // Minimum acceptable throughput
+	ThresholdsFile string        // JSON file overriding the thresholds, re-read on SIGHUP
+
+	// Thresholds relative to a baseline results JSON (0 disables each check)
+	BaselineFile                 string
+	BaselineMaxP99Percent        float64 // p99 latency must be within this percent of the baseline's, e.g. 110
+	BaselineMinThroughputPercent float64 // Throughput must be at least this percent of the baseline's, e.g. 95
+
+	// Custom pass/fail logic registered with results.RegisterThresholdEvaluator,
+	// evaluated in order after the built-in thresholds
+	ThresholdEvaluators []string
+
+	// Named threshold profile from the scenario file (e.g. "prod"), applied
+	// over the thresholds above
+	ThresholdProfile string
+
+	// Server-side persistence latency thresholds (require ServerMetricsURLs)
+	PersistenceThresholds []PersistenceThreshold
+
+	// Read-path latency thresholds measured by the background read workload
+	// (require ReadQPS; 0 disables each check)
+	MaxDescribeP99   time.Duration
+	MaxGetHistoryP99 time.Duration
+
+	// Temporal connection
+	TemporalAddress string // Temporal frontend address
+
+	AddressFamily     string        // Address family preference: "auto", "ipv6", "ipv4", "ipv6-only" or "ipv4-only"
+	DialFallbackDelay time.Duration // Preferred family's head start before happy-eyeballs dialing tries the other
+
+	// Client authentication (see the auth package for the providers)
+	AuthProvider      string   // Credential provider: "none", "mtls", "api-key", "oauth", "aws-sigv4", "sidecar-token" or a registered custom provider
+	TLSCertFile       string   // Client certificate for mTLS
+	TLSKeyFile        string   // Client private key for mTLS
+	TLSCAFile         string   // CA bundle to verify the server with (empty = system roots)
+	TLSCertPEM        []byte   // Client certificate resolved from a secret reference, used instead of TLSCertFile
+	TLSKeyPEM         []byte   // Client private key resolved from a secret reference, used instead of TLSKeyFile
+	TLSCAPEM          []byte   // CA bundle resolved from a secret reference, used instead of TLSCAFile
+	TLSServerName     string   // Server name to verify (empty = the host of TemporalAddress)
+	TLSDisabled       bool     // If true, send API keys and tokens without TLS (e.g. behind a TLS-terminating sidecar)
+	APIKey            string   // Static API key
+	OAuthTokenURL     string   // OAuth/OIDC token endpoint for the client credentials grant
+	OAuthClientID     string   // OAuth client ID
+	OAuthClientSecret string   // OAuth client secret
+	OAuthScopes       []string // OAuth scopes to request
+	OAuthAudience     string   // Audience to request, for identity providers that require one
+	SigV4Region       string   // Region requests are signed for (empty = the AWS SDK's default region)
+	SigV4Service      string   // Service name requests are signed for
+	AuthTokenFile     string   // Token file kept fresh by a sidecar, re-read whenever it changes
+
+	// Environment fingerprint validation
+	ExpectationsFile string // JSON file of the expected server version, shard count and DSQL endpoint (disabled if empty)
+
+	// Concurrent-run lock
+	RunLock          string        // "off", "fail" or "queue" when another run holds the lock
+	RunLockNamespace string        // Namespace of the lock workflow, shared by every run against the cluster
+	RunLockWait      time.Duration // Longest a queued run waits for the lock
+	RunLockTTL       time.Duration // Lock expiry, so a crashed run cannot hold it forever
+
+	// Active run registry and stale-run detection. Registered runs hold a
+	// workflow in RunLockNamespace (expiring after RunLockTTL) and tag their
+	// workflows with the run; worker services treat workflows of unregistered
+	// runs as stale
+	RunRegistry      bool          // Register each run and tag its workflows (generators)
+	StaleRuns        string        // "off", "report" or "terminate" workflows of stale runs (workers)
+	StaleRunInterval time.Duration // Interval between stale-run checks
+	StaleRunGrace    time.Duration // Workflows started more recently are never stale
+
+	// Run catalog
+	RunCatalog          bool   // Record each run's metadata, status and result location in the catalog
+	RunCatalogNamespace string // Namespace of the catalog workflow, read by "benchmark runs list"
+
+	// Namespace TTL: each namespace a run creates is deleted after
+	// NamespaceTTL by a janitor workflow in JanitorNamespace, even if the run
+	// crashes. Janitors make progress while any benchmark process with a TTL
+	// configured (a run, or the work role) polls their task queue.
+	NamespaceTTL     time.Duration // Delete created namespaces after this long (0 disables)
+	JanitorNamespace string        // Namespace of the janitor workflows
+
+	// Event recording and replay
+	RecordFile string // Record each run's raw workflow events to this file (disabled if empty)
+	ReplayFile string // Replay a recorded event log through the results pipeline instead of running a benchmark
+
+	// Per-workflow record streaming
+	FirehoseStream string // Firehose delivery stream name or ARN receiving one record per finished workflow (disabled if empty)
+
+	// Run lifecycle timeline
+	Timeline         string        // "stdout" or "file:<path>" receiving JSON lines of run lifecycle events (disabled if empty)
+	TimelineInterval time.Duration // Interval between interval_snapshot events
+
+	// Simulation: run against an in-process fake Temporal frontend instead of a cluster
+	Simulate                 bool
+	SimulateLatency          string  // Workflow latency distribution: fixed:<d>, uniform:<min>:<max> or lognormal:<median>:<p99>
+	SimulateFailureRate      float64 // Fraction of simulated workflows that fail
+	SimulateStartFailureRate float64 // Fraction of simulated starts rejected
+
+	// Local progress view
+	TUI bool // Draw a terminal progress view (--tui); disabled when stdout is not a terminal
+}
+
+// DefaultConfig returns a BenchmarkConfig with default values.
+func DefaultConfig() BenchmarkConfig {
+	return BenchmarkConfig{
+		WorkflowType:          WorkflowTypeSimple,
+		ActivityCount:         5,
+		TimerDuration:         time.Second,
+		ChildCount:            3,
+		ChildDepth:            1,
+		FanIn:                 100,
+		HeartbeatDuration:     DefaultHeartbeatDuration,
+		HeartbeatInterval:     DefaultHeartbeatInterval,
+		TargetRate:            100,
+		Duration:              5 * time.Minute,
+		RampUpDuration:        30 * time.Second,
+		WorkerCount:           4,
+		LatencySemantics:      LatencySubmitToComplete,
+		WorkflowIDTemplate:    DefaultWorkflowIDTemplate,
+		LatencyMemoryBudgetMB: 256,
+		LatencyHeatmapWindow:  DefaultLatencyHeatmapWindow,
+		WorkflowCacheSize:     DefaultWorkflowCacheSize,
+		GrafanaDashboard:      DefaultGrafanaDashboard,
+		GrafanaSnapshotExpiry: DefaultGrafanaSnapshotExpiry,
+		Iterations:            1,
+		Mode:                  ModeBenchmark,
+		Role:                  RoleAll,
+		CompletionTimeout:     0, // 0 means drain adaptively from the live backlog
+		DrainStallTimeout:     time.Minute,
+		DrainMaxTimeout:       DefaultDrainMaxTimeout,
+		VisibilityQPS:         20,
+		VisibilityCountQPS:    5,
+		VisibilityPageSize:    100,
+		BackpressureThreshold: 0.9,
+		ClockSkewCanaries:     3,
+		HistorySizeSamples:    20,
+		ClusterSnapshot:       true,
+		MetricsPort:           DefaultMetricsPort,
+		CardinalityLimit:      DefaultCardinalityLimit,
+		WorkerMetricsPort:     DefaultMetricsPort,
+		AdminPort:             DefaultAdminPort,
+		RetentionSampleSize:   100,
+		ResultSinks:           "stdout",
+		ResultsFormat:         ResultsFormatJSON,
+		HistoryMaxMB:          10,
+		HistoryKeep:           5,
+		MaxP99Latency:         5 * time.Second,
+		MinThroughput:         50,
+		TemporalAddress:       "temporal-frontend:7233",
+		AddressFamily:         AddressFamilyAuto,
+		DialFallbackDelay:     DefaultDialFallbackDelay,
+		AuthProvider:          "none",
+		SigV4Service:          "vpc-lattice-svcs",
+		RunLock:               RunLockOff,
+		RunLockNamespace:      DefaultRunLockNamespace,
+		RunLockWait:           DefaultRunLockWait,
+		RunLockTTL:            DefaultRunLockTTL,
+		StaleRuns:             StaleRunsOff,
+		StaleRunInterval:      DefaultStaleRunInterval,
+		StaleRunGrace:         DefaultStaleRunGrace,
+		RunCatalogNamespace:   DefaultRunCatalogNamespace,
+		JanitorNamespace:      DefaultJanitorNamespace,
+		SimulateLatency:       DefaultSimulateLatency,
+		TimelineInterval:      DefaultTimelineInterval,
+		BurnRateWindow:        DefaultBurnRateWindow,
+		BurnRateThreshold:     DefaultBurnRateThreshold,
+		CancelAfter:           DefaultCancelAfter,
+
+		FailureRate:             DefaultFailureRate,
+		RetryMaxAttempts:        DefaultRetryMaxAttempts,
+		RetryInitialInterval:    DefaultRetryInitialInterval,
+		RetryBackoffCoefficient: DefaultRetryBackoffCoefficient,
+		SearchAttributeUpserts:  DefaultSearchAttributeUpserts,
+		SideEffects:             DefaultSideEffects,
+		LongHistoryEvents:       DefaultLongHistoryEvents,
+		FanoutWidth:             DefaultFanoutWidth,
+		FanoutDepth:             DefaultFanoutDepth,
+		FanoutJoin:              DefaultFanoutJoin,
+		ActivityWork:            ActivityWorkSleep,
+		ActivityCPUIterations:   DefaultActivityCPUIterations,
+		WorkerTasks:             WorkerTasksAll,
+	}
+}
+
+// LoadFromEnv loads configuration from environment variables.
+// It starts with default values and overrides with any set environment variables.
+func LoadFromEnv() (BenchmarkConfig, error) {
+	cfg := DefaultConfig()
+
+	// Workflow configuration
+	if v := os.Getenv("BENCHMARK_WORKFLOW_TYPE"); v != "" {
+		cfg.WorkflowType = v
+	}
+
+	if v := os.Getenv("BENCHMARK_WORKFLOW_MIX"); v != "" {
+		mix, err := ParseWorkflowMix(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_WORKFLOW_MIX: %w", err)
+		}
+		cfg.WorkflowMix = mix
+	}
+
+	if v := os.Getenv("BENCHMARK_ACTIVITY_WORK"); v != "" {
+		cfg.ActivityWork = v
+	}
+
+	if v := os.Getenv("BENCHMARK_ACTIVITY_CPU_ITERATIONS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_ACTIVITY_CPU_ITERATIONS: %w", err)
+		}
+		cfg.ActivityCPUIterations = n
+	}
+
+	if v := os.Getenv("BENCHMARK_ACTIVITY_COUNT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_ACTIVITY_COUNT: %w", err)
+		}
+		cfg.ActivityCount = n
+	}
+
+	if v := os.Getenv("BENCHMARK_TIMER_DURATION"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_TIMER_DURATION: %w", err)
+		}
+		cfg.TimerDuration = d
+	}
+
+	if v := os.Getenv("BENCHMARK_HEARTBEAT_DURATION"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_HEARTBEAT_DURATION: %w", err)
+		}
+		cfg.HeartbeatDuration = d
+	}
+
+	if v := os.Getenv("BENCHMARK_HEARTBEAT_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_HEARTBEAT_INTERVAL: %w", err)
+		}
+		cfg.HeartbeatInterval = d
+	}
+
+	if v := os.Getenv("BENCHMARK_FAILURE_RATE"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_FAILURE_RATE: %w", err)
+		}
+		cfg.FailureRate = f
+	}
+
+	if v := os.Getenv("BENCHMARK_RETRY_MAX_ATTEMPTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RETRY_MAX_ATTEMPTS: %w", err)
+		}
+		cfg.RetryMaxAttempts = n
+	}
+
+	if v := os.Getenv("BENCHMARK_RETRY_INITIAL_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RETRY_INITIAL_INTERVAL: %w", err)
+		}
+		cfg.RetryInitialInterval = d
+	}
+
+	if v := os.Getenv("BENCHMARK_RETRY_BACKOFF_COEFFICIENT"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RETRY_BACKOFF_COEFFICIENT: %w", err)
+		}
+		cfg.RetryBackoffCoefficient = f
+	}
+
+	if v := os.Getenv("BENCHMARK_SEARCH_ATTRIBUTE_UPSERTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_SEARCH_ATTRIBUTE_UPSERTS: %w", err)
+		}
+		cfg.SearchAttributeUpserts = n
+	}
+
+	if v := os.Getenv("BENCHMARK_SIDE_EFFECTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_SIDE_EFFECTS: %w", err)
+		}
+		cfg.SideEffects = n
+	}
+
+	if v := os.Getenv("BENCHMARK_LONG_HISTORY_EVENTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_LONG_HISTORY_EVENTS: %w", err)
+		}
+		cfg.LongHistoryEvents = n
+	}
+
+	if v := os.Getenv("BENCHMARK_FANOUT_WIDTH"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_FANOUT_WIDTH: %w", err)
+		}
+		cfg.FanoutWidth = n
+	}
+
+	if v := os.Getenv("BENCHMARK_FANOUT_DEPTH"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_FANOUT_DEPTH: %w", err)
+		}
+		cfg.FanoutDepth = n
+	}
+
+	if v := os.Getenv("BENCHMARK_FANOUT_JOIN"); v != "" {
+		cfg.FanoutJoin = v
+	}
+
+	if v := os.Getenv("BENCHMARK_CHILD_COUNT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CHILD_COUNT: %w", err)
+		}
+		cfg.ChildCount = n
+	}
+
+	if v := os.Getenv("BENCHMARK_CHILD_DEPTH"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CHILD_DEPTH: %w", err)
+		}
+		cfg.ChildDepth = n
+	}
+
+	if v := os.Getenv("BENCHMARK_CONTENTION_FAN_IN"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CONTENTION_FAN_IN: %w", err)
+		}
+		cfg.FanIn = n
+	}
+
+	// Load configuration
+	if v := os.Getenv("BENCHMARK_TARGET_RATE"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_TARGET_RATE: %w", err)
+		}
+		cfg.TargetRate = f
+	}
+
+	if v := os.Getenv("BENCHMARK_TARGET_STATE_TRANSITIONS"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_TARGET_STATE_TRANSITIONS: %w", err)
+		}
+		cfg.TargetStateTransitions = f
+	}
+
+	if v := os.Getenv("BENCHMARK_CALIBRATION_FILE"); v != "" {
+		cfg.CalibrationFile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_DURATION"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_DURATION: %w", err)
+		}
+		cfg.Duration = d
+	}
+
+	if v := os.Getenv("BENCHMARK_RAMP_UP"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RAMP_UP: %w", err)
+		}
+		cfg.RampUpDuration = d
+	}
+
+	if v := os.Getenv("BENCHMARK_WORKER_COUNT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_WORKER_COUNT: %w", err)
+		}
+		cfg.WorkerCount = n
+	}
+
+	// Measurement configuration
+	if v := os.Getenv("BENCHMARK_LATENCY_SEMANTICS"); v != "" {
+		cfg.LatencySemantics = v
+	}
+
+	if v := os.Getenv("BENCHMARK_LATENCY_MEMORY_BUDGET_MB"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_LATENCY_MEMORY_BUDGET_MB: %w", err)
+		}
+		cfg.LatencyMemoryBudgetMB = n
+	}
+
+	if v := os.Getenv("BENCHMARK_LATENCY_HEATMAP_WINDOW"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_LATENCY_HEATMAP_WINDOW: %w", err)
+		}
+		cfg.LatencyHeatmapWindow = d
+	}
+
+	if v := os.Getenv("BENCHMARK_ALREADY_STARTED_AS_SUCCESS"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_ALREADY_STARTED_AS_SUCCESS: %w", err)
+		}
+		cfg.AlreadyStartedAsSuccess = b
+	}
+
+	// Execution configuration
+	if v := os.Getenv("BENCHMARK_MODE"); v != "" {
+		cfg.Mode = v
+	}
+
+	if v := os.Getenv("BENCHMARK_NAMESPACE"); v != "" {
+		cfg.Namespace = v
+	}
+
+	if v := os.Getenv("BENCHMARK_VISIBILITY_QPS"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_VISIBILITY_QPS: %w", err)
+		}
+		cfg.VisibilityQPS = f
+	}
+
+	if v := os.Getenv("BENCHMARK_VISIBILITY_COUNT_QPS"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_VISIBILITY_COUNT_QPS: %w", err)
+		}
+		cfg.VisibilityCountQPS = f
+	}
+
+	if v := os.Getenv("BENCHMARK_VISIBILITY_PAGE_SIZE"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_VISIBILITY_PAGE_SIZE: %w", err)
+		}
+		cfg.VisibilityPageSize = n
+	}
+
+	if v := os.Getenv("BENCHMARK_ITERATIONS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_ITERATIONS: %w", err)
+		}
+		cfg.Iterations = n
+	}
+
+	if v := os.Getenv("BENCHMARK_SCENARIO_FILE"); v != "" {
+		cfg.ScenarioFile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_SCENARIO"); v != "" {
+		cfg.Scenario = v
+	}
+
+	if v := os.Getenv("BENCHMARK_WORKFLOW_ID_TEMPLATE"); v != "" {
+		cfg.WorkflowIDTemplate = v
+	}
+
+	if v := os.Getenv("BENCHMARK_MEMO"); v != "" {
+		memo, err := ParseMemo(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_MEMO: %w", err)
+		}
+		cfg.Memo = memo
+	}
+
+	if v := os.Getenv("BENCHMARK_MEMO_SIZE_BYTES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_MEMO_SIZE_BYTES: %w", err)
+		}
+		cfg.MemoSizeBytes = n
+	}
+
+	if v := os.Getenv("BENCHMARK_HEADER_SIZE_BYTES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_HEADER_SIZE_BYTES: %w", err)
+		}
+		cfg.HeaderSizeBytes = n
+	}
+
+	// Completion timeout
+	if v := os.Getenv("BENCHMARK_COMPLETION_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_COMPLETION_TIMEOUT: %w", err)
+		}
+		cfg.CompletionTimeout = d
+	}
+
+	if v := os.Getenv("BENCHMARK_DRAIN_STALL_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_DRAIN_STALL_TIMEOUT: %w", err)
+		}
+		cfg.DrainStallTimeout = d
+	}
+
+	if v := os.Getenv("BENCHMARK_DRAIN_MAX_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_DRAIN_MAX_TIMEOUT: %w", err)
+		}
+		cfg.DrainMaxTimeout = d
+	}
+
+	if v := os.Getenv("BENCHMARK_MAX_TOTAL_RUNTIME"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_MAX_TOTAL_RUNTIME: %w", err)
+		}
+		cfg.MaxTotalRuntime = d
+	}
+
+	// Backpressure configuration
+	if v := os.Getenv("BENCHMARK_BACKPRESSURE_THRESHOLD"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_BACKPRESSURE_THRESHOLD: %w", err)
+		}
+		cfg.BackpressureThreshold = f
+	}
+
+	if v := os.Getenv("BENCHMARK_BACKPRESSURE_HOLD"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_BACKPRESSURE_HOLD: %w", err)
+		}
+		cfg.BackpressureHold = b
+	}
+
+	// Latency SLA burn-rate alerting configuration
+	if v := os.Getenv("BENCHMARK_BURN_RATE_WINDOW"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_BURN_RATE_WINDOW: %w", err)
+		}
+		cfg.BurnRateWindow = d
+	}
+
+	if v := os.Getenv("BENCHMARK_BURN_RATE_THRESHOLD"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_BURN_RATE_THRESHOLD: %w", err)
+		}
+		cfg.BurnRateThreshold = f
+	}
+
+	// Worker scaling experiment configuration
+	if v := os.Getenv("BENCHMARK_WORKER_SCALING_SCHEDULE"); v != "" {
+		cfg.WorkerScalingSchedule = v
+	}
+
+	if v := os.Getenv("BENCHMARK_WORKER_SCALING_CLUSTER"); v != "" {
+		cfg.WorkerScalingCluster = v
+	}
+
+	if v := os.Getenv("BENCHMARK_WORKER_SCALING_SERVICE"); v != "" {
+		cfg.WorkerScalingService = v
+	}
+
+	// Shard churn configuration
+	if v := os.Getenv("BENCHMARK_HISTORY_CLUSTER"); v != "" {
+		cfg.HistoryCluster = v
+	}
+
+	if v := os.Getenv("BENCHMARK_HISTORY_SERVICE"); v != "" {
+		cfg.HistoryService = v
+	}
+
+	// Topology configuration
+	if v := os.Getenv("BENCHMARK_FRONTEND_CLUSTER"); v != "" {
+		cfg.FrontendCluster = v
+	}
+
+	if v := os.Getenv("BENCHMARK_FRONTEND_SERVICE"); v != "" {
+		cfg.FrontendService = v
+	}
+
+	// Dynamic config override configuration
+	if v := os.Getenv("BENCHMARK_DYNAMIC_CONFIG_CLUSTER"); v != "" {
+		cfg.DynamicConfigCluster = v
+	}
+
+	if v := os.Getenv("BENCHMARK_DYNAMIC_CONFIG_SERVICES"); v != "" {
+		for _, service := range strings.Split(v, ",") {
+			if service = strings.TrimSpace(service); service != "" {
+				cfg.DynamicConfigServices = append(cfg.DynamicConfigServices, service)
+			}
+		}
+	}
+
+	// Stuck workflow detection configuration
+	if v := os.Getenv("BENCHMARK_STUCK_WORKFLOW_THRESHOLD"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_STUCK_WORKFLOW_THRESHOLD: %w", err)
+		}
+		cfg.StuckWorkflowThreshold = d
+	}
+
+	if v := os.Getenv("BENCHMARK_STUCK_WORKFLOW_TERMINATE"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_STUCK_WORKFLOW_TERMINATE: %w", err)
+		}
+		cfg.StuckWorkflowTerminate = b
+	}
+
+	// History size accounting configuration
+	if v := os.Getenv("BENCHMARK_HISTORY_SIZE_SAMPLES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_HISTORY_SIZE_SAMPLES: %w", err)
+		}
+		cfg.HistorySizeSamples = n
+	}
+
+	// History integrity audit configuration
+	if v := os.Getenv("BENCHMARK_INTEGRITY_SAMPLES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_INTEGRITY_SAMPLES: %w", err)
+		}
+		cfg.IntegritySamples = n
+	}
+
+	// Workflow outcome verification configuration
+	if v := os.Getenv("BENCHMARK_OUTCOME_SAMPLE_RATE"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_OUTCOME_SAMPLE_RATE: %w", err)
+		}
+		cfg.OutcomeSampleRate = f
+	}
+
+	// Workflow cancellation configuration
+	if v := os.Getenv("BENCHMARK_CANCEL_RATE"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CANCEL_RATE: %w", err)
+		}
+		cfg.CancelRate = f
+	}
+
+	if v := os.Getenv("BENCHMARK_CANCEL_AFTER"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CANCEL_AFTER: %w", err)
+		}
+		cfg.CancelAfter = d
+	}
+
+	// Cluster warm-state snapshot configuration
+	if v := os.Getenv("BENCHMARK_CLUSTER_SNAPSHOT"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CLUSTER_SNAPSHOT: %w", err)
+		}
+		cfg.ClusterSnapshot = b
+	}
+
+	// Clock skew detection configuration
+	if v := os.Getenv("BENCHMARK_CLOCK_SKEW_CANARIES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CLOCK_SKEW_CANARIES: %w", err)
+		}
+		cfg.ClockSkewCanaries = n
+	}
+
+	// Background read workload configuration
+	if v := os.Getenv("BENCHMARK_READ_QPS"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_READ_QPS: %w", err)
+		}
+		cfg.ReadQPS = f
+	}
+
+	// Control-plane client configuration
+	if v := os.Getenv("BENCHMARK_CONTROL_RPS"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CONTROL_RPS: %w", err)
+		}
+		cfg.ControlRPS = f
+	}
+
+	if v := os.Getenv("BENCHMARK_CONTROL_NAMESPACE"); v != "" {
+		cfg.ControlNamespace = v
+	}
+
+	// Role configuration; the removed booleans fail rather than silently run both roles
+	if v := os.Getenv("BENCHMARK_ROLE"); v != "" {
+		cfg.Role = v
+	}
+	if v := os.Getenv("BENCHMARK_WORKER_TASKS"); v != "" {
+		cfg.WorkerTasks = v
+	}
+	if os.Getenv("BENCHMARK_GENERATOR_ONLY") != "" {
+		return cfg, fmt.Errorf("BENCHMARK_GENERATOR_ONLY is no longer supported: run the %q subcommand or set BENCHMARK_ROLE=%s", RoleGenerate, RoleGenerate)
+	}
+	if os.Getenv("BENCHMARK_WORKER_ONLY") != "" {
+		return cfg, fmt.Errorf("BENCHMARK_WORKER_ONLY is no longer supported: run the %q subcommand or set BENCHMARK_ROLE=%s", RoleWork, RoleWork)
+	}
+
+	if v := os.Getenv("BENCHMARK_DISABLE_STICKY"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_DISABLE_STICKY: %w", err)
+		}
+		cfg.DisableSticky = b
+	}
+
+	if v := os.Getenv("BENCHMARK_WORKFLOW_CACHE_SIZE"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_WORKFLOW_CACHE_SIZE: %w", err)
+		}
+		cfg.WorkflowCacheSize = n
+	}
+
+	if v := os.Getenv("BENCHMARK_WORKER_ACTIVITIES_PER_SECOND"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_WORKER_ACTIVITIES_PER_SECOND: %w", err)
+		}
+		cfg.WorkerActivitiesPerSecond = f
+	}
+
+	if v := os.Getenv("BENCHMARK_TASK_QUEUE_ACTIVITIES_PER_SECOND"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_TASK_QUEUE_ACTIVITIES_PER_SECOND: %w", err)
+		}
+		cfg.TaskQueueActivitiesPerSecond = f
+	}
+
+	// Metrics configuration
+	if v := os.Getenv("BENCHMARK_METRICS_PORT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_METRICS_PORT: %w", err)
+		}
+		cfg.MetricsPort = n
+	}
+
+	if v := os.Getenv("BENCHMARK_WORKER_METRICS_PORT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_WORKER_METRICS_PORT: %w", err)
+		}
+		cfg.WorkerMetricsPort = n
+	}
+
+	if v := os.Getenv("BENCHMARK_METRICS_PREFIX"); v != "" {
+		cfg.MetricsPrefix = v
+	}
+
+	if v := os.Getenv("BENCHMARK_METRICS_LABELS"); v != "" {
+		labels, err := ParseMetricsLabels(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_METRICS_LABELS: %w", err)
+		}
+		cfg.MetricsLabels = labels
+	}
+
+	if v := os.Getenv("BENCHMARK_HISTOGRAM_BUCKETS"); v != "" {
+		buckets, err := ParseHistogramBuckets(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_HISTOGRAM_BUCKETS: %w", err)
+		}
+		cfg.HistogramBuckets = buckets
+	}
+
+	if v := os.Getenv("BENCHMARK_NATIVE_HISTOGRAMS"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_NATIVE_HISTOGRAMS: %w", err)
+		}
+		cfg.NativeHistograms = b
+	}
+
+	if v := os.Getenv("BENCHMARK_METRICS_NORMALIZE_NAMESPACE"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_METRICS_NORMALIZE_NAMESPACE: %w", err)
+		}
+		cfg.NormalizeNamespaceLabel = b
+	}
+
+	if v := os.Getenv("BENCHMARK_METRICS_CARDINALITY_LIMIT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_METRICS_CARDINALITY_LIMIT: %w", err)
+		}
+		cfg.CardinalityLimit = n
+	}
+
+	// Server metrics configuration
+	if v := os.Getenv("BENCHMARK_SERVER_METRICS_URLS"); v != "" {
+		for _, u := range strings.Split(v, ",") {
+			if u = strings.TrimSpace(u); u != "" {
+				cfg.ServerMetricsURLs = append(cfg.ServerMetricsURLs, u)
+			}
+		}
+	}
+	if v := os.Getenv("BENCHMARK_WORKER_METRICS_URLS"); v != "" {
+		for _, u := range strings.Split(v, ",") {
+			if u = strings.TrimSpace(u); u != "" {
+				cfg.WorkerMetricsURLs = append(cfg.WorkerMetricsURLs, u)
+			}
+		}
+	}
+
+	// Admin endpoint configuration
+	if v := os.Getenv("BENCHMARK_ADMIN_PORT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_ADMIN_PORT: %w", err)
+		}
+		cfg.AdminPort = n
+	}
+
+	if v := os.Getenv("BENCHMARK_ADMIN_TOKEN"); v != "" {
+		cfg.AdminToken = v
+	}
+
+	// Grafana snapshot configuration
+	if v := os.Getenv("BENCHMARK_GRAFANA_URL"); v != "" {
+		cfg.GrafanaURL = v
+	}
+
+	if v := os.Getenv("BENCHMARK_GRAFANA_TOKEN"); v != "" {
+		cfg.GrafanaToken = v
+	}
+
+	if v := os.Getenv("BENCHMARK_GRAFANA_DASHBOARD"); v != "" {
+		cfg.GrafanaDashboard = v
+	}
+
+	if v := os.Getenv("BENCHMARK_GRAFANA_SNAPSHOT_EXPIRY"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_GRAFANA_SNAPSHOT_EXPIRY: %w", err)
+		}
+		cfg.GrafanaSnapshotExpiry = d
+	}
+
+	// Cleanup configuration
+	if v := os.Getenv("BENCHMARK_CLEANUP_CONCURRENCY"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CLEANUP_CONCURRENCY: %w", err)
+		}
+		cfg.CleanupConcurrency = n
+	}
+
+	if v := os.Getenv("BENCHMARK_CLEANUP_RATE"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CLEANUP_RATE: %w", err)
+		}
+		cfg.CleanupRate = f
+	}
+
+	if v := os.Getenv("BENCHMARK_CLEANUP_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CLEANUP_TIMEOUT: %w", err)
+		}
+		cfg.CleanupTimeout = d
+	}
+
+	if v := os.Getenv("BENCHMARK_CLEANUP_CALL_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_CLEANUP_CALL_TIMEOUT: %w", err)
+		}
+		cfg.CleanupCallTimeout = d
+	}
+
+	// Retention verification configuration
+	if v := os.Getenv("BENCHMARK_RETENTION_VERIFY_DELAY"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RETENTION_VERIFY_DELAY: %w", err)
+		}
+		cfg.RetentionVerifyDelay = d
+	}
+
+	if v := os.Getenv("BENCHMARK_RETENTION_RESULTS_FILE"); v != "" {
+		cfg.RetentionResultsFile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_RETENTION_SAMPLE_SIZE"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RETENTION_SAMPLE_SIZE: %w", err)
+		}
+		cfg.RetentionSampleSize = n
+	}
+
+	// Output configuration
+	if v := os.Getenv("BENCHMARK_RESULT_SINKS"); v != "" {
+		cfg.ResultSinks = v
+	}
+	if v := os.Getenv("BENCHMARK_RESULTS_FORMAT"); v != "" {
+		cfg.ResultsFormat = v
+	}
+	if v := os.Getenv("BENCHMARK_ARTIFACT_BUNDLE"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_ARTIFACT_BUNDLE: %w", err)
+		}
+		cfg.ArtifactBundle = b
+	}
+	if v := os.Getenv("BENCHMARK_REDACT"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_REDACT: %w", err)
+		}
+		cfg.Redact = b
+	}
+	if v := os.Getenv("BENCHMARK_EXPORT_FILE"); v != "" {
+		cfg.ExportFile = v
+	}
+	if v := os.Getenv("BENCHMARK_HISTORY_FILE"); v != "" {
+		cfg.HistoryFile = v
+	}
+	if v := os.Getenv("BENCHMARK_HISTORY_MAX_MB"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_HISTORY_MAX_MB: %w", err)
+		}
+		cfg.HistoryMaxMB = n
+	}
+	if v := os.Getenv("BENCHMARK_HISTORY_KEEP"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_HISTORY_KEEP: %w", err)
+		}
+		cfg.HistoryKeep = n
+	}
+
+	// Daemon configuration
+	if v := os.Getenv("BENCHMARK_DAEMON_SCHEDULE"); v != "" {
+		cfg.DaemonSchedule = v
+	}
+
+	// Thresholds
+	if v := os.Getenv("BENCHMARK_MAX_P99_LATENCY"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_MAX_P99_LATENCY: %w", err)
+		}
+		cfg.MaxP99Latency = d
+	}
+
+	if v := os.Getenv("BENCHMARK_MIN_THROUGHPUT"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_MIN_THROUGHPUT: %w", err)
+		}
+		cfg.MinThroughput = f
+	}
+
+	if v := os.Getenv("BENCHMARK_THRESHOLDS_FILE"); v != "" {
+		cfg.ThresholdsFile = v
+		maxP99Latency, minThroughput, err := LoadThresholdsFile(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_THRESHOLDS_FILE: %w", err)
+		}
+		cfg.MaxP99Latency = maxP99Latency
+		cfg.MinThroughput = minThroughput
+	}
+
+	if v := os.Getenv("BENCHMARK_BASELINE_FILE"); v != "" {
+		cfg.BaselineFile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_BASELINE_MAX_P99_PERCENT"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_BASELINE_MAX_P99_PERCENT: %w", err)
+		}
+		cfg.BaselineMaxP99Percent = f
+	}
+
+	if v := os.Getenv("BENCHMARK_BASELINE_MIN_THROUGHPUT_PERCENT"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_BASELINE_MIN_THROUGHPUT_PERCENT: %w", err)
+		}
+		cfg.BaselineMinThroughputPercent = f
+	}
+
+	if v := os.Getenv("BENCHMARK_THRESHOLD_EVALUATORS"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				cfg.ThresholdEvaluators = append(cfg.ThresholdEvaluators, name)
+			}
+		}
+	}
+
+	if v := os.Getenv("BENCHMARK_THRESHOLD_PROFILE"); v != "" {
+		cfg.ThresholdProfile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_PERSISTENCE_THRESHOLDS"); v != "" {
+		thresholds, err := ParsePersistenceThresholds(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_PERSISTENCE_THRESHOLDS: %w", err)
+		}
+		cfg.PersistenceThresholds = thresholds
+	}
+
+	if v := os.Getenv("BENCHMARK_MAX_DESCRIBE_P99"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_MAX_DESCRIBE_P99: %w", err)
+		}
+		cfg.MaxDescribeP99 = d
+	}
+
+	if v := os.Getenv("BENCHMARK_MAX_GET_HISTORY_P99"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_MAX_GET_HISTORY_P99: %w", err)
+		}
+		cfg.MaxGetHistoryP99 = d
+	}
+
+	// Temporal connection
+	if v := os.Getenv("TEMPORAL_ADDRESS"); v != "" {
+		cfg.TemporalAddress = v
+	}
+
+	if v := os.Getenv("BENCHMARK_ADDRESS_FAMILY"); v != "" {
+		cfg.AddressFamily = v
+	}
+
+	if v := os.Getenv("BENCHMARK_DIAL_FALLBACK_DELAY"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_DIAL_FALLBACK_DELAY: %w", err)
+		}
+		cfg.DialFallbackDelay = d
+	}
+
+	// Client authentication
+	if v := os.Getenv("BENCHMARK_AUTH_PROVIDER"); v != "" {
+		cfg.AuthProvider = v
+	}
+
+	if v := os.Getenv("BENCHMARK_TLS_CERT_FILE"); v != "" {
+		cfg.TLSCertFile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_TLS_KEY_FILE"); v != "" {
+		cfg.TLSKeyFile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_TLS_CA_FILE"); v != "" {
+		cfg.TLSCAFile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_TLS_SERVER_NAME"); v != "" {
+		cfg.TLSServerName = v
+	}
+
+	if v := os.Getenv("BENCHMARK_TLS_DISABLED"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_TLS_DISABLED: %w", err)
+		}
+		cfg.TLSDisabled = b
+	}
+
+	if v := os.Getenv("BENCHMARK_API_KEY"); v != "" {
+		cfg.APIKey = v
+	}
+
+	if v := os.Getenv("BENCHMARK_OAUTH_TOKEN_URL"); v != "" {
+		cfg.OAuthTokenURL = v
+	}
+
+	if v := os.Getenv("BENCHMARK_OAUTH_CLIENT_ID"); v != "" {
+		cfg.OAuthClientID = v
+	}
+
+	if v := os.Getenv("BENCHMARK_OAUTH_CLIENT_SECRET"); v != "" {
+		cfg.OAuthClientSecret = v
+	}
+
+	if v := os.Getenv("BENCHMARK_OAUTH_SCOPES"); v != "" {
+		for _, scope := range strings.Split(v, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				cfg.OAuthScopes = append(cfg.OAuthScopes, scope)
+			}
+		}
+	}
+
+	if v := os.Getenv("BENCHMARK_OAUTH_AUDIENCE"); v != "" {
+		cfg.OAuthAudience = v
+	}
+
+	if v := os.Getenv("BENCHMARK_SIGV4_REGION"); v != "" {
+		cfg.SigV4Region = v
+	}
+
+	if v := os.Getenv("BENCHMARK_SIGV4_SERVICE"); v != "" {
+		cfg.SigV4Service = v
+	}
+
+	if v := os.Getenv("BENCHMARK_AUTH_TOKEN_FILE"); v != "" {
+		cfg.AuthTokenFile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_EXPECTATIONS_FILE"); v != "" {
+		cfg.ExpectationsFile = v
+	}
+
+	// Concurrent-run lock configuration
+	if v := os.Getenv("BENCHMARK_RUN_LOCK"); v != "" {
+		cfg.RunLock = v
+	}
+
+	if v := os.Getenv("BENCHMARK_RUN_LOCK_NAMESPACE"); v != "" {
+		cfg.RunLockNamespace = v
+	}
+
+	if v := os.Getenv("BENCHMARK_RUN_LOCK_WAIT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RUN_LOCK_WAIT: %w", err)
+		}
+		cfg.RunLockWait = d
+	}
+
+	if v := os.Getenv("BENCHMARK_RUN_LOCK_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RUN_LOCK_TTL: %w", err)
+		}
+		cfg.RunLockTTL = d
+	}
+
+	// Active run registry and stale-run detection configuration
+	if v := os.Getenv("BENCHMARK_RUN_REGISTRY"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RUN_REGISTRY: %w", err)
+		}
+		cfg.RunRegistry = b
+	}
+
+	if v := os.Getenv("BENCHMARK_STALE_RUNS"); v != "" {
+		cfg.StaleRuns = v
+	}
+
+	if v := os.Getenv("BENCHMARK_STALE_RUN_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_STALE_RUN_INTERVAL: %w", err)
+		}
+		cfg.StaleRunInterval = d
+	}
+
+	if v := os.Getenv("BENCHMARK_STALE_RUN_GRACE"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_STALE_RUN_GRACE: %w", err)
+		}
+		cfg.StaleRunGrace = d
+	}
+
+	// Run catalog configuration
+	if v := os.Getenv("BENCHMARK_RUN_CATALOG"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_RUN_CATALOG: %w", err)
+		}
+		cfg.RunCatalog = b
+	}
+
+	if v := os.Getenv("BENCHMARK_RUN_CATALOG_NAMESPACE"); v != "" {
+		cfg.RunCatalogNamespace = v
+	}
+
+	// Namespace TTL configuration
+	if v := os.Getenv("BENCHMARK_NAMESPACE_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_NAMESPACE_TTL: %w", err)
+		}
+		cfg.NamespaceTTL = d
+	}
+
+	if v := os.Getenv("BENCHMARK_JANITOR_NAMESPACE"); v != "" {
+		cfg.JanitorNamespace = v
+	}
+
+	// Event recording and replay configuration
+	if v := os.Getenv("BENCHMARK_RECORD_FILE"); v != "" {
+		cfg.RecordFile = v
+	}
+
+	if v := os.Getenv("BENCHMARK_REPLAY_FILE"); v != "" {
+		cfg.ReplayFile = v
+	}
+
+	// Per-workflow record streaming configuration
+	if v := os.Getenv("BENCHMARK_FIREHOSE_STREAM"); v != "" {
+		cfg.FirehoseStream = v
+	}
+
+	// Run lifecycle timeline configuration
+	if v := os.Getenv("BENCHMARK_TIMELINE"); v != "" {
+		cfg.Timeline = v
+	}
+
+	if v := os.Getenv("BENCHMARK_TIMELINE_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_TIMELINE_INTERVAL: %w", err)
+		}
+		cfg.TimelineInterval = d
+	}
+
+	// Simulation configuration
+	if v := os.Getenv("BENCHMARK_SIMULATE"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_SIMULATE: %w", err)
+		}
+		cfg.Simulate = b
+	}
+
+	if v := os.Getenv("BENCHMARK_SIMULATE_LATENCY"); v != "" {
+		cfg.SimulateLatency = v
+	}
+
+	if v := os.Getenv("BENCHMARK_SIMULATE_FAILURE_RATE"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_SIMULATE_FAILURE_RATE: %w", err)
+		}
+		cfg.SimulateFailureRate = f
+	}
+
+	if v := os.Getenv("BENCHMARK_SIMULATE_START_FAILURE_RATE"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid BENCHMARK_SIMULATE_START_FAILURE_RATE: %w", err)
+		}
+		cfg.SimulateStartFailureRate = f
+	}
+
+	return cfg, nil
+}
+
+// ApplyArgs applies the command line, "[role] [mode] [--tui]", over the
+// environment: an optional role subcommand (all, generate, work) followed by
+// an optional run mode, e.g. "benchmark generate", "benchmark smoke" or
+// "benchmark all calibrate". The runs mode takes an optional list subcommand,
+// "benchmark runs list". Flags may appear anywhere.
+func (c *BenchmarkConfig) ApplyArgs(args []string) error {
+	var positional []string
+	for _, arg := range args {
+		switch {
+		case arg == "--tui":
+			c.TUI = true
+		case strings.HasPrefix(arg, "-"):
+			return fmt.Errorf("unknown flag %q: usage: benchmark [all|generate|work] [mode] [--tui]", arg)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	args = positional
+
+	if len(args) > 0 {
+		switch args[0] {
+		case RoleAll, RoleGenerate, RoleWork:
+			c.Role = args[0]
+			args = args[1:]
+		}
+	}
+	if len(args) > 0 {
+		c.Mode = args[0]
+		args = args[1:]
+	}
+	// "list" is the only runs subcommand and may be omitted
+	if c.Mode == ModeRuns && len(args) > 0 && args[0] == "list" {
+		args = args[1:]
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments %q: usage: benchmark [all|generate|work] [mode] [--tui]", args)
+	}
+	return nil
+}
+
+// Validate checks that the configuration values are within acceptable ranges.
+func (c *BenchmarkConfig) Validate() error {
+	// Validate role
+	switch c.Role {
+	case RoleAll, RoleGenerate:
+		if err := c.validateLoad(); err != nil {
+			return err
+		}
+	case RoleWork:
+		// A worker only processes workflows: the load settings are unused
+		if c.Mode != ModeBenchmark {
+			return fmt.Errorf("%s mode cannot be combined with the work role", c.Mode)
+		}
+		if c.DaemonSchedule != "" || c.RetentionResultsFile != "" || c.ReplayFile != "" {
+			return fmt.Errorf("the work role cannot be combined with BENCHMARK_DAEMON_SCHEDULE, BENCHMARK_RETENTION_RESULTS_FILE or BENCHMARK_REPLAY_FILE")
+		}
+		if c.RecordFile != "" || c.FirehoseStream != "" || c.Simulate || c.RunRegistry || c.RunCatalog {
+			return fmt.Errorf("the work role cannot use BENCHMARK_RECORD_FILE, BENCHMARK_FIREHOSE_STREAM, BENCHMARK_SIMULATE, " +
+				"BENCHMARK_RUN_REGISTRY or BENCHMARK_RUN_CATALOG: set them on the generator")
+		}
+	default:
+		return fmt.Errorf("invalid role %q: must be one of: %s, %s, %s", c.Role, RoleAll, RoleGenerate, RoleWork)
+	}
+
+	// Validate worker tasks; only separate worker processes may split them
+	switch c.WorkerTasks {
+	case WorkerTasksAll:
+		// valid
+	case WorkerTasksWorkflow, WorkerTasksActivity:
+		if c.Role != RoleWork {
+			return fmt.Errorf("BENCHMARK_WORKER_TASKS=%s requires the work role: the embedded worker handles all tasks", c.WorkerTasks)
+		}
+	default:
+		return fmt.Errorf("invalid BENCHMARK_WORKER_TASKS %q: must be one of: %s, %s, %s", c.WorkerTasks, WorkerTasksAll, WorkerTasksWorkflow, WorkerTasksActivity)
+	}
+
+	// Validate latency semantics
+	switch c.LatencySemantics {
+	case LatencySubmitToComplete, LatencyServerStartToComplete, LatencyScheduleToFirstWFT:
+		// valid
+	default:
+		return fmt.Errorf("invalid latency semantics %q: must be one of: %s, %s, %s",
+			c.LatencySemantics, LatencySubmitToComplete, LatencyServerStartToComplete, LatencyScheduleToFirstWFT)
+	}
+	if c.LatencyMemoryBudgetMB < 0 {
+		return fmt.Errorf("latency memory budget must be non-negative, got %d MB", c.LatencyMemoryBudgetMB)
+	}
+	if c.LatencyHeatmapWindow < 0 {
+		return fmt.Errorf("BENCHMARK_LATENCY_HEATMAP_WINDOW must not be negative, got %v", c.LatencyHeatmapWindow)
+	}
+
+	// Validate the workflow cache; an empty cache is BENCHMARK_DISABLE_STICKY
+	if c.WorkflowCacheSize < 1 {
+		return fmt.Errorf("BENCHMARK_WORKFLOW_CACHE_SIZE must be positive, got %d: set BENCHMARK_DISABLE_STICKY to disable the cache", c.WorkflowCacheSize)
+	}
+	if c.DisableSticky && c.WorkflowCacheSize != DefaultWorkflowCacheSize {
+		return fmt.Errorf("BENCHMARK_WORKFLOW_CACHE_SIZE cannot be combined with BENCHMARK_DISABLE_STICKY, which disables the cache")
+	}
+
+	// Validate activity rate limits; 0 keeps the SDK default
+	if c.WorkerActivitiesPerSecond < 0 {
+		return fmt.Errorf("BENCHMARK_WORKER_ACTIVITIES_PER_SECOND must not be negative, got %v", c.WorkerActivitiesPerSecond)
+	}
+	if c.TaskQueueActivitiesPerSecond < 0 {
+		return fmt.Errorf("BENCHMARK_TASK_QUEUE_ACTIVITIES_PER_SECOND must not be negative, got %v", c.TaskQueueActivitiesPerSecond)
+	}
+
+	// Validate mode
+	switch c.Mode {
+	case ModeBenchmark:
+		// valid
+	case ModeSmoke, ModeVerify, ModeVisibility, ModeCalibrate, ModeRuns, ModeExport:
+		if c.DaemonSchedule != "" || c.RetentionResultsFile != "" {
+			return fmt.Errorf("%s mode cannot be combined with BENCHMARK_DAEMON_SCHEDULE or BENCHMARK_RETENTION_RESULTS_FILE", c.Mode)
+		}
+	default:
+		return fmt.Errorf("invalid mode %q: must be one of: %s, %s, %s, %s, %s, %s, %s", c.Mode, ModeBenchmark, ModeSmoke, ModeVerify, ModeVisibility, ModeCalibrate, ModeRuns, ModeExport)
+	}
+	if (c.Mode == ModeExport) != (c.ExportFile != "") {
+		return fmt.Errorf("export mode and BENCHMARK_EXPORT_FILE must be used together")
+	}
+	if c.Mode == ModeExport && c.HistoryFile != "" {
+		return fmt.Errorf("export mode cannot be combined with BENCHMARK_HISTORY_FILE: anonymized copies would be mixed into the run history")
+	}
+	if c.Mode == ModeCalibrate && c.CalibrationFile == "" {
+		return fmt.Errorf("calibrate mode requires BENCHMARK_CALIBRATION_FILE to write the calibration table to")
+	}
+	if c.Mode == ModeVisibility {
+		if c.Namespace == "" {
+			return fmt.Errorf("visibility mode requires BENCHMARK_NAMESPACE naming a pre-populated namespace")
+		}
+		if c.VisibilityQPS < 0 || c.VisibilityQPS > MaxVisibilityQPS {
+			return fmt.Errorf("visibility QPS %.2f out of range [0, %d]", c.VisibilityQPS, MaxVisibilityQPS)
+		}
+		if c.VisibilityCountQPS < 0 || c.VisibilityCountQPS > MaxVisibilityQPS {
+			return fmt.Errorf("visibility count QPS %.2f out of range [0, %d]", c.VisibilityCountQPS, MaxVisibilityQPS)
+		}
+		if c.VisibilityQPS == 0 && c.VisibilityCountQPS == 0 {
+			return fmt.Errorf("visibility mode requires a positive list or count QPS")
+		}
+		if c.VisibilityPageSize < 1 || c.VisibilityPageSize > MaxVisibilityPageSize {
+			return fmt.Errorf("visibility page size %d out of range [1, %d]", c.VisibilityPageSize, MaxVisibilityPageSize)
+		}
+	}
+
+	// Validate max total runtime (must be non-negative, 0 means unbounded)
+	if c.MaxTotalRuntime < 0 {
+		return fmt.Errorf("max total runtime must be non-negative, got %v", c.MaxTotalRuntime)
+	}
+
+	// Validate metrics ports (0 means ephemeral)
+	if c.MetricsPort < MinMetricsPort || c.MetricsPort > MaxMetricsPort {
+		return fmt.Errorf("metrics port %d out of range [%d, %d]", c.MetricsPort, MinMetricsPort, MaxMetricsPort)
+	}
+	if c.WorkerMetricsPort < MinMetricsPort || c.WorkerMetricsPort > MaxMetricsPort {
+		return fmt.Errorf("worker metrics port %d out of range [%d, %d]", c.WorkerMetricsPort, MinMetricsPort, MaxMetricsPort)
+	}
+
+	// Validate metric naming
+	if c.MetricsPrefix != "" && !metricNamePattern.MatchString(c.MetricsPrefix) {
+		return fmt.Errorf("invalid metrics prefix %q: must match %s", c.MetricsPrefix, metricNamePattern)
+	}
+	for name := range c.MetricsLabels {
+		if !labelNamePattern.MatchString(name) || strings.HasPrefix(name, "__") {
+			return fmt.Errorf("invalid metrics label name %q: must match %s and not start with __", name, labelNamePattern)
+		}
+	}
+	if c.NormalizeNamespaceLabel && (c.Namespace != "" || c.DaemonSchedule != "") {
+		return fmt.Errorf("BENCHMARK_METRICS_NORMALIZE_NAMESPACE only rewrites generated per-run namespaces, " +
+			"but BENCHMARK_NAMESPACE or BENCHMARK_DAEMON_SCHEDULE fixes the namespace: unset one or the other")
+	}
+	if c.CardinalityLimit < 0 {
+		return fmt.Errorf("metrics cardinality limit must not be negative, got %d", c.CardinalityLimit)
+	}
+	for family, bounds := range c.HistogramBuckets {
+		switch {
+		case family == HistogramWorkflowLatency, family == HistogramSDKLatency, family == HistogramSDKLongLatency:
+		case strings.HasPrefix(family, "temporal_"):
+		default:
+			return fmt.Errorf("invalid histogram family %q: must be one of: %s, %s, %s or an SDK timer name",
+				family, HistogramWorkflowLatency, HistogramSDKLatency, HistogramSDKLongLatency)
+		}
+		if len(bounds) == 0 {
+			return fmt.Errorf("histogram %q has no buckets", family)
+		}
+		for i, b := range bounds {
+			if b <= 0 || (i > 0 && b <= bounds[i-1]) {
+				return fmt.Errorf("histogram %q buckets must be positive and increasing", family)
+			}
+		}
+	}
+
+	// Validate admin port (0 means ephemeral)
+	if c.AdminPort < MinMetricsPort || c.AdminPort > MaxMetricsPort {
+		return fmt.Errorf("admin port %d out of range [%d, %d]", c.AdminPort, MinMetricsPort, MaxMetricsPort)
+	}
+	if c.AdminToken != "" && c.AdminPort != 0 && (c.AdminPort == c.MetricsPort || c.AdminPort == c.WorkerMetricsPort) {
+		return fmt.Errorf("BENCHMARK_ADMIN_PORT %d conflicts with BENCHMARK_METRICS_PORT or BENCHMARK_WORKER_METRICS_PORT", c.AdminPort)
+	}
+
+	// Validate cleanup limits (0 means auto-size)
+	if c.CleanupConcurrency < 0 || c.CleanupConcurrency > MaxCleanupConcurrency {
+		return fmt.Errorf("cleanup concurrency %d out of range [0, %d]", c.CleanupConcurrency, MaxCleanupConcurrency)
+	}
+	if c.CleanupRate < 0 || c.CleanupRate > MaxCleanupRate {
+		return fmt.Errorf("cleanup rate %.2f out of range [0, %d]", c.CleanupRate, MaxCleanupRate)
+	}
+	if c.CleanupTimeout < 0 {
+		return fmt.Errorf("cleanup timeout must not be negative, got %v", c.CleanupTimeout)
+	}
+	if c.CleanupCallTimeout < 0 {
+		return fmt.Errorf("cleanup call timeout must not be negative, got %v", c.CleanupCallTimeout)
+	}
+	if c.CleanupTimeout > 0 && c.CleanupCallTimeout > c.CleanupTimeout {
+		return fmt.Errorf("BENCHMARK_CLEANUP_CALL_TIMEOUT %v exceeds BENCHMARK_CLEANUP_TIMEOUT %v", c.CleanupCallTimeout, c.CleanupTimeout)
+	}
+
+	// Validate server metrics endpoints
+	for _, u := range c.ServerMetricsURLs {
+		parsed, err := url.Parse(u)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid server metrics URL %q: must be an http(s) URL", u)
+		}
+	}
+	for _, u := range c.WorkerMetricsURLs {
+		parsed, err := url.Parse(u)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid worker metrics URL %q: must be an http(s) URL", u)
+		}
+	}
+	if len(c.WorkerMetricsURLs) > 0 && c.Role != RoleGenerate {
+		return fmt.Errorf("BENCHMARK_WORKER_METRICS_URLS require the generate role; the %s role counts its own workers' executions", c.Role)
+	}
+
+	// Validate stuck workflow detection
+	if c.StuckWorkflowThreshold < 0 {
+		return fmt.Errorf("stuck workflow threshold must not be negative, got %v", c.StuckWorkflowThreshold)
+	}
+	if c.StuckWorkflowTerminate && c.StuckWorkflowThreshold == 0 {
+		return fmt.Errorf("BENCHMARK_STUCK_WORKFLOW_TERMINATE requires BENCHMARK_STUCK_WORKFLOW_THRESHOLD")
+	}
+
+	// Validate history size sampling
+	if c.HistorySizeSamples < 0 || c.HistorySizeSamples > MaxHistorySizeSamples {
+		return fmt.Errorf("history size samples %d out of range [0, %d]", c.HistorySizeSamples, MaxHistorySizeSamples)
+	}
+
+	// Validate history integrity sampling
+	if c.IntegritySamples < 0 || c.IntegritySamples > MaxIntegritySamples {
+		return fmt.Errorf("integrity samples %d out of range [0, %d]", c.IntegritySamples, MaxIntegritySamples)
+	}
+
+	// Validate outcome verification
+	if c.OutcomeSampleRate < 0 || c.OutcomeSampleRate > 1 {
+		return fmt.Errorf("outcome sample rate %.2f out of range [0, 1]", c.OutcomeSampleRate)
+	}
+
+	// Validate workflow cancellation
+	if c.CancelRate < 0 || c.CancelRate > 1 {
+		return fmt.Errorf("cancel rate %.2f out of range [0, 1]", c.CancelRate)
+	}
+	if c.CancelRate > 0 && c.CancelAfter <= 0 {
+		return fmt.Errorf("cancel delay must be positive, got %v (BENCHMARK_CANCEL_AFTER)", c.CancelAfter)
+	}
+
+	// Validate start padding
+	if c.MemoSizeBytes < 0 || c.MemoSizeBytes > MaxStartPaddingBytes {
+		return fmt.Errorf("memo size %d bytes out of range [0, %d]", c.MemoSizeBytes, MaxStartPaddingBytes)
+	}
+	if c.HeaderSizeBytes < 0 || c.HeaderSizeBytes > MaxStartPaddingBytes {
+		return fmt.Errorf("header size %d bytes out of range [0, %d]", c.HeaderSizeBytes, MaxStartPaddingBytes)
+	}
+
+	// Validate clock skew canaries
+	if c.ClockSkewCanaries < 0 || c.ClockSkewCanaries > MaxClockSkewCanaries {
+		return fmt.Errorf("clock skew canaries %d out of range [0, %d]", c.ClockSkewCanaries, MaxClockSkewCanaries)
+	}
+
+	// Validate the background read workload and its thresholds
+	if c.ReadQPS < 0 || c.ReadQPS > MaxReadQPS {
+		return fmt.Errorf("read QPS %.2f out of range [0, %d]", c.ReadQPS, MaxReadQPS)
+	}
+	if c.MaxDescribeP99 < 0 || c.MaxGetHistoryP99 < 0 {
+		return fmt.Errorf("read-path latency thresholds must not be negative")
+	}
+	if (c.MaxDescribeP99 > 0 || c.MaxGetHistoryP99 > 0) && c.ReadQPS == 0 {
+		return fmt.Errorf("BENCHMARK_MAX_DESCRIBE_P99 and BENCHMARK_MAX_GET_HISTORY_P99 require BENCHMARK_READ_QPS")
+	}
+
+	// Validate the control-plane client
+	if c.ControlRPS < 0 || c.ControlRPS > MaxControlRPS {
+		return fmt.Errorf("control RPS %.2f out of range [0, %d]", c.ControlRPS, MaxControlRPS)
+	}
+	if c.ControlNamespace != "" && c.ControlRPS == 0 {
+		return fmt.Errorf("BENCHMARK_CONTROL_NAMESPACE requires BENCHMARK_CONTROL_RPS")
+	}
+
+	// Validate persistence thresholds (evaluated from scraped server metrics)
+	if len(c.PersistenceThresholds) > 0 && len(c.ServerMetricsURLs) == 0 {
+		return fmt.Errorf("BENCHMARK_PERSISTENCE_THRESHOLDS require BENCHMARK_SERVER_METRICS_URLS")
+	}
+	for _, t := range c.PersistenceThresholds {
+		if t.Percentile <= 0 || t.Percentile > 100 {
+			return fmt.Errorf("persistence threshold percentile %g out of range (0, 100]", t.Percentile)
+		}
+		if t.Max <= 0 {
+			return fmt.Errorf("persistence threshold %s must be positive, got %v", t, t.Max)
+		}
+	}
+
+	// Validate backpressure threshold (a utilization fraction)
+	if c.BackpressureThreshold <= 0 || c.BackpressureThreshold > 1 {
+		return fmt.Errorf("backpressure threshold %.2f out of range (0, 1]", c.BackpressureThreshold)
+	}
+	if c.BackpressureHold && c.Role == RoleGenerate {
+		return fmt.Errorf("BENCHMARK_BACKPRESSURE_HOLD needs the embedded worker's slot metrics, which the generate role does not run")
+	}
+
+	// Validate latency SLA burn-rate alerting
+	if c.BurnRateWindow != 0 && c.BurnRateWindow < MinBurnRateWindow {
+		return fmt.Errorf("burn rate window %v must be 0 (disabled) or at least %v (BENCHMARK_BURN_RATE_WINDOW)", c.BurnRateWindow, MinBurnRateWindow)
+	}
+	if c.BurnRateThreshold <= 0 {
+		return fmt.Errorf("burn rate threshold must be positive, got %v (BENCHMARK_BURN_RATE_THRESHOLD)", c.BurnRateThreshold)
+	}
+
+	// Validate worker scaling experiment (workers must run in the separate service)
+	if c.WorkerScalingSchedule != "" {
+		if c.WorkerScalingCluster == "" || c.WorkerScalingService == "" {
+			return fmt.Errorf("BENCHMARK_WORKER_SCALING_SCHEDULE requires BENCHMARK_WORKER_SCALING_CLUSTER and BENCHMARK_WORKER_SCALING_SERVICE")
+		}
+		if c.Role != RoleGenerate {
+			return fmt.Errorf("BENCHMARK_WORKER_SCALING_SCHEDULE requires the generate role (benchmark generate)")
+		}
+	}
+
+	// Validate topology detection (the frontend service is looked up in its cluster)
+	if c.FrontendService != "" && c.FrontendCluster == "" {
+		return fmt.Errorf("BENCHMARK_FRONTEND_SERVICE requires BENCHMARK_FRONTEND_CLUSTER")
+	}
+
+	// Validate dynamic config overrides (the services are looked up in their cluster)
+	if len(c.DynamicConfigServices) > 0 && c.DynamicConfigCluster == "" {
+		return fmt.Errorf("BENCHMARK_DYNAMIC_CONFIG_SERVICES requires BENCHMARK_DYNAMIC_CONFIG_CLUSTER")
+	}
+
+	// Validate retention verification
+	if c.RetentionVerifyDelay < 0 {
+		return fmt.Errorf("retention verify delay must not be negative, got %v", c.RetentionVerifyDelay)
+	}
+	if c.RetentionSampleSize < 1 || c.RetentionSampleSize > MaxRetentionSampleSize {
+		return fmt.Errorf("retention sample size %d out of range [1, %d]", c.RetentionSampleSize, MaxRetentionSampleSize)
+	}
+	if c.RetentionResultsFile != "" && c.Role == RoleGenerate {
+		return fmt.Errorf("BENCHMARK_RETENTION_RESULTS_FILE cannot be combined with the generate role")
+	}
+
+	if c.BaselineMaxP99Percent < 0 || c.BaselineMinThroughputPercent < 0 {
+		return fmt.Errorf("baseline threshold percentages must not be negative")
+	}
+	if (c.BaselineMaxP99Percent > 0 || c.BaselineMinThroughputPercent > 0) && c.BaselineFile == "" {
+		return fmt.Errorf("BENCHMARK_BASELINE_MAX_P99_PERCENT and BENCHMARK_BASELINE_MIN_THROUGHPUT_PERCENT require BENCHMARK_BASELINE_FILE")
+	}
+	if c.ScenarioFile != "" && c.Scenario != "" {
+		return fmt.Errorf("BENCHMARK_SCENARIO_FILE and BENCHMARK_SCENARIO cannot both be set")
+	}
+	if c.ThresholdProfile != "" && !c.HasScenario() {
+		return fmt.Errorf("BENCHMARK_THRESHOLD_PROFILE=%s requires BENCHMARK_SCENARIO_FILE or BENCHMARK_SCENARIO", c.ThresholdProfile)
+	}
+
+	// Validate results history
+	if c.HistoryFile != "" {
+		if c.HistoryMaxMB < 1 {
+			return fmt.Errorf("history max size must be at least 1 MB, got %d", c.HistoryMaxMB)
+		}
+		if c.HistoryKeep < 0 {
+			return fmt.Errorf("history keep count must not be negative, got %d", c.HistoryKeep)
+		}
+	}
+
+	// Validate daemon schedule
+	if c.DaemonSchedule != "" {
+		if _, err := cron.ParseStandard(c.DaemonSchedule); err != nil {
+			return fmt.Errorf("invalid daemon schedule %q: %w", c.DaemonSchedule, err)
+		}
+		if c.RetentionResultsFile != "" {
+			return fmt.Errorf("BENCHMARK_DAEMON_SCHEDULE cannot be combined with BENCHMARK_RETENTION_RESULTS_FILE")
+		}
+		if c.RetentionVerifyDelay > 0 {
+			return fmt.Errorf("BENCHMARK_RETENTION_VERIFY_DELAY is not supported with BENCHMARK_DAEMON_SCHEDULE")
+		}
+		if c.MaxTotalRuntime > 0 {
+			return fmt.Errorf("BENCHMARK_MAX_TOTAL_RUNTIME is not supported with BENCHMARK_DAEMON_SCHEDULE")
+		}
+	}
+
+	// Validate thresholds (must be positive)
+	if c.MaxP99Latency <= 0 {
+		return fmt.Errorf("max p99 latency must be positive, got %v", c.MaxP99Latency)
+	}
+	if c.MinThroughput <= 0 {
+		return fmt.Errorf("min throughput must be positive, got %.2f", c.MinThroughput)
+	}
+
+	// Validate Temporal address (must not be empty)
+	if c.AuthProvider == "" {
+		return fmt.Errorf("auth provider is required")
+	}
+	if c.TemporalAddress == "" {
+		return fmt.Errorf("temporal address must not be empty")
+	}
+
+	// Validate the address family preference
+	switch c.AddressFamily {
+	case AddressFamilyAuto, AddressFamilyIPv6, AddressFamilyIPv4, AddressFamilyIPv4Only:
+	case AddressFamilyIPv6Only:
+		if c.Simulate {
+			return fmt.Errorf("BENCHMARK_ADDRESS_FAMILY=%s cannot reach the simulated frontend, which listens on IPv4 loopback", c.AddressFamily)
+		}
+	default:
+		return fmt.Errorf("invalid address family %q: must be one of: %s, %s, %s, %s, %s (BENCHMARK_ADDRESS_FAMILY)",
+			c.AddressFamily, AddressFamilyAuto, AddressFamilyIPv6, AddressFamilyIPv4, AddressFamilyIPv6Only, AddressFamilyIPv4Only)
+	}
+	if c.DialFallbackDelay <= 0 {
+		return fmt.Errorf("dial fallback delay must be positive, got %v (BENCHMARK_DIAL_FALLBACK_DELAY)", c.DialFallbackDelay)
+	}
+
+	// Validate the concurrent-run lock
+	switch c.RunLock {
+	case RunLockOff, RunLockFail, RunLockQueue:
+	default:
+		return fmt.Errorf("invalid run lock %q: must be one of: %s, %s, %s", c.RunLock, RunLockOff, RunLockFail, RunLockQueue)
+	}
+	if c.RunLock != RunLockOff {
+		if c.RunLockNamespace == "" {
+			return fmt.Errorf("run lock namespace must not be empty")
+		}
+		if c.RunLockTTL <= 0 {
+			return fmt.Errorf("run lock TTL must be positive, got %v", c.RunLockTTL)
+		}
+		if c.RunLock == RunLockQueue && c.RunLockWait <= 0 {
+			return fmt.Errorf("run lock wait must be positive, got %v", c.RunLockWait)
+		}
+	}
+
+	// Validate the run registry and stale-run detection
+	switch c.StaleRuns {
+	case StaleRunsOff, StaleRunsReport, StaleRunsTerminate:
+	default:
+		return fmt.Errorf("invalid BENCHMARK_STALE_RUNS %q: must be one of: %s, %s, %s", c.StaleRuns, StaleRunsOff, StaleRunsReport, StaleRunsTerminate)
+	}
+	if c.RunRegistry || c.StaleRuns != StaleRunsOff {
+		if c.RunLockNamespace == "" {
+			return fmt.Errorf("BENCHMARK_RUN_LOCK_NAMESPACE must not be empty: it holds the run registry")
+		}
+		if c.RunLockTTL <= 0 {
+			return fmt.Errorf("BENCHMARK_RUN_LOCK_TTL must be positive, got %v: it expires registered runs", c.RunLockTTL)
+		}
+	}
+	if c.StaleRuns != StaleRunsOff {
+		if c.Role != RoleWork {
+			return fmt.Errorf("BENCHMARK_STALE_RUNS runs in worker services, so it requires the work role")
+		}
+		if c.StaleRunInterval <= 0 {
+			return fmt.Errorf("BENCHMARK_STALE_RUN_INTERVAL must be positive, got %v", c.StaleRunInterval)
+		}
+		if c.StaleRunGrace < 0 {
+			return fmt.Errorf("BENCHMARK_STALE_RUN_GRACE must not be negative, got %v", c.StaleRunGrace)
+		}
+	}
+
+	if (c.RunCatalog || c.Mode == ModeRuns) && c.RunCatalogNamespace == "" {
+		return fmt.Errorf("BENCHMARK_RUN_CATALOG_NAMESPACE must not be empty: it holds the run catalog")
+	}
+
+	if c.NamespaceTTL < 0 {
+		return fmt.Errorf("BENCHMARK_NAMESPACE_TTL must not be negative, got %v", c.NamespaceTTL)
+	}
+	if c.NamespaceTTL > 0 {
+		if c.JanitorNamespace == "" {
+			return fmt.Errorf("BENCHMARK_JANITOR_NAMESPACE must not be empty: it holds the namespace janitors")
+		}
+		// Janitors and admin cleanup delete benchmark- namespaces
+		if strings.HasPrefix(c.JanitorNamespace, "benchmark-") {
+			return fmt.Errorf("BENCHMARK_JANITOR_NAMESPACE %q must not have the benchmark- prefix of deletable namespaces", c.JanitorNamespace)
+		}
+		if c.Simulate {
+			return fmt.Errorf("BENCHMARK_NAMESPACE_TTL cannot be combined with BENCHMARK_SIMULATE: the simulated server keeps no namespaces")
+		}
+	}
+
+	if err := c.ValidateRunLength(c.MaxRunLength(c.Duration, 0)); err != nil {
+		return err
+	}
+
+	switch c.ResultsFormat {
+	case ResultsFormatJSON, ResultsFormatProto:
+		// valid
+	default:
+		return fmt.Errorf("invalid results format %q: must be %s or %s", c.ResultsFormat, ResultsFormatJSON, ResultsFormatProto)
+	}
+
+	// Validate recording, replay and streaming, which cover benchmark runs only
+	if c.RecordFile != "" && c.ReplayFile != "" {
+		return fmt.Errorf("BENCHMARK_RECORD_FILE and BENCHMARK_REPLAY_FILE cannot both be set")
+	}
+	if (c.RecordFile != "" || c.ReplayFile != "" || c.FirehoseStream != "") && c.Mode != ModeBenchmark {
+		return fmt.Errorf("%s mode cannot be combined with BENCHMARK_RECORD_FILE, BENCHMARK_REPLAY_FILE or BENCHMARK_FIREHOSE_STREAM", c.Mode)
+	}
+	if c.ReplayFile != "" && (c.DaemonSchedule != "" || c.RetentionResultsFile != "" || c.Simulate) {
+		return fmt.Errorf("BENCHMARK_REPLAY_FILE replays without connecting to Temporal, " +
+			"so it cannot be combined with BENCHMARK_DAEMON_SCHEDULE, BENCHMARK_RETENTION_RESULTS_FILE or BENCHMARK_SIMULATE")
+	}
+
+	// Validate Grafana snapshots, taken of benchmark runs by the generator
+	if (c.GrafanaURL == "") != (c.GrafanaToken == "") {
+		return fmt.Errorf("BENCHMARK_GRAFANA_URL and BENCHMARK_GRAFANA_TOKEN must be set together")
+	}
+	if c.GrafanaURL != "" {
+		if u, err := url.Parse(c.GrafanaURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid BENCHMARK_GRAFANA_URL %q: must be an http(s) URL", c.GrafanaURL)
+		}
+		if c.Role == RoleWork {
+			return fmt.Errorf("BENCHMARK_GRAFANA_URL cannot be combined with the work role: set it on the generator")
+		}
+		if c.GrafanaDashboard == "" {
+			return fmt.Errorf("BENCHMARK_GRAFANA_DASHBOARD must not be empty")
+		}
+		if c.GrafanaSnapshotExpiry < 0 {
+			return fmt.Errorf("BENCHMARK_GRAFANA_SNAPSHOT_EXPIRY must not be negative, got %v", c.GrafanaSnapshotExpiry)
+		}
+	}
+
+	// Validate the timeline, which covers benchmark runs only
+	if c.Timeline != "" {
+		if c.Timeline != "stdout" && (!strings.HasPrefix(c.Timeline, "file:") || c.Timeline == "file:") {
+			return fmt.Errorf("invalid BENCHMARK_TIMELINE %q: must be stdout or file:<path>", c.Timeline)
+		}
+		if c.TimelineInterval <= 0 {
+			return fmt.Errorf("BENCHMARK_TIMELINE_INTERVAL must be positive, got %s", c.TimelineInterval)
+		}
+		if c.Role == RoleWork || c.Mode != ModeBenchmark || c.ReplayFile != "" {
+			return fmt.Errorf("BENCHMARK_TIMELINE records benchmark runs, so it cannot be combined with the work role, " +
+				"other modes or BENCHMARK_REPLAY_FILE")
+		}
+		if c.Timeline == "stdout" && c.TUI {
+			return fmt.Errorf("BENCHMARK_TIMELINE=stdout would draw over --tui: write the timeline to a file:<path> instead")
+		}
+	}
+
+	// The progress view follows a single benchmark run in the foreground
+	if c.TUI && (c.Role == RoleWork || c.Mode != ModeBenchmark || c.DaemonSchedule != "" || c.ReplayFile != "") {
+		return fmt.Errorf("--tui shows a single benchmark run, so it cannot be combined with the work role, " +
+			"other modes, BENCHMARK_DAEMON_SCHEDULE or BENCHMARK_REPLAY_FILE")
+	}
+
+	// Validate the simulation
+	if c.Simulate {
+		if len(c.ServerMetricsURLs) > 0 || c.WorkerScalingSchedule != "" {
+			return fmt.Errorf("BENCHMARK_SIMULATE replaces the cluster, so BENCHMARK_SERVER_METRICS_URLS and " +
+				"BENCHMARK_WORKER_SCALING_SCHEDULE would measure or scale a system the run does not use")
+		}
+		if _, err := simulate.ParseLatency(c.SimulateLatency); err != nil {
+			return err
+		}
+		if c.SimulateFailureRate < 0 || c.SimulateFailureRate > 1 {
+			return fmt.Errorf("simulated failure rate %.2f out of range [0, 1]", c.SimulateFailureRate)
+		}
+		if c.SimulateStartFailureRate < 0 || c.SimulateStartFailureRate > 1 {
+			return fmt.Errorf("simulated start failure rate %.2f out of range [0, 1]", c.SimulateStartFailureRate)
+		}
+	}
+
+	return nil
+}
+
+// validateLoad validates the settings that shape generated load, which the
+// work role ignores.
+func (c *BenchmarkConfig) validateLoad() error {
+	// Validate workflow type
+	switch c.WorkflowType {
+	case WorkflowTypeSimple, WorkflowTypeMultiActivity, WorkflowTypeTimer, WorkflowTypeChildWorkflow, WorkflowTypeStateTransitions, WorkflowTypeContention, WorkflowTypeHeartbeat, WorkflowTypeFlaky, WorkflowTypeSearchAttributes, WorkflowTypeSideEffects, WorkflowTypeLongHistory, WorkflowTypeFanout:
+		if len(c.WorkflowMix) > 0 {
+			return fmt.Errorf("workflow mix requires workflow type %s, got %q (BENCHMARK_WORKFLOW_MIX)", WorkflowTypeMixed, c.WorkflowType)
+		}
+	case WorkflowTypeMixed:
+		if len(c.WorkflowMix) == 0 {
+			return fmt.Errorf("workflow type %s requires a workflow mix (BENCHMARK_WORKFLOW_MIX)", WorkflowTypeMixed)
+		}
+		for workflowType, weight := range c.WorkflowMix {
+			if !slices.Contains(ValidWorkflowTypes(), workflowType) {
+				return fmt.Errorf("invalid workflow type %q in workflow mix: must be one of: %s", workflowType, strings.Join(ValidWorkflowTypes(), ", "))
+			}
+			if weight <= 0 {
+				return fmt.Errorf("workflow mix weight %d of %s must be positive", weight, workflowType)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid workflow type %q: must be one of: simple, multi-activity, timer, child-workflow, state-transitions, contention, heartbeat, flaky, search-attributes, side-effects, long-history, fanout, mixed", c.WorkflowType)
+	}
+
+	// Validate workflow ID template
+	for _, placeholder := range placeholderPattern.FindAllString(c.WorkflowIDTemplate, -1) {
+		if !slices.Contains(WorkflowIDPlaceholders, placeholder) {
+			return fmt.Errorf("unknown placeholder %s in workflow ID template: must be one of %s",
+				placeholder, strings.Join(WorkflowIDPlaceholders, ", "))
+		}
+	}
+
+	// Validate activity count
+	if c.ActivityCount < MinActivityCount || c.ActivityCount > MaxActivityCount {
+		return fmt.Errorf("activity count %d out of range [%d, %d]", c.ActivityCount, MinActivityCount, MaxActivityCount)
+	}
+
+	// Validate activity work
+	switch c.ActivityWork {
+	case ActivityWorkSleep:
+		// valid
+	case ActivityWorkCPU:
+		if c.ActivityCPUIterations < MinActivityCPUIterations || c.ActivityCPUIterations > MaxActivityCPUIterations {
+			return fmt.Errorf("activity CPU iterations %d out of range [%d, %d] (BENCHMARK_ACTIVITY_CPU_ITERATIONS)", c.ActivityCPUIterations, MinActivityCPUIterations, MaxActivityCPUIterations)
+		}
+	default:
+		return fmt.Errorf("invalid activity work %q: must be one of: %s, %s (BENCHMARK_ACTIVITY_WORK)", c.ActivityWork, ActivityWorkSleep, ActivityWorkCPU)
+	}
+
+	// Validate child count
+	if c.ChildCount < MinChildCount || c.ChildCount > MaxChildCount {
+		return fmt.Errorf("child count %d out of range [%d, %d]", c.ChildCount, MinChildCount, MaxChildCount)
+	}
+	if c.ChildDepth < MinChildDepth || c.ChildDepth > MaxChildDepth {
+		return fmt.Errorf("child depth %d out of range [%d, %d] (BENCHMARK_CHILD_DEPTH)", c.ChildDepth, MinChildDepth, MaxChildDepth)
+	}
+	if parents, leaves := ChildTree(c.ChildCount, c.ChildDepth); parents+leaves-1 > MaxChildTreeChildren {
+		return fmt.Errorf("child count %d at depth %d starts more than %d child workflows per workflow", c.ChildCount, c.ChildDepth, MaxChildTreeChildren)
+	}
+
+	// Validate contention fan-in
+	if c.FanIn < MinFanIn || c.FanIn > MaxFanIn {
+		return fmt.Errorf("contention fan-in %d out of range [%d, %d] (BENCHMARK_CONTENTION_FAN_IN)", c.FanIn, MinFanIn, MaxFanIn)
+	}
+
+	// Validate timer duration (must be positive)
+	if c.TimerDuration <= 0 {
+		return fmt.Errorf("timer duration must be positive, got %v", c.TimerDuration)
+	}
+
+	// Validate heartbeat activity settings
+	if c.HeartbeatInterval < MinHeartbeatInterval {
+		return fmt.Errorf("heartbeat interval %v must be at least %v (BENCHMARK_HEARTBEAT_INTERVAL)", c.HeartbeatInterval, MinHeartbeatInterval)
+	}
+	if c.HeartbeatDuration < c.HeartbeatInterval {
+		return fmt.Errorf("heartbeat duration %v must be at least the heartbeat interval %v (BENCHMARK_HEARTBEAT_DURATION)", c.HeartbeatDuration, c.HeartbeatInterval)
+	}
+
+	// Validate injected failures and their retry policy
+	if c.FailureRate < 0 || c.FailureRate >= 1 {
+		return fmt.Errorf("failure rate %v out of range [0, 1) (BENCHMARK_FAILURE_RATE)", c.FailureRate)
+	}
+	if c.RetryMaxAttempts < MinRetryMaxAttempts || c.RetryMaxAttempts > MaxRetryMaxAttempts {
+		return fmt.Errorf("retry max attempts %d out of range [%d, %d] (BENCHMARK_RETRY_MAX_ATTEMPTS)", c.RetryMaxAttempts, MinRetryMaxAttempts, MaxRetryMaxAttempts)
+	}
+	if c.RetryInitialInterval <= 0 {
+		return fmt.Errorf("retry initial interval must be positive, got %v (BENCHMARK_RETRY_INITIAL_INTERVAL)", c.RetryInitialInterval)
+	}
+	if c.RetryBackoffCoefficient < 1 {
+		return fmt.Errorf("retry backoff coefficient %v must be at least 1 (BENCHMARK_RETRY_BACKOFF_COEFFICIENT)", c.RetryBackoffCoefficient)
+	}
+
+	// Validate search attribute upserts
+	if c.SearchAttributeUpserts < MinSearchAttributeUpserts || c.SearchAttributeUpserts > MaxSearchAttributeUpserts {
+		return fmt.Errorf("search attribute upserts %d out of range [%d, %d] (BENCHMARK_SEARCH_ATTRIBUTE_UPSERTS)", c.SearchAttributeUpserts, MinSearchAttributeUpserts, MaxSearchAttributeUpserts)
+	}
+
+	// Validate side effects
+	if c.SideEffects < MinSideEffects || c.SideEffects > MaxSideEffects {
+		return fmt.Errorf("side effects %d out of range [%d, %d] (BENCHMARK_SIDE_EFFECTS)", c.SideEffects, MinSideEffects, MaxSideEffects)
+	}
+
+	// Validate long history events
+	if c.LongHistoryEvents < MinLongHistoryEvents || c.LongHistoryEvents > MaxLongHistoryEvents {
+		return fmt.Errorf("long history events %d out of range [%d, %d] (BENCHMARK_LONG_HISTORY_EVENTS)", c.LongHistoryEvents, MinLongHistoryEvents, MaxLongHistoryEvents)
+	}
+
+	// Validate fanout shape
+	if c.FanoutWidth < MinFanoutWidth || c.FanoutWidth > MaxFanoutWidth {
+		return fmt.Errorf("fanout width %d out of range [%d, %d] (BENCHMARK_FANOUT_WIDTH)", c.FanoutWidth, MinFanoutWidth, MaxFanoutWidth)
+	}
+	if c.FanoutDepth < MinFanoutDepth || c.FanoutDepth > MaxFanoutDepth {
+		return fmt.Errorf("fanout depth %d out of range [%d, %d] (BENCHMARK_FANOUT_DEPTH)", c.FanoutDepth, MinFanoutDepth, MaxFanoutDepth)
+	}
+	if c.FanoutWidth*c.FanoutDepth > MaxFanoutActivities {
+		return fmt.Errorf("fanout width %d × depth %d exceeds %d activities per workflow", c.FanoutWidth, c.FanoutDepth, MaxFanoutActivities)
+	}
+	switch c.FanoutJoin {
+	case FanoutJoinAll, FanoutJoinQuorum, FanoutJoinAny:
+		// valid
+	default:
+		return fmt.Errorf("invalid fanout join %q: must be one of: %s, %s, %s (BENCHMARK_FANOUT_JOIN)", c.FanoutJoin, FanoutJoinAll, FanoutJoinQuorum, FanoutJoinAny)
+	}
+
+	// Validate target rate
+	if c.TargetRate < MinTargetRate || c.TargetRate > MaxTargetRate {
+		return fmt.Errorf("target rate %.2f out of range [%d, %d]", c.TargetRate, MinTargetRate, MaxTargetRate)
+	}
+	if c.TargetStateTransitions < 0 {
+		return fmt.Errorf("target state transitions must not be negative, got %.2f", c.TargetStateTransitions)
+	}
+	if c.TargetStateTransitions > 0 {
+		if c.HasScenario() {
+			return fmt.Errorf("BENCHMARK_TARGET_STATE_TRANSITIONS cannot be combined with a scenario: set each phase's target rate")
+		}
+		if rate := c.EffectiveTargetRate(); rate < MinTargetRate || rate > MaxTargetRate {
+			return fmt.Errorf("target of %.2f state transitions/s is %.2f %s workflows/s, out of range [%d, %d]",
+				c.TargetStateTransitions, rate, c.WorkflowType, MinTargetRate, MaxTargetRate)
+		}
+	}
+
+	// Validate duration
+	if c.Duration < MinDuration || c.Duration > MaxDuration {
+		return fmt.Errorf("duration %v out of range [%v, %v]", c.Duration, MinDuration, MaxDuration)
+	}
+
+	// Validate ramp-up duration (must be non-negative and less than total duration)
+	if c.RampUpDuration < 0 {
+		return fmt.Errorf("ramp-up duration must be non-negative, got %v", c.RampUpDuration)
+	}
+	if c.RampUpDuration >= c.Duration {
+		return fmt.Errorf("ramp-up duration %v must be less than total duration %v", c.RampUpDuration, c.Duration)
+	}
+
+	// Validate worker count
+	if c.WorkerCount < MinWorkerCount || c.WorkerCount > MaxWorkerCount {
+		return fmt.Errorf("worker count %d out of range [%d, %d]", c.WorkerCount, MinWorkerCount, MaxWorkerCount)
+	}
+
+	// Validate iterations
+	if c.Iterations < MinIterations || c.Iterations > MaxIterations {
+		return fmt.Errorf("iterations %d out of range [%d, %d]", c.Iterations, MinIterations, MaxIterations)
+	}
+
+	// Validate completion timeout (must be non-negative, 0 means auto-calculate)
+	if c.CompletionTimeout < 0 {
+		return fmt.Errorf("completion timeout must be non-negative, got %v", c.CompletionTimeout)
+	}
+	if c.DrainStallTimeout < 0 {
+		return fmt.Errorf("drain stall timeout must be non-negative, got %v", c.DrainStallTimeout)
+	}
+	if c.DrainMaxTimeout < 0 {
+		return fmt.Errorf("drain max timeout must be non-negative, got %v", c.DrainMaxTimeout)
+	}
+
+	return nil
+}
+
+// HasScenario reports whether a scenario file or inline scenario is configured.
+func (c BenchmarkConfig) HasScenario() bool {
+	return c.ScenarioFile != "" || c.Scenario != ""
+}
+
+// Cost model: approximate state transitions (history events) per workflow,
+// counting 3 per workflow task and activity (scheduled, started, completed)
+// plus the start and completion events.
+const (
+	simpleStateTransitions           = 5  // 1 workflow task
+	timerStateTransitions            = 10 // 2 workflow tasks, timer started and fired
+	multiActivityStateTransitions    = 56 // 10 activities, ~8 workflow tasks
+	stateTransitionsStateTransitions = 60 // 10 serial activities; the figure used in sizing docs
+	childParentStateTransitions      = 8  // Parent's own events with 2 workflow tasks
+	childStateTransitions            = 8  // Per child: initiated, started and completed in the parent, 5 in the child
+	contentionStateTransitions       = 14 // 2 workflow tasks, signal initiated and signaled, ~4 on the aggregator
+	heartbeatStateTransitions        = 9  // 2 workflow tasks, 1 activity; each heartbeat adds 1
+	flakyStateTransitions            = 5  // 1 workflow task, plus per activity:
+	flakyActivityStateTransitions    = 6  // scheduled, started and completed, and a workflow task
+	flakyRetryStateTransitions       = 2  // Per failed attempt: the failure and the retry timer firing
+	searchAttributeStateTransitions  = 5  // 1 workflow task; each upsert adds 1
+	sideEffectStateTransitions       = 5  // 1 workflow task; markers add none, each activity adds 6
+
+	longHistoryStateTransitions         = 5 // 1 workflow task, plus per step alternately:
+	longHistoryActivityStateTransitions = 6 // an activity and a workflow task
+	longHistoryTimerStateTransitions    = 5 // a timer started and fired, and a workflow task
+
+	fanoutStateTransitions          = 5 // 1 workflow task, plus per activity:
+	fanoutActivityStateTransitions  = 6 // awaited: scheduled, started and completed, and a workflow task
+	fanoutCancelledStateTransitions = 4 // cancelled: scheduled, started, cancel requested and closed
+)
+
+// StateTransitionsPerWorkflow returns the built-in cost model's state
+// transitions for one workflow of the given type (0 for an unknown type).
+// Heartbeat, flaky, search attribute, side effect and long-history workflows
+// and fanout workflows are costed at their default settings; see
+// HeartbeatStateTransitions, FlakyStateTransitions,
+// SearchAttributeStateTransitions, SideEffectStateTransitions,
+// LongHistoryStateTransitions and FanoutStateTransitions.
+func StateTransitionsPerWorkflow(workflowType string, childCount int) float64 {
+	switch workflowType {
+	case WorkflowTypeSimple:
+		return simpleStateTransitions
+	case WorkflowTypeTimer:
+		return timerStateTransitions
+	case WorkflowTypeMultiActivity:
+		return multiActivityStateTransitions
+	case WorkflowTypeStateTransitions:
+		return stateTransitionsStateTransitions
+	case WorkflowTypeChildWorkflow:
+		return childParentStateTransitions + childStateTransitions*float64(childCount)
+	case WorkflowTypeContention:
+		return contentionStateTransitions
+	case WorkflowTypeHeartbeat:
+		return HeartbeatStateTransitions(DefaultHeartbeatDuration, DefaultHeartbeatInterval)
+	case WorkflowTypeFlaky:
+		return FlakyStateTransitions(DefaultConfig().ActivityCount, DefaultFailureRate, DefaultRetryMaxAttempts)
+	case WorkflowTypeSearchAttributes:
+		return SearchAttributeStateTransitions(DefaultSearchAttributeUpserts)
+	case WorkflowTypeSideEffects:
+		return SideEffectStateTransitions(DefaultConfig().ActivityCount)
+	case WorkflowTypeLongHistory:
+		return LongHistoryStateTransitions(DefaultLongHistoryEvents)
+	case WorkflowTypeFanout:
+		return FanoutStateTransitions(DefaultFanoutWidth, DefaultFanoutDepth, DefaultFanoutJoin)
+	default:
+		return 0
+	}
+}
+
+// ChildTree returns the workflows of a child-workflow tree of depth levels of
+// count children per parent: the parents, including the root, and the
+// leaves, which run the simple workflow. The counts stop growing once the
+// tree exceeds MaxChildTreeChildren children.
+func ChildTree(count, depth int) (parents, leaves int) {
+	parents, leaves = 0, 1
+	for level := 0; level < depth && parents+leaves-1 <= MaxChildTreeChildren; level++ {
+		parents += leaves
+		leaves *= count
+	}
+	return parents, leaves
+}
+
+// ChildStateTransitions returns the built-in cost model's state transitions
+// for a child-workflow tree of depth levels of count children per parent,
+// counting every execution in the tree: each parent's own events, each
+// leaf's events in its parent and in itself, and each child that is itself a
+// parent's events in its parent.
+func ChildStateTransitions(count, depth int) float64 {
+	parents, leaves := ChildTree(count, depth)
+	return childParentStateTransitions*float64(parents) + childStateTransitions*float64(leaves) +
+		(childStateTransitions-simpleStateTransitions)*float64(parents-1)
+}
+
+// HeartbeatStateTransitions returns the built-in cost model's state
+// transitions for a heartbeat workflow whose activity runs for duration,
+// heartbeating every interval. Each heartbeat updates the workflow's mutable
+// state without adding a history event.
+func HeartbeatStateTransitions(duration, interval time.Duration) float64 {
+	if interval <= 0 {
+		return heartbeatStateTransitions
+	}
+	return heartbeatStateTransitions + float64(duration/interval)
+}
+
+// FlakyStateTransitions returns the built-in cost model's state transitions
+// for a flaky workflow of activities activities whose attempts fail with
+// probability failureRate, up to maxAttempts attempts each.
+func FlakyStateTransitions(activities int, failureRate float64, maxAttempts int) float64 {
+	return flakyStateTransitions + float64(activities)*
+		(flakyActivityStateTransitions+flakyRetryStateTransitions*ExpectedFailedAttempts(failureRate, maxAttempts))
+}
+
+// ExpectedFailedAttempts returns the mean failed attempts of a flaky
+// activity: each of the first maxAttempts-1 attempts is reached, and fails,
+// with probability failureRate to the power of its number.
+func ExpectedFailedAttempts(failureRate float64, maxAttempts int) float64 {
+	expected, p := 0.0, 1.0
+	for i := 1; i < maxAttempts; i++ {
+		p *= failureRate
+		expected += p
+	}
+	return expected
+}
+
+// SearchAttributeStateTransitions returns the built-in cost model's state
+// transitions for a search attribute workflow of upserts upserts. Each upsert
+// adds a history event and a visibility store write.
+func SearchAttributeStateTransitions(upserts int) float64 {
+	return searchAttributeStateTransitions + float64(upserts)
+}
+
+// SideEffectStateTransitions returns the built-in cost model's state
+// transitions for a side effect workflow of activities activities. Its
+// side effect markers are recorded with the workflow task that makes them,
+// so they grow the history but not the transitions.
+func SideEffectStateTransitions(activities int) float64 {
+	return sideEffectStateTransitions + flakyActivityStateTransitions*float64(activities)
+}
+
+// LongHistorySteps returns the steps, alternating activities and timers, that
+// bring a long-history workflow to at least events history events, and the
+// events they produce when every workflow task succeeds on its first attempt.
+func LongHistorySteps(events int) (steps, historyEvents int) {
+	historyEvents = longHistoryStateTransitions
+	for historyEvents < events {
+		if steps%2 == 0 {
+			historyEvents += longHistoryActivityStateTransitions
+		} else {
+			historyEvents += longHistoryTimerStateTransitions
+		}
+		steps++
+	}
+	return steps, historyEvents
+}
+
+// LongHistoryStateTransitions returns the built-in cost model's state
+// transitions for a long-history workflow of about events history events:
+// the events of the steps it runs to reach them.
+func LongHistoryStateTransitions(events int) float64 {
+	_, historyEvents := LongHistorySteps(events)
+	return float64(historyEvents)
+}
+
+// FanoutAwait returns the activities of a fanout stage of width parallel
+// activities that the join strategy waits for.
+func FanoutAwait(width int, join string) int {
+	switch join {
+	case FanoutJoinAny:
+		return 1
+	case FanoutJoinQuorum:
+		return width/2 + 1
+	default:
+		return width
+	}
+}
+
+// FanoutStateTransitions returns the built-in cost model's state transitions
+// for a fanout workflow of depth stages of width activities joined with join.
+// Every activity is scheduled; those a stage doesn't wait for are cancelled,
+// and their closing events mostly share the workflow tasks of the others.
+func FanoutStateTransitions(width, depth int, join string) float64 {
+	awaited := FanoutAwait(width, join)
+	return fanoutStateTransitions + float64(depth)*
+		(fanoutActivityStateTransitions*float64(awaited)+fanoutCancelledStateTransitions*float64(width-awaited))
+}
+
+// ModelTransitionCost returns the built-in cost model's state transitions per
+// workflow of the configured type with the configured workflow parameters.
+// A mixed workflow costs the weighted mean of its types.
+func (c BenchmarkConfig) ModelTransitionCost() float64 {
+	switch c.WorkflowType {
+	case WorkflowTypeMixed:
+		return c.mixCost(BenchmarkConfig.ModelTransitionCost)
+	case WorkflowTypeHeartbeat:
+		return HeartbeatStateTransitions(c.HeartbeatDuration, c.HeartbeatInterval)
+	case WorkflowTypeFlaky:
+		return FlakyStateTransitions(c.ActivityCount, c.FailureRate, c.RetryMaxAttempts)
+	case WorkflowTypeSearchAttributes:
+		return SearchAttributeStateTransitions(c.SearchAttributeUpserts)
+	case WorkflowTypeSideEffects:
+		return SideEffectStateTransitions(c.ActivityCount)
+	case WorkflowTypeLongHistory:
+		return LongHistoryStateTransitions(c.LongHistoryEvents)
+	case WorkflowTypeFanout:
+		return FanoutStateTransitions(c.FanoutWidth, c.FanoutDepth, c.FanoutJoin)
+	case WorkflowTypeChildWorkflow:
+		return ChildStateTransitions(c.ChildCount, c.ChildDepth)
+	}
+	return StateTransitionsPerWorkflow(c.WorkflowType, c.ChildCount)
+}
+
+// Sources of a workflow type's transition cost.
+const (
+	TransitionCostModel      = "model"      // Built-in cost model
+	TransitionCostCalibrated = "calibrated" // Measured by calibrate mode
+)
+
+// TransitionCost returns the state transitions per workflow of the configured
+// type and where the figure came from: the calibration table when it has the
+// type (for child workflows, measured with the same child count and depth), otherwise
+// the built-in cost model. Heartbeat, flaky, search attribute, side effect,
+// long-history and fanout workflows always use the model, which scales with
+// the configured heartbeats, injected failures, upserts, activities, events or
+// stages the calibration table doesn't record. A mixed workflow costs the
+// weighted mean of its types, and is calibrated only if each of them is.
+func (c BenchmarkConfig) TransitionCost() (float64, string) {
+	if c.WorkflowType == WorkflowTypeMixed {
+		calibrated := true
+		cost := c.mixCost(func(t BenchmarkConfig) float64 {
+			cost, source := t.TransitionCost()
+			calibrated = calibrated && source == TransitionCostCalibrated
+			return cost
+		})
+		if calibrated {
+			return cost, TransitionCostCalibrated
+		}
+		return cost, TransitionCostModel
+	}
+	if c.Calibration != nil && c.WorkflowType != WorkflowTypeHeartbeat && c.WorkflowType != WorkflowTypeFlaky &&
+		c.WorkflowType != WorkflowTypeSearchAttributes && c.WorkflowType != WorkflowTypeSideEffects &&
+		c.WorkflowType != WorkflowTypeLongHistory && c.WorkflowType != WorkflowTypeFanout {
+		if t, ok := c.Calibration.Types[c.WorkflowType]; ok && t.StateTransitions > 0 &&
+			(c.WorkflowType != WorkflowTypeChildWorkflow || t.ChildCount == c.ChildCount && max(t.ChildDepth, 1) == c.ChildDepth) {
+			return t.StateTransitions, TransitionCostCalibrated
+		}
+	}
+	return c.ModelTransitionCost(), TransitionCostModel
+}
+
+// EffectiveTargetRate returns the workflow rate to generate: TargetRate, or
+// TargetStateTransitions translated with the transition cost when that is set.
+func (c BenchmarkConfig) EffectiveTargetRate() float64 {
+	if c.TargetStateTransitions <= 0 {
+		return c.TargetRate
+	}
+	cost, _ := c.TransitionCost()
+	if cost == 0 {
+		return c.TargetRate
+	}
+	return c.TargetStateTransitions / cost
+}
+
+// CalibrationTable records the measured cost of each workflow type, written
+// by calibrate mode to BENCHMARK_CALIBRATION_FILE.
+type CalibrationTable struct {
+	Timestamp time.Time                     `json:"timestamp"`
+	Types     map[string]CalibratedWorkflow `json:"types"`
+}
+
+// CalibratedWorkflow is the mean cost of one workflow of a type, read from
+// the server after the workflows completed.
+type CalibratedWorkflow struct {
+	Samples          int     `json:"samples"`
+	HistoryEvents    float64 `json:"historyEvents"`
+	StateTransitions float64 `json:"stateTransitions"`
+	ChildCount       int     `json:"childCount,omitempty"` // Children per workflow when measured (child-workflow only)
+	ChildDepth       int     `json:"childDepth,omitempty"` // Levels of children when measured (child-workflow only; unset is 1)
+}
+
+// LoadCalibrationTable reads a calibration table written by calibrate mode.
+func LoadCalibrationTable(path string) (*CalibrationTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var t CalibrationTable
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if len(t.Types) == 0 {
+		return nil, fmt.Errorf("calibration table %s has no workflow types", path)
+	}
+	return &t, nil
+}
+
+// WriteCalibrationTable writes t to path as indented JSON.
+func WriteCalibrationTable(path string, t *CalibrationTable) error {
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize calibration table: %w", err)
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// ValidWorkflowTypes returns a list of valid workflow types.
+func ValidWorkflowTypes() []string {
+	return []string{
+		WorkflowTypeSimple,
+		WorkflowTypeMultiActivity,
+		WorkflowTypeTimer,
+		WorkflowTypeChildWorkflow,
+		WorkflowTypeStateTransitions,
+		WorkflowTypeContention,
+		WorkflowTypeHeartbeat,
+		WorkflowTypeFlaky,
+		WorkflowTypeSearchAttributes,
+		WorkflowTypeSideEffects,
+		WorkflowTypeLongHistory,
+		WorkflowTypeFanout,
+	}
+}
+
+// CPUIterations returns the SHA-256 iterations of each generic activity, or
+// 0 if activities sleep.
+func (c BenchmarkConfig) CPUIterations() int {
+	if c.ActivityWork != ActivityWorkCPU {
+		return 0
+	}
+	return c.ActivityCPUIterations
+}
+
+// WorkflowTypes returns the workflow types the configuration starts: the
+// types of WorkflowMix in name order for the mixed type, otherwise
+// WorkflowType alone.
+func (c BenchmarkConfig) WorkflowTypes() []string {
+	if c.WorkflowType != WorkflowTypeMixed {
+		return []string{c.WorkflowType}
+	}
+	return slices.Sorted(maps.Keys(c.WorkflowMix))
+}
+
+// ForWorkflowType returns a copy of the configuration that starts only
+// workflows of workflowType, with the same workflow parameters.
+func (c BenchmarkConfig) ForWorkflowType(workflowType string) BenchmarkConfig {
+	c.WorkflowType = workflowType
+	c.WorkflowMix = nil
+	return c
+}
+
+// mixCost returns the mean of cost over the types of WorkflowMix, weighted
+// by their share of the workflows started.
+func (c BenchmarkConfig) mixCost(cost func(BenchmarkConfig) float64) float64 {
+	var sum float64
+	var total int
+	for workflowType, weight := range c.WorkflowMix {
+		sum += cost(c.ForWorkflowType(workflowType)) * float64(weight)
+		total += weight
+	}
+	if total == 0 {
+		return 0
+	}
+	return sum / float64(total)
+}
+
+// EffectiveWorkflowCacheSize returns the workflow cache size of this
+// process's workers: 0 with sticky execution disabled, otherwise
+// WorkflowCacheSize.
+func (c BenchmarkConfig) EffectiveWorkflowCacheSize() int {
+	if c.DisableSticky {
+		return 0
+	}
+	return c.WorkflowCacheSize
+}
+
+// MaxRunLength returns the longest a benchmark run can last once it holds
+// the run lock: setup (such as redeploying services with dynamic config) plus
+// each iteration's load phases of loadDuration (Duration, or a scenario's
+// total) and drain, plus the cleanup, capped by MaxTotalRuntime.
+func (c BenchmarkConfig) MaxRunLength(loadDuration, setup time.Duration) time.Duration {
+	drain := c.CompletionTimeout
+	if drain <= 0 {
+		drain = c.DrainMaxTimeout
+	}
+	if drain <= 0 {
+		drain = DefaultDrainMaxTimeout
+	}
+	cleanup := c.CleanupTimeout
+	if cleanup <= 0 {
+		cleanup = DefaultCleanupTimeout
+	}
+	length := setup + time.Duration(c.Iterations)*(loadDuration+drain) + cleanup
+	if c.MaxTotalRuntime > 0 {
+		length = min(length, c.MaxTotalRuntime)
+	}
+	return length
+}
+
+// ValidateRunLength checks that nothing with a TTL a run depends on can
+// expire while a run of up to length (see MaxRunLength) is still going.
+func (c BenchmarkConfig) ValidateRunLength(length time.Duration) error {
+	// The work role only hosts the janitors
+	if c.Role == RoleWork {
+		return nil
+	}
+	// The namespace janitor deletes the run's namespace once the TTL elapses
+	if c.NamespaceTTL > 0 && c.NamespaceTTL <= length {
+		return fmt.Errorf("BENCHMARK_NAMESPACE_TTL (%v) must exceed the longest run (%v: iterations × (duration + drain) + cleanup), "+
+			"or the namespace is deleted while the run uses it: raise it or bound the run with BENCHMARK_MAX_TOTAL_RUNTIME", c.NamespaceTTL, length)
+	}
+	if c.Mode != ModeBenchmark || c.ReplayFile != "" || c.RetentionResultsFile != "" {
+		return nil
+	}
+	// Another benchmark can take an expired lock and run alongside this one
+	if c.RunLock != RunLockOff && c.RunLockTTL < length {
+		return fmt.Errorf("BENCHMARK_RUN_LOCK_TTL (%v) must cover the longest run (%v: iterations × (duration + drain) + cleanup), "+
+			"or the run lock expires while the run holds it: raise it or bound the run with BENCHMARK_MAX_TOTAL_RUNTIME", c.RunLockTTL, length)
+	}
+	// Worker services terminate the workflows of runs whose registration expired
+	if c.RunRegistry && c.RunLockTTL < length {
+		return fmt.Errorf("BENCHMARK_RUN_LOCK_TTL (%v) must cover the longest run (%v: iterations × (duration + drain) + cleanup), "+
+			"or the run's registration expires while it runs: raise it or bound the run with BENCHMARK_MAX_TOTAL_RUNTIME", c.RunLockTTL, length)
+	}
+	return nil
+}
+
+// SensitiveValues returns the configured values that identify or grant access
+// to the deployment (addresses, endpoints, credentials and TLS file paths),
+// which BENCHMARK_REDACT scrubs from logs and results.
+func (c BenchmarkConfig) SensitiveValues() []string {
+	values := []string{
+		c.TemporalAddress,
+		c.TLSCertFile, c.TLSKeyFile, c.TLSCAFile, c.TLSServerName,
+		c.APIKey, c.OAuthTokenURL, c.OAuthClientID, c.OAuthClientSecret, c.AuthTokenFile,
+		c.AdminToken, c.GrafanaURL, c.GrafanaToken,
+	}
+	if host, _, err := net.SplitHostPort(c.TemporalAddress); err == nil {
+		values = append(values, host)
+	}
+	values = append(values, c.ServerMetricsURLs...)
+	values = append(values, c.WorkerMetricsURLs...)
+	for _, sink := range strings.Split(c.ResultSinks, ",") {
+		if sink = strings.TrimSpace(sink); strings.HasPrefix(sink, "http://") || strings.HasPrefix(sink, "https://") {
+			values = append(values, sink)
+		}
+	}
+	return values
+}
+
+// ContentionAggregators returns the number of aggregator workflows a
+// contention run spreads its workflows over: the workflows expected at the
+// effective target rate divided by FanIn, at least 1.
+func (c BenchmarkConfig) ContentionAggregators() int {
+	expected := c.EffectiveTargetRate() * c.Duration.Seconds()
+	return max(1, int(math.Round(expected/float64(max(c.FanIn, 1)))))
+}
+
+// thresholdsFile is the JSON layout of BENCHMARK_THRESHOLDS_FILE.
+type thresholdsFile struct {
+	MaxP99Latency string  `json:"maxP99Latency"` // Go duration, e.g. "5s"
+	MinThroughput float64 `json:"minThroughput"`
+}
+
+// LoadThresholdsFile reads pass/fail thresholds from a JSON file such as
+// {"maxP99Latency": "5s", "minThroughput": 50}.
+func LoadThresholdsFile(path string) (time.Duration, float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var f thresholdsFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	maxP99Latency, err := time.ParseDuration(f.MaxP99Latency)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid maxP99Latency in %s: %w", path, err)
+	}
+	if maxP99Latency <= 0 {
+		return 0, 0, fmt.Errorf("maxP99Latency in %s must be positive, got %v", path, maxP99Latency)
+	}
+	if f.MinThroughput <= 0 {
+		return 0, 0, fmt.Errorf("minThroughput in %s must be positive, got %.2f", path, f.MinThroughput)
+	}
+
+	return maxP99Latency, f.MinThroughput, nil
+}
+
+// PersistenceThreshold caps a server-side persistence latency percentile for
+// every persistence operation of a Temporal service.
+type PersistenceThreshold struct {
+	Service    string        // Temporal service (service_name label), empty for all services
+	Percentile float64       // Latency percentile, e.g. 99 for p99
+	Max        time.Duration // Maximum acceptable latency
+}
+
+// String formats the threshold as it is written in BENCHMARK_PERSISTENCE_THRESHOLDS.
+func (t PersistenceThreshold) String() string {
+	s := fmt.Sprintf("p%g<%v", t.Percentile, t.Max)
+	if t.Service != "" {
+		s = t.Service + ":" + s
+	}
+	return s
+}
+
+// ParsePersistenceThresholds parses a comma-separated list of
+// "[service:]p<percentile><<duration>" thresholds, e.g. "history:p99<50ms,p95<20ms".
+func ParsePersistenceThresholds(spec string) ([]PersistenceThreshold, error) {
+	var thresholds []PersistenceThreshold
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		var t PersistenceThreshold
+		rest := entry
+		if service, after, ok := strings.Cut(entry, ":"); ok {
+			t.Service, rest = service, after
+		}
+		percentile, limit, ok := strings.Cut(rest, "<")
+		if !ok || !strings.HasPrefix(percentile, "p") {
+			return nil, fmt.Errorf("invalid persistence threshold %q: must be [service:]p<percentile><<duration>", entry)
+		}
+		p, err := strconv.ParseFloat(strings.TrimPrefix(percentile, "p"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid persistence threshold %q: %w", entry, err)
+		}
+		d, err := time.ParseDuration(limit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid persistence threshold %q: %w", entry, err)
+		}
+		t.Percentile, t.Max = p, d
+		thresholds = append(thresholds, t)
+	}
+	return thresholds, nil
+}
+
+// Prometheus metric and label name syntax, used to validate metric naming.
+var (
+	metricNamePattern = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNamePattern  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// placeholderPattern matches a "{name}" placeholder in a workflow ID template.
+var placeholderPattern = regexp.MustCompile(`\{[^{}]*\}`)
+
+// ParseMetricsLabels parses a comma-separated list of "name=value" labels,
+// e.g. "scenario=steady,role=generator".
+func ParseMetricsLabels(spec string) (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid metrics label %q: must be name=value", entry)
+		}
+		labels[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return labels, nil
+}
+
+// ParseMemo parses a comma-separated list of "key=value" memo entries,
+// e.g. "team=persistence,cost-center=1234,experiment=occ-retries".
+func ParseMemo(spec string) (map[string]string, error) {
+	memo := make(map[string]string)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid memo entry %q: must be key=value", entry)
+		}
+		memo[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return memo, nil
+}
+
+// ParseWorkflowMix parses a comma-separated list of "type=weight" entries,
+// e.g. "simple=50,timer=30,multi-activity=20". Weights are relative and
+// need not sum to 100.
+func ParseWorkflowMix(spec string) (map[string]int, error) {
+	mix := make(map[string]int)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		workflowType, weight, ok := strings.Cut(entry, "=")
+		if !ok || strings.TrimSpace(workflowType) == "" {
+			return nil, fmt.Errorf("invalid workflow mix entry %q: must be type=weight", entry)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(weight))
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight in workflow mix entry %q: %w", entry, err)
+		}
+		mix[strings.TrimSpace(workflowType)] = n
+	}
+	return mix, nil
+}
+
+// ParseHistogramBuckets parses a semicolon-separated list of
+// "family=bounds" entries. Bounds are either a comma-separated list of
+// durations (or plain seconds), e.g. "sdk=100us,500us,1ms,5ms", or an
+// exponential series "exp:<start>,<factor>,<count>", e.g. "workflow=exp:1s,2,12".
+func ParseHistogramBuckets(spec string) (map[string][]float64, error) {
+	buckets := make(map[string][]float64)
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		family, bounds, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid histogram buckets %q: must be family=bounds", entry)
+		}
+		family = strings.TrimSpace(family)
+
+		var values []float64
+		if exp, isExp := strings.CutPrefix(strings.TrimSpace(bounds), "exp:"); isExp {
+			parts := strings.Split(exp, ",")
+			if len(parts) != 3 {
+				return nil, fmt.Errorf("invalid histogram buckets %q: exp must be exp:<start>,<factor>,<count>", entry)
+			}
+			start, err := parseBucketBound(parts[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid histogram buckets %q: %w", entry, err)
+			}
+			factor, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+			if err != nil || factor <= 1 {
+				return nil, fmt.Errorf("invalid histogram buckets %q: factor must be greater than 1", entry)
+			}
+			count, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("invalid histogram buckets %q: count must be at least 1", entry)
+			}
+			for i, b := 0, start; i < count; i, b = i+1, b*factor {
+				values = append(values, b)
+			}
+		} else {
+			for _, bound := range strings.Split(bounds, ",") {
+				b, err := parseBucketBound(bound)
+				if err != nil {
+					return nil, fmt.Errorf("invalid histogram buckets %q: %w", entry, err)
+				}
+				values = append(values, b)
+			}
+		}
+		buckets[family] = values
+	}
+	return buckets, nil
+}
+
+// parseBucketBound parses a bucket bound given as a duration ("5ms") or as
+// plain seconds ("0.005").
+func parseBucketBound(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if d, err := time.ParseDuration(s); err == nil {
+		return d.Seconds(), nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
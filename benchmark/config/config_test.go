@@ -0,0 +1,164 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyArgs(t *testing.T) {
+	const usage = "usage: benchmark [all|generate|work] [mode] [--tui]"
+	tests := []struct {
+		name        string
+		args        []string
+		role        string
+		mode        string
+		tui         bool
+		argsErr     string // Expected ApplyArgs error
+		validateErr string // Expected Validate error once the args applied
+	}{
+		{name: "no args", args: []string{}, role: RoleAll, mode: ModeBenchmark},
+		{name: "role only", args: []string{"work"}, role: RoleWork, mode: ModeBenchmark},
+		{name: "mode only", args: []string{"smoke"}, role: RoleAll, mode: ModeSmoke},
+		{name: "role and mode", args: []string{"all", "smoke"}, role: RoleAll, mode: ModeSmoke},
+		{name: "flag after role", args: []string{"generate", "--tui"}, role: RoleGenerate, mode: ModeBenchmark, tui: true},
+		{name: "flag first", args: []string{"--tui", "generate"}, role: RoleGenerate, mode: ModeBenchmark, tui: true},
+		{name: "runs list", args: []string{"runs", "list"}, role: RoleAll, mode: ModeRuns},
+		{
+			name: "work role with another mode", args: []string{"work", "smoke"}, role: RoleWork, mode: ModeSmoke,
+			validateErr: "smoke mode cannot be combined with the work role",
+		},
+		{
+			name: "tui with the work role", args: []string{"work", "--tui"}, role: RoleWork, mode: ModeBenchmark, tui: true,
+			validateErr: "--tui shows a single benchmark run",
+		},
+		{name: "unknown flag", args: []string{"generate", "--verbose"}, argsErr: `unknown flag "--verbose": ` + usage},
+		{name: "extra args", args: []string{"all", "smoke", "now"}, argsErr: `unexpected arguments ["now"]: ` + usage},
+		{name: "extra runs args", args: []string{"runs", "list", "all"}, argsErr: `unexpected arguments ["all"]: ` + usage},
+		{name: "unknown mode", args: []string{"generate", "stress"}, role: RoleGenerate, mode: "stress", validateErr: `invalid mode "stress"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			err := cfg.ApplyArgs(tt.args)
+			if tt.argsErr != "" {
+				require.EqualError(t, err, tt.argsErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.role, cfg.Role)
+			require.Equal(t, tt.mode, cfg.Mode)
+			require.Equal(t, tt.tui, cfg.TUI)
+
+			err = cfg.Validate()
+			if tt.validateErr != "" {
+				require.ErrorContains(t, err, tt.validateErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidate_Role(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Role = "worker"
+	require.EqualError(t, cfg.Validate(), `invalid role "worker": must be one of: all, generate, work`)
+
+	// A worker ignores the load settings
+	cfg = DefaultConfig()
+	cfg.Role = RoleWork
+	cfg.TargetRate = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.RecordFile = "/tmp/events.jsonl"
+	require.ErrorContains(t, cfg.Validate(), "the work role cannot use BENCHMARK_RECORD_FILE")
+
+	cfg = DefaultConfig()
+	cfg.WorkerTasks = WorkerTasksActivity
+	require.EqualError(t, cfg.Validate(), "BENCHMARK_WORKER_TASKS=activity requires the work role: the embedded worker handles all tasks")
+	cfg.Role = RoleWork
+	require.NoError(t, cfg.Validate())
+}
+
+func TestValidate_WorkerMetricsURLs(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.WorkerMetricsURLs = []string{"http://worker:9090/metrics"}
+	require.EqualError(t, cfg.Validate(), "BENCHMARK_WORKER_METRICS_URLS require the generate role; the all role counts its own workers' executions")
+
+	cfg.Role = RoleGenerate
+	require.NoError(t, cfg.Validate())
+
+	cfg.WorkerMetricsURLs = []string{"worker:9090"}
+	require.EqualError(t, cfg.Validate(), `invalid worker metrics URL "worker:9090": must be an http(s) URL`)
+}
+
+func TestLoadFromEnv_RemovedRoleFlags(t *testing.T) {
+	for env, role := range map[string]string{
+		"BENCHMARK_GENERATOR_ONLY": RoleGenerate,
+		"BENCHMARK_WORKER_ONLY":    RoleWork,
+	} {
+		t.Run(env, func(t *testing.T) {
+			t.Setenv(env, "true")
+			_, err := LoadFromEnv()
+			require.EqualError(t, err, env+` is no longer supported: run the "`+role+`" subcommand or set BENCHMARK_ROLE=`+role)
+		})
+	}
+
+	cfg, err := LoadFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, RoleAll, cfg.Role)
+
+	t.Setenv("BENCHMARK_ROLE", RoleWork)
+	cfg, err = LoadFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, RoleWork, cfg.Role)
+}
+
+func TestMaxRunLength(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Iterations = 3
+	cfg.Duration = time.Hour
+	// 3 × (1h + 30m drain) + 15m cleanup
+	require.Equal(t, 4*time.Hour+45*time.Minute, cfg.MaxRunLength(cfg.Duration, 0))
+	require.Equal(t, 5*time.Hour+15*time.Minute, cfg.MaxRunLength(cfg.Duration, 30*time.Minute), "setup")
+
+	cfg.CompletionTimeout = 5 * time.Minute
+	cfg.CleanupTimeout = time.Minute
+	require.Equal(t, 3*time.Hour+16*time.Minute, cfg.MaxRunLength(cfg.Duration, 0), "fixed drain and cleanup budget")
+
+	cfg.MaxTotalRuntime = 2 * time.Hour
+	require.Equal(t, 2*time.Hour, cfg.MaxRunLength(cfg.Duration, 0), "bounded by the total runtime")
+}
+
+func TestValidate_RunLength(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.RunRegistry = true
+	cfg.Iterations = 10
+	cfg.Duration = time.Hour
+	require.ErrorContains(t, cfg.Validate(), "BENCHMARK_RUN_LOCK_TTL (6h0m0s) must cover the longest run (15h15m0s")
+
+	cfg.MaxTotalRuntime = 6 * time.Hour
+	require.NoError(t, cfg.Validate(), "the total runtime bounds the run")
+
+	cfg = DefaultConfig()
+	cfg.RunLock = RunLockFail
+	cfg.Iterations = 10
+	cfg.Duration = time.Hour
+	require.ErrorContains(t, cfg.Validate(), "or the run lock expires while the run holds it")
+	cfg.RunLockTTL = 16 * time.Hour
+	require.NoError(t, cfg.Validate())
+
+	// The janitor deletes the namespace of a run outlasting the TTL
+	cfg = DefaultConfig()
+	cfg.NamespaceTTL = 2 * time.Hour
+	cfg.Iterations = 2
+	cfg.Duration = time.Hour
+	require.ErrorContains(t, cfg.Validate(), "BENCHMARK_NAMESPACE_TTL (2h0m0s) must exceed the longest run (3h15m0s")
+	cfg.Iterations = 1
+	cfg.NamespaceTTL = 90 * time.Minute
+	require.ErrorContains(t, cfg.Validate(), "must exceed the longest run (1h45m0s", "the drain and cleanup count")
+	cfg.NamespaceTTL = 24 * time.Hour
+	require.NoError(t, cfg.Validate())
+}
@@ -0,0 +1,240 @@
+// Package dialer connects the benchmark's Temporal clients to the frontend
+// over the address family BENCHMARK_ADDRESS_FAMILY prefers, dialing
+// dual-stack endpoints happy-eyeballs style, and records the family each
+// connection was made over for the results.
+package dialer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// passthroughScheme makes gRPC hand the frontend's host name to the dialer
+// instead of resolving it and dialing each address on its own.
+const passthroughScheme = "passthrough:///"
+
+// Dialer dials the frontend for every Temporal client it is applied to. It
+// is safe for concurrent use; a nil Dialer leaves clients to gRPC's dialing.
+type Dialer struct {
+	preference    string
+	fallbackDelay time.Duration
+	resolver      *net.Resolver
+	dialer        net.Dialer
+
+	mu        sync.Mutex
+	families  map[string]int64 // Connections by address family
+	fallbacks int64
+}
+
+// New creates a Dialer for cfg.AddressFamily and cfg.DialFallbackDelay.
+func New(cfg config.BenchmarkConfig) *Dialer {
+	return &Dialer{
+		preference:    cfg.AddressFamily,
+		fallbackDelay: cfg.DialFallbackDelay,
+		resolver:      net.DefaultResolver,
+		families:      make(map[string]int64),
+	}
+}
+
+// Apply makes clients dialed with opts connect through d.
+func (d *Dialer) Apply(opts *client.Options) {
+	if d == nil {
+		return
+	}
+	if !strings.Contains(opts.HostPort, "://") {
+		opts.HostPort = passthroughScheme + opts.HostPort
+	}
+	opts.ConnectionOptions.DialOptions = append(opts.ConnectionOptions.DialOptions,
+		grpc.WithContextDialer(d.DialContext))
+}
+
+// DialContext connects to address (host:port). It resolves the host and
+// dials the preferred family's addresses one after another; unless the
+// preference is single-stack, the other family's addresses are dialed in
+// parallel once those fail or have not connected within the fallback delay,
+// and the first connection wins. A host without addresses of the preferred
+// family is dialed over the other.
+func (d *Dialer) DialContext(ctx context.Context, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := d.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	primaries, fallbacks := partition(d.preference, addrs)
+	if len(primaries) == 0 {
+		if len(fallbacks) == 0 || d.singleStack() {
+			return nil, fmt.Errorf("%s has no address for address family %s", host, d.preference)
+		}
+		primaries, fallbacks = fallbacks, nil
+	}
+	if d.singleStack() {
+		fallbacks = nil
+	}
+
+	conn, fellBack, err := d.race(ctx, primaries, fallbacks, port)
+	if err != nil {
+		return nil, err
+	}
+	family := addressFamily(conn.RemoteAddr())
+	d.mu.Lock()
+	d.families[family]++
+	if fellBack {
+		d.fallbacks++
+	}
+	d.mu.Unlock()
+	if fellBack {
+		slog.Warn("Frontend connection fell back to the other address family", "host", host, "family", family)
+	} else {
+		slog.Debug("Connected to the frontend", "host", host, "family", family)
+	}
+	return conn, nil
+}
+
+// Result returns the connections dialed so far by address family, or nil if
+// d is nil or dialed none.
+func (d *Dialer) Result() *results.Connection {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c := &results.Connection{Preference: d.preference, Fallbacks: d.fallbacks}
+	for family, dials := range d.families {
+		c.Dials += dials
+		if c.AddressFamily == "" {
+			c.AddressFamily = family
+		} else {
+			c.AddressFamily = results.AddressFamilyMixed
+		}
+	}
+	if c.Dials == 0 {
+		return nil
+	}
+	return c
+}
+
+func (d *Dialer) singleStack() bool {
+	return d.preference == config.AddressFamilyIPv6Only || d.preference == config.AddressFamilyIPv4Only
+}
+
+// race dials primaries and, after the fallback delay or once they fail,
+// fallbacks, returning the first connection and whether it was a fallback.
+// A connection the losing attempt makes after all is closed.
+func (d *Dialer) race(ctx context.Context, primaries, fallbacks []net.IPAddr, port string) (net.Conn, bool, error) {
+	if len(fallbacks) == 0 {
+		conn, err := d.dialSerial(ctx, primaries, port)
+		return conn, false, err
+	}
+
+	type attempt struct {
+		conn     net.Conn
+		fallback bool
+		err      error
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	attempts := make(chan attempt, 2)
+	start := func(addrs []net.IPAddr, fallback bool) {
+		go func() {
+			conn, err := d.dialSerial(ctx, addrs, port)
+			attempts <- attempt{conn: conn, fallback: fallback, err: err}
+		}()
+	}
+
+	start(primaries, false)
+	timer := time.NewTimer(d.fallbackDelay)
+	defer timer.Stop()
+	pending, fallbackStarted := 1, false
+	var errs []error
+	for {
+		select {
+		case <-timer.C:
+			if !fallbackStarted {
+				start(fallbacks, true)
+				pending, fallbackStarted = pending+1, true
+			}
+		case a := <-attempts:
+			pending--
+			if a.err == nil {
+				go func(pending int) {
+					for ; pending > 0; pending-- {
+						if late := <-attempts; late.conn != nil {
+							late.conn.Close()
+						}
+					}
+				}(pending)
+				return a.conn, a.fallback, nil
+			}
+			errs = append(errs, a.err)
+			if !fallbackStarted {
+				start(fallbacks, true)
+				pending, fallbackStarted = pending+1, true
+			} else if pending == 0 {
+				return nil, false, errors.Join(errs...)
+			}
+		}
+	}
+}
+
+// dialSerial dials addrs in order, returning the first connection.
+func (d *Dialer) dialSerial(ctx context.Context, addrs []net.IPAddr, port string) (net.Conn, error) {
+	var errs []error
+	for _, addr := range addrs {
+		conn, err := d.dialer.DialContext(ctx, "tcp", net.JoinHostPort(addr.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+// partition splits addrs into the preferred family's and the other's,
+// keeping the resolver's order within each. The auto preference prefers the
+// family of the resolver's first address, which it orders by RFC 6724.
+func partition(preference string, addrs []net.IPAddr) (primaries, fallbacks []net.IPAddr) {
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+	preferIPv6 := addrs[0].IP.To4() == nil
+	switch preference {
+	case config.AddressFamilyIPv6, config.AddressFamilyIPv6Only:
+		preferIPv6 = true
+	case config.AddressFamilyIPv4, config.AddressFamilyIPv4Only:
+		preferIPv6 = false
+	}
+	for _, addr := range addrs {
+		if (addr.IP.To4() == nil) == preferIPv6 {
+			primaries = append(primaries, addr)
+		} else {
+			fallbacks = append(fallbacks, addr)
+		}
+	}
+	return primaries, fallbacks
+}
+
+// addressFamily returns the family of a connection's remote address.
+func addressFamily(addr net.Addr) string {
+	if tcp, ok := addr.(*net.TCPAddr); ok && tcp.IP.To4() == nil {
+		return results.AddressFamilyIPv6
+	}
+	return results.AddressFamilyIPv4
+}
@@ -0,0 +1,109 @@
+package dialer
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// listen accepts connections on an IPv4 loopback port.
+func listen(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	return strconv.Itoa(l.Addr().(*net.TCPAddr).Port)
+}
+
+func newDialer(preference string) *Dialer {
+	cfg := config.DefaultConfig()
+	cfg.AddressFamily = preference
+	return New(cfg)
+}
+
+func TestPartition(t *testing.T) {
+	v4 := net.IPAddr{IP: net.ParseIP("10.0.0.1")}
+	v6 := net.IPAddr{IP: net.ParseIP("fd00::1")}
+	v4b := net.IPAddr{IP: net.ParseIP("10.0.0.2")}
+
+	primaries, fallbacks := partition(config.AddressFamilyAuto, []net.IPAddr{v6, v4, v4b})
+	require.Equal(t, []net.IPAddr{v6}, primaries, "resolver's first family")
+	require.Equal(t, []net.IPAddr{v4, v4b}, fallbacks)
+
+	primaries, fallbacks = partition(config.AddressFamilyIPv6, []net.IPAddr{v4, v6, v4b})
+	require.Equal(t, []net.IPAddr{v6}, primaries)
+	require.Equal(t, []net.IPAddr{v4, v4b}, fallbacks)
+
+	primaries, fallbacks = partition(config.AddressFamilyIPv4Only, []net.IPAddr{v6, v4, v4b})
+	require.Equal(t, []net.IPAddr{v4, v4b}, primaries)
+	require.Equal(t, []net.IPAddr{v6}, fallbacks)
+}
+
+func TestDialContext(t *testing.T) {
+	port := listen(t)
+	d := newDialer(config.AddressFamilyAuto)
+	require.Nil(t, d.Result(), "nothing dialed")
+
+	conn, err := d.DialContext(context.Background(), net.JoinHostPort("127.0.0.1", port))
+	require.NoError(t, err)
+	conn.Close()
+	require.Equal(t, &results.Connection{
+		Preference:    config.AddressFamilyAuto,
+		AddressFamily: results.AddressFamilyIPv4,
+		Dials:         1,
+	}, d.Result())
+
+	_, err = newDialer(config.AddressFamilyIPv6Only).DialContext(context.Background(), net.JoinHostPort("127.0.0.1", port))
+	require.ErrorContains(t, err, "no address for address family ipv6-only")
+
+	conn, err = newDialer(config.AddressFamilyIPv6).DialContext(context.Background(), net.JoinHostPort("127.0.0.1", port))
+	require.NoError(t, err, "IPv4-only host with IPv6 preferred")
+	conn.Close()
+}
+
+func TestRace_FallsBack(t *testing.T) {
+	port := listen(t)
+	d := newDialer(config.AddressFamilyIPv6)
+	v6 := net.IPAddr{IP: net.IPv6loopback} // Nothing listens on the IPv6 port
+	v4 := net.IPAddr{IP: net.ParseIP("127.0.0.1")}
+
+	conn, fellBack, err := d.race(context.Background(), []net.IPAddr{v6}, []net.IPAddr{v4}, port)
+	require.NoError(t, err)
+	conn.Close()
+	require.True(t, fellBack)
+	require.Equal(t, results.AddressFamilyIPv4, addressFamily(conn.RemoteAddr()))
+
+	conn, fellBack, err = d.race(context.Background(), []net.IPAddr{v4}, []net.IPAddr{v6}, port)
+	require.NoError(t, err)
+	conn.Close()
+	require.False(t, fellBack, "preferred family connected")
+
+	_, _, err = d.race(context.Background(), []net.IPAddr{v6}, []net.IPAddr{{IP: net.ParseIP("127.0.0.1")}}, "1")
+	require.Error(t, err, "both families refused")
+}
+
+func TestApply(t *testing.T) {
+	opts := client.Options{HostPort: "temporal-frontend:7233"}
+	(*Dialer)(nil).Apply(&opts)
+	require.Equal(t, "temporal-frontend:7233", opts.HostPort)
+
+	newDialer(config.AddressFamilyAuto).Apply(&opts)
+	require.Equal(t, "passthrough:///temporal-frontend:7233", opts.HostPort)
+	require.Len(t, opts.ConnectionOptions.DialOptions, 1)
+}
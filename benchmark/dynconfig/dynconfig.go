@@ -0,0 +1,126 @@
+// Package dynconfig applies a scenario's Temporal dynamic config overrides
+// for the duration of a benchmark run. The servers read dynamic config only
+// from a file in their image, which no Temporal API can change, so each
+// Temporal service is redeployed with the overrides in OverridesEnv, which
+// the image's render-and-start.sh merges into the file, and redeployed
+// without them once the run is over.
+package dynconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// OverridesEnv is the server container environment variable holding the
+// overrides as dynamic config YAML.
+const OverridesEnv = "TEMPORAL_DYNAMIC_CONFIG_OVERRIDES"
+
+// Deployer rolls out Temporal services.
+type Deployer interface {
+	// Override deploys the service with overrides in OverridesEnv, waits
+	// until the new tasks replaced the old ones and returns the deployment
+	// it replaced, for Restore
+	Override(ctx context.Context, service, overrides string) (previous string, err error)
+
+	// Restore deploys the service's previous deployment again and waits
+	// until its tasks replaced the overridden ones
+	Restore(ctx context.Context, service, previous string) error
+}
+
+// Overrides are the dynamic config values a run applies to Services. Apply
+// them before the run and Revert them after it.
+type Overrides struct {
+	Values   map[string]json.RawMessage
+	Services []string
+	Deployer Deployer
+
+	previous map[string]string // Deployments replaced by Apply, by service
+}
+
+// Render returns values as a dynamic config file fragment: one line per key,
+// in key order, with its list of constrained values as JSON, which YAML
+// reads as a flow sequence.
+func Render(values map[string]json.RawMessage) (string, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		var value bytes.Buffer
+		if err := json.Compact(&value, values[key]); err != nil {
+			return "", fmt.Errorf("invalid value for %s: %w", key, err)
+		}
+		fmt.Fprintf(&b, "%s: %s\n", key, value.String())
+	}
+	return b.String(), nil
+}
+
+// Apply redeploys each service with the overrides, one after another. If a
+// service fails to deploy, the services already (or partly) overridden are
+// restored and the error is returned.
+func (o *Overrides) Apply(ctx context.Context) error {
+	overrides, err := Render(o.Values)
+	if err != nil {
+		return err
+	}
+	o.previous = make(map[string]string)
+	for _, service := range o.Services {
+		slog.Info("Applying dynamic config overrides", "service", service, "keys", len(o.Values))
+		previous, err := o.Deployer.Override(ctx, service, overrides)
+		if previous != "" {
+			// Restore a service whose deployment started even if it failed
+			o.previous[service] = previous
+		}
+		if err != nil {
+			err = fmt.Errorf("failed to apply dynamic config overrides to %s: %w", service, err)
+			if r := o.Revert(ctx); !r.Reverted {
+				err = errors.Join(err, errors.New(r.Error))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// RevertTimeout is the longest Revert takes: one rollout per service.
+func (o *Overrides) RevertTimeout() time.Duration {
+	return time.Duration(len(o.Services)) * DeployTimeout
+}
+
+// Revert redeploys each overridden service as it was before Apply and
+// returns the record of the overrides for the results.
+func (o *Overrides) Revert(ctx context.Context) *results.DynamicConfig {
+	var errs []error
+	for _, service := range o.Services {
+		previous, ok := o.previous[service]
+		if !ok {
+			continue
+		}
+		slog.Info("Reverting dynamic config overrides", "service", service)
+		if err := o.Deployer.Restore(ctx, service, previous); err != nil {
+			slog.Error("Failed to revert dynamic config overrides; redeploy the service's previous task definition by hand",
+				"service", service, "task_definition", previous, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", service, err))
+			continue
+		}
+		delete(o.previous, service)
+	}
+
+	d := &results.DynamicConfig{Overrides: o.Values, Services: o.Services, Reverted: len(errs) == 0}
+	if err := errors.Join(errs...); err != nil {
+		d.Error = err.Error()
+	}
+	return d
+}
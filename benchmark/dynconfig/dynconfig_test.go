@@ -0,0 +1,87 @@
+package dynconfig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDeployer records deployments; services in fail fail to deploy after
+// their deployment started.
+type fakeDeployer struct {
+	deployed map[string]string // Current deployment by service
+	fail     map[string]bool
+	calls    []string
+}
+
+func (d *fakeDeployer) Override(_ context.Context, service, overrides string) (string, error) {
+	d.calls = append(d.calls, "override "+service)
+	previous := d.deployed[service]
+	d.deployed[service] = overrides
+	if d.fail[service] {
+		return previous, errors.New("deployment failed")
+	}
+	return previous, nil
+}
+
+func (d *fakeDeployer) Restore(_ context.Context, service, previous string) error {
+	d.calls = append(d.calls, "restore "+service)
+	d.deployed[service] = previous
+	return nil
+}
+
+func newOverrides(d *fakeDeployer) *Overrides {
+	return &Overrides{
+		Values: map[string]json.RawMessage{
+			"matching.rps":              json.RawMessage(`[{"value": 5000}]`),
+			"history.persistenceMaxQPS": json.RawMessage(`[ {"value": 20000, "constraints": {}} ]`),
+		},
+		Services: []string{"frontend", "history", "matching"},
+		Deployer: d,
+	}
+}
+
+func TestRender(t *testing.T) {
+	rendered, err := Render(newOverrides(nil).Values)
+	require.NoError(t, err)
+	require.Equal(t, "history.persistenceMaxQPS: [{\"value\":20000,\"constraints\":{}}]\n"+
+		"matching.rps: [{\"value\":5000}]\n", rendered)
+
+	_, err = Render(map[string]json.RawMessage{"matching.rps": json.RawMessage(`[{`)})
+	require.Error(t, err)
+}
+
+func TestOverrides_ApplyRevert(t *testing.T) {
+	d := &fakeDeployer{deployed: map[string]string{"frontend": "fe:1", "history": "hi:1", "matching": "ma:1"}}
+	o := newOverrides(d)
+	require.NoError(t, o.Apply(context.Background()))
+	require.Contains(t, d.deployed["history"], "matching.rps")
+
+	r := o.Revert(context.Background())
+	require.True(t, r.Reverted)
+	require.Empty(t, r.Error)
+	require.Equal(t, []string{"frontend", "history", "matching"}, r.Services)
+	require.Len(t, r.Overrides, 2)
+	require.Equal(t, map[string]string{"frontend": "fe:1", "history": "hi:1", "matching": "ma:1"}, d.deployed)
+}
+
+func TestOverrides_ApplyFailureRestores(t *testing.T) {
+	d := &fakeDeployer{
+		deployed: map[string]string{"frontend": "fe:1", "history": "hi:1", "matching": "ma:1"},
+		fail:     map[string]bool{"history": true},
+	}
+	err := newOverrides(d).Apply(context.Background())
+	require.ErrorContains(t, err, "failed to apply dynamic config overrides to history")
+	require.Equal(t, []string{"override frontend", "override history", "restore frontend", "restore history"}, d.calls,
+		"matching is left alone; the partly deployed history service is restored")
+	require.Equal(t, map[string]string{"frontend": "fe:1", "history": "hi:1", "matching": "ma:1"}, d.deployed)
+}
+
+func TestOverrides_RevertTimeout(t *testing.T) {
+	// One rollout of each of the three services
+	require.Equal(t, 45*time.Minute, newOverrides(nil).RevertTimeout())
+}
@@ -0,0 +1,162 @@
+package dynconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+// DeployTimeout bounds how long a service may take to replace its tasks.
+const DeployTimeout = 15 * time.Minute
+
+// serverEnv marks the Temporal server container of a task definition.
+const serverEnv = "SERVICES"
+
+// ecsDeployer redeploys ECS services with a revision of their task
+// definition.
+type ecsDeployer struct {
+	client  *ecs.Client
+	cluster string
+}
+
+// NewECSDeployer creates a Deployer for the ECS services of cluster using the
+// default AWS credential chain (the task role when running on ECS).
+func NewECSDeployer(ctx context.Context, cluster string) (Deployer, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	return &ecsDeployer{
+		client:  ecs.NewFromConfig(cfg),
+		cluster: cluster,
+	}, nil
+}
+
+// Override registers a revision of the service's task definition with
+// overrides in the server container's environment and deploys it. It
+// returns the task definition it replaced.
+func (d *ecsDeployer) Override(ctx context.Context, service, overrides string) (string, error) {
+	previous, err := d.taskDefinition(ctx, service)
+	if err != nil {
+		return "", err
+	}
+	out, err := d.client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(previous),
+		Include:        []types.TaskDefinitionField{types.TaskDefinitionFieldTags},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to describe task definition %s: %w", previous, err)
+	}
+	td := out.TaskDefinition
+
+	containers := td.ContainerDefinitions
+	server := -1
+	for i, c := range containers {
+		for _, env := range c.Environment {
+			if aws.ToString(env.Name) == serverEnv {
+				server = i
+			}
+		}
+	}
+	if server < 0 {
+		return "", fmt.Errorf("task definition %s has no container with %s set", previous, serverEnv)
+	}
+	containers[server].Environment = setEnv(containers[server].Environment, OverridesEnv, overrides)
+
+	registered, err := d.client.RegisterTaskDefinition(ctx, &ecs.RegisterTaskDefinitionInput{
+		Family:                  td.Family,
+		ContainerDefinitions:    containers,
+		Cpu:                     td.Cpu,
+		Memory:                  td.Memory,
+		NetworkMode:             td.NetworkMode,
+		ExecutionRoleArn:        td.ExecutionRoleArn,
+		TaskRoleArn:             td.TaskRoleArn,
+		Volumes:                 td.Volumes,
+		PlacementConstraints:    td.PlacementConstraints,
+		RequiresCompatibilities: td.RequiresCompatibilities,
+		RuntimePlatform:         td.RuntimePlatform,
+		EphemeralStorage:        td.EphemeralStorage,
+		InferenceAccelerators:   td.InferenceAccelerators,
+		IpcMode:                 td.IpcMode,
+		PidMode:                 td.PidMode,
+		ProxyConfiguration:      td.ProxyConfiguration,
+		EnableFaultInjection:    td.EnableFaultInjection,
+		Tags:                    out.Tags,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to register task definition for %s: %w", service, err)
+	}
+	if err := d.deploy(ctx, service, aws.ToString(registered.TaskDefinition.TaskDefinitionArn)); err != nil {
+		return previous, err
+	}
+	return previous, nil
+}
+
+// Restore deploys the previous task definition and deregisters the
+// overridden revision.
+func (d *ecsDeployer) Restore(ctx context.Context, service, previous string) error {
+	overridden, err := d.taskDefinition(ctx, service)
+	if err != nil {
+		return err
+	}
+	if err := d.deploy(ctx, service, previous); err != nil {
+		return err
+	}
+	if overridden != previous {
+		if _, err := d.client.DeregisterTaskDefinition(ctx, &ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: aws.String(overridden),
+		}); err != nil {
+			return fmt.Errorf("failed to deregister task definition %s: %w", overridden, err)
+		}
+	}
+	return nil
+}
+
+// taskDefinition returns the task definition the service runs.
+func (d *ecsDeployer) taskDefinition(ctx context.Context, service string) (string, error) {
+	out, err := d.client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(d.cluster),
+		Services: []string{service},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to describe service %s: %w", service, err)
+	}
+	if len(out.Services) == 0 {
+		return "", fmt.Errorf("service %s not found in cluster %s", service, d.cluster)
+	}
+	return aws.ToString(out.Services[0].TaskDefinition), nil
+}
+
+// deploy updates the service to taskDefinition and waits until it is stable.
+func (d *ecsDeployer) deploy(ctx context.Context, service, taskDefinition string) error {
+	if _, err := d.client.UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:        aws.String(d.cluster),
+		Service:        aws.String(service),
+		TaskDefinition: aws.String(taskDefinition),
+	}); err != nil {
+		return fmt.Errorf("failed to update service %s: %w", service, err)
+	}
+	if err := ecs.NewServicesStableWaiter(d.client).Wait(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(d.cluster),
+		Services: []string{service},
+	}, DeployTimeout); err != nil {
+		return fmt.Errorf("service %s did not stabilize on %s: %w", service, taskDefinition, err)
+	}
+	return nil
+}
+
+// setEnv sets name to value in env, replacing an existing entry.
+func setEnv(env []types.KeyValuePair, name, value string) []types.KeyValuePair {
+	for i := range env {
+		if aws.ToString(env[i].Name) == name {
+			env[i].Value = aws.String(value)
+			return env
+		}
+	}
+	return append(env, types.KeyValuePair{Name: aws.String(name), Value: aws.String(value)})
+}
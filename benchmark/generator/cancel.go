@@ -0,0 +1,77 @@
+// Package generator provides workflow generation with rate limiting.
+package generator
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+)
+
+// ErrCanceled is wrapped in the error passed to completion callbacks when a
+// workflow the generator cancelled (see config.CancelRate) closed as
+// cancelled, so callers can tell cancellations from failures with errors.Is.
+var ErrCanceled = errors.New("workflow canceled")
+
+// CancelCallback is called when a workflow the generator requested to cancel
+// closes. closeLatency is the time from the cancel request to the close, and
+// canceled is false if the workflow completed or failed instead.
+type CancelCallback func(workflowID, runID string, closeLatency time.Duration, canceled bool)
+
+// WithCancelCallback sets a callback for cancelled workflows.
+func WithCancelCallback(cb CancelCallback) GeneratorOption {
+	return func(g *generator) {
+		g.onCancel = cb
+	}
+}
+
+// pendingCancel is a cancel request scheduled for a started workflow.
+type pendingCancel struct {
+	stopCh      chan struct{}
+	doneCh      chan struct{}
+	requestedAt time.Time // Zero unless the request was made
+}
+
+// scheduleCancel requests cancellation of the workflow cfg.CancelAfter after
+// now, unless stopped first.
+func (g *generator) scheduleCancel(ctx context.Context, workflowID, runID string) *pendingCancel {
+	p := &pendingCancel{stopCh: make(chan struct{}), doneCh: make(chan struct{})}
+	go func() {
+		defer close(p.doneCh)
+		timer := time.NewTimer(g.cfg.CancelAfter)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.stopCh:
+			return
+		case <-timer.C:
+		}
+
+		requestedAt := g.clock.Now()
+		if err := g.client.CancelWorkflow(ctx, workflowID, runID); err != nil {
+			// The workflow may have closed since the timer fired
+			var notFound *serviceerror.NotFound
+			if !errors.As(err, &notFound) && ctx.Err() == nil {
+				slog.Warn("Failed to cancel workflow", "workflow_id", workflowID, "error", err)
+			}
+			return
+		}
+		g.stats.cancelRequested.Add(1)
+		p.requestedAt = requestedAt
+	}()
+	return p
+}
+
+// stop abandons the request if it has not been made yet, and returns when
+// it was made, if it was. A nil pendingCancel was never scheduled.
+func (p *pendingCancel) stop() (time.Time, bool) {
+	if p == nil {
+		return time.Time{}, false
+	}
+	close(p.stopCh)
+	<-p.doneCh
+	return p.requestedAt, !p.requestedAt.IsZero()
+}
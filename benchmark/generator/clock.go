@@ -0,0 +1,161 @@
+package generator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is the generator's source of time. The generator and ramp controller
+// read time only through it, so load schedules can run in virtual time with a
+// FakeClock.
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker delivers ticks like time.Ticker.
+type Ticker interface {
+	C() <-chan time.Time
+	Reset(d time.Duration)
+	Stop()
+}
+
+// RealClock returns the wall clock.
+func RealClock() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) NewTicker(d time.Duration) Ticker { return realTicker{time.NewTicker(d)} }
+
+type realTicker struct{ *time.Ticker }
+
+func (t realTicker) C() <-chan time.Time { return t.Ticker.C }
+
+// SleepUntil waits on the wall clock until t, returning false if ctx is done
+// first. Schedules of run-time experiments (worker scaling, shard churn) wait
+// with it between steps.
+func SleepUntil(ctx context.Context, t time.Time) bool {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// FakeClock is a Clock whose time only moves with Advance. Unlike a real
+// ticker, a fake ticker never drops ticks: Advance delivers every tick due,
+// in order, and waits for each to be received, so a generator driven by it
+// submits exactly the workflows its schedule calls for.
+type FakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	tickers []*fakeTicker
+}
+
+// NewFakeClock returns a FakeClock reading start.
+func NewFakeClock(start time.Time) *FakeClock {
+	c := &FakeClock{now: start}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the clock's current virtual time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTicker returns a ticker firing every d of virtual time.
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTicker{clock: c, ch: make(chan time.Time), stopped: make(chan struct{}), period: d, next: c.now.Add(d)}
+	c.tickers = append(c.tickers, t)
+	c.cond.Broadcast()
+	return t
+}
+
+// WaitForTickers blocks until n tickers are running, e.g. until a started
+// generator is ready for Advance.
+func (c *FakeClock) WaitForTickers(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.tickers) < n {
+		c.cond.Wait()
+	}
+}
+
+// Advance moves the clock forward by d, delivering every tick due on the way.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	end := c.now.Add(d)
+	for {
+		t := c.nextDue(end)
+		if t == nil {
+			break
+		}
+		c.now = t.next
+		t.next = t.next.Add(t.period)
+		tick := c.now
+
+		// Deliver without the lock, so the receiver can Reset or Stop
+		c.mu.Unlock()
+		select {
+		case t.ch <- tick:
+		case <-t.stopped:
+		}
+		c.mu.Lock()
+	}
+	c.now = end
+	c.mu.Unlock()
+}
+
+// nextDue returns the running ticker with the earliest tick at or before end.
+func (c *FakeClock) nextDue(end time.Time) *fakeTicker {
+	var due *fakeTicker
+	for _, t := range c.tickers {
+		if !t.next.After(end) && (due == nil || t.next.Before(due.next)) {
+			due = t
+		}
+	}
+	return due
+}
+
+type fakeTicker struct {
+	clock   *FakeClock
+	ch      chan time.Time
+	stopped chan struct{}
+	period  time.Duration
+	next    time.Time
+}
+
+func (t *fakeTicker) C() <-chan time.Time { return t.ch }
+
+func (t *fakeTicker) Reset(d time.Duration) {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	t.period = d
+	t.next = t.clock.now.Add(d)
+}
+
+func (t *fakeTicker) Stop() {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	for i, other := range t.clock.tickers {
+		if other == t {
+			t.clock.tickers = append(t.clock.tickers[:i], t.clock.tickers[i+1:]...)
+			close(t.stopped)
+			break
+		}
+	}
+}
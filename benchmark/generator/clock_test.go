@@ -0,0 +1,226 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// fakeClient starts every workflow successfully and completes it immediately.
+type fakeClient struct {
+	client.Client
+}
+
+func (fakeClient) ExecuteWorkflow(context.Context, client.StartWorkflowOptions, interface{}, ...interface{}) (client.WorkflowRun, error) {
+	return fakeRun{}, nil
+}
+
+type fakeRun struct {
+	client.WorkflowRun
+}
+
+func (fakeRun) GetRunID() string                       { return "run" }
+func (fakeRun) Get(context.Context, interface{}) error { return nil }
+
+// startVirtual starts a generator driven by a FakeClock and waits until it is
+// ready for Advance.
+func startVirtual(t *testing.T, ctx context.Context, rate float64, duration, rampUp time.Duration) (WorkflowGenerator, *FakeClock) {
+	t.Helper()
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = "simple"
+	cfg.TargetRate = rate
+	cfg.Duration = duration
+	cfg.RampUpDuration = rampUp
+
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	g := NewGenerator(fakeClient{}, cfg, "benchmark", WithClock(clock))
+	require.NoError(t, g.Start(ctx))
+	t.Cleanup(func() { _ = g.Stop() })
+	clock.WaitForTickers(1)
+	return g, clock
+}
+
+// waitSubmitted waits for the generator to finish handling the last tick
+// delivered, which happens asynchronously after Advance returns.
+func waitSubmitted(t *testing.T, g WorkflowGenerator, want int64) {
+	t.Helper()
+	require.Eventually(t, func() bool { return g.Stats().WorkflowsSubmitted == want },
+		5*time.Second, time.Millisecond)
+}
+
+func TestSleepUntil(t *testing.T) {
+	require.True(t, SleepUntil(context.Background(), time.Now().Add(-time.Second)), "already due")
+	require.True(t, SleepUntil(context.Background(), time.Now().Add(10*time.Millisecond)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.False(t, SleepUntil(ctx, time.Now().Add(time.Hour)), "cancelled")
+}
+
+func TestFakeClock_Ticker(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	ticker := clock.NewTicker(time.Second)
+
+	var ticks []time.Time
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 3 {
+			ticks = append(ticks, <-ticker.C())
+		}
+		ticker.Stop()
+	}()
+
+	clock.Advance(5 * time.Second)
+	<-done
+	require.Equal(t, []time.Time{time.Unix(1, 0), time.Unix(2, 0), time.Unix(3, 0)}, ticks)
+	require.Equal(t, time.Unix(5, 0), clock.Now())
+}
+
+func TestGenerator_VirtualTimePacing(t *testing.T) {
+	g, clock := startVirtual(t, context.Background(), 10, time.Minute, 0)
+
+	clock.Advance(10 * time.Second)
+	waitSubmitted(t, g, 100)
+
+	// Past the configured duration the generator stops on its own
+	clock.Advance(2 * time.Minute)
+	require.NoError(t, g.Stop())
+	require.EqualValues(t, 600, g.Stats().WorkflowsSubmitted)
+	require.NoError(t, g.Wait(context.Background()))
+	require.EqualValues(t, 600, g.Stats().WorkflowsCompleted)
+}
+
+func TestGenerator_VirtualTimeRampUp(t *testing.T) {
+	g, clock := startVirtual(t, context.Background(), 100, time.Hour, 10*time.Second)
+
+	// Ramping linearly from 10 to 100 WPS submits about (10+100)/2 * 10s
+	clock.Advance(10 * time.Second)
+	require.NoError(t, g.Stop())
+	require.InDelta(t, 550, g.Stats().WorkflowsSubmitted, 30)
+	require.InDelta(t, 100, g.Stats().CurrentRate, 2)
+}
+
+func TestGenerator_VirtualTimeAbort(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g, clock := startVirtual(t, ctx, 10, time.Hour, 0)
+
+	clock.Advance(time.Second)
+	waitSubmitted(t, g, 10)
+
+	// Paused ticks submit nothing
+	g.Pause()
+	clock.Advance(time.Second)
+	require.EqualValues(t, 10, g.Stats().WorkflowsSubmitted)
+
+	// Nothing is submitted once the context is cancelled
+	cancel()
+	require.NoError(t, g.Stop())
+	g.Resume()
+	clock.Advance(time.Minute)
+	require.EqualValues(t, 10, g.Stats().WorkflowsSubmitted)
+}
+
+// memoClient records the memo of each started workflow, and its header
+// padding if headers is set.
+type memoClient struct {
+	fakeClient
+	memos   chan map[string]interface{}
+	headers chan *commonpb.Payload
+}
+
+func (c memoClient) ExecuteWorkflow(ctx context.Context, opts client.StartWorkflowOptions, wf interface{}, args ...interface{}) (client.WorkflowRun, error) {
+	c.memos <- opts.Memo
+	if c.headers != nil {
+		c.headers <- headerPadding(ctx)
+	}
+	return c.fakeClient.ExecuteWorkflow(ctx, opts, wf, args...)
+}
+
+func TestGenerator_Memo(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = "simple"
+	cfg.TargetRate = 10
+	cfg.Duration = time.Minute
+	cfg.RampUpDuration = 0
+	cfg.Memo = map[string]string{"team": "persistence", "cost-center": "1234"}
+
+	c := memoClient{memos: make(chan map[string]interface{}, 100)}
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	g := NewGenerator(c, cfg, "benchmark", WithClock(clock))
+	require.NoError(t, g.Start(context.Background()))
+	t.Cleanup(func() { _ = g.Stop() })
+	clock.WaitForTickers(1)
+	clock.Advance(time.Second)
+
+	require.Equal(t, map[string]interface{}{"team": "persistence", "cost-center": "1234"}, <-c.memos)
+}
+
+func TestGenerator_StartPadding(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = "simple"
+	cfg.TargetRate = 10
+	cfg.Duration = time.Minute
+	cfg.RampUpDuration = 0
+	cfg.Memo = map[string]string{"team": "persistence"}
+	cfg.MemoSizeBytes = 1024
+	cfg.HeaderSizeBytes = 512
+
+	c := memoClient{memos: make(chan map[string]interface{}, 100), headers: make(chan *commonpb.Payload, 100)}
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	g := NewGenerator(c, cfg, "benchmark", WithClock(clock))
+	require.NoError(t, g.Start(context.Background()))
+	t.Cleanup(func() { _ = g.Stop() })
+	clock.WaitForTickers(1)
+	clock.Advance(time.Second)
+
+	memo := <-c.memos
+	require.Equal(t, "persistence", memo["team"])
+	require.Len(t, memo[PaddingKey], 1024)
+
+	var header string
+	require.NoError(t, converter.GetDefaultDataConverter().FromPayload(<-c.headers, &header))
+	require.Len(t, header, 512)
+	require.NotEqual(t, strings.Repeat(header[:1], 512), header, "padding is not a repeated byte")
+}
+
+// describeFailingClient fails every DescribeWorkflowExecution.
+type describeFailingClient struct {
+	fakeClient
+}
+
+func (describeFailingClient) DescribeWorkflowExecution(context.Context, string, string) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	return nil, errors.New("unavailable")
+}
+
+func TestGenerator_LatencyFallbacks(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = "simple"
+	cfg.TargetRate = 10
+	cfg.Duration = time.Minute
+	cfg.RampUpDuration = 0
+	cfg.LatencySemantics = config.LatencyServerStartToComplete
+
+	clock := NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	g := NewGenerator(describeFailingClient{}, cfg, "benchmark", WithClock(clock))
+	require.NoError(t, g.Start(context.Background()))
+	t.Cleanup(func() { _ = g.Stop() })
+	clock.WaitForTickers(1)
+	clock.Advance(time.Second)
+
+	// Every completion keeps its client latency and is counted
+	require.Eventually(t, func() bool {
+		stats := g.Stats()
+		return stats.WorkflowsCompleted == 10 && stats.LatencyFallbacks == 10
+	}, 5*time.Second, time.Millisecond)
+}
@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func TestWorkflowIDPrefix(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = "simple"
+
+	g := &generator{cfg: cfg}
+	require.Equal(t, "simple-1700000000", g.workflowIDPrefix("1700000000"))
+
+	host, err := os.Hostname()
+	require.NoError(t, err)
+	g.cfg.WorkflowIDTemplate = "bench-{scenario}-{phase}-{index}-{host}-{run}"
+	g.idFields = IDFields{Scenario: "soak", Phase: "peak", Index: 2}
+	require.Equal(t, "bench-soak-peak-2-"+host+"-42", g.workflowIDPrefix("42"))
+}
+
+func TestContentionAggregatorID(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeContention
+	cfg.TargetRate = 10
+	cfg.Duration = time.Minute
+	cfg.FanIn = 150
+	require.Equal(t, 4, cfg.ContentionAggregators())
+
+	seen := map[string]bool{}
+	for i := range 200 {
+		id := contentionAggregatorID(cfg, fmt.Sprintf("contention-42-%d", i))
+		require.Equal(t, id, contentionAggregatorID(cfg, fmt.Sprintf("contention-42-%d", i)), "stable per workflow")
+		seen[id] = true
+	}
+	require.Len(t, seen, 4)
+
+	cfg.FanIn = 100000
+	require.Equal(t, "contention-aggregator-0", contentionAggregatorID(cfg, "contention-42-1"))
+}
+
+func TestInSample(t *testing.T) {
+	sampled := 0
+	for n := int64(1); n <= 1000; n++ {
+		if inSample(n, 0.05) {
+			sampled++
+		}
+	}
+	require.Equal(t, 50, sampled)
+
+	require.False(t, inSample(1, 0))
+	require.True(t, inSample(1, 1))
+}
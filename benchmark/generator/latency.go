@@ -0,0 +1,51 @@
+// Package generator provides workflow generation with rate limiting.
+package generator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// serverLatency measures a completed workflow's latency from server
+// timestamps according to semantics, which must not be submit-to-complete.
+// Both timestamps come from the server, so client clock skew does not apply.
+func serverLatency(ctx context.Context, c client.Client, workflowID, runID, semantics string) (time.Duration, error) {
+	switch semantics {
+	case config.LatencyServerStartToComplete:
+		resp, err := c.DescribeWorkflowExecution(ctx, workflowID, runID)
+		if err != nil {
+			return 0, err
+		}
+		info := resp.GetWorkflowExecutionInfo()
+		if info.GetCloseTime() == nil {
+			return 0, fmt.Errorf("workflow %s has not closed", workflowID)
+		}
+		return info.GetCloseTime().AsTime().Sub(info.GetStartTime().AsTime()), nil
+
+	case config.LatencyScheduleToFirstWFT:
+		var started time.Time
+		history := c.GetWorkflowHistory(ctx, workflowID, runID, false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+		for history.HasNext() {
+			event, err := history.Next()
+			if err != nil {
+				return 0, err
+			}
+			switch event.GetEventType() {
+			case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+				started = event.GetEventTime().AsTime()
+			case enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED:
+				return event.GetEventTime().AsTime().Sub(started), nil
+			}
+		}
+		return 0, fmt.Errorf("workflow %s has no started workflow task", workflowID)
+
+	default:
+		return 0, fmt.Errorf("unsupported latency semantics %q", semantics)
+	}
+}
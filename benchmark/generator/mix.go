@@ -0,0 +1,55 @@
+// Package generator provides workflow generation with rate limiting.
+package generator
+
+import (
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// workflowMix picks the workflow type of each start of a mixed run by
+// smooth weighted round-robin: every type gets exactly its share of each
+// cycle of total-weight starts, interleaved rather than in bursts, so short
+// runs and every stretch of a long run see the configured ratio. It is not
+// safe for concurrent use.
+type workflowMix struct {
+	types   []string
+	weights []int
+	current []int
+	total   int
+
+	// Configuration of each type's starts
+	configs map[string]config.BenchmarkConfig
+}
+
+// newWorkflowMix creates a mix of cfg.WorkflowMix, or returns nil if cfg
+// is not of the mixed type.
+func newWorkflowMix(cfg config.BenchmarkConfig) *workflowMix {
+	if cfg.WorkflowType != config.WorkflowTypeMixed {
+		return nil
+	}
+	m := &workflowMix{configs: make(map[string]config.BenchmarkConfig, len(cfg.WorkflowMix))}
+	for _, workflowType := range cfg.WorkflowTypes() {
+		weight := cfg.WorkflowMix[workflowType]
+		if weight <= 0 {
+			continue
+		}
+		m.types = append(m.types, workflowType)
+		m.weights = append(m.weights, weight)
+		m.total += weight
+		m.configs[workflowType] = cfg.ForWorkflowType(workflowType)
+	}
+	m.current = make([]int, len(m.types))
+	return m
+}
+
+// next returns the workflow type of the next start.
+func (m *workflowMix) next() string {
+	best := 0
+	for i, weight := range m.weights {
+		m.current[i] += weight
+		if m.current[i] > m.current[best] {
+			best = i
+		}
+	}
+	m.current[best] -= m.total
+	return m.types[best]
+}
@@ -0,0 +1,36 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func TestWorkflowMix(t *testing.T) {
+	cfg := config.DefaultConfig()
+	require.Nil(t, newWorkflowMix(cfg), "not mixed")
+
+	cfg.WorkflowType = config.WorkflowTypeMixed
+	cfg.WorkflowMix = map[string]int{config.WorkflowTypeSimple: 5, config.WorkflowTypeTimer: 3, config.WorkflowTypeMultiActivity: 2}
+	mix := newWorkflowMix(cfg)
+	require.NotNil(t, mix)
+
+	counts := map[string]int{}
+	longestRun, run := 0, 0
+	var last string
+	for range 100 {
+		workflowType := mix.next()
+		counts[workflowType]++
+		require.Equal(t, workflowType, mix.configs[workflowType].WorkflowType)
+		if workflowType == last {
+			run++
+		} else {
+			run = 1
+		}
+		longestRun, last = max(longestRun, run), workflowType
+	}
+	require.Equal(t, map[string]int{config.WorkflowTypeSimple: 50, config.WorkflowTypeTimer: 30, config.WorkflowTypeMultiActivity: 20}, counts)
+	require.LessOrEqual(t, longestRun, 2, "types are interleaved rather than started in bursts")
+}
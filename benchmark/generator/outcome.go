@@ -0,0 +1,75 @@
+// Package generator provides workflow generation with rate limiting.
+package generator
+
+import (
+	"log/slog"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+// maxMismatchedWorkflowIDs caps the workflow IDs of mismatched outcomes kept
+// for the results.
+const maxMismatchedWorkflowIDs = 10
+
+// inSample reports whether the n-th submitted workflow is in a sample of
+// rate of the workflows, such as those whose outcome is verified or those
+// that are cancelled. Every workflow where n*rate crosses an integer is
+// sampled, so the sample is spread evenly over the run and exactly rate of
+// the workflows.
+func inSample(n int64, rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	return int64(float64(n)*rate) > int64(float64(n-1)*rate)
+}
+
+// expectedSteps returns the steps a correct workflow of cfg.WorkflowType
+// completes, following the workflow definitions.
+func expectedSteps(cfg config.BenchmarkConfig) int {
+	switch cfg.WorkflowType {
+	case config.WorkflowTypeMultiActivity:
+		return 10 // 4 concurrent and 6 sequential activities
+	case config.WorkflowTypeStateTransitions:
+		return 10
+	case config.WorkflowTypeTimer, config.WorkflowTypeContention, config.WorkflowTypeHeartbeat:
+		return 1
+	case config.WorkflowTypeChildWorkflow:
+		return cfg.ChildCount
+	case config.WorkflowTypeFlaky, config.WorkflowTypeSideEffects:
+		return cfg.ActivityCount
+	case config.WorkflowTypeSearchAttributes:
+		return cfg.SearchAttributeUpserts
+	case config.WorkflowTypeLongHistory:
+		steps, _ := config.LongHistorySteps(cfg.LongHistoryEvents)
+		return steps
+	case config.WorkflowTypeFanout:
+		return cfg.FanoutDepth * config.FanoutAwait(cfg.FanoutWidth, cfg.FanoutJoin)
+	default:
+		return 0
+	}
+}
+
+// verifyOutcome compares the outcome of a completed workflow with the
+// outcome a correct run of cfg produces, counting and logging a mismatch.
+func (g *generator) verifyOutcome(cfg config.BenchmarkConfig, workflowID, runID string, outcome workflows.Outcome) {
+	g.stats.outcomesChecked.Add(1)
+	expected := workflows.ExpectedOutcome(runID, expectedSteps(cfg))
+	if outcome.Matches(expected) {
+		return
+	}
+
+	g.stats.outcomeMismatches.Add(1)
+	g.stats.mismatchMu.Lock()
+	if len(g.stats.mismatchedIDs) < maxMismatchedWorkflowIDs {
+		g.stats.mismatchedIDs = append(g.stats.mismatchedIDs, workflowID)
+	}
+	g.stats.mismatchMu.Unlock()
+	slog.Error("Workflow outcome mismatch",
+		"workflow_id", workflowID,
+		"run_id", runID,
+		"steps", outcome.Steps,
+		"expected_steps", expected.Steps,
+		"checksum", outcome.Checksum,
+		"expected_checksum", expected.Checksum)
+}
@@ -0,0 +1,81 @@
+// Package generator provides workflow generation with rate limiting.
+package generator
+
+import (
+	"context"
+	"math/rand/v2"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/interceptor"
+)
+
+// PaddingKey names the memo entry and the header that pad generated workflow
+// starts to config.MemoSizeBytes and config.HeaderSizeBytes.
+const PaddingKey = "benchmarkPadding"
+
+// paddingAlphabet keeps padding printable in the UI and CLI.
+const paddingAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// padding returns n pseudo-random printable bytes. They are random rather than
+// repeated so the padding costs as much to store as real data would.
+func padding(n int) string {
+	r := rand.New(rand.NewPCG(uint64(n), 0))
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = paddingAlphabet[r.IntN(len(paddingAlphabet))]
+	}
+	return string(b)
+}
+
+// headerPaddingKey is the context key of the header padding of a start.
+type headerPaddingKey struct{}
+
+// withHeaderPadding returns a copy of ctx whose workflow starts carry payload
+// as their PaddingKey header, if the client has a HeaderInterceptor.
+func withHeaderPadding(ctx context.Context, payload *commonpb.Payload) context.Context {
+	return context.WithValue(ctx, headerPaddingKey{}, payload)
+}
+
+// headerPadding returns the header padding of starts through ctx, if any.
+func headerPadding(ctx context.Context) *commonpb.Payload {
+	payload, _ := ctx.Value(headerPaddingKey{}).(*commonpb.Payload)
+	return payload
+}
+
+// newHeaderPadding encodes n bytes of padding as a header payload, or returns
+// nil if n is 0.
+func newHeaderPadding(n int) (*commonpb.Payload, error) {
+	if n == 0 {
+		return nil, nil
+	}
+	return converter.GetDefaultDataConverter().ToPayload(padding(n))
+}
+
+// HeaderInterceptor sets the PaddingKey header of workflows the generator
+// starts with config.HeaderSizeBytes > 0. The SDK only lets interceptors and
+// context propagators write headers, so clients that start generated
+// workflows must be dialed with it; other starts through them are unchanged.
+type HeaderInterceptor struct {
+	interceptor.ClientInterceptorBase
+}
+
+// InterceptClient implements interceptor.ClientInterceptor.
+func (*HeaderInterceptor) InterceptClient(next interceptor.ClientOutboundInterceptor) interceptor.ClientOutboundInterceptor {
+	return &headerOutbound{ClientOutboundInterceptorBase: interceptor.ClientOutboundInterceptorBase{Next: next}}
+}
+
+type headerOutbound struct {
+	interceptor.ClientOutboundInterceptorBase
+}
+
+// ExecuteWorkflow implements interceptor.ClientOutboundInterceptor.
+func (h *headerOutbound) ExecuteWorkflow(ctx context.Context, in *interceptor.ClientExecuteWorkflowInput) (client.WorkflowRun, error) {
+	if payload := headerPadding(ctx); payload != nil {
+		if header := interceptor.Header(ctx); header != nil {
+			header[PaddingKey] = payload
+		}
+	}
+	return h.Next.ExecuteWorkflow(ctx, in)
+}
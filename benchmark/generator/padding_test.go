@@ -0,0 +1,52 @@
+package generator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/interceptor"
+	"google.golang.org/grpc"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/simulate"
+)
+
+func TestHeaderInterceptor(t *testing.T) {
+	latency, err := simulate.ParseLatency("fixed:0s")
+	require.NoError(t, err)
+	s := simulate.NewServer(simulate.Options{Latency: latency})
+	require.NoError(t, s.Start("127.0.0.1:0"))
+	t.Cleanup(s.Stop)
+
+	// Record the header of each start request
+	headers := make(chan *commonpb.Header, 2)
+	record := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if start, ok := req.(*workflowservice.StartWorkflowExecutionRequest); ok {
+			headers <- start.GetHeader()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	c, err := client.Dial(client.Options{
+		HostPort:          s.Addr(),
+		Namespace:         "benchmark-1",
+		Interceptors:      []interceptor.ClientInterceptor{&HeaderInterceptor{}},
+		ConnectionOptions: client.ConnectionOptions{DialOptions: []grpc.DialOption{grpc.WithUnaryInterceptor(record)}},
+	})
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+
+	payload, err := newHeaderPadding(256)
+	require.NoError(t, err)
+	ctx := withHeaderPadding(context.Background(), payload)
+	_, err = c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{ID: "padded", TaskQueue: "q"}, "SimpleWorkflow")
+	require.NoError(t, err)
+	require.Equal(t, payload.GetData(), (<-headers).GetFields()[PaddingKey].GetData())
+
+	// Starts without padding are unchanged
+	_, err = c.ExecuteWorkflow(context.Background(), client.StartWorkflowOptions{ID: "plain", TaskQueue: "q"}, "SimpleWorkflow")
+	require.NoError(t, err)
+	require.NotContains(t, (<-headers).GetFields(), PaddingKey)
+}
@@ -16,31 +16,47 @@ type RampUpController struct {
 	rampUpDuration time.Duration
 	startTime      time.Time
 	lastRate       float64
+	clock          Clock
+}
+
+// RampUpOption configures a RampUpController.
+type RampUpOption func(*RampUpController)
+
+// WithRampUpClock sets the clock the controller reads the current time from.
+func WithRampUpClock(c Clock) RampUpOption {
+	return func(r *RampUpController) {
+		r.clock = c
+	}
 }
 
 // NewRampUpController creates a new RampUpController.
 // If rampUpDuration is 0, the controller will immediately return the target rate.
-func NewRampUpController(targetRate float64, rampUpDuration time.Duration) *RampUpController {
+func NewRampUpController(targetRate float64, rampUpDuration time.Duration, opts ...RampUpOption) *RampUpController {
 	// Start at 10% of target rate or 1 WPS, whichever is higher
 	initialRate := max(targetRate*0.1, 1.0)
 	if rampUpDuration == 0 {
 		initialRate = targetRate
 	}
 
-	return &RampUpController{
+	r := &RampUpController{
 		targetRate:     targetRate,
 		initialRate:    initialRate,
 		rampUpDuration: rampUpDuration,
-		startTime:      time.Now(),
 		lastRate:       initialRate,
+		clock:          RealClock(),
+	}
+	for _, opt := range opts {
+		opt(r)
 	}
+	r.startTime = r.clock.Now()
+	return r
 }
 
 // CurrentRate returns the current rate based on elapsed time.
 // The rate monotonically increases from initialRate to targetRate during ramp-up.
 // After ramp-up completes, it returns the target rate.
 func (r *RampUpController) CurrentRate() float64 {
-	return r.RateAt(time.Now())
+	return r.RateAt(r.clock.Now())
 }
 
 // RateAt returns the rate at a specific time.
@@ -76,7 +92,7 @@ func (r *RampUpController) RateAt(t time.Time) float64 {
 
 // IsRampUpComplete returns true if the ramp-up period has completed.
 func (r *RampUpController) IsRampUpComplete() bool {
-	return r.IsRampUpCompleteAt(time.Now())
+	return r.IsRampUpCompleteAt(r.clock.Now())
 }
 
 // IsRampUpCompleteAt returns true if the ramp-up period has completed at the given time.
@@ -90,7 +106,7 @@ func (r *RampUpController) IsRampUpCompleteAt(t time.Time) bool {
 // Progress returns the ramp-up progress as a value between 0 and 1.
 // Returns 1.0 if ramp-up is complete.
 func (r *RampUpController) Progress() float64 {
-	return r.ProgressAt(time.Now())
+	return r.ProgressAt(r.clock.Now())
 }
 
 // ProgressAt returns the ramp-up progress at a specific time.
@@ -113,7 +129,7 @@ func (r *RampUpController) ProgressAt(t time.Time) float64 {
 
 // Reset resets the ramp-up controller to start from the current time.
 func (r *RampUpController) Reset() {
-	r.startTime = time.Now()
+	r.startTime = r.clock.Now()
 	r.lastRate = r.initialRate
 }
 
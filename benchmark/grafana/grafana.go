@@ -0,0 +1,120 @@
+// Package grafana captures Grafana dashboard snapshots of benchmark runs, so
+// reviewers can open the server-side graphs of a run's time window from its
+// results with one click.
+//
+// A snapshot stores the dashboard with its time range fixed to the run. It is
+// created through the HTTP API, which does not render panels, so snapshots of
+// dashboards whose data has since expired may show no data; the time-windowed
+// dashboard link is returned alongside for that case.
+package grafana
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// requestTimeout bounds each Grafana API call.
+const requestTimeout = 10 * time.Second
+
+// Client creates snapshots with a Grafana service account token.
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// Snapshot is a captured dashboard snapshot.
+type Snapshot struct {
+	URL          string // Snapshot of the dashboard over the run's time window
+	DashboardURL string // Live dashboard over the same window
+}
+
+// New creates a client for the Grafana at baseURL (e.g.
+// "http://grafana:3000"), authenticating with a service account token.
+func New(baseURL, token string) *Client {
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Snapshot snapshots the dashboard with uid over [from, to] as name. The
+// snapshot expires after expires (0 = never).
+func (c *Client) Snapshot(ctx context.Context, uid, name string, from, to time.Time, expires time.Duration) (*Snapshot, error) {
+	var dashboard struct {
+		Dashboard map[string]any `json:"dashboard"`
+		Meta      struct {
+			URL string `json:"url"`
+		} `json:"meta"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/api/dashboards/uid/"+url.PathEscape(uid), nil, &dashboard); err != nil {
+		return nil, fmt.Errorf("failed to read dashboard %s: %w", uid, err)
+	}
+	if dashboard.Dashboard == nil {
+		return nil, fmt.Errorf("failed to read dashboard %s: empty response", uid)
+	}
+
+	dashboard.Dashboard["time"] = map[string]string{
+		"from": from.UTC().Format(time.RFC3339),
+		"to":   to.UTC().Format(time.RFC3339),
+	}
+	request := map[string]any{
+		"dashboard": dashboard.Dashboard,
+		"name":      name,
+		"expires":   int64(expires.Seconds()),
+	}
+	var created struct {
+		URL string `json:"url"`
+	}
+	if err := c.call(ctx, http.MethodPost, "/api/snapshots", request, &created); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot of dashboard %s: %w", uid, err)
+	}
+
+	return &Snapshot{
+		URL:          created.URL,
+		DashboardURL: fmt.Sprintf("%s%s?from=%d&to=%d", c.baseURL, dashboard.Meta.URL, from.UnixMilli(), to.UnixMilli()),
+	}, nil
+}
+
+// call sends body (if any) as JSON to the API path and decodes the response into out.
+func (c *Client) call(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("grafana returned %s", resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	var created map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/dashboards/uid/temporal-benchmark":
+			w.Write([]byte(`{"dashboard": {"uid": "temporal-benchmark", "time": {"from": "now-30m", "to": "now"}}, "meta": {"url": "/d/temporal-benchmark/benchmark-analysis"}}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/snapshots":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.Write([]byte(`{"key": "abc", "url": "http://grafana/dashboard/snapshot/abc"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	from := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(5 * time.Minute)
+	snapshot, err := New(server.URL+"/", "token").Snapshot(context.Background(), "temporal-benchmark", "Benchmark run", from, to, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, "http://grafana/dashboard/snapshot/abc", snapshot.URL)
+	require.Equal(t, server.URL+"/d/temporal-benchmark/benchmark-analysis?from=1772366400000&to=1772366700000", snapshot.DashboardURL)
+
+	require.Equal(t, "Benchmark run", created["name"])
+	require.Equal(t, 3600.0, created["expires"])
+	dashboard := created["dashboard"].(map[string]any)
+	require.Equal(t, "temporal-benchmark", dashboard["uid"])
+	require.Equal(t, map[string]any{"from": "2026-03-01T12:00:00Z", "to": "2026-03-01T12:05:00Z"}, dashboard["time"])
+}
+
+func TestSnapshot_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "forbidden", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	_, err := New(server.URL, "token").Snapshot(context.Background(), "missing", "run", time.Now(), time.Now(), 0)
+	require.EqualError(t, err, "failed to read dashboard missing: grafana returned 403 Forbidden")
+}
@@ -0,0 +1,280 @@
+// Package admin provides an authenticated HTTP endpoint for operator actions
+// on a running benchmark or worker process.
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/cleanup"
+)
+
+// Cleanup job states reported by the admin endpoint.
+const (
+	CleanupStateRunning   = "running"
+	CleanupStateSucceeded = "succeeded"
+	CleanupStateFailed    = "failed"
+)
+
+// CleanupStatus describes the state of an on-demand cleanup for a namespace.
+type CleanupStatus struct {
+	Namespace              string    `json:"namespace"`
+	State                  string    `json:"state"`
+	StartedAt              time.Time `json:"startedAt"`
+	FinishedAt             time.Time `json:"finishedAt,omitempty"`
+	WorkflowsFound         int       `json:"workflowsFound"`
+	WorkflowsTerminated    int       `json:"workflowsTerminated"`
+	TerminationErrors      int       `json:"terminationErrors"`
+	SchedulesDeleted       int       `json:"schedulesDeleted"`
+	BatchOperationsStopped int       `json:"batchOperationsStopped"`
+	ResourceErrors         int       `json:"resourceErrors"`
+	WorkflowsSkipped       int       `json:"workflowsSkipped"`
+	Partial                bool      `json:"partial"`
+	Error                  string    `json:"error,omitempty"`
+}
+
+// Server serves admin requests. All requests must carry the configured bearer token.
+type Server struct {
+	token          string
+	cleaner        *cleanup.Cleaner
+	allowNamespace func(namespace string) bool
+	config         func() any
+
+	mu     sync.Mutex
+	jobs   map[string]*CleanupStatus
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	server *http.Server
+	port   int
+}
+
+// Option configures the admin server.
+type Option func(*Server)
+
+// WithNamespaceFilter restricts which namespaces may be cleaned up.
+// Requirement 8.3: THE Benchmark_Runner SHALL NOT interfere with workflows in other namespaces
+func WithNamespaceFilter(allow func(namespace string) bool) Option {
+	return func(s *Server) {
+		s.allowNamespace = allow
+	}
+}
+
+// WithConfig serves the value returned by report, e.g. the process's
+// effective worker configuration, from /config.
+func WithConfig(report func() any) Option {
+	return func(s *Server) {
+		s.config = report
+	}
+}
+
+// NewServer creates a new admin server. The token must be non-empty.
+func NewServer(token string, cleaner *cleanup.Cleaner, opts ...Option) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		token:          token,
+		cleaner:        cleaner,
+		allowNamespace: func(string) bool { return true },
+		jobs:           make(map[string]*CleanupStatus),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Handler returns the HTTP handler for admin routes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cleanup", s.handleCleanup)
+	mux.HandleFunc("/config", s.handleConfig)
+	return s.authenticate(mux)
+}
+
+// Start binds the admin port and serves requests in the background.
+func (s *Server) Start(port int) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to bind admin port %d: %w", port, err)
+	}
+
+	s.port = listener.Addr().(*net.TCPAddr).Port
+	s.server = &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	slog.Info("Starting admin server", "requested_port", port, "port", s.port)
+	go func() {
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			slog.Error("Admin server error", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// Port returns the port the admin server is listening on, or 0 if not started.
+func (s *Server) Port() int {
+	return s.port
+}
+
+// Stop shuts down the HTTP server and cancels any in-flight cleanup jobs.
+func (s *Server) Stop(ctx context.Context) error {
+	s.cancel()
+	defer s.wg.Wait()
+
+	if s.server == nil {
+		return nil
+	}
+
+	slog.Info("Stopping admin server")
+	return s.server.Shutdown(ctx)
+}
+
+// authenticate rejects requests that do not present the configured bearer token.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presented, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || s.token == "" || subtle.ConstantTimeCompare([]byte(presented), []byte(s.token)) != 1 {
+			slog.Warn("Rejected unauthenticated admin request", "path", r.URL.Path, "remote_addr", r.RemoteAddr)
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleCleanup starts a cleanup (POST) or reports its status (GET).
+func (s *Server) handleCleanup(w http.ResponseWriter, r *http.Request) {
+	namespace := r.URL.Query().Get("namespace")
+	if namespace == "" {
+		http.Error(w, "namespace query parameter is required", http.StatusBadRequest)
+		return
+	}
+	if !s.allowNamespace(namespace) {
+		http.Error(w, fmt.Sprintf("namespace %q is not a benchmark namespace", namespace), http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		status, started := s.startCleanup(namespace)
+		code := http.StatusAccepted
+		if !started {
+			code = http.StatusConflict
+		}
+		writeJSON(w, code, status)
+	case http.MethodGet:
+		s.mu.Lock()
+		status, ok := s.jobs[namespace]
+		var snapshot CleanupStatus
+		if ok {
+			snapshot = *status
+		}
+		s.mu.Unlock()
+		if !ok {
+			http.Error(w, fmt.Sprintf("no cleanup has been requested for namespace %q", namespace), http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, snapshot)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleConfig reports the process's configuration (GET).
+func (s *Server) handleConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if s.config == nil {
+		http.Error(w, "no configuration is reported by this process", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.config())
+}
+
+// startCleanup launches a background cleanup for the namespace unless one is
+// already running. It returns a snapshot of the job and whether it was started.
+func (s *Server) startCleanup(namespace string) (CleanupStatus, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.jobs[namespace]; ok && existing.State == CleanupStateRunning {
+		return *existing, false
+	}
+
+	status := &CleanupStatus{
+		Namespace: namespace,
+		State:     CleanupStateRunning,
+		StartedAt: time.Now(),
+	}
+	s.jobs[namespace] = status
+
+	slog.Info("Admin cleanup requested", "namespace", namespace)
+
+	// Run detached from the request so long cleanups survive client disconnects
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		result, err := s.cleaner.CleanupNamespace(s.ctx, namespace)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		status.FinishedAt = time.Now()
+		if result != nil {
+			status.WorkflowsFound = result.WorkflowsFound
+			status.WorkflowsTerminated = result.WorkflowsTerminated
+			status.TerminationErrors = len(result.TerminationErrors)
+			status.SchedulesDeleted = result.SchedulesDeleted
+			status.BatchOperationsStopped = result.BatchOperationsStopped
+			status.ResourceErrors = len(result.ResourceErrors)
+			status.WorkflowsSkipped = result.WorkflowsSkipped
+			status.Partial = result.Partial
+		}
+		switch {
+		case err != nil:
+			status.State = CleanupStateFailed
+			status.Error = err.Error()
+		case result != nil && result.Partial:
+			status.State = CleanupStateFailed
+			status.Error = fmt.Sprintf("cleanup stopped before completion, %d workflows skipped", result.WorkflowsSkipped)
+		case result != nil && !result.Success:
+			status.State = CleanupStateFailed
+			status.Error = fmt.Sprintf("%d workflows failed to terminate, %d other resources failed to clean up",
+				len(result.TerminationErrors), len(result.ResourceErrors))
+		default:
+			status.State = CleanupStateSucceeded
+		}
+		slog.Info("Admin cleanup finished", "namespace", namespace, "state", status.State)
+	}()
+
+	return *status, true
+}
+
+// writeJSON writes v as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Warn("Failed to write admin response", "error", err)
+	}
+}
@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer() *Server {
+	return NewServer("secret", nil, WithNamespaceFilter(func(namespace string) bool {
+		return strings.HasPrefix(namespace, "benchmark-")
+	}))
+}
+
+func doRequest(h http.Handler, method, target, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_RejectsMissingToken(t *testing.T) {
+	rec := doRequest(newTestServer().Handler(), http.MethodPost, "/cleanup?namespace=benchmark-1", "")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandler_RejectsWrongToken(t *testing.T) {
+	rec := doRequest(newTestServer().Handler(), http.MethodPost, "/cleanup?namespace=benchmark-1", "wrong")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandler_RejectsEmptyConfiguredToken(t *testing.T) {
+	s := NewServer("", nil)
+	req := httptest.NewRequest(http.MethodPost, "/cleanup?namespace=benchmark-1", nil)
+	req.Header.Set("Authorization", "Bearer ")
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandler_RequiresNamespace(t *testing.T) {
+	rec := doRequest(newTestServer().Handler(), http.MethodPost, "/cleanup", "secret")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandler_RejectsForeignNamespace(t *testing.T) {
+	rec := doRequest(newTestServer().Handler(), http.MethodPost, "/cleanup?namespace=default", "secret")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestHandler_RejectsUnsupportedMethod(t *testing.T) {
+	rec := doRequest(newTestServer().Handler(), http.MethodDelete, "/cleanup?namespace=benchmark-1", "secret")
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestHandler_StatusNotFoundBeforeCleanup(t *testing.T) {
+	rec := doRequest(newTestServer().Handler(), http.MethodGet, "/cleanup?namespace=benchmark-1", "secret")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestStartCleanup_RejectsConcurrentJob(t *testing.T) {
+	s := newTestServer()
+	s.jobs["benchmark-1"] = &CleanupStatus{Namespace: "benchmark-1", State: CleanupStateRunning}
+
+	status, started := s.startCleanup("benchmark-1")
+	require.False(t, started)
+	require.Equal(t, CleanupStateRunning, status.State)
+}
+
+func TestHandler_ConfigNotFoundWithoutReport(t *testing.T) {
+	rec := doRequest(newTestServer().Handler(), http.MethodGet, "/config", "secret")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandler_ServesConfig(t *testing.T) {
+	s := NewServer("secret", nil, WithConfig(func() any {
+		return map[string]string{"role": "work"}
+	}))
+
+	rec := doRequest(s.Handler(), http.MethodGet, "/config", "")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = doRequest(s.Handler(), http.MethodGet, "/config", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"role":"work"}`, rec.Body.String())
+
+	rec = doRequest(s.Handler(), http.MethodPost, "/config", "secret")
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
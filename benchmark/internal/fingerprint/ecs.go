@@ -0,0 +1,59 @@
+package fingerprint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+)
+
+// sqlHostVariable is the server container variable holding the DSQL endpoint.
+const sqlHostVariable = "TEMPORAL_SQL_HOST"
+
+// ecsEndpoints reads the DSQL endpoint from the task definition an ECS
+// service currently runs.
+type ecsEndpoints struct {
+	client  *ecs.Client
+	cluster string
+	service string
+}
+
+// NewECSEndpointSource creates an EndpointSource for an ECS service using the
+// default AWS credential chain (the task role when running on ECS).
+func NewECSEndpointSource(ctx context.Context, cluster, service string) (EndpointSource, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	return &ecsEndpoints{client: ecs.NewFromConfig(cfg), cluster: cluster, service: service}, nil
+}
+
+func (s *ecsEndpoints) DSQLEndpoint(ctx context.Context) (string, error) {
+	out, err := s.client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(s.cluster),
+		Services: []string{s.service},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to describe service %s: %w", s.service, err)
+	}
+	if len(out.Services) == 0 {
+		return "", fmt.Errorf("service %s not found in cluster %s", s.service, s.cluster)
+	}
+
+	def, err := s.client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: out.Services[0].TaskDefinition,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to describe task definition of %s: %w", s.service, err)
+	}
+	for _, container := range def.TaskDefinition.ContainerDefinitions {
+		for _, env := range container.Environment {
+			if aws.ToString(env.Name) == sqlHostVariable {
+				return aws.ToString(env.Value), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("task definition of %s does not set %s", s.service, sqlHostVariable)
+}
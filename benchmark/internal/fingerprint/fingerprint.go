@@ -0,0 +1,126 @@
+// Package fingerprint checks that the live Temporal cluster is the deployment
+// a benchmark expects, so results are not collected against the wrong
+// environment by mistake.
+package fingerprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// callTimeout bounds each lookup of the live environment.
+const callTimeout = 10 * time.Second
+
+// Expectations describe the deployment a benchmark must run against. Empty
+// fields are not checked.
+type Expectations struct {
+	ServerVersion     string `json:"serverVersion,omitempty"`     // Exact version, or a prefix such as "1.27"
+	HistoryShardCount int32  `json:"historyShardCount,omitempty"` // numHistoryShards of the cluster
+	ClusterName       string `json:"clusterName,omitempty"`
+	DSQLEndpoint      string `json:"dsqlEndpoint,omitempty"` // Cluster endpoint the server persists to
+
+	// ECS service whose task definition holds the server's DSQL endpoint;
+	// required to check DSQLEndpoint
+	ECSCluster string `json:"ecsCluster,omitempty"`
+	ECSService string `json:"ecsService,omitempty"`
+}
+
+// Load reads expectations from a JSON file.
+func Load(path string) (Expectations, error) {
+	var e Expectations
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return e, fmt.Errorf("failed to read expectations file: %w", err)
+	}
+	if err := json.Unmarshal(data, &e); err != nil {
+		return e, fmt.Errorf("expectations file %s: %w", path, err)
+	}
+	if e.DSQLEndpoint != "" && (e.ECSCluster == "" || e.ECSService == "") {
+		return e, fmt.Errorf("expectations file %s: dsqlEndpoint requires ecsCluster and ecsService", path)
+	}
+	return e, nil
+}
+
+// Fingerprint is what the live environment reports.
+type Fingerprint struct {
+	ServerVersion     string
+	HistoryShardCount int32
+	ClusterName       string
+	DSQLEndpoint      string
+}
+
+// EndpointSource looks up the DSQL endpoint a server is configured with.
+type EndpointSource interface {
+	DSQLEndpoint(ctx context.Context) (string, error)
+}
+
+// Collect reads the fingerprint from the cluster, and the DSQL endpoint from
+// endpoints if it is not nil.
+func Collect(ctx context.Context, svc workflowservice.WorkflowServiceClient, endpoints EndpointSource) (Fingerprint, error) {
+	var f Fingerprint
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	info, err := svc.GetClusterInfo(callCtx, &workflowservice.GetClusterInfoRequest{})
+	if err != nil {
+		return f, fmt.Errorf("failed to get cluster info: %w", err)
+	}
+	f.ServerVersion = info.GetServerVersion()
+	f.HistoryShardCount = info.GetHistoryShardCount()
+	f.ClusterName = info.GetClusterName()
+
+	if endpoints != nil {
+		endpointCtx, cancel := context.WithTimeout(ctx, callTimeout)
+		defer cancel()
+		if f.DSQLEndpoint, err = endpoints.DSQLEndpoint(endpointCtx); err != nil {
+			return f, fmt.Errorf("failed to look up DSQL endpoint: %w", err)
+		}
+	}
+	return f, nil
+}
+
+// Mismatch is one expectation the live environment does not meet.
+type Mismatch struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("%s: expected %q, got %q", m.Field, m.Expected, m.Actual)
+}
+
+// Diff returns the expectations f does not meet.
+func (e Expectations) Diff(f Fingerprint) []Mismatch {
+	var mismatches []Mismatch
+	if e.ServerVersion != "" && f.ServerVersion != e.ServerVersion && !strings.HasPrefix(f.ServerVersion, e.ServerVersion+".") {
+		mismatches = append(mismatches, Mismatch{"serverVersion", e.ServerVersion, f.ServerVersion})
+	}
+	if e.HistoryShardCount != 0 && f.HistoryShardCount != e.HistoryShardCount {
+		mismatches = append(mismatches, Mismatch{"historyShardCount", fmt.Sprint(e.HistoryShardCount), fmt.Sprint(f.HistoryShardCount)})
+	}
+	if e.ClusterName != "" && f.ClusterName != e.ClusterName {
+		mismatches = append(mismatches, Mismatch{"clusterName", e.ClusterName, f.ClusterName})
+	}
+	if e.DSQLEndpoint != "" && !strings.EqualFold(f.DSQLEndpoint, e.DSQLEndpoint) {
+		mismatches = append(mismatches, Mismatch{"dsqlEndpoint", e.DSQLEndpoint, f.DSQLEndpoint})
+	}
+	return mismatches
+}
+
+// Error reports mismatches as one error listing each of them, or nil.
+func Error(mismatches []Mismatch) error {
+	if len(mismatches) == 0 {
+		return nil
+	}
+	lines := make([]string, len(mismatches))
+	for i, m := range mismatches {
+		lines[i] = "  " + m.String()
+	}
+	return fmt.Errorf("environment does not match expectations:\n%s", strings.Join(lines, "\n"))
+}
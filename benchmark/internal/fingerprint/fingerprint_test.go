@@ -0,0 +1,63 @@
+package fingerprint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+type fakeService struct {
+	workflowservice.WorkflowServiceClient
+	info *workflowservice.GetClusterInfoResponse
+}
+
+func (f *fakeService) GetClusterInfo(context.Context, *workflowservice.GetClusterInfoRequest, ...grpc.CallOption) (*workflowservice.GetClusterInfoResponse, error) {
+	return f.info, nil
+}
+
+type fakeEndpoints string
+
+func (f fakeEndpoints) DSQLEndpoint(context.Context) (string, error) { return string(f), nil }
+
+func TestCollectAndDiff(t *testing.T) {
+	svc := &fakeService{info: &workflowservice.GetClusterInfoResponse{
+		ServerVersion:     "1.27.2",
+		HistoryShardCount: 4096,
+		ClusterName:       "active",
+	}}
+	live, err := Collect(context.Background(), svc, fakeEndpoints("abc.dsql.us-east-1.on.aws"))
+	require.NoError(t, err)
+	require.Equal(t, Fingerprint{ServerVersion: "1.27.2", HistoryShardCount: 4096, ClusterName: "active", DSQLEndpoint: "abc.dsql.us-east-1.on.aws"}, live)
+
+	matching := Expectations{ServerVersion: "1.27", HistoryShardCount: 4096, DSQLEndpoint: "ABC.dsql.us-east-1.on.aws"}
+	require.Empty(t, matching.Diff(live))
+	require.NoError(t, Error(matching.Diff(live)))
+
+	wrong := Expectations{ServerVersion: "1.2", HistoryShardCount: 512, ClusterName: "standby", DSQLEndpoint: "xyz.dsql.us-east-1.on.aws"}
+	mismatches := wrong.Diff(live)
+	require.Equal(t, []Mismatch{
+		{"serverVersion", "1.2", "1.27.2"},
+		{"historyShardCount", "512", "4096"},
+		{"clusterName", "standby", "active"},
+		{"dsqlEndpoint", "xyz.dsql.us-east-1.on.aws", "abc.dsql.us-east-1.on.aws"},
+	}, mismatches)
+	require.ErrorContains(t, Error(mismatches), `historyShardCount: expected "512", got "4096"`)
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "expectations.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"serverVersion":"1.27","historyShardCount":4096}`), 0o644))
+	e, err := Load(path)
+	require.NoError(t, err)
+	require.Equal(t, Expectations{ServerVersion: "1.27", HistoryShardCount: 4096}, e)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"dsqlEndpoint":"abc.dsql.us-east-1.on.aws"}`), 0o644))
+	_, err = Load(path)
+	require.ErrorContains(t, err, "requires ecsCluster and ecsService")
+}
@@ -1,361 +0,0 @@
-// Package generator provides workflow generation with rate limiting.
-package generator
-
-import (
-	"context"
-	"fmt"
-	"log/slog"
-	"strings"
-	"sync"
-	"sync/atomic"
-	"time"
-
-	"go.temporal.io/sdk/client"
-
-	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/config"
-	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
-)
-
-// GeneratorStats contains current generation statistics.
-type GeneratorStats struct {
-	WorkflowsStarted   int64
-	WorkflowsCompleted int64
-	WorkflowsFailed    int64
-	CurrentRate        float64
-	TargetRate         float64
-}
-
-// WorkflowGenerator creates and submits workflows at a configured rate.
-type WorkflowGenerator interface {
-	// Start begins generating workflows at the configured rate
-	Start(ctx context.Context) error
-
-	// Stop halts workflow generation
-	Stop() error
-
-	// Stats returns current generation statistics
-	Stats() GeneratorStats
-
-	// Wait blocks until all started workflows complete or context is cancelled
-	Wait(ctx context.Context) error
-}
-
-// CompletionCallback is called when a workflow completes.
-type CompletionCallback func(workflowID string, duration time.Duration, err error)
-
-// atomicStats provides thread-safe statistics tracking.
-type atomicStats struct {
-	started   atomic.Int64
-	completed atomic.Int64
-	failed    atomic.Int64
-}
-
-func (s *atomicStats) incStarted() {
-	s.started.Add(1)
-}
-
-func (s *atomicStats) incCompleted() {
-	s.completed.Add(1)
-}
-
-func (s *atomicStats) incFailed() {
-	s.failed.Add(1)
-}
-
-func (s *atomicStats) snapshot() (started, completed, failed int64) {
-	return s.started.Load(), s.completed.Load(), s.failed.Load()
-}
-
-// generator implements WorkflowGenerator with rate limiting and ramp-up support.
-type generator struct {
-	client     client.Client
-	cfg        config.BenchmarkConfig
-	taskQueue  string
-	stats      atomicStats
-	onComplete CompletionCallback
-
-	// Rate control
-	currentRate    atomic.Int64 // stored as rate * 1000 for precision
-	targetRate     float64
-	rampController *RampUpController
-
-	// Lifecycle
-	mu      sync.Mutex
-	running bool
-	stopCh  chan struct{}
-	doneCh  chan struct{}
-	wg      sync.WaitGroup
-	startMu sync.Mutex
-}
-
-// GeneratorOption configures the generator.
-type GeneratorOption func(*generator)
-
-// WithCompletionCallback sets a callback for workflow completions.
-func WithCompletionCallback(cb CompletionCallback) GeneratorOption {
-	return func(g *generator) {
-		g.onComplete = cb
-	}
-}
-
-// NewGenerator creates a new WorkflowGenerator.
-func NewGenerator(c client.Client, cfg config.BenchmarkConfig, taskQueue string, opts ...GeneratorOption) WorkflowGenerator {
-	g := &generator{
-		client:     c,
-		cfg:        cfg,
-		taskQueue:  taskQueue,
-		targetRate: cfg.TargetRate,
-		stopCh:     make(chan struct{}),
-		doneCh:     make(chan struct{}),
-	}
-
-	for _, opt := range opts {
-		opt(g)
-	}
-
-	return g
-}
-
-// Start begins generating workflows at the configured rate.
-// It implements ramp-up logic if RampUpDuration > 0.
-func (g *generator) Start(ctx context.Context) error {
-	g.startMu.Lock()
-	defer g.startMu.Unlock()
-
-	g.mu.Lock()
-	if g.running {
-		g.mu.Unlock()
-		return fmt.Errorf("generator already running")
-	}
-	g.running = true
-	g.stopCh = make(chan struct{})
-	g.doneCh = make(chan struct{})
-	g.mu.Unlock()
-
-	slog.Info("Starting workflow generator",
-		"target_rate", g.targetRate,
-		"duration", g.cfg.Duration,
-		"ramp_up", g.cfg.RampUpDuration)
-
-	go g.runGenerator(ctx)
-
-	return nil
-}
-
-// Stop halts workflow generation.
-func (g *generator) Stop() error {
-	g.mu.Lock()
-	if !g.running {
-		g.mu.Unlock()
-		return nil
-	}
-	g.running = false
-	close(g.stopCh)
-	g.mu.Unlock()
-
-	// Wait for generator to finish
-	<-g.doneCh
-
-	slog.Info("Workflow generator stopped")
-	return nil
-}
-
-// Stats returns current generation statistics.
-func (g *generator) Stats() GeneratorStats {
-	started, completed, failed := g.stats.snapshot()
-	currentRate := float64(g.currentRate.Load()) / 1000.0
-
-	return GeneratorStats{
-		WorkflowsStarted:   started,
-		WorkflowsCompleted: completed,
-		WorkflowsFailed:    failed,
-		CurrentRate:        currentRate,
-		TargetRate:         g.targetRate,
-	}
-}
-
-// Wait blocks until all started workflows complete or context is cancelled.
-func (g *generator) Wait(ctx context.Context) error {
-	done := make(chan struct{})
-	go func() {
-		g.wg.Wait()
-		close(done)
-	}()
-
-	select {
-	case <-done:
-		return nil
-	case <-ctx.Done():
-		return ctx.Err()
-	}
-}
-
-// runGenerator is the main generation loop.
-func (g *generator) runGenerator(ctx context.Context) {
-	defer close(g.doneCh)
-
-	startTime := time.Now()
-	endTime := startTime.Add(g.cfg.Duration)
-
-	// Generate a run ID for this benchmark run (timestamp-based for uniqueness)
-	runID := startTime.Format("20060102-150405")
-
-	// Initialize ramp-up controller
-	g.rampController = NewRampUpController(g.targetRate, g.cfg.RampUpDuration)
-	g.rampController.ResetAt(startTime)
-
-	initialRate := g.rampController.InitialRate()
-	ticker := time.NewTicker(g.calculateTickInterval(initialRate))
-	defer ticker.Stop()
-
-	workflowCounter := atomic.Int64{}
-	var lastRate float64
-
-	for {
-		select {
-		case <-ctx.Done():
-			slog.Info("Generator stopping: context cancelled")
-			return
-		case <-g.stopCh:
-			slog.Info("Generator stopping: stop requested")
-			return
-		case now := <-ticker.C:
-			if now.After(endTime) {
-				slog.Info("Benchmark duration completed")
-				return
-			}
-
-			// Calculate current rate using ramp-up controller (ensures monotonic increase)
-			currentRate := g.rampController.RateAt(now)
-			g.currentRate.Store(int64(currentRate * 1000))
-
-			// Adjust ticker if rate changed significantly (>5% change)
-			if lastRate == 0 || abs(currentRate-lastRate)/lastRate > 0.05 {
-				newInterval := g.calculateTickInterval(currentRate)
-				ticker.Reset(newInterval)
-				lastRate = currentRate
-			}
-
-			// Start workflow with unique ID: <type>-<runID>-<counter>
-			workflowID := fmt.Sprintf("%s-%s-%d", g.cfg.WorkflowType, runID, workflowCounter.Add(1))
-			g.wg.Add(1)
-			go g.startWorkflow(ctx, workflowID)
-		}
-	}
-}
-
-// calculateTickInterval returns the interval between workflow submissions.
-func (g *generator) calculateTickInterval(rate float64) time.Duration {
-	if rate <= 0 {
-		return time.Second // Fallback to 1 WPS
-	}
-	interval := time.Duration(float64(time.Second) / rate)
-	// Minimum interval of 1ms to prevent tight loops
-	return max(interval, time.Millisecond)
-}
-
-// abs returns the absolute value of a float64.
-func abs(x float64) float64 {
-	if x < 0 {
-		return -x
-	}
-	return x
-}
-
-// startWorkflow starts a single workflow and tracks its completion.
-func (g *generator) startWorkflow(ctx context.Context, workflowID string) {
-	defer g.wg.Done()
-
-	startTime := time.Now()
-	g.stats.incStarted()
-
-	// Build workflow options
-	// Use the namespace from config to ensure workflows are created in the benchmark namespace
-	opts := client.StartWorkflowOptions{
-		ID:        workflowID,
-		TaskQueue: g.taskQueue,
-	}
-
-	// If a namespace is specified in config, we need to use a namespace-specific client
-	// The client.ExecuteWorkflow will use the client's default namespace
-
-	// Start the appropriate workflow type
-	var run client.WorkflowRun
-	var err error
-
-	switch g.cfg.WorkflowType {
-	case config.WorkflowTypeSimple:
-		run, err = g.client.ExecuteWorkflow(ctx, opts, workflows.SimpleWorkflowName)
-	case config.WorkflowTypeMultiActivity:
-		run, err = g.client.ExecuteWorkflow(ctx, opts, workflows.MultiActivityWorkflowName)
-	case config.WorkflowTypeStateTransitions:
-		run, err = g.client.ExecuteWorkflow(ctx, opts, workflows.StateTransitionWorkflowName)
-	case config.WorkflowTypeTimer:
-		run, err = g.client.ExecuteWorkflow(ctx, opts, workflows.TimerWorkflowName, g.cfg.TimerDuration)
-	case config.WorkflowTypeChildWorkflow:
-		run, err = g.client.ExecuteWorkflow(ctx, opts, workflows.ChildWorkflowName, g.cfg.ChildCount)
-	default:
-		err = fmt.Errorf("unknown workflow type: %s", g.cfg.WorkflowType)
-	}
-
-	if err != nil {
-		g.stats.incFailed()
-		duration := time.Since(startTime)
-		if g.onComplete != nil {
-			g.onComplete(workflowID, duration, err)
-		}
-		slog.Error("Failed to start workflow", "workflow_id", workflowID, "error", err)
-		return
-	}
-
-	// Wait for workflow completion
-	err = run.Get(ctx, nil)
-	duration := time.Since(startTime)
-
-	if err != nil {
-		// Check if this is a client shutdown error - don't count as failure
-		// The workflow likely completed successfully on the server
-		errStr := err.Error()
-		isClientShutdown := strings.Contains(errStr, "client connection is closing") ||
-			strings.Contains(errStr, "context canceled") ||
-			strings.Contains(errStr, "context deadline exceeded")
-
-		if isClientShutdown {
-			// Don't count as failure - workflow status is unknown due to client shutdown
-			// The workflow is likely still running or completed on the server
-			// Don't log these as they're expected during shutdown
-			if g.onComplete != nil {
-				g.onComplete(workflowID, duration, nil) // Report as success for metrics
-			}
-			g.stats.incCompleted() // Count as completed since server-side likely succeeded
-			return
-		}
-
-		g.stats.incFailed()
-		if g.onComplete != nil {
-			g.onComplete(workflowID, duration, err)
-		}
-		// Only log if not context cancelled
-		if ctx.Err() == nil {
-			slog.Error("Workflow failed", "workflow_id", workflowID, "error", err)
-		}
-		return
-	}
-
-	g.stats.incCompleted()
-	if g.onComplete != nil {
-		g.onComplete(workflowID, duration, nil)
-	}
-}
-
-// LogActualRate logs the actual achieved rate if it differs from target.
-// This satisfies Requirement 2.4: WHEN the target rate cannot be sustained,
-// THE Benchmark_Runner SHALL log the actual achieved rate.
-func (g *generator) LogActualRate() {
-	stats := g.Stats()
-	if stats.CurrentRate < stats.TargetRate*0.9 {
-		slog.Warn("Actual rate below target",
-			"actual_rate", stats.CurrentRate,
-			"target_rate", stats.TargetRate)
-	}
-}
@@ -0,0 +1,193 @@
+// Package redact scrubs deployment internals (addresses, tokens, TLS file
+// paths) from logs and result artifacts so they can be shared externally.
+package redact
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Placeholder replaces every redacted value.
+const Placeholder = "[REDACTED]"
+
+// patterns match sensitive values that aren't known up front. Each match is
+// replaced by the expansion of its replacement, so URLs keep their scheme.
+var patterns = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	// Authorization headers and key=value style credentials
+	{regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9._~+/=-]+`), "$1 " + Placeholder},
+	{regexp.MustCompile(`(?i)\b((?:api[_-]?key|token|secret|password)["']?\s*[=:]\s*["']?)[^\s"',&]+`), "${1}" + Placeholder},
+	// URLs, keeping the scheme so the kind of endpoint is still visible
+	{regexp.MustCompile(`\b([a-zA-Z][a-zA-Z0-9+.-]*)://[^\s"'<>]+`), "${1}://" + Placeholder},
+	// AWS resource names and hostnames, which carry account IDs, regions and cluster IDs
+	{regexp.MustCompile(`\barn:aws[a-zA-Z-]*:[^\s"',]+`), Placeholder},
+	{regexp.MustCompile(`\b[a-zA-Z0-9-]+(?:\.[a-zA-Z0-9-]+)*\.(?:amazonaws\.com|on\.aws)(?::\d+)?\b`), Placeholder},
+	// IPv4 addresses and host:port pairs; hosts must start with a letter so
+	// timestamps like 10:00:00 are left alone
+	{regexp.MustCompile(`\b\d{1,3}(?:\.\d{1,3}){3}(?::\d{1,5})?\b`), Placeholder},
+	{regexp.MustCompile(`\b[a-zA-Z][a-zA-Z0-9-]*(?:\.[a-zA-Z0-9-]+)*:\d{2,5}\b`), Placeholder},
+	// TLS certificates and keys
+	{regexp.MustCompile(`[^\s"'=,]*\.(?:pem|crt|cer|key|p12|pfx)\b`), Placeholder},
+}
+
+// Redactor replaces sensitive values in strings. A nil Redactor leaves
+// everything unchanged, so callers need not check whether redaction is on.
+type Redactor struct {
+	literals []string // Longest first, so a value isn't partially replaced by its prefix
+}
+
+// New creates a Redactor for the generic patterns plus the given literal
+// values, e.g. the configured address, TLS file paths and tokens. Empty
+// values are ignored.
+func New(literals ...string) *Redactor {
+	r := &Redactor{}
+	for _, v := range literals {
+		if v != "" {
+			r.literals = append(r.literals, v)
+		}
+	}
+	sort.Slice(r.literals, func(i, j int) bool { return len(r.literals[i]) > len(r.literals[j]) })
+	return r
+}
+
+// String returns s with every sensitive value replaced by Placeholder.
+func (r *Redactor) String(s string) string {
+	if r == nil || s == "" {
+		return s
+	}
+	for _, v := range r.literals {
+		s = strings.ReplaceAll(s, v, Placeholder)
+	}
+	for _, p := range patterns {
+		s = p.re.ReplaceAllString(s, p.replacement)
+	}
+	return s
+}
+
+// Value redacts, in place, every string reachable from v, which must be a
+// pointer. Unexported fields are left alone.
+func (r *Redactor) Value(v any) {
+	if r == nil {
+		return
+	}
+	r.walk(reflect.ValueOf(v))
+}
+
+func (r *Redactor) walk(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		elem := v.Elem()
+		if v.Kind() == reflect.Interface && elem.Kind() == reflect.String {
+			if v.CanSet() {
+				v.Set(reflect.ValueOf(r.String(elem.String())).Convert(elem.Type()))
+			}
+			return
+		}
+		r.walk(elem)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				r.walk(v.Field(i))
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			r.walk(v.Index(i))
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			// Map values aren't addressable, so redact a copy and store it back
+			elem := reflect.New(iter.Value().Type()).Elem()
+			elem.Set(iter.Value())
+			r.walk(elem)
+			v.SetMapIndex(iter.Key(), elem)
+		}
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(r.String(v.String()))
+		}
+	}
+}
+
+// Handler wraps h so log messages and attribute values are redacted before
+// they are written. It returns h unchanged on a nil Redactor.
+func (r *Redactor) Handler(h slog.Handler) slog.Handler {
+	if r == nil {
+		return h
+	}
+	return &handler{next: h, r: r}
+}
+
+type handler struct {
+	next slog.Handler
+	r    *Redactor
+}
+
+func (h *handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *handler) Handle(ctx context.Context, rec slog.Record) error {
+	out := slog.NewRecord(rec.Time, rec.Level, h.r.String(rec.Message), rec.PC)
+	rec.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(h.attr(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = h.attr(a)
+	}
+	return &handler{next: h.next.WithAttrs(redacted), r: h.r}
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	return &handler{next: h.next.WithGroup(name), r: h.r}
+}
+
+// attr redacts a's value. Strings, errors, Stringers and string slices are
+// redacted as text; numbers, durations and times pass through.
+func (h *handler) attr(a slog.Attr) slog.Attr {
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, h.r.String(v.String()))
+	case slog.KindGroup:
+		group := v.Group()
+		redacted := make([]any, len(group))
+		for i, ga := range group {
+			redacted[i] = h.attr(ga)
+		}
+		return slog.Group(a.Key, redacted...)
+	case slog.KindAny:
+		switch x := v.Any().(type) {
+		case error:
+			return slog.String(a.Key, h.r.String(x.Error()))
+		case interface{ String() string }:
+			return slog.String(a.Key, h.r.String(x.String()))
+		case []string:
+			redacted := make([]string, len(x))
+			for i, s := range x {
+				redacted[i] = h.r.String(s)
+			}
+			return slog.Any(a.Key, redacted)
+		}
+	}
+	return slog.Attr{Key: a.Key, Value: v}
+}
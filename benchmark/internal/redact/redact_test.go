@@ -0,0 +1,104 @@
+package redact
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	r := New("temporal-frontend.internal:7233", "s3cr3t", "")
+
+	tests := []struct {
+		in, want string
+	}{
+		{"connecting to temporal-frontend.internal:7233", "connecting to [REDACTED]"},
+		{"dial tcp 10.0.3.17:7233: connection refused", "dial tcp [REDACTED]: connection refused"},
+		{"client secret s3cr3t rejected", "client secret [REDACTED] rejected"},
+		{"Authorization: Bearer eyJhbGciOi.abc.def", "Authorization: Bearer [REDACTED]"},
+		{"snapshot at https://grafana.example.com/dashboard/snapshot/abc", "snapshot at https://[REDACTED]"},
+		{"endpoint abc123.dsql.us-east-1.on.aws", "endpoint [REDACTED]"},
+		{"role arn:aws:iam::123456789012:role/bench", "role [REDACTED]"},
+		{"open /etc/temporal/tls/client.pem: no such file", "open [REDACTED]: no such file"},
+		{"api_key=abcdef&x=1", "api_key=[REDACTED]&x=1"},
+		{"started at 2025-01-15T10:00:00Z in 1m30s", "started at 2025-01-15T10:00:00Z in 1m30s"},
+		{"namespace benchmark-1736935200000 p99 12.5ms", "namespace benchmark-1736935200000 p99 12.5ms"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, r.String(tt.in), tt.in)
+	}
+}
+
+func TestNilRedactor(t *testing.T) {
+	var r *Redactor
+	require.Equal(t, "10.0.0.1:7233", r.String("10.0.0.1:7233"))
+
+	v := struct{ Addr string }{"10.0.0.1:7233"}
+	r.Value(&v)
+	require.Equal(t, "10.0.0.1:7233", v.Addr)
+
+	h := slog.NewJSONHandler(&bytes.Buffer{}, nil)
+	require.Same(t, slog.Handler(h), r.Handler(h))
+}
+
+func TestValue(t *testing.T) {
+	type inner struct {
+		URL string
+	}
+	type result struct {
+		Address string
+		Count   int
+		URLs    []string
+		Labels  map[string]string
+		Inner   *inner
+		Nested  []inner
+		Any     any
+		secret  string
+	}
+	v := &result{
+		Address: "temporal:7233",
+		Count:   3,
+		URLs:    []string{"http://10.0.0.1:9090/metrics"},
+		Labels:  map[string]string{"host": "ip-10-0-0-1.ec2.internal:8080", "env": "prod"},
+		Inner:   &inner{URL: "https://grafana.local/d/x"},
+		Nested:  []inner{{URL: "s3://bucket/key"}},
+		Any:     "frontend.local:7233",
+		secret:  "temporal:7233",
+	}
+
+	New().Value(v)
+	require.Equal(t, "[REDACTED]", v.Address)
+	require.Equal(t, 3, v.Count)
+	require.Equal(t, []string{"http://[REDACTED]"}, v.URLs)
+	require.Equal(t, map[string]string{"host": "[REDACTED]", "env": "prod"}, v.Labels)
+	require.Equal(t, "https://[REDACTED]", v.Inner.URL)
+	require.Equal(t, "s3://[REDACTED]", v.Nested[0].URL)
+	require.Equal(t, "[REDACTED]", v.Any)
+	require.Equal(t, "temporal:7233", v.secret, "unexported fields are left alone")
+}
+
+func TestHandler(t *testing.T) {
+	var buf bytes.Buffer
+	r := New("my-api-key")
+	logger := slog.New(r.Handler(slog.NewJSONHandler(&buf, nil))).
+		With("temporal_address", "temporal.local:7233")
+
+	logger.Info("connected to 10.1.2.3:7233",
+		"error", errors.New("auth failed for my-api-key"),
+		"sinks", []string{"https://hooks.example.com/T0/B0"},
+		"duration", 5*time.Second,
+		slog.Group("tls", "ca_file", "/certs/ca.crt"))
+
+	out := buf.String()
+	for _, leaked := range []string{"10.1.2.3", "temporal.local", "my-api-key", "hooks.example.com", "/certs/ca.crt"} {
+		require.NotContains(t, out, leaked)
+	}
+	require.True(t, strings.Contains(out, `"duration":5000000000`), out)
+	require.Contains(t, out, `"msg":"connected to [REDACTED]"`)
+	require.Contains(t, out, `"tls":{"ca_file":"[REDACTED]"}`)
+}
@@ -0,0 +1,297 @@
+// Package retention provides post-run verification that namespace retention
+// actually removed a benchmark run's executions and visibility records.
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// Report statuses.
+const (
+	// StatusClean means retention has elapsed and no residual data was found.
+	StatusClean = "clean"
+	// StatusResidual means retention has elapsed but executions or visibility records remain.
+	StatusResidual = "residual"
+	// StatusPending means retention (plus grace) has not yet elapsed since the run ended.
+	StatusPending = "pending"
+)
+
+// Verification defaults.
+const (
+	DefaultSampleSize  = 100
+	DefaultGracePeriod = time.Hour // Allowance for the retention scanner to catch up
+	callTimeout        = 10 * time.Second
+)
+
+// Target identifies the benchmark run whose data should have been deleted.
+type Target struct {
+	Namespace   string
+	StartTime   time.Time
+	EndTime     time.Time
+	WorkflowIDs []results.WorkflowIDRange
+}
+
+// TargetFromResult builds a Target from a previously written benchmark result.
+func TargetFromResult(r *results.BenchmarkResultJSON) (Target, error) {
+	if r.Config.Namespace == "" {
+		return Target{}, fmt.Errorf("result has no namespace")
+	}
+	if r.Run.EndTime.IsZero() {
+		return Target{}, fmt.Errorf("result has no run end time")
+	}
+	return Target{
+		Namespace:   r.Config.Namespace,
+		StartTime:   r.Run.StartTime,
+		EndTime:     r.Run.EndTime,
+		WorkflowIDs: r.Run.WorkflowIDs,
+	}, nil
+}
+
+// Report is the outcome of a retention verification.
+type Report struct {
+	Namespace        string    `json:"namespace"`
+	CheckedAt        time.Time `json:"checkedAt"`
+	RunEndTime       time.Time `json:"runEndTime"`
+	Retention        string    `json:"retention"`
+	RetentionElapsed bool      `json:"retentionElapsed"`
+	NamespaceDeleted bool      `json:"namespaceDeleted"`
+
+	// Visibility rows for workflows started during the run window
+	VisibilityRecords int64 `json:"visibilityRecords"`
+
+	// Execution rows, estimated by describing a sample of the run's workflow IDs
+	ExecutionsSampled           int   `json:"executionsSampled"`
+	ExecutionsFound             int   `json:"executionsFound"`
+	ExecutionProbeErrors        int   `json:"executionProbeErrors"`
+	WorkflowsGenerated          int64 `json:"workflowsGenerated"`
+	EstimatedResidualExecutions int64 `json:"estimatedResidualExecutions"`
+
+	Status string `json:"status"`
+}
+
+// Verifier checks a namespace for data that retention should have removed.
+type Verifier struct {
+	client      client.Client
+	sampleSize  int
+	gracePeriod time.Duration
+	now         func() time.Time
+}
+
+// Option configures the Verifier.
+type Option func(*Verifier)
+
+// WithSampleSize sets how many workflow IDs are probed to estimate residual executions.
+func WithSampleSize(n int) Option {
+	return func(v *Verifier) {
+		if n > 0 {
+			v.sampleSize = n
+		}
+	}
+}
+
+// WithGracePeriod sets how long after retention expiry residual data is tolerated.
+func WithGracePeriod(d time.Duration) Option {
+	return func(v *Verifier) {
+		v.gracePeriod = d
+	}
+}
+
+// NewVerifier creates a new retention Verifier.
+func NewVerifier(c client.Client, opts ...Option) *Verifier {
+	v := &Verifier{
+		client:      c,
+		sampleSize:  DefaultSampleSize,
+		gracePeriod: DefaultGracePeriod,
+		now:         time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verify checks that the target run's executions and visibility records were removed.
+// It returns an error only if the check itself could not be performed.
+func (v *Verifier) Verify(ctx context.Context, target Target) (*Report, error) {
+	report := &Report{
+		Namespace:  target.Namespace,
+		CheckedAt:  v.now(),
+		RunEndTime: target.EndTime,
+	}
+
+	slog.Info("Starting retention verification",
+		"namespace", target.Namespace,
+		"run_end_time", target.EndTime,
+		"sample_size", v.sampleSize)
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	ns, err := v.client.WorkflowService().DescribeNamespace(callCtx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: target.Namespace,
+	})
+	cancel()
+	if err != nil {
+		if isNotFound(err) {
+			// Deleting the namespace removes everything in it
+			report.NamespaceDeleted = true
+			report.RetentionElapsed = true
+			report.Status = StatusClean
+			return report, nil
+		}
+		return nil, fmt.Errorf("failed to describe namespace %s: %w", target.Namespace, err)
+	}
+
+	retention := ns.GetConfig().GetWorkflowExecutionRetentionTtl().AsDuration()
+	report.Retention = retention.String()
+	report.RetentionElapsed = report.CheckedAt.After(target.EndTime.Add(retention + v.gracePeriod))
+
+	visibility, err := v.countVisibility(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	report.VisibilityRecords = visibility
+
+	v.probeExecutions(ctx, target, report)
+	if report.ExecutionsSampled > 0 && report.ExecutionProbeErrors == report.ExecutionsSampled {
+		return nil, fmt.Errorf("all %d execution probes failed", report.ExecutionsSampled)
+	}
+
+	report.Status = evaluate(report)
+	return report, nil
+}
+
+// countVisibility counts visibility records for workflows started during the run.
+func (v *Verifier) countVisibility(ctx context.Context, target Target) (int64, error) {
+	query := fmt.Sprintf("StartTime BETWEEN '%s' AND '%s'",
+		target.StartTime.UTC().Format(time.RFC3339Nano),
+		target.EndTime.UTC().Format(time.RFC3339Nano))
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	resp, err := v.client.WorkflowService().CountWorkflowExecutions(callCtx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: target.Namespace,
+		Query:     query,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count visibility records: %w", err)
+	}
+	return resp.Count, nil
+}
+
+// probeExecutions describes a sample of the run's workflow IDs. Describe reads the
+// execution store directly, so it finds executions even if visibility rows are gone.
+func (v *Verifier) probeExecutions(ctx context.Context, target Target, report *Report) {
+	for _, r := range target.WorkflowIDs {
+		report.WorkflowsGenerated += r.Count
+	}
+
+	for _, workflowID := range sampleWorkflowIDs(target.WorkflowIDs, v.sampleSize) {
+		if ctx.Err() != nil {
+			break
+		}
+		report.ExecutionsSampled++
+
+		callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+		_, err := v.client.WorkflowService().DescribeWorkflowExecution(callCtx, &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: target.Namespace,
+			Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+		})
+		cancel()
+
+		switch {
+		case err == nil:
+			report.ExecutionsFound++
+		case isNotFound(err):
+			// Deleted, or never started
+		default:
+			report.ExecutionProbeErrors++
+			slog.Warn("Failed to probe workflow execution", "workflow_id", workflowID, "error", err)
+		}
+	}
+
+	probed := report.ExecutionsSampled - report.ExecutionProbeErrors
+	if probed > 0 {
+		report.EstimatedResidualExecutions = report.WorkflowsGenerated * int64(report.ExecutionsFound) / int64(probed)
+	}
+}
+
+// sampleWorkflowIDs returns up to n workflow IDs spread evenly across the ranges.
+func sampleWorkflowIDs(ranges []results.WorkflowIDRange, n int) []string {
+	var total int64
+	for _, r := range ranges {
+		total += r.Count
+	}
+	if total == 0 || n <= 0 {
+		return nil
+	}
+
+	count := min(int64(n), total)
+	ids := make([]string, 0, count)
+	for i := int64(0); i < count; i++ {
+		// Index of the i-th sample across the concatenated ranges
+		index := i * total / count
+		for _, r := range ranges {
+			if index < r.Count {
+				ids = append(ids, fmt.Sprintf("%s-%d", r.Prefix, index+1))
+				break
+			}
+			index -= r.Count
+		}
+	}
+	return ids
+}
+
+// evaluate derives the report status from its findings.
+func evaluate(report *Report) string {
+	if !report.RetentionElapsed {
+		return StatusPending
+	}
+	if report.VisibilityRecords > 0 || report.ExecutionsFound > 0 {
+		return StatusResidual
+	}
+	return StatusClean
+}
+
+// isNotFound reports whether err indicates the target does not exist.
+func isNotFound(err error) bool {
+	var notFound *serviceerror.NotFound
+	var nsNotFound *serviceerror.NamespaceNotFound
+	return errors.As(err, &notFound) || errors.As(err, &nsNotFound)
+}
+
+// PrintSummary prints a human-readable summary of the report.
+func (r *Report) PrintSummary(w io.Writer) {
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintln(w, "                 RETENTION VERIFICATION REPORT")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintf(w, "  Namespace:              %s\n", r.Namespace)
+	fmt.Fprintf(w, "  Run Ended:              %s\n", r.RunEndTime.Format(time.RFC3339))
+	fmt.Fprintf(w, "  Checked:                %s\n", r.CheckedAt.Format(time.RFC3339))
+	if r.NamespaceDeleted {
+		fmt.Fprintln(w, "  Namespace deleted:      all run data removed")
+	} else {
+		fmt.Fprintf(w, "  Retention:              %s (elapsed: %t)\n", r.Retention, r.RetentionElapsed)
+		fmt.Fprintf(w, "  Visibility Records:     %d\n", r.VisibilityRecords)
+		fmt.Fprintf(w, "  Executions Found:       %d of %d sampled (%d probe errors)\n",
+			r.ExecutionsFound, r.ExecutionsSampled, r.ExecutionProbeErrors)
+		fmt.Fprintf(w, "  Residual Executions:    ~%d of %d generated\n",
+			r.EstimatedResidualExecutions, r.WorkflowsGenerated)
+	}
+	fmt.Fprintf(w, "  Status:                 %s\n", r.Status)
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+}
@@ -0,0 +1,55 @@
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+func TestSampleWorkflowIDs_SpreadsAcrossRanges(t *testing.T) {
+	ranges := []results.WorkflowIDRange{
+		{Prefix: "simple-a", Count: 4},
+		{Prefix: "simple-b", Count: 4},
+	}
+
+	ids := sampleWorkflowIDs(ranges, 4)
+	require.Equal(t, []string{"simple-a-1", "simple-a-3", "simple-b-1", "simple-b-3"}, ids)
+}
+
+func TestSampleWorkflowIDs_SmallerThanSample(t *testing.T) {
+	ids := sampleWorkflowIDs([]results.WorkflowIDRange{{Prefix: "timer-x", Count: 2}}, 100)
+	require.Equal(t, []string{"timer-x-1", "timer-x-2"}, ids)
+
+	require.Empty(t, sampleWorkflowIDs(nil, 100))
+}
+
+func TestEvaluate(t *testing.T) {
+	require.Equal(t, StatusPending, evaluate(&Report{RetentionElapsed: false}))
+	require.Equal(t, StatusClean, evaluate(&Report{RetentionElapsed: true}))
+	require.Equal(t, StatusResidual, evaluate(&Report{RetentionElapsed: true, VisibilityRecords: 3}))
+	require.Equal(t, StatusResidual, evaluate(&Report{RetentionElapsed: true, ExecutionsFound: 1}))
+}
+
+func TestTargetFromResult(t *testing.T) {
+	end := time.Date(2025, 1, 15, 10, 5, 0, 0, time.UTC)
+	result := &results.BenchmarkResultJSON{
+		Config: results.ResultConfig{Namespace: "benchmark-1"},
+		Run: results.ResultRun{
+			StartTime:   end.Add(-5 * time.Minute),
+			EndTime:     end,
+			WorkflowIDs: []results.WorkflowIDRange{{Prefix: "simple-20250115-100000", Count: 30000}},
+		},
+	}
+
+	target, err := TargetFromResult(result)
+	require.NoError(t, err)
+	require.Equal(t, "benchmark-1", target.Namespace)
+	require.Equal(t, end, target.EndTime)
+	require.Len(t, target.WorkflowIDs, 1)
+
+	_, err = TargetFromResult(&results.BenchmarkResultJSON{Config: results.ResultConfig{Namespace: "benchmark-1"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package simulate
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// Latency distribution kinds.
+const (
+	LatencyFixed     = "fixed"     // fixed:<d>
+	LatencyUniform   = "uniform"   // uniform:<min>:<max>
+	LatencyLogNormal = "lognormal" // lognormal:<median>:<p99>
+)
+
+// z99 is the standard normal quantile of the 99th percentile.
+const z99 = 2.3263
+
+// Latency is a synthetic workflow latency distribution.
+type Latency struct {
+	kind string
+	a, b time.Duration
+}
+
+// ParseLatency parses a distribution spec: "fixed:100ms", "uniform:50ms:500ms"
+// or "lognormal:200ms:2s" (median and p99).
+func ParseLatency(spec string) (Latency, error) {
+	parts := strings.Split(spec, ":")
+	want := 3
+	if parts[0] == LatencyFixed {
+		want = 2
+	}
+	if len(parts) != want {
+		return Latency{}, fmt.Errorf("invalid latency distribution %q: must be %s:<d>, %s:<min>:<max> or %s:<median>:<p99>",
+			spec, LatencyFixed, LatencyUniform, LatencyLogNormal)
+	}
+
+	l := Latency{kind: parts[0]}
+	var err error
+	if l.a, err = time.ParseDuration(parts[1]); err != nil {
+		return Latency{}, fmt.Errorf("invalid latency distribution %q: %w", spec, err)
+	}
+	if want == 3 {
+		if l.b, err = time.ParseDuration(parts[2]); err != nil {
+			return Latency{}, fmt.Errorf("invalid latency distribution %q: %w", spec, err)
+		}
+	}
+
+	switch {
+	case l.kind != LatencyFixed && l.kind != LatencyUniform && l.kind != LatencyLogNormal:
+		return Latency{}, fmt.Errorf("invalid latency distribution %q: unknown kind %q", spec, l.kind)
+	case l.a < 0:
+		return Latency{}, fmt.Errorf("invalid latency distribution %q: durations must not be negative", spec)
+	case l.kind == LatencyUniform && l.b < l.a:
+		return Latency{}, fmt.Errorf("invalid latency distribution %q: max must not be below min", spec)
+	case l.kind == LatencyLogNormal && (l.a == 0 || l.b < l.a):
+		return Latency{}, fmt.Errorf("invalid latency distribution %q: median must be positive and p99 not below it", spec)
+	}
+	return l, nil
+}
+
+// Sample draws one latency.
+func (l Latency) Sample(r *rand.Rand) time.Duration {
+	switch l.kind {
+	case LatencyUniform:
+		return l.a + time.Duration(r.Int64N(int64(l.b-l.a)+1))
+	case LatencyLogNormal:
+		sigma := math.Log(float64(l.b)/float64(l.a)) / z99
+		return time.Duration(float64(l.a) * math.Exp(sigma*r.NormFloat64()))
+	default:
+		return l.a
+	}
+}
+
+// String returns the distribution's spec.
+func (l Latency) String() string {
+	if l.kind == LatencyFixed {
+		return fmt.Sprintf("%s:%s", l.kind, l.a)
+	}
+	return fmt.Sprintf("%s:%s:%s", l.kind, l.a, l.b)
+}
@@ -0,0 +1,321 @@
+// Package simulate serves an in-memory fake Temporal frontend, so scenario
+// files, thresholds and reports can be exercised end to end without a live
+// cluster. Workflows are never executed: each start draws a synthetic latency
+// and outcome, and the workflow closes once its latency has elapsed.
+//
+// The server speaks the real WorkflowService gRPC API on a loopback port, so
+// every Temporal client the benchmark dials (including per-namespace clients
+// and embedded workers, which simply find no tasks) runs unchanged against it.
+// RPCs it does not simulate return Unimplemented.
+package simulate
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Options configures the simulated outcomes.
+type Options struct {
+	Latency          Latency // Time from start to close of each workflow
+	FailureRate      float64 // Fraction of started workflows that fail
+	StartFailureRate float64 // Fraction of starts rejected (as a start timeout)
+}
+
+// execution is a simulated workflow run.
+type execution struct {
+	runID        string
+	workflowType string
+	startTime    time.Time
+	closeTime    time.Time
+	failed       bool
+	terminated   chan struct{} // Closed by TerminateWorkflowExecution
+	canceled     chan struct{} // Closed by RequestCancelWorkflowExecution
+}
+
+// closed reports whether the run is closed at now.
+func (e *execution) closed(now time.Time) bool {
+	select {
+	case <-e.terminated:
+		return true
+	case <-e.canceled:
+		return true
+	default:
+		return !now.Before(e.closeTime)
+	}
+}
+
+type executionKey struct {
+	namespace  string
+	workflowID string
+}
+
+// Server is the fake frontend.
+type Server struct {
+	workflowservice.UnimplementedWorkflowServiceServer
+
+	opts     Options
+	grpc     *grpc.Server
+	listener net.Listener
+
+	mu         sync.Mutex
+	rand       *rand.Rand
+	executions map[executionKey]*execution
+}
+
+// NewServer creates a server simulating opts.
+func NewServer(opts Options) *Server {
+	return &Server{
+		opts:       opts,
+		rand:       rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+		executions: map[executionKey]*execution{},
+	}
+}
+
+// Start listens on addr (e.g. "127.0.0.1:0") and serves in the background.
+func (s *Server) Start(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	s.listener = listener
+
+	s.grpc = grpc.NewServer(grpc.UnaryInterceptor(statusInterceptor))
+	workflowservice.RegisterWorkflowServiceServer(s.grpc, s)
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(workflowservice.WorkflowService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s.grpc, healthServer)
+
+	go func() {
+		if err := s.grpc.Serve(listener); err != nil {
+			slog.Error("Simulated frontend stopped", "error", err)
+		}
+	}()
+	slog.Info("Simulated Temporal frontend started", "address", s.Addr(),
+		"latency", s.opts.Latency.String(), "failure_rate", s.opts.FailureRate, "start_failure_rate", s.opts.StartFailureRate)
+	return nil
+}
+
+// statusInterceptor returns service errors as the gRPC statuses clients
+// decode them from; gRPC would otherwise send them as codes.Unknown, which the
+// SDK retries.
+func statusInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, serviceerror.ToStatus(err).Err()
+	}
+	return resp, nil
+}
+
+// Addr returns the host:port clients connect to.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Stop closes all connections and stops serving.
+func (s *Server) Stop() {
+	s.grpc.Stop()
+}
+
+// GetSystemInfo implements WorkflowServiceServer.
+func (s *Server) GetSystemInfo(context.Context, *workflowservice.GetSystemInfoRequest) (*workflowservice.GetSystemInfoResponse, error) {
+	return &workflowservice.GetSystemInfoResponse{ServerVersion: "simulated"}, nil
+}
+
+// DescribeNamespace implements WorkflowServiceServer. Every namespace exists.
+func (s *Server) DescribeNamespace(_ context.Context, req *workflowservice.DescribeNamespaceRequest) (*workflowservice.DescribeNamespaceResponse, error) {
+	return &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &namespacepb.NamespaceInfo{Name: req.GetNamespace(), State: enumspb.NAMESPACE_STATE_REGISTERED},
+		Config:        &namespacepb.NamespaceConfig{WorkflowExecutionRetentionTtl: durationpb.New(24 * time.Hour)},
+	}, nil
+}
+
+// RegisterNamespace implements WorkflowServiceServer.
+func (s *Server) RegisterNamespace(context.Context, *workflowservice.RegisterNamespaceRequest) (*workflowservice.RegisterNamespaceResponse, error) {
+	return &workflowservice.RegisterNamespaceResponse{}, nil
+}
+
+// StartWorkflowExecution implements WorkflowServiceServer, drawing the run's
+// latency and outcome.
+func (s *Server) StartWorkflowExecution(_ context.Context, req *workflowservice.StartWorkflowExecutionRequest) (*workflowservice.StartWorkflowExecutionResponse, error) {
+	now := time.Now()
+	key := executionKey{req.GetNamespace(), req.GetWorkflowId()}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rand.Float64() < s.opts.StartFailureRate {
+		return nil, serviceerror.NewDeadlineExceeded("simulated start timeout")
+	}
+	if existing, ok := s.executions[key]; ok && !existing.closed(now) {
+		return nil, serviceerror.NewWorkflowExecutionAlreadyStarted("workflow execution already started", req.GetRequestId(), existing.runID)
+	}
+
+	e := &execution{
+		runID:        fmt.Sprintf("%016x%016x", s.rand.Uint64(), s.rand.Uint64()),
+		workflowType: req.GetWorkflowType().GetName(),
+		startTime:    now,
+		closeTime:    now.Add(s.opts.Latency.Sample(s.rand)),
+		failed:       s.rand.Float64() < s.opts.FailureRate,
+		terminated:   make(chan struct{}),
+		canceled:     make(chan struct{}),
+	}
+	s.executions[key] = e
+	return &workflowservice.StartWorkflowExecutionResponse{RunId: e.runID, Started: true}, nil
+}
+
+// GetWorkflowExecutionHistory implements WorkflowServiceServer for the close
+// event long poll the SDK uses to wait for a result, blocking until the run
+// closes.
+func (s *Server) GetWorkflowExecutionHistory(ctx context.Context, req *workflowservice.GetWorkflowExecutionHistoryRequest) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+	e, err := s.execution(req.GetNamespace(), req.GetExecution())
+	if err != nil {
+		return nil, err
+	}
+
+	timer := time.NewTimer(time.Until(e.closeTime))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-e.terminated:
+	case <-e.canceled:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return &workflowservice.GetWorkflowExecutionHistoryResponse{
+		History: &historypb.History{Events: []*historypb.HistoryEvent{e.closeEvent()}},
+	}, nil
+}
+
+// closeEvent returns the run's closing history event.
+func (e *execution) closeEvent() *historypb.HistoryEvent {
+	event := &historypb.HistoryEvent{EventId: 1, EventTime: timestamppb.New(e.closeTime)}
+	select {
+	case <-e.terminated:
+		event.EventType = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED
+		event.Attributes = &historypb.HistoryEvent_WorkflowExecutionTerminatedEventAttributes{
+			WorkflowExecutionTerminatedEventAttributes: &historypb.WorkflowExecutionTerminatedEventAttributes{Reason: "terminated"},
+		}
+		return event
+	case <-e.canceled:
+		event.EventType = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED
+		event.Attributes = &historypb.HistoryEvent_WorkflowExecutionCanceledEventAttributes{
+			WorkflowExecutionCanceledEventAttributes: &historypb.WorkflowExecutionCanceledEventAttributes{},
+		}
+		return event
+	default:
+	}
+	if e.failed {
+		event.EventType = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED
+		event.Attributes = &historypb.HistoryEvent_WorkflowExecutionFailedEventAttributes{
+			WorkflowExecutionFailedEventAttributes: &historypb.WorkflowExecutionFailedEventAttributes{
+				Failure: &failurepb.Failure{
+					Message:     "simulated workflow failure",
+					FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{Type: "SimulatedFailure"}},
+				},
+			},
+		}
+		return event
+	}
+	event.EventType = enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED
+	event.Attributes = &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{
+		WorkflowExecutionCompletedEventAttributes: &historypb.WorkflowExecutionCompletedEventAttributes{},
+	}
+	return event
+}
+
+// execution looks up the run of we, or the current run if we has no run ID.
+func (s *Server) execution(namespace string, we *commonpb.WorkflowExecution) (*execution, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.executions[executionKey{namespace, we.GetWorkflowId()}]
+	if !ok || (we.GetRunId() != "" && we.GetRunId() != e.runID) {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("workflow execution %s not found", we.GetWorkflowId()))
+	}
+	return e, nil
+}
+
+// ListOpenWorkflowExecutions implements WorkflowServiceServer, returning every
+// open run of the namespace in one page.
+func (s *Server) ListOpenWorkflowExecutions(_ context.Context, req *workflowservice.ListOpenWorkflowExecutionsRequest) (*workflowservice.ListOpenWorkflowExecutionsResponse, error) {
+	now := time.Now()
+	resp := &workflowservice.ListOpenWorkflowExecutionsResponse{}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, e := range s.executions {
+		if key.namespace != req.GetNamespace() || e.closed(now) {
+			continue
+		}
+		resp.Executions = append(resp.Executions, &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: key.workflowID, RunId: e.runID},
+			Type:      &commonpb.WorkflowType{Name: e.workflowType},
+			StartTime: timestamppb.New(e.startTime),
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		})
+	}
+	return resp, nil
+}
+
+// TerminateWorkflowExecution implements WorkflowServiceServer.
+func (s *Server) TerminateWorkflowExecution(_ context.Context, req *workflowservice.TerminateWorkflowExecutionRequest) (*workflowservice.TerminateWorkflowExecutionResponse, error) {
+	e, err := s.execution(req.GetNamespace(), req.GetWorkflowExecution())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e.closed(time.Now()) {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("workflow execution %s already completed", req.GetWorkflowExecution().GetWorkflowId()))
+	}
+	close(e.terminated)
+	return &workflowservice.TerminateWorkflowExecutionResponse{}, nil
+}
+
+// RequestCancelWorkflowExecution implements WorkflowServiceServer. Simulated
+// workflows honour the request at once, closing as cancelled.
+func (s *Server) RequestCancelWorkflowExecution(_ context.Context, req *workflowservice.RequestCancelWorkflowExecutionRequest) (*workflowservice.RequestCancelWorkflowExecutionResponse, error) {
+	e, err := s.execution(req.GetNamespace(), req.GetWorkflowExecution())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e.closed(time.Now()) {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("workflow execution %s already completed", req.GetWorkflowExecution().GetWorkflowId()))
+	}
+	close(e.canceled)
+	return &workflowservice.RequestCancelWorkflowExecutionResponse{}, nil
+}
+
+// PollWorkflowTaskQueue implements WorkflowServiceServer. There are never
+// tasks, so pollers of embedded workers idle until their poll times out.
+func (s *Server) PollWorkflowTaskQueue(ctx context.Context, _ *workflowservice.PollWorkflowTaskQueueRequest) (*workflowservice.PollWorkflowTaskQueueResponse, error) {
+	<-ctx.Done()
+	return &workflowservice.PollWorkflowTaskQueueResponse{}, nil
+}
+
+// PollActivityTaskQueue implements WorkflowServiceServer like PollWorkflowTaskQueue.
+func (s *Server) PollActivityTaskQueue(ctx context.Context, _ *workflowservice.PollActivityTaskQueueRequest) (*workflowservice.PollActivityTaskQueueResponse, error) {
+	<-ctx.Done()
+	return &workflowservice.PollActivityTaskQueueResponse{}, nil
+}
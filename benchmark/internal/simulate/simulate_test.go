@@ -0,0 +1,121 @@
+package simulate
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+func TestParseLatency(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	l, err := ParseLatency("fixed:100ms")
+	require.NoError(t, err)
+	require.Equal(t, 100*time.Millisecond, l.Sample(r))
+
+	l, err = ParseLatency("uniform:50ms:60ms")
+	require.NoError(t, err)
+	for range 100 {
+		require.InDelta(t, 55*time.Millisecond, l.Sample(r), float64(5*time.Millisecond))
+	}
+
+	l, err = ParseLatency("lognormal:200ms:2s")
+	require.NoError(t, err)
+	require.Equal(t, "lognormal:200ms:2s", l.String())
+	var below int
+	for range 10000 {
+		if l.Sample(r) <= 200*time.Millisecond {
+			below++
+		}
+	}
+	require.InDelta(t, 5000, below, 300)
+
+	for _, spec := range []string{"", "fixed", "fixed:1s:2s", "gamma:1s:2s", "uniform:2s:1s", "lognormal:0s:1s", "uniform:1x:2s"} {
+		_, err := ParseLatency(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func startServer(t *testing.T, opts Options) client.Client {
+	t.Helper()
+	s := NewServer(opts)
+	require.NoError(t, s.Start("127.0.0.1:0"))
+	t.Cleanup(s.Stop)
+
+	c, err := client.Dial(client.Options{HostPort: s.Addr(), Namespace: "benchmark-1"})
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+	_, err = c.CheckHealth(context.Background(), nil)
+	require.NoError(t, err)
+	return c
+}
+
+func TestServer_Workflows(t *testing.T) {
+	latency, err := ParseLatency("fixed:50ms")
+	require.NoError(t, err)
+	c := startServer(t, Options{Latency: latency})
+	ctx := context.Background()
+
+	opts := client.StartWorkflowOptions{ID: "wf-1", TaskQueue: "q", WorkflowExecutionErrorWhenAlreadyStarted: true}
+	start := time.Now()
+	run, err := c.ExecuteWorkflow(ctx, opts, "SimpleWorkflow")
+	require.NoError(t, err)
+
+	// The ID is taken while the run is open
+	_, err = c.ExecuteWorkflow(ctx, opts, "SimpleWorkflow")
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	require.ErrorAs(t, err, &alreadyStarted)
+
+	require.NoError(t, run.Get(ctx, nil))
+	require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	// Open runs are listed and can be terminated
+	opts.ID = "wf-2"
+	latency, err = ParseLatency("fixed:1h")
+	require.NoError(t, err)
+	c = startServer(t, Options{Latency: latency})
+	run, err = c.ExecuteWorkflow(ctx, opts, "SimpleWorkflow")
+	require.NoError(t, err)
+	open, err := c.WorkflowService().ListOpenWorkflowExecutions(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{Namespace: "benchmark-1"})
+	require.NoError(t, err)
+	require.Len(t, open.GetExecutions(), 1)
+	require.NoError(t, c.TerminateWorkflow(ctx, "wf-2", "", "cleanup"))
+	var terminated *temporal.TerminatedError
+	require.ErrorAs(t, run.Get(ctx, nil), &terminated)
+
+	// Or cancelled
+	opts.ID = "wf-3"
+	run, err = c.ExecuteWorkflow(ctx, opts, "SimpleWorkflow")
+	require.NoError(t, err)
+	require.NoError(t, c.CancelWorkflow(ctx, "wf-3", ""))
+	require.True(t, temporal.IsCanceledError(run.Get(ctx, nil)))
+	var notFound *serviceerror.NotFound
+	require.ErrorAs(t, c.CancelWorkflow(ctx, "wf-3", ""), &notFound)
+}
+
+func TestServer_Failures(t *testing.T) {
+	latency, err := ParseLatency("fixed:0s")
+	require.NoError(t, err)
+	ctx := context.Background()
+	opts := client.StartWorkflowOptions{ID: "wf", TaskQueue: "q"}
+
+	c := startServer(t, Options{Latency: latency, FailureRate: 1})
+	run, err := c.ExecuteWorkflow(ctx, opts, "SimpleWorkflow")
+	require.NoError(t, err)
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, run.Get(ctx, nil), &appErr)
+	require.Equal(t, "SimulatedFailure", appErr.Type())
+
+	c = startServer(t, Options{Latency: latency, StartFailureRate: 1})
+	_, err = c.ExecuteWorkflow(ctx, opts, "SimpleWorkflow")
+	var deadline *serviceerror.DeadlineExceeded
+	require.True(t, errors.As(err, &deadline), "got %v", err)
+}
@@ -0,0 +1,273 @@
+// Package tui draws a live progress view of a benchmark run in the terminal,
+// for engineers running the benchmark locally against dev clusters.
+//
+// The view polls a runner.Progress once per interval and redraws the whole
+// screen with ANSI escapes on the terminal's alternate screen, which is
+// restored when the view stops.
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/runner"
+)
+
+// View tuning.
+const (
+	defaultInterval = time.Second
+	defaultWidth    = 100 // Used when COLUMNS is unset
+	historySize     = 60  // Sparkline samples, one per interval
+	feedSize        = 5   // Failures and log lines shown
+)
+
+// ANSI escape sequences.
+const (
+	enterScreen = "\x1b[?1049h\x1b[?25l" // Alternate screen, hidden cursor
+	leaveScreen = "\x1b[?25h\x1b[?1049l"
+	home        = "\x1b[H"
+	clearLine   = "\x1b[K"
+	clearBelow  = "\x1b[J"
+)
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// Option configures a View.
+type Option func(*View)
+
+// WithLogLines shows the most recent of the lines returned by lines (JSON log
+// records or plain text) below the failure feed.
+func WithLogLines(lines func() []string) Option {
+	return func(v *View) {
+		v.logLines = lines
+	}
+}
+
+// WithInterval sets how often the view redraws.
+func WithInterval(d time.Duration) Option {
+	return func(v *View) {
+		v.interval = d
+	}
+}
+
+// View is a terminal progress view of one benchmark run.
+type View struct {
+	out      io.Writer
+	progress *runner.Progress
+	logLines func() []string
+	interval time.Duration
+	width    int
+
+	// Sparkline history; NaN marks intervals without completions
+	throughput []float64
+	p99        []float64
+	last       runner.ProgressSnapshot
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// New creates a view drawing progress to out, which should be a terminal.
+func New(out io.Writer, progress *runner.Progress, opts ...Option) *View {
+	v := &View{
+		out:      out,
+		progress: progress,
+		interval: defaultInterval,
+		width:    defaultWidth,
+	}
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 0 {
+		v.width = cols
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// IsTerminal reports whether f is a terminal (character device).
+func IsTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// Start switches to the alternate screen and redraws every interval until
+// Stop is called. It is a no-op on a nil View.
+func (v *View) Start() {
+	if v == nil {
+		return
+	}
+	v.stop = make(chan struct{})
+	v.done = make(chan struct{})
+	v.last = v.progress.Snapshot()
+	fmt.Fprint(v.out, enterScreen)
+	go v.run()
+}
+
+// Stop stops redrawing and restores the screen. It is a no-op on a nil or
+// unstarted View.
+func (v *View) Stop() {
+	if v == nil || v.stop == nil {
+		return
+	}
+	v.once.Do(func() { close(v.stop) })
+	<-v.done
+}
+
+func (v *View) run() {
+	defer close(v.done)
+	defer fmt.Fprint(v.out, leaveScreen)
+	ticker := time.NewTicker(v.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-v.stop:
+			return
+		case <-ticker.C:
+			s := v.progress.Snapshot()
+			v.update(s)
+			fmt.Fprint(v.out, v.frame(s))
+		}
+	}
+}
+
+// update adds the interval ending at s to the sparkline history.
+func (v *View) update(s runner.ProgressSnapshot) {
+	throughput := math.NaN()
+	if dt := s.Time.Sub(v.last.Time).Seconds(); dt > 0 && s.Completed >= v.last.Completed {
+		throughput = float64(s.Completed-v.last.Completed) / dt
+	}
+	v.throughput = appendSample(v.throughput, throughput)
+	v.p99 = appendSample(v.p99, p99Seconds(s.Latencies))
+	v.last = s
+}
+
+// frame renders the screen for s, each line truncated to the view width.
+func (v *View) frame(s runner.ProgressSnapshot) string {
+	state := "running"
+	switch {
+	case s.Start.IsZero():
+		state = "starting"
+	case s.Draining:
+		state = "draining"
+	case s.Paused:
+		state = "paused"
+	}
+	elapsed := time.Duration(0)
+	if !s.Start.IsZero() {
+		elapsed = s.Time.Sub(s.Start).Truncate(time.Second)
+	}
+
+	lines := []string{
+		fmt.Sprintf("Benchmark %s  %s  %s  elapsed %s", s.Namespace, s.Phase, state, elapsed),
+		"",
+		fmt.Sprintf("Rate         target %.1f/s  current %.1f/s", s.TargetRate, s.CurrentRate),
+		fmt.Sprintf("Workflows    started %d  completed %d  failed %d  in flight %d", s.Started, s.Completed, s.Failed, s.InFlight),
+		fmt.Sprintf("Throughput   %-10s %s", formatLast(v.throughput, "%.1f/s"), sparkline(v.throughput)),
+		fmt.Sprintf("p99 latency  %-10s %s", formatLast(v.p99, "%.3fs"), sparkline(v.p99)),
+		"",
+		"Recent failures",
+	}
+	failures := s.Failures[max(0, len(s.Failures)-feedSize):]
+	if len(failures) == 0 {
+		lines = append(lines, "  none")
+	}
+	for _, f := range failures {
+		lines = append(lines, fmt.Sprintf("  %s  %s  %s", f.Time.Format(time.TimeOnly), f.WorkflowID, strings.ReplaceAll(f.Error, "\n", " ")))
+	}
+	if v.logLines != nil {
+		lines = append(lines, "", "Recent logs")
+		logs := v.logLines()
+		for _, l := range logs[max(0, len(logs)-feedSize):] {
+			lines = append(lines, "  "+formatLogLine(l))
+		}
+	}
+	lines = append(lines, "", "Ctrl-C stops the run")
+
+	var b strings.Builder
+	b.WriteString(home)
+	for _, l := range lines {
+		b.WriteString(truncate(l, v.width))
+		b.WriteString(clearLine + "\r\n")
+	}
+	b.WriteString(clearBelow)
+	return b.String()
+}
+
+func appendSample(history []float64, sample float64) []float64 {
+	history = append(history, sample)
+	if len(history) > historySize {
+		history = history[len(history)-historySize:]
+	}
+	return history
+}
+
+// p99Seconds returns the 99th percentile of latencies in seconds, or NaN if
+// there are none.
+func p99Seconds(latencies []time.Duration) float64 {
+	if len(latencies) == 0 {
+		return math.NaN()
+	}
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+	i := int(math.Ceil(0.99*float64(len(sorted)))) - 1
+	return sorted[i].Seconds()
+}
+
+// sparkline draws samples scaled to their maximum, leaving gaps for NaN.
+func sparkline(samples []float64) string {
+	peak := 0.0
+	for _, s := range samples {
+		if !math.IsNaN(s) {
+			peak = max(peak, s)
+		}
+	}
+	var b strings.Builder
+	for _, s := range samples {
+		switch {
+		case math.IsNaN(s):
+			b.WriteRune(' ')
+		case peak == 0:
+			b.WriteRune(sparkBlocks[0])
+		default:
+			b.WriteRune(sparkBlocks[int(s/peak*float64(len(sparkBlocks)-1)+0.5)])
+		}
+	}
+	return b.String()
+}
+
+func formatLast(samples []float64, format string) string {
+	if len(samples) == 0 || math.IsNaN(samples[len(samples)-1]) {
+		return "-"
+	}
+	return fmt.Sprintf(format, samples[len(samples)-1])
+}
+
+// formatLogLine shortens a JSON log record to its time, level and message.
+func formatLogLine(line string) string {
+	var rec struct {
+		Time  time.Time `json:"time"`
+		Level string    `json:"level"`
+		Msg   string    `json:"msg"`
+	}
+	if err := json.Unmarshal([]byte(line), &rec); err != nil || rec.Msg == "" {
+		return strings.TrimSpace(line)
+	}
+	return fmt.Sprintf("%s %-5s %s", rec.Time.Format(time.TimeOnly), rec.Level, rec.Msg)
+}
+
+func truncate(s string, width int) string {
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	return string(r[:width])
+}
@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/runner"
+)
+
+func TestSparkline(t *testing.T) {
+	require.Equal(t, "▁▅█ █", sparkline([]float64{0, 5, 10, math.NaN(), 10}))
+	require.Equal(t, "▁▁", sparkline([]float64{0, 0}))
+	require.Empty(t, sparkline(nil))
+}
+
+func TestP99Seconds(t *testing.T) {
+	require.True(t, math.IsNaN(p99Seconds(nil)))
+
+	latencies := make([]time.Duration, 0, 200)
+	for i := 200; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	require.InDelta(t, 0.198, p99Seconds(latencies), 1e-9)
+	require.Equal(t, 200*time.Millisecond, latencies[0], "input is not reordered")
+}
+
+func TestFrame(t *testing.T) {
+	v := New(&bytes.Buffer{}, nil, WithLogLines(func() []string {
+		return []string{`{"time":"2026-01-02T10:00:00Z","level":"INFO","msg":"Benchmark duration completed"}`, "plain"}
+	}))
+	v.width = 80
+
+	start := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	v.last = runner.ProgressSnapshot{Time: start, Start: start}
+	s := runner.ProgressSnapshot{
+		Time:        start.Add(90 * time.Second),
+		Namespace:   "benchmark-abc",
+		Start:       start,
+		Phase:       "iteration 1",
+		Draining:    true,
+		TargetRate:  100,
+		CurrentRate: 99.5,
+		Started:     9000,
+		Completed:   8991,
+		Failed:      2,
+		InFlight:    7,
+		Latencies:   []time.Duration{time.Second, 2 * time.Second},
+		Failures: []runner.WorkflowFailure{
+			{Time: start.Add(time.Minute), WorkflowID: "wf-42", Error: "workflow timed out\nafter 1m"},
+		},
+	}
+	v.update(s)
+	frame := v.frame(s)
+
+	require.True(t, strings.HasPrefix(frame, home))
+	require.Contains(t, frame, "benchmark-abc  iteration 1  draining  elapsed 1m30s")
+	require.Contains(t, frame, "target 100.0/s  current 99.5/s")
+	require.Contains(t, frame, "started 9000  completed 8991  failed 2  in flight 7")
+	require.Contains(t, frame, "99.9/s")
+	require.Contains(t, frame, "2.000s")
+	require.Contains(t, frame, "10:01:00  wf-42  workflow timed out after 1m")
+	require.Contains(t, frame, "INFO  Benchmark duration completed")
+	require.Contains(t, frame, "  plain")
+	for _, line := range strings.Split(frame, "\r\n") {
+		line = strings.TrimPrefix(strings.TrimSuffix(line, clearLine), home)
+		require.LessOrEqual(t, len([]rune(line)), 80)
+	}
+}
+
+func TestViewStartStop(t *testing.T) {
+	var out bytes.Buffer
+	v := New(&out, runner.NewProgress(), WithInterval(time.Millisecond))
+	v.Start()
+	time.Sleep(20 * time.Millisecond)
+	v.Stop()
+	v.Stop()
+	require.True(t, strings.HasPrefix(out.String(), enterScreen))
+	require.True(t, strings.HasSuffix(out.String(), leaveScreen))
+
+	var nilView *View
+	nilView.Start()
+	nilView.Stop()
+}
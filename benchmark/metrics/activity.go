@@ -0,0 +1,112 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SDK activity latency histograms as exported by SDKMetricsHandler, labelled
+// by namespace and activity_type, with bounds in seconds.
+const (
+	activityExecutionLatencyMetric       = "temporal_activity_execution_latency_seconds"
+	activityScheduleToStartLatencyMetric = "temporal_activity_schedule_to_start_latency_seconds"
+)
+
+// ActivitySnapshot holds the SDK activity latency histograms of a
+// namespace's workers at one point in time, by activity type.
+type ActivitySnapshot struct {
+	execution       map[string]*histogramBuckets
+	scheduleToStart map[string]*histogramBuckets
+}
+
+// ActivityTypeLatency is the latency of one activity type over an interval,
+// in milliseconds. Percentiles are interpolated within histogram buckets.
+type ActivityTypeLatency struct {
+	ActivityType string
+	Executions   int64 // Activity executions that completed, failed or timed out
+
+	ExecutionP50, ExecutionP95, ExecutionP99                   float64
+	ScheduleToStartP50, ScheduleToStartP95, ScheduleToStartP99 float64
+}
+
+// ActivityLatencies reads the activity latency histograms of the workers
+// polling namespace from the SDK metrics in g.
+func ActivityLatencies(g prometheus.Gatherer, namespace string) (ActivitySnapshot, error) {
+	families, err := g.Gather()
+	if err != nil {
+		return ActivitySnapshot{}, err
+	}
+
+	s := ActivitySnapshot{
+		execution:       make(map[string]*histogramBuckets),
+		scheduleToStart: make(map[string]*histogramBuckets),
+	}
+	for _, mf := range families {
+		var byType map[string]*histogramBuckets
+		switch mf.GetName() {
+		case activityExecutionLatencyMetric:
+			byType = s.execution
+		case activityScheduleToStartLatencyMetric:
+			byType = s.scheduleToStart
+		default:
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			var ns, activityType string
+			for _, label := range m.GetLabel() {
+				switch label.GetName() {
+				case "namespace":
+					ns = label.GetValue()
+				case "activity_type":
+					activityType = label.GetValue()
+				}
+			}
+			if ns != namespace || activityType == "" {
+				continue
+			}
+
+			h, exists := byType[activityType]
+			if !exists {
+				h = &histogramBuckets{counts: make(map[float64]float64)}
+				byType[activityType] = h
+			}
+			h.total += float64(m.GetHistogram().GetSampleCount())
+			for _, b := range m.GetHistogram().GetBucket() {
+				h.counts[b.GetUpperBound()*1000] += float64(b.GetCumulativeCount())
+			}
+		}
+	}
+	return s, nil
+}
+
+// ActivityLatencyDelta returns the latency of each activity type executed
+// between two snapshots, sorted by activity type. Types without executions in
+// the interval are omitted.
+func ActivityLatencyDelta(before, after ActivitySnapshot) []ActivityTypeLatency {
+	var latencies []ActivityTypeLatency
+	for activityType, end := range after.execution {
+		execution := end.since(before.execution[activityType])
+		if execution.total <= 0 {
+			continue
+		}
+		l := ActivityTypeLatency{
+			ActivityType: activityType,
+			Executions:   int64(execution.total),
+			ExecutionP50: execution.quantile(0.50),
+			ExecutionP95: execution.quantile(0.95),
+			ExecutionP99: execution.quantile(0.99),
+		}
+		if end, ok := after.scheduleToStart[activityType]; ok {
+			if scheduleToStart := end.since(before.scheduleToStart[activityType]); scheduleToStart.total > 0 {
+				l.ScheduleToStartP50 = scheduleToStart.quantile(0.50)
+				l.ScheduleToStartP95 = scheduleToStart.quantile(0.95)
+				l.ScheduleToStartP99 = scheduleToStart.quantile(0.99)
+			}
+		}
+		latencies = append(latencies, l)
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i].ActivityType < latencies[j].ActivityType })
+	return latencies
+}
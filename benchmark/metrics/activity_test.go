@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityLatencyDelta(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	activity := func(namespace, activityType string) prometheus.Labels {
+		return prometheus.Labels{"namespace": namespace, "task_queue": "benchmark", "activity_type": activityType}
+	}
+	record := func(labels prometheus.Labels, scheduleToStart, execution time.Duration, n int) {
+		h := handler.WithTags(labels)
+		for i := 0; i < n; i++ {
+			h.Timer("temporal_activity_schedule_to_start_latency").Record(scheduleToStart)
+			h.Timer("temporal_activity_execution_latency").Record(execution)
+		}
+	}
+
+	// Executions before the run are excluded
+	record(activity("bench", "NoOpActivity"), time.Second, time.Second, 50)
+	before, err := ActivityLatencies(registry, "bench")
+	require.NoError(t, err)
+
+	record(activity("bench", "NoOpActivity"), 3*time.Millisecond, 1500*time.Microsecond, 100)
+	record(activity("bench", "FastActivity"), 3*time.Millisecond, 6*time.Millisecond, 10)
+	// Another namespace's workers are ignored
+	record(activity("other", "SlowActivity"), time.Second, time.Second, 10)
+
+	after, err := ActivityLatencies(registry, "bench")
+	require.NoError(t, err)
+	latencies := ActivityLatencyDelta(before, after)
+	require.Len(t, latencies, 2)
+
+	fast, noop := latencies[0], latencies[1]
+	require.Equal(t, "FastActivity", fast.ActivityType)
+	require.Equal(t, int64(10), fast.Executions)
+	require.Equal(t, "NoOpActivity", noop.ActivityType)
+	require.Equal(t, int64(100), noop.Executions)
+
+	// Default buckets double from 1ms, so observations interpolate within (1ms, 2ms], (2ms, 4ms] and (4ms, 8ms]
+	require.Greater(t, noop.ExecutionP50, 1.0)
+	require.LessOrEqual(t, noop.ExecutionP99, 2.0)
+	require.Greater(t, fast.ExecutionP50, 4.0)
+	require.LessOrEqual(t, fast.ExecutionP99, 8.0)
+	require.Greater(t, noop.ScheduleToStartP50, 2.0)
+	require.LessOrEqual(t, noop.ScheduleToStartP99, 4.0)
+}
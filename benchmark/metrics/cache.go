@@ -0,0 +1,66 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SDK workflow (sticky) cache counters as exported by SDKMetricsHandler,
+// labelled by namespace.
+const (
+	stickyCacheHitMetric      = "temporal_sticky_cache_hit_total"
+	stickyCacheMissMetric     = "temporal_sticky_cache_miss_total"
+	stickyCacheEvictionMetric = "temporal_sticky_cache_total_forced_eviction_total"
+)
+
+// WorkflowCacheCounts are the SDK workflow cache counters of a namespace's
+// workers. A hit is a workflow task served from cached state; a miss replays
+// the workflow's history; a forced eviction drops a running workflow from a
+// full cache, so its next task misses.
+type WorkflowCacheCounts struct {
+	Hits            int64
+	Misses          int64
+	ForcedEvictions int64
+}
+
+// Sub returns the counts accumulated since before.
+func (c WorkflowCacheCounts) Sub(before WorkflowCacheCounts) WorkflowCacheCounts {
+	return WorkflowCacheCounts{
+		Hits:            c.Hits - before.Hits,
+		Misses:          c.Misses - before.Misses,
+		ForcedEvictions: c.ForcedEvictions - before.ForcedEvictions,
+	}
+}
+
+// WorkflowCacheCounters reads the workflow cache counters of the workers
+// polling namespace from the SDK metrics in g. Counters the SDK has not
+// incremented yet read as zero.
+func WorkflowCacheCounters(g prometheus.Gatherer, namespace string) (WorkflowCacheCounts, error) {
+	families, err := g.Gather()
+	if err != nil {
+		return WorkflowCacheCounts{}, err
+	}
+
+	var counts WorkflowCacheCounts
+	for _, mf := range families {
+		var count *int64
+		switch mf.GetName() {
+		case stickyCacheHitMetric:
+			count = &counts.Hits
+		case stickyCacheMissMetric:
+			count = &counts.Misses
+		case stickyCacheEvictionMetric:
+			count = &counts.ForcedEvictions
+		default:
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "namespace" && label.GetValue() == namespace {
+					*count += int64(m.GetCounter().GetValue())
+				}
+			}
+		}
+	}
+	return counts, nil
+}
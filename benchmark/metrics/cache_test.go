@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowCacheCounters(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	counts, err := WorkflowCacheCounters(registry, "bench")
+	require.NoError(t, err)
+	require.Equal(t, WorkflowCacheCounts{}, counts, "no cache counters reported yet")
+
+	bench := handler.WithTags(map[string]string{"namespace": "bench"})
+	bench.Counter("temporal_sticky_cache_hit").Inc(90)
+	bench.Counter("temporal_sticky_cache_miss").Inc(10)
+	bench.Counter("temporal_sticky_cache_total_forced_eviction").Inc(3)
+
+	// Another namespace's workers are ignored
+	other := handler.WithTags(map[string]string{"namespace": "other"})
+	other.Counter("temporal_sticky_cache_miss").Inc(500)
+
+	before, err := WorkflowCacheCounters(registry, "bench")
+	require.NoError(t, err)
+	require.Equal(t, WorkflowCacheCounts{Hits: 90, Misses: 10, ForcedEvictions: 3}, before)
+
+	bench.Counter("temporal_sticky_cache_miss").Inc(5)
+	after, err := WorkflowCacheCounters(registry, "bench")
+	require.NoError(t, err)
+	require.Equal(t, WorkflowCacheCounts{Misses: 5}, after.Sub(before))
+}
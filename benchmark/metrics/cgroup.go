@@ -0,0 +1,151 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// cgroupRoot is where the container's own cgroup is mounted in ECS tasks.
+const cgroupRoot = "/sys/fs/cgroup"
+
+// cgroupCollector exports the container's cgroup CPU and memory limits and
+// usage, read at scrape time, so an undersized benchmark task (CPU throttling,
+// memory near its limit) shows up as the cause of poor results rather than
+// being mistaken for server behaviour. Both cgroup v2 (unified) and v1
+// hierarchies are supported; values that can't be read are omitted, so the
+// collector exports nothing outside a container.
+type cgroupCollector struct {
+	root string
+
+	cpuLimit         *prometheus.Desc
+	cpuUsage         *prometheus.Desc
+	cpuPeriods       *prometheus.Desc
+	cpuThrottled     *prometheus.Desc
+	cpuThrottledTime *prometheus.Desc
+	memoryUsage      *prometheus.Desc
+	memoryLimit      *prometheus.Desc
+}
+
+// newCgroupCollector creates a collector reading the cgroup filesystem at root.
+func newCgroupCollector(root string) *cgroupCollector {
+	return &cgroupCollector{
+		root:             root,
+		cpuLimit:         prometheus.NewDesc("benchmark_container_cpu_limit_cores", "CPU limit of the benchmark container in cores (absent if unlimited)", nil, nil),
+		cpuUsage:         prometheus.NewDesc("benchmark_container_cpu_usage_seconds_total", "CPU time consumed by the benchmark container", nil, nil),
+		cpuPeriods:       prometheus.NewDesc("benchmark_container_cpu_periods_total", "CFS enforcement periods elapsed for the benchmark container", nil, nil),
+		cpuThrottled:     prometheus.NewDesc("benchmark_container_cpu_throttled_periods_total", "CFS enforcement periods in which the benchmark container was throttled", nil, nil),
+		cpuThrottledTime: prometheus.NewDesc("benchmark_container_cpu_throttled_seconds_total", "Time the benchmark container was throttled", nil, nil),
+		memoryUsage:      prometheus.NewDesc("benchmark_container_memory_usage_bytes", "Memory used by the benchmark container", nil, nil),
+		memoryLimit:      prometheus.NewDesc("benchmark_container_memory_limit_bytes", "Memory limit of the benchmark container (absent if unlimited)", nil, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *cgroupCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.cpuLimit
+	ch <- c.cpuUsage
+	ch <- c.cpuPeriods
+	ch <- c.cpuThrottled
+	ch <- c.cpuThrottledTime
+	ch <- c.memoryUsage
+	ch <- c.memoryLimit
+}
+
+// Collect implements prometheus.Collector.
+func (c *cgroupCollector) Collect(ch chan<- prometheus.Metric) {
+	emit := func(desc *prometheus.Desc, kind prometheus.ValueType, value float64, ok bool) {
+		if ok {
+			ch <- prometheus.MustNewConstMetric(desc, kind, value)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(c.root, "cgroup.controllers")); err == nil {
+		// cgroup v2: cpu.max is "<quota|max> <period>", cpu.stat is in microseconds
+		if fields := readFields(filepath.Join(c.root, "cpu.max")); len(fields) == 2 && fields[0] != "max" {
+			quota, err1 := strconv.ParseFloat(fields[0], 64)
+			period, err2 := strconv.ParseFloat(fields[1], 64)
+			emit(c.cpuLimit, prometheus.GaugeValue, quota/period, err1 == nil && err2 == nil && period > 0)
+		}
+		stat := readKeyValues(filepath.Join(c.root, "cpu.stat"))
+		emit(c.cpuUsage, prometheus.CounterValue, stat["usage_usec"]/1e6, has(stat, "usage_usec"))
+		emit(c.cpuPeriods, prometheus.CounterValue, stat["nr_periods"], has(stat, "nr_periods"))
+		emit(c.cpuThrottled, prometheus.CounterValue, stat["nr_throttled"], has(stat, "nr_throttled"))
+		emit(c.cpuThrottledTime, prometheus.CounterValue, stat["throttled_usec"]/1e6, has(stat, "throttled_usec"))
+
+		usage, ok := readNumber(filepath.Join(c.root, "memory.current"))
+		emit(c.memoryUsage, prometheus.GaugeValue, usage, ok)
+		limit, ok := readNumber(filepath.Join(c.root, "memory.max")) // "max" fails to parse
+		emit(c.memoryLimit, prometheus.GaugeValue, limit, ok)
+		return
+	}
+
+	// cgroup v1: quota of -1 means unlimited, throttled_time is in nanoseconds
+	quota, okQuota := readNumber(filepath.Join(c.root, "cpu", "cpu.cfs_quota_us"))
+	period, okPeriod := readNumber(filepath.Join(c.root, "cpu", "cpu.cfs_period_us"))
+	emit(c.cpuLimit, prometheus.GaugeValue, quota/period, okQuota && okPeriod && quota > 0 && period > 0)
+	usage, ok := readNumber(filepath.Join(c.root, "cpuacct", "cpuacct.usage"))
+	emit(c.cpuUsage, prometheus.CounterValue, usage/1e9, ok)
+	stat := readKeyValues(filepath.Join(c.root, "cpu", "cpu.stat"))
+	emit(c.cpuPeriods, prometheus.CounterValue, stat["nr_periods"], has(stat, "nr_periods"))
+	emit(c.cpuThrottled, prometheus.CounterValue, stat["nr_throttled"], has(stat, "nr_throttled"))
+	emit(c.cpuThrottledTime, prometheus.CounterValue, stat["throttled_time"]/1e9, has(stat, "throttled_time"))
+
+	memUsage, ok := readNumber(filepath.Join(c.root, "memory", "memory.usage_in_bytes"))
+	emit(c.memoryUsage, prometheus.GaugeValue, memUsage, ok)
+	// An unlimited v1 memory cgroup reports a huge page-aligned number
+	memLimit, ok := readNumber(filepath.Join(c.root, "memory", "memory.limit_in_bytes"))
+	emit(c.memoryLimit, prometheus.GaugeValue, memLimit, ok && memLimit < 1<<62)
+}
+
+// readFields returns the whitespace-separated fields of a one-line file.
+func readFields(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	return strings.Fields(string(data))
+}
+
+// readNumber reads a file containing a single number.
+func readNumber(path string) (float64, bool) {
+	fields := readFields(path)
+	if len(fields) != 1 {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(fields[0], 64)
+	return n, err == nil
+}
+
+// readKeyValues reads a file of "key value" lines such as cpu.stat.
+func readKeyValues(path string) map[string]float64 {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	values := make(map[string]float64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if n, err := strconv.ParseFloat(fields[1], 64); err == nil {
+			values[fields[0]] = n
+		}
+	}
+	return values
+}
+
+// has reports whether key was read from a key-value file.
+func has(values map[string]float64, key string) bool {
+	_, ok := values[key]
+	return ok
+}
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+// gatherCgroup collects from a cgroup collector rooted at root by metric name.
+func gatherCgroup(t *testing.T, root string) map[string]float64 {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(newCgroupCollector(root))
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	values := make(map[string]float64)
+	for _, mf := range families {
+		m := mf.GetMetric()[0]
+		if m.GetCounter() != nil {
+			values[mf.GetName()] = m.GetCounter().GetValue()
+		} else {
+			values[mf.GetName()] = m.GetGauge().GetValue()
+		}
+	}
+	return values
+}
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func TestCgroupCollector_V2(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"cgroup.controllers": "cpu memory\n",
+		"cpu.max":            "200000 100000\n",
+		"cpu.stat":           "usage_usec 5000000\nnr_periods 100\nnr_throttled 25\nthrottled_usec 1500000\n",
+		"memory.current":     "1048576\n",
+		"memory.max":         "max\n",
+	})
+
+	values := gatherCgroup(t, root)
+	require.Equal(t, 2.0, values["benchmark_container_cpu_limit_cores"])
+	require.Equal(t, 5.0, values["benchmark_container_cpu_usage_seconds_total"])
+	require.Equal(t, 100.0, values["benchmark_container_cpu_periods_total"])
+	require.Equal(t, 25.0, values["benchmark_container_cpu_throttled_periods_total"])
+	require.Equal(t, 1.5, values["benchmark_container_cpu_throttled_seconds_total"])
+	require.Equal(t, 1048576.0, values["benchmark_container_memory_usage_bytes"])
+
+	// An unlimited memory cgroup has no limit series
+	require.NotContains(t, values, "benchmark_container_memory_limit_bytes")
+}
+
+func TestCgroupCollector_V1(t *testing.T) {
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"cpu/cpu.cfs_quota_us":         "-1\n",
+		"cpu/cpu.cfs_period_us":        "100000\n",
+		"cpu/cpu.stat":                 "nr_periods 10\nnr_throttled 2\nthrottled_time 3000000000\n",
+		"cpuacct/cpuacct.usage":        "7000000000\n",
+		"memory/memory.usage_in_bytes": "2048\n",
+		"memory/memory.limit_in_bytes": "4096\n",
+	})
+
+	values := gatherCgroup(t, root)
+	require.NotContains(t, values, "benchmark_container_cpu_limit_cores")
+	require.Equal(t, 7.0, values["benchmark_container_cpu_usage_seconds_total"])
+	require.Equal(t, 2.0, values["benchmark_container_cpu_throttled_periods_total"])
+	require.Equal(t, 3.0, values["benchmark_container_cpu_throttled_seconds_total"])
+	require.Equal(t, 2048.0, values["benchmark_container_memory_usage_bytes"])
+	require.Equal(t, 4096.0, values["benchmark_container_memory_limit_bytes"])
+}
+
+func TestCgroupCollector_NoCgroup(t *testing.T) {
+	require.Empty(t, gatherCgroup(t, filepath.Join(t.TempDir(), "missing")))
+}
@@ -0,0 +1,82 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+)
+
+// HandlerOption configures a MetricsHandler.
+type HandlerOption func(*handler)
+
+// WithPrefix prepends prefix and an underscore to every exported metric name,
+// including SDK metrics registered on the handler's registry.
+func WithPrefix(prefix string) HandlerOption {
+	return func(h *handler) {
+		h.prefix = prefix
+	}
+}
+
+// WithConstLabels adds labels (e.g. scenario, run_id, role) to every exported
+// series, including SDK metrics registered on the handler's registry, so the
+// series of concurrent benchmark tasks can be told apart. A label a series
+// already carries is left unchanged.
+func WithConstLabels(labels map[string]string) HandlerOption {
+	return func(h *handler) {
+		h.constLabels = labels
+	}
+}
+
+// labeledGatherer applies the metric prefix and constant labels when metrics
+// are exported. Renaming at gather time rather than registration time leaves
+// the registry itself unchanged, so in-process readers (slot utilization,
+// backpressure, diagnostics) keep using the plain metric names.
+type labeledGatherer struct {
+	gatherer prometheus.Gatherer
+	prefix   string
+	labels   []*dto.LabelPair
+}
+
+// newLabeledGatherer wraps g, or returns it unchanged if there is nothing to apply.
+func newLabeledGatherer(g prometheus.Gatherer, prefix string, constLabels map[string]string) prometheus.Gatherer {
+	if prefix == "" && len(constLabels) == 0 {
+		return g
+	}
+	labels := make([]*dto.LabelPair, 0, len(constLabels))
+	for name, value := range constLabels {
+		labels = append(labels, &dto.LabelPair{Name: proto.String(name), Value: proto.String(value)})
+	}
+	return &labeledGatherer{gatherer: g, prefix: prefix, labels: labels}
+}
+
+func (g *labeledGatherer) Gather() ([]*dto.MetricFamily, error) {
+	families, err := g.gatherer.Gather()
+	for _, mf := range families {
+		if g.prefix != "" {
+			mf.Name = proto.String(g.prefix + "_" + mf.GetName())
+		}
+		for _, m := range mf.GetMetric() {
+			m.Label = withLabels(m.GetLabel(), g.labels)
+		}
+	}
+	return families, err
+}
+
+// withLabels adds the labels that pairs does not already have, keeping the
+// result sorted by name as the exposition format expects.
+func withLabels(pairs, labels []*dto.LabelPair) []*dto.LabelPair {
+	existing := make(map[string]bool, len(pairs))
+	for _, p := range pairs {
+		existing[p.GetName()] = true
+	}
+	for _, l := range labels {
+		if !existing[l.GetName()] {
+			pairs = append(pairs, l)
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].GetName() < pairs[j].GetName() })
+	return pairs
+}
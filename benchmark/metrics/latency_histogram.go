@@ -0,0 +1,100 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// Log-linear histogram layout: bucket i covers [min*growth^i, min*growth^(i+1))
+// milliseconds, so any value is represented within 1% of its true value.
+// Values below histogramMinMs fall into bucket 0 and values beyond the last
+// bucket into the last one (about 1.7 hours).
+const (
+	histogramMinMs       = 0.01
+	histogramGrowth      = 1.02
+	histogramBucketCount = 1024
+)
+
+// latencyHistogram is a fixed-size, lock-free latency histogram used once a
+// LatencyCollector exceeds its memory budget. Its memory use is constant
+// (about 8 KB) regardless of how many samples are recorded.
+type latencyHistogram struct {
+	counts [histogramBucketCount]atomic.Uint64
+	total  atomic.Uint64
+	maxMs  atomic.Uint64 // math.Float64bits of the largest sample; exact
+}
+
+// add records a sample in milliseconds.
+func (h *latencyHistogram) add(latencyMs float64) {
+	h.counts[histogramBucket(latencyMs)].Add(1)
+	h.total.Add(1)
+	for {
+		current := h.maxMs.Load()
+		if latencyMs <= math.Float64frombits(current) || h.maxMs.CompareAndSwap(current, math.Float64bits(latencyMs)) {
+			return
+		}
+	}
+}
+
+// count returns the number of recorded samples.
+func (h *latencyHistogram) count() int {
+	return int(h.total.Load())
+}
+
+// percentiles estimates percentiles from the bucket counts, using the same
+// rank as percentileFromSorted and each bucket's geometric midpoint. Max is exact.
+func (h *latencyHistogram) percentiles() LatencyPercentiles {
+	total := h.total.Load()
+	if total == 0 {
+		return LatencyPercentiles{}
+	}
+	maxMs := math.Float64frombits(h.maxMs.Load())
+
+	var counts [histogramBucketCount]uint64
+	for i := range h.counts {
+		counts[i] = h.counts[i].Load()
+	}
+	at := func(p float64) float64 {
+		rank := uint64(math.Floor((p / 100) * float64(total-1)))
+		var seen uint64
+		for i, c := range counts {
+			seen += c
+			if seen > rank {
+				return math.Min(histogramMidpoint(i), maxMs)
+			}
+		}
+		return maxMs
+	}
+
+	return LatencyPercentiles{
+		P50:         at(50),
+		P95:         at(95),
+		P99:         at(99),
+		Max:         maxMs,
+		Approximate: true,
+	}
+}
+
+// reset clears the histogram.
+func (h *latencyHistogram) reset() {
+	for i := range h.counts {
+		h.counts[i].Store(0)
+	}
+	h.total.Store(0)
+	h.maxMs.Store(0)
+}
+
+// histogramBucket returns the bucket index of a latency in milliseconds.
+func histogramBucket(latencyMs float64) int {
+	if latencyMs <= histogramMinMs {
+		return 0
+	}
+	i := int(math.Log(latencyMs/histogramMinMs) / math.Log(histogramGrowth))
+	return min(i, histogramBucketCount-1)
+}
+
+// histogramMidpoint returns the geometric midpoint of bucket i in milliseconds.
+func histogramMidpoint(i int) float64 {
+	return histogramMinMs * math.Pow(histogramGrowth, float64(i)+0.5)
+}
@@ -0,0 +1,423 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// MetricsHandler exposes Prometheus metrics.
+type MetricsHandler interface {
+	// ServeHTTP handles Prometheus scrape requests
+	http.Handler
+
+	// RecordWorkflowLatency records a workflow completion latency
+	RecordWorkflowLatency(duration time.Duration)
+
+	// RecordWorkflowResult records a workflow completion (success/failure)
+	RecordWorkflowResult(success bool)
+
+	// GetLatencyPercentiles returns p50, p95, p99, and max latencies in milliseconds
+	GetLatencyPercentiles() LatencyPercentiles
+
+	// GetThroughput returns the current throughput (completions per second)
+	GetThroughput() float64
+
+	// Registry returns the Prometheus registry for SDK metrics integration
+	Registry() *prometheus.Registry
+
+	// StartServer starts the HTTP server for metrics on the specified port.
+	// A port of 0 binds an ephemeral port; use Port to discover it.
+	StartServer(ctx context.Context, port int) error
+
+	// Port returns the port the metrics server is listening on, or 0 if not started
+	Port() int
+
+	// StopServer stops the HTTP server
+	StopServer(ctx context.Context) error
+
+	// ResetStartTime clears latency and throughput tracking for a new benchmark run
+	ResetStartTime()
+}
+
+// ExemplarRecorder is implemented by MetricsHandlers that can attach the
+// workflow and run IDs to a latency observation as an exemplar, so latency
+// spikes can be traced to specific workflow histories.
+type ExemplarRecorder interface {
+	RecordWorkflowLatencyExemplar(duration time.Duration, workflowID, runID string)
+}
+
+// RecordWorkflowLatency records a workflow latency on h, with an exemplar if
+// h implements ExemplarRecorder.
+func RecordWorkflowLatency(h MetricsHandler, duration time.Duration, workflowID, runID string) {
+	if r, ok := h.(ExemplarRecorder); ok {
+		r.RecordWorkflowLatencyExemplar(duration, workflowID, runID)
+		return
+	}
+	h.RecordWorkflowLatency(duration)
+}
+
+// DrainRecorder is implemented by MetricsHandlers that separate the drain of
+// in-flight workflows from the measurement window. After StartDrain,
+// completions are counted as drained and throughput covers only the window.
+type DrainRecorder interface {
+	StartDrain()
+}
+
+// StartDrain marks the end of the measurement window on h if h implements
+// DrainRecorder. ResetStartTime starts a new window.
+func StartDrain(h MetricsHandler) {
+	if r, ok := h.(DrainRecorder); ok {
+		r.StartDrain()
+	}
+}
+
+// LatencyBudgetSharer is implemented by MetricsHandlers whose raw latency
+// samples draw on a LatencyBudget (see WithLatencyMemoryBudget), so a run's
+// other latency collectors can share it.
+type LatencyBudgetSharer interface {
+	LatencyBudget() *LatencyBudget
+}
+
+// SharedLatencyBudget returns h's latency budget if h implements
+// LatencyBudgetSharer, and otherwise a new budget of budgetBytes.
+func SharedLatencyBudget(h MetricsHandler, budgetBytes int64) *LatencyBudget {
+	if s, ok := h.(LatencyBudgetSharer); ok {
+		return s.LatencyBudget()
+	}
+	return NewLatencyBudget(budgetBytes)
+}
+
+// LatencyPercentiles contains latency percentile values in milliseconds.
+type LatencyPercentiles struct {
+	P50 float64
+	P95 float64
+	P99 float64
+	Max float64
+
+	// Approximate is set when the percentiles were estimated from a histogram
+	// because raw samples exceeded the memory budget (Max is still exact)
+	Approximate bool
+}
+
+// handler implements MetricsHandler with Prometheus metrics.
+type handler struct {
+	registry        *prometheus.Registry
+	workflowLatency prometheus.Histogram
+	workflowsTotal  *prometheus.CounterVec
+	drainedTotal    *prometheus.CounterVec
+	draining        prometheus.Gauge
+	throughput      prometheus.Gauge
+	listenPort      prometheus.Gauge
+	httpHandler     http.Handler
+	server          *http.Server
+	port            int
+
+	// Export naming (see WithPrefix and WithConstLabels)
+	prefix      string
+	constLabels map[string]string
+
+	// Histogram bucket overrides (see WithHistogramBuckets)
+	buckets map[string][]float64
+
+	// Native histograms (see WithNativeHistograms)
+	nativeHistograms bool
+
+	// Series per metric family before warning (see WithCardinalityLimit)
+	cardinalityLimit int
+
+	// Clock for the throughput window (see WithTimeSource)
+	now func() time.Time
+
+	// Latency and throughput tracking, recorded concurrently by completion
+	// callbacks without a handler-wide lock
+	latencies      *LatencyCollector
+	latencyBudget  *LatencyBudget // Shared with the run's other collectors (nil = unlimited)
+	startTime      atomic.Int64   // Unix nanoseconds
+	drainStart     atomic.Int64   // Unix nanoseconds, 0 during the measurement window
+	completedCount atomic.Int64   // Successful completions in the measurement window
+}
+
+// NewHandler creates a new MetricsHandler with Prometheus metrics.
+func NewHandler(opts ...HandlerOption) MetricsHandler {
+	h := &handler{
+		registry:  prometheus.NewRegistry(),
+		latencies: NewLatencyCollector(10000),
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	h.startTime.Store(h.now().UnixNano())
+
+	h.workflowLatency = prometheus.NewHistogram(nativeHistogramOpts(prometheus.HistogramOpts{
+		Name:    "benchmark_workflow_latency_seconds",
+		Help:    "Workflow completion latency in seconds",
+		Buckets: WorkflowLatencyBuckets(h.buckets),
+	}, h.nativeHistograms))
+
+	// Counter for workflow results (success/failure)
+	h.workflowsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchmark_workflows_total",
+		Help: "Total number of workflows by result",
+	}, []string{"result"})
+
+	// Workflows finishing after generation stopped, which don't count toward throughput
+	h.drainedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "benchmark_drained_workflows_total",
+		Help: "Workflows that finished during the drain after generation stopped, by result",
+	}, []string{"result"})
+	h.draining = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "benchmark_draining",
+		Help: "1 while in-flight workflows are drained after generation stopped",
+	})
+
+	// Gauge for current throughput
+	h.throughput = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "benchmark_throughput_per_second",
+		Help: "Current workflow throughput (completions per second)",
+	})
+
+	// Gauge exposing the bound metrics port (useful when binding port 0)
+	h.listenPort = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "benchmark_metrics_listen_port",
+		Help: "TCP port the benchmark metrics server is listening on",
+	})
+
+	h.registry.MustRegister(h.workflowLatency)
+	h.registry.MustRegister(h.workflowsTotal)
+	h.registry.MustRegister(h.drainedTotal)
+	h.registry.MustRegister(h.draining)
+	h.registry.MustRegister(h.throughput)
+	h.registry.MustRegister(h.listenPort)
+	h.registry.MustRegister(newCgroupCollector(cgroupRoot))
+
+	// Scrapes measure their own duration and the registry's size
+	stats := newScrapeStats(h.registry, h.cardinalityLimit)
+	stats.register(h.registry)
+
+	// Latency exemplars are only exposed in the OpenMetrics format
+	gatherer := newLabeledGatherer(stats, h.prefix, h.constLabels)
+	h.httpHandler = stats.instrument(promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{EnableOpenMetrics: true}))
+	return h
+}
+
+// WorkflowLatencyBuckets returns the workflow latency histogram's bucket
+// upper bounds in seconds: the config.HistogramWorkflowLatency override in
+// buckets, if any, otherwise 1ms to ~500s.
+func WorkflowLatencyBuckets(buckets map[string][]float64) []float64 {
+	if b, ok := buckets[config.HistogramWorkflowLatency]; ok {
+		return b
+	}
+	// Buckets: 1ms, 2ms, 4ms, 8ms, 16ms, 32ms, 64ms, 128ms, 256ms, 512ms, 1s, 2s, 4s, 8s, 16s, 32s, 64s, 128s, 256s, 512s
+	return prometheus.ExponentialBuckets(0.001, 2, 20)
+}
+
+// WithHistogramBuckets overrides histogram bucket upper bounds (in seconds)
+// by family; the handler uses config.HistogramWorkflowLatency. Pass the same
+// map to SDKMetricsHandler via WithSDKHistogramBuckets for the SDK families.
+func WithHistogramBuckets(buckets map[string][]float64) HandlerOption {
+	return func(h *handler) {
+		h.buckets = buckets
+	}
+}
+
+// WithNativeHistograms also exposes the workflow latency histogram as a
+// Prometheus native histogram (requires Prometheus 2.40+ with native
+// histograms enabled). Pass WithSDKNativeHistograms for the SDK histograms.
+func WithNativeHistograms(enabled bool) HandlerOption {
+	return func(h *handler) {
+		h.nativeHistograms = enabled
+	}
+}
+
+// WithTimeSource sets the clock the throughput window is measured with, e.g.
+// to replay a recorded run in its own timestamps.
+func WithTimeSource(now func() time.Time) HandlerOption {
+	return func(h *handler) {
+		h.now = now
+	}
+}
+
+// WithLatencyMemoryBudget limits the memory used for raw latency samples
+// (0 = unlimited); beyond it percentiles are estimated from a histogram. The
+// budget covers the handler's samples and those of every collector sharing
+// it (see SharedLatencyBudget).
+func WithLatencyMemoryBudget(budgetBytes int64) HandlerOption {
+	return func(h *handler) {
+		h.latencyBudget = NewLatencyBudget(budgetBytes)
+		h.latencies.ShareMemoryBudget(h.latencyBudget)
+	}
+}
+
+// LatencyBudget returns the budget the handler's raw latency samples draw on.
+func (h *handler) LatencyBudget() *LatencyBudget {
+	return h.latencyBudget
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.httpHandler.ServeHTTP(w, r)
+}
+
+func (h *handler) RecordWorkflowLatency(duration time.Duration) {
+	h.RecordWorkflowLatencyExemplar(duration, "", "")
+}
+
+// RecordWorkflowLatencyExemplar records a workflow completion latency with the
+// workflow and run IDs as an exemplar; either may be empty.
+func (h *handler) RecordWorkflowLatencyExemplar(duration time.Duration, workflowID, runID string) {
+	latencySeconds := duration.Seconds()
+	if exemplar := latencyExemplar(workflowID, runID); exemplar != nil {
+		h.workflowLatency.(prometheus.ExemplarObserver).ObserveWithExemplar(latencySeconds, exemplar)
+	} else {
+		h.workflowLatency.Observe(latencySeconds)
+	}
+
+	// Store latency for percentile calculation
+	h.latencies.Add(latencySeconds * 1000) // Store in milliseconds
+}
+
+// latencyExemplar builds the exemplar labels for a workflow observation.
+// Exemplar labels are limited to prometheus.ExemplarMaxRunes, so the run ID is
+// dropped if both IDs don't fit, and nil is returned if the workflow ID alone
+// doesn't fit (ObserveWithExemplar panics on oversized exemplars).
+func latencyExemplar(workflowID, runID string) prometheus.Labels {
+	if workflowID == "" {
+		return nil
+	}
+	labels := prometheus.Labels{"workflow_id": workflowID}
+	if runID != "" {
+		labels["run_id"] = runID
+	}
+	if exemplarRunes(labels) > prometheus.ExemplarMaxRunes {
+		delete(labels, "run_id")
+	}
+	if exemplarRunes(labels) > prometheus.ExemplarMaxRunes {
+		return nil
+	}
+	return labels
+}
+
+// exemplarRunes counts the runes of exemplar label names and values, the
+// quantity the client library limits.
+func exemplarRunes(labels prometheus.Labels) int {
+	runes := 0
+	for name, value := range labels {
+		runes += utf8.RuneCountInString(name) + utf8.RuneCountInString(value)
+	}
+	return runes
+}
+
+func (h *handler) RecordWorkflowResult(success bool) {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	h.workflowsTotal.WithLabelValues(result).Inc()
+
+	if h.drainStart.Load() != 0 {
+		h.drainedTotal.WithLabelValues(result).Inc()
+		return
+	}
+	if success {
+		h.completedCount.Add(1)
+		h.throughput.Set(h.GetThroughput())
+	}
+}
+
+// StartDrain ends the measurement window: later results are counted as
+// drained, and throughput stays at its value for the window.
+func (h *handler) StartDrain() {
+	if h.drainStart.CompareAndSwap(0, h.now().UnixNano()) {
+		h.draining.Set(1)
+		h.throughput.Set(h.GetThroughput())
+	}
+}
+
+// GetLatencyPercentiles calculates and returns p50, p95, p99, and max latencies.
+func (h *handler) GetLatencyPercentiles() LatencyPercentiles {
+	return h.latencies.Percentiles()
+}
+
+// GetThroughput returns the throughput (completions per second) over the
+// measurement window, which ends when the drain starts.
+func (h *handler) GetThroughput() float64 {
+	end := h.now()
+	if drainStart := h.drainStart.Load(); drainStart != 0 {
+		end = time.Unix(0, drainStart)
+	}
+	elapsed := end.Sub(time.Unix(0, h.startTime.Load())).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(h.completedCount.Load()) / elapsed
+}
+
+// Registry returns the Prometheus registry for SDK metrics integration.
+func (h *handler) Registry() *prometheus.Registry {
+	return h.registry
+}
+
+// StartServer starts the HTTP server for metrics on the specified port.
+// The listener is bound synchronously so port conflicts are reported to the caller
+// and an ephemeral port (0) is resolved before returning.
+func (h *handler) StartServer(ctx context.Context, port int) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", h)
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to bind metrics port %d: %w", port, err)
+	}
+
+	h.port = listener.Addr().(*net.TCPAddr).Port
+	h.listenPort.Set(float64(h.port))
+	h.server = &http.Server{
+		Handler: mux,
+	}
+
+	slog.Info("Starting metrics server", "requested_port", port, "port", h.port)
+	go func() {
+		if err := h.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			slog.Error("Metrics server error", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// Port returns the port the metrics server is listening on, or 0 if not started.
+func (h *handler) Port() int {
+	return h.port
+}
+
+// StopServer stops the HTTP server.
+func (h *handler) StopServer(ctx context.Context) error {
+	if h.server == nil {
+		return nil
+	}
+
+	slog.Info("Stopping metrics server")
+	return h.server.Shutdown(ctx)
+}
+
+// ResetStartTime resets the start time for throughput calculation.
+// Call this when starting a new benchmark run.
+func (h *handler) ResetStartTime() {
+	h.startTime.Store(h.now().UnixNano())
+	h.drainStart.Store(0)
+	h.draining.Set(0)
+	h.completedCount.Store(0)
+	h.latencies.Reset()
+}
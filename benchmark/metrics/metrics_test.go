@@ -0,0 +1,275 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_StartServer_EphemeralPort(t *testing.T) {
+	h := NewHandler()
+	require.Equal(t, 0, h.Port())
+
+	require.NoError(t, h.StartServer(context.Background(), 0))
+	defer h.StopServer(context.Background())
+
+	// Port 0 should resolve to a concrete ephemeral port
+	port := h.Port()
+	require.Greater(t, port, 0)
+
+	resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/metrics", port))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	// The bound port is exported as a gauge
+	require.True(t, strings.Contains(string(body), fmt.Sprintf("benchmark_metrics_listen_port %d", port)))
+}
+
+func TestHandler_StartServer_PortConflict(t *testing.T) {
+	first := NewHandler()
+	require.NoError(t, first.StartServer(context.Background(), 0))
+	defer first.StopServer(context.Background())
+
+	// Binding the same port again must fail synchronously
+	second := NewHandler()
+	err := second.StartServer(context.Background(), first.Port())
+	require.Error(t, err)
+	require.Equal(t, 0, second.Port())
+}
+
+func TestHandler_RecordWorkflowLatency_Exemplar(t *testing.T) {
+	h := NewHandler()
+	RecordWorkflowLatency(h, 3*time.Millisecond, "bench-1", "run-1")
+
+	families, err := h.Registry().Gather()
+	require.NoError(t, err)
+
+	var exemplar *dto.Exemplar
+	for _, mf := range families {
+		if mf.GetName() != "benchmark_workflow_latency_seconds" {
+			continue
+		}
+		for _, b := range mf.GetMetric()[0].GetHistogram().GetBucket() {
+			if b.GetExemplar() != nil {
+				exemplar = b.GetExemplar()
+			}
+		}
+	}
+	require.NotNil(t, exemplar)
+	require.Equal(t, 0.003, exemplar.GetValue())
+
+	labels := make(map[string]string)
+	for _, l := range exemplar.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	require.Equal(t, map[string]string{"workflow_id": "bench-1", "run_id": "run-1"}, labels)
+}
+
+func TestLatencyExemplar_Limits(t *testing.T) {
+	require.Nil(t, latencyExemplar("", "run-1"))
+
+	// The run ID is dropped before the workflow ID when over the rune limit
+	longRunID := strings.Repeat("r", prometheus.ExemplarMaxRunes)
+	require.Equal(t, prometheus.Labels{"workflow_id": "bench-1"}, latencyExemplar("bench-1", longRunID))
+
+	require.Nil(t, latencyExemplar(strings.Repeat("w", 200), "run-1"))
+}
+
+func TestHandler_PrefixAndConstLabels(t *testing.T) {
+	h := NewHandler(
+		WithPrefix("team_a"),
+		WithConstLabels(map[string]string{"role": "generator", "result": "ignored"}),
+	)
+	h.RecordWorkflowResult(true)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	body := rec.Body.String()
+
+	// Existing labels win over constant labels of the same name
+	require.Contains(t, body, `team_a_benchmark_workflows_total{result="success",role="generator"} 1`)
+	require.Contains(t, body, `team_a_benchmark_metrics_listen_port{result="ignored",role="generator"} 0`)
+
+	// The registry itself keeps the plain names for in-process readers
+	families, err := h.Registry().Gather()
+	require.NoError(t, err)
+	for _, mf := range families {
+		require.False(t, strings.HasPrefix(mf.GetName(), "team_a_"))
+	}
+}
+
+func TestHandler_ConcurrentRecording(t *testing.T) {
+	h := NewHandler()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				RecordWorkflowLatency(h, time.Millisecond, "bench", "run")
+				h.RecordWorkflowResult(true)
+				h.GetLatencyPercentiles()
+				h.GetThroughput()
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 1.0, h.GetLatencyPercentiles().Max)
+	require.Greater(t, h.GetThroughput(), 0.0)
+
+	h.ResetStartTime()
+	require.Equal(t, LatencyPercentiles{}, h.GetLatencyPercentiles())
+}
+
+func TestHandler_StartDrain(t *testing.T) {
+	h := NewHandler()
+	h.RecordWorkflowResult(true)
+	StartDrain(h)
+	windowThroughput := h.GetThroughput()
+	require.Greater(t, windowThroughput, 0.0)
+
+	// Drained completions are counted separately and leave throughput alone
+	time.Sleep(10 * time.Millisecond)
+	h.RecordWorkflowResult(true)
+	h.RecordWorkflowResult(false)
+	require.Equal(t, windowThroughput, h.GetThroughput())
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+	require.Contains(t, body, `benchmark_workflows_total{result="success"} 2`)
+	require.Contains(t, body, `benchmark_drained_workflows_total{result="success"} 1`)
+	require.Contains(t, body, `benchmark_drained_workflows_total{result="failure"} 1`)
+	require.Contains(t, body, "benchmark_draining 1")
+
+	// A new run starts a new measurement window
+	h.ResetStartTime()
+	h.RecordWorkflowResult(true)
+	require.Greater(t, h.GetThroughput(), 0.0)
+	require.NotEqual(t, windowThroughput, h.GetThroughput())
+}
+
+func TestHandler_TimeSource(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := NewHandler(WithTimeSource(func() time.Time { return now }))
+
+	for range 20 {
+		h.RecordWorkflowResult(true)
+	}
+	now = now.Add(10 * time.Second)
+	require.Equal(t, 2.0, h.GetThroughput())
+
+	StartDrain(h)
+	now = now.Add(time.Hour)
+	require.Equal(t, 2.0, h.GetThroughput())
+}
+
+func TestHandler_NativeHistograms(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		h := NewHandler(WithNativeHistograms(enabled))
+		h.RecordWorkflowLatency(5 * time.Millisecond)
+
+		families, err := h.Registry().Gather()
+		require.NoError(t, err)
+		for _, mf := range families {
+			if mf.GetName() != "benchmark_workflow_latency_seconds" {
+				continue
+			}
+			histogram := mf.GetMetric()[0].GetHistogram()
+			// Classic buckets are always kept; native spans only when enabled
+			require.NotEmpty(t, histogram.GetBucket())
+			require.Equal(t, enabled, len(histogram.GetPositiveSpan()) > 0)
+		}
+	}
+}
+
+func TestHandler_ScrapeStatsAndCardinalityGuard(t *testing.T) {
+	h := NewHandler(WithCardinalityLimit(3))
+	pollers := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_polls_total", Help: "Polls"}, []string{"poller"})
+	h.Registry().MustRegister(pollers)
+	for i := 0; i < 5; i++ {
+		pollers.WithLabelValues(fmt.Sprint(i)).Inc()
+	}
+
+	scrape := func() string {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		return rec.Body.String()
+	}
+	scrape()
+
+	// Self-metrics describe the previous scrape
+	body := scrape()
+	require.Contains(t, body, "benchmark_scrape_duration_seconds_count 1")
+	require.Contains(t, body, "benchmark_cardinality_limit_exceeded 1")
+	require.Regexp(t, `benchmark_registry_metric_families \d+`, body)
+
+	families, err := h.Registry().Gather()
+	require.NoError(t, err)
+	series := 0
+	for _, mf := range families {
+		series += len(mf.GetMetric())
+	}
+	require.Contains(t, body, fmt.Sprintf("benchmark_registry_series %d", series))
+}
+
+func TestHandler_SharedLatencyBudget(t *testing.T) {
+	const budgetSamples = 1000
+	h := NewHandler(WithLatencyMemoryBudget(budgetSamples * latencySampleBytes))
+	budget := SharedLatencyBudget(h, 0)
+	require.Equal(t, int64(budgetSamples*latencySampleBytes), budget.Bytes())
+
+	// Like a scenario run: each sample goes to the handler and its phase's
+	// tracker, and all phases of the run stay alive until it ends
+	phases := make([]*LatencyCollector, 4)
+	for i := range phases {
+		phases[i] = NewLatencyCollector(100)
+		phases[i].ShareMemoryBudget(budget)
+	}
+	var wg sync.WaitGroup
+	for i, phase := range phases {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < budgetSamples; j++ {
+				latency := time.Duration(i*budgetSamples+j) * time.Millisecond
+				h.RecordWorkflowLatency(latency)
+				phase.AddDuration(latency)
+				require.LessOrEqual(t, budget.RetainedBytes(), budget.Bytes())
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, budget.RetainedBytes(), budget.Bytes())
+	require.True(t, h.GetLatencyPercentiles().Approximate, "the handler alone outgrew the budget")
+	for _, phase := range phases {
+		require.Equal(t, budgetSamples, phase.Count(), "no sample lost to the switch")
+	}
+
+	// Starting a new run returns the handler's samples to the budget
+	h.ResetStartTime()
+	for _, phase := range phases {
+		phase.Reset()
+	}
+	require.Zero(t, budget.RetainedBytes())
+
+	// Without a shared budget on the handler, collectors get one of their own
+	require.Nil(t, SharedLatencyBudget(NewHandler(), 0))
+	require.Equal(t, int64(64), SharedLatencyBudget(struct{ MetricsHandler }{}, 64).Bytes())
+}
@@ -0,0 +1,29 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Native histogram settings: a bucket factor of 1.1 bounds the relative error
+// of server-side percentiles to about 5%, and the bucket count is capped so a
+// wide latency range widens the resolution instead of growing without bound.
+const (
+	nativeHistogramBucketFactor     = 1.1
+	nativeHistogramMaxBucketNumber  = 160
+	nativeHistogramMinResetDuration = time.Hour
+)
+
+// nativeHistogramOpts adds Prometheus native (sparse) histogram settings to
+// opts if enabled. The classic buckets are kept, so scrapers that don't
+// negotiate native histograms still see the same series.
+func nativeHistogramOpts(opts prometheus.HistogramOpts, enabled bool) prometheus.HistogramOpts {
+	if enabled {
+		opts.NativeHistogramBucketFactor = nativeHistogramBucketFactor
+		opts.NativeHistogramMaxBucketNumber = nativeHistogramMaxBucketNumber
+		opts.NativeHistogramMinResetDuration = nativeHistogramMinResetDuration
+	}
+	return opts
+}
@@ -0,0 +1,63 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/status"
+)
+
+// ServerOCCConflictMetric is the Temporal DSQL persistence counter of
+// transactions that hit an optimistic concurrency conflict.
+const ServerOCCConflictMetric = "dsql_tx_conflict_total"
+
+// occPatterns identify DSQL optimistic concurrency conflicts in error text
+// (lowercase). DSQL reports conflicts as SQLSTATE 40001 with codes OC000
+// (data conflict) and OC001 (schema conflict).
+var occPatterns = []string{
+	"sqlstate 40001",
+	"(oc000)",
+	"(oc001)",
+	"change conflicts with another transaction",
+	"schema has been updated by another transaction",
+	"could not serialize access",
+}
+
+// IsOCCConflict reports whether err was caused by a DSQL optimistic
+// concurrency conflict that the server surfaced to the client. It checks the
+// error chain and any gRPC status message and details.
+func IsOCCConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	texts := []string{err.Error()}
+	if st, ok := status.FromError(err); ok {
+		texts = append(texts, st.Message())
+		for _, detail := range st.Details() {
+			texts = append(texts, fmt.Sprint(detail))
+		}
+	}
+	// Temporal service errors carry their gRPC status without implementing GRPCStatus
+	var withStatus interface{ Status() *status.Status }
+	if errors.As(err, &withStatus) {
+		if st := withStatus.Status(); st != nil {
+			texts = append(texts, st.Message())
+			for _, detail := range st.Details() {
+				texts = append(texts, fmt.Sprint(detail))
+			}
+		}
+	}
+
+	for _, text := range texts {
+		text = strings.ToLower(text)
+		for _, pattern := range occPatterns {
+			if strings.Contains(text, pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
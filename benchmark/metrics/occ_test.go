@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsOCCConflict(t *testing.T) {
+	dsqlMessage := "ERROR: change conflicts with another transaction, please retry: (OC000) (SQLSTATE 40001)"
+
+	require.True(t, IsOCCConflict(errors.New(dsqlMessage)))
+	require.True(t, IsOCCConflict(fmt.Errorf("start workflow: %w", serviceerror.NewUnavailable(dsqlMessage))))
+	require.True(t, IsOCCConflict(status.Error(codes.Aborted, "could not serialize access due to concurrent update")))
+
+	require.False(t, IsOCCConflict(nil))
+	require.False(t, IsOCCConflict(errors.New("context deadline exceeded")))
+	require.False(t, IsOCCConflict(serviceerror.NewWorkflowExecutionAlreadyStarted("already started: benchmark-40001", "", "")))
+}
@@ -0,0 +1,291 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"log/slog"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// CalculatePercentiles computes p50, p95, p99, and max from a slice of latency values.
+// Input values should be in milliseconds. Returns LatencyPercentiles with values in milliseconds.
+// This function is exported for testing and direct use.
+func CalculatePercentiles(latencies []float64) LatencyPercentiles {
+	if len(latencies) == 0 {
+		return LatencyPercentiles{}
+	}
+
+	// Make a copy to avoid modifying the original slice
+	sorted := make([]float64, len(latencies))
+	copy(sorted, latencies)
+	sort.Float64s(sorted)
+
+	return LatencyPercentiles{
+		P50: percentileFromSorted(sorted, 50),
+		P95: percentileFromSorted(sorted, 95),
+		P99: percentileFromSorted(sorted, 99),
+		Max: sorted[len(sorted)-1],
+	}
+}
+
+// percentileFromSorted calculates the p-th percentile from a sorted slice.
+// Uses linear interpolation between data points for more accurate results.
+func percentileFromSorted(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+
+	// Use linear interpolation for percentile calculation
+	// This is the "exclusive" method (R6 in R terminology)
+	rank := (p / 100) * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	// Clamp to valid indices
+	if lower < 0 {
+		lower = 0
+	}
+	if upper >= len(sorted) {
+		upper = len(sorted) - 1
+	}
+
+	if lower == upper {
+		return sorted[lower]
+	}
+
+	// Linear interpolation between lower and upper values
+	weight := rank - float64(lower)
+	return sorted[lower]*(1-weight) + sorted[upper]*weight
+}
+
+// latencyShards is the number of independently locked sample buffers in a
+// LatencyCollector. Concurrent recorders are spread across them so completion
+// callbacks from many workflow goroutines rarely contend on the same lock.
+const latencyShards = 16
+
+// latencySampleBytes is the memory retained per raw latency sample.
+const latencySampleBytes = 8
+
+// LatencyBudget is a memory budget for raw latency samples shared by several
+// LatencyCollectors, e.g. a run's overall and per-phase latencies, which each
+// retain a copy of the same samples. Together the collectors retain at most
+// the budget: a collector whose next sample would exceed it switches to
+// histogram-only percentiles and returns its samples' share to the others.
+// A nil LatencyBudget is unlimited.
+type LatencyBudget struct {
+	maxSamples int64
+	samples    atomic.Int64 // Raw samples retained by all collectors
+}
+
+// NewLatencyBudget creates a budget of budgetBytes, or returns nil
+// (unlimited) if budgetBytes is not positive.
+func NewLatencyBudget(budgetBytes int64) *LatencyBudget {
+	if budgetBytes <= 0 {
+		return nil
+	}
+	return &LatencyBudget{maxSamples: max(budgetBytes/latencySampleBytes, 1)}
+}
+
+// Bytes returns the size of the budget (0 for unlimited).
+func (b *LatencyBudget) Bytes() int64 {
+	if b == nil {
+		return 0
+	}
+	return b.maxSamples * latencySampleBytes
+}
+
+// RetainedBytes returns the memory the collectors sharing b currently retain
+// for raw samples.
+func (b *LatencyBudget) RetainedBytes() int64 {
+	if b == nil {
+		return 0
+	}
+	return b.samples.Load() * latencySampleBytes
+}
+
+// reserve takes one sample from the budget, reporting false (and taking
+// nothing) if the budget is exhausted.
+func (b *LatencyBudget) reserve() bool {
+	if b == nil {
+		return true
+	}
+	if b.samples.Add(1) > b.maxSamples {
+		b.samples.Add(-1)
+		return false
+	}
+	return true
+}
+
+// release returns n samples to the budget.
+func (b *LatencyBudget) release(n int) {
+	if b != nil && n > 0 {
+		b.samples.Add(-int64(n))
+	}
+}
+
+// LatencyCollector collects latency samples and computes percentiles.
+// It is thread-safe and can be used concurrently.
+//
+// Raw samples are retained for exact percentiles until they exceed the memory
+// budget (see SetMemoryBudget and ShareMemoryBudget); the collector then
+// folds them into a fixed-size histogram and reports approximate percentiles
+// from then on.
+type LatencyCollector struct {
+	next   atomic.Uint64 // Round-robin shard selection
+	shards [latencyShards]latencyShard
+
+	budget        atomic.Pointer[LatencyBudget] // nil = unlimited
+	histogramOnly atomic.Bool
+	histogram     latencyHistogram
+}
+
+// latencyShard is one lock-protected sample buffer, padded to its own cache line.
+type latencyShard struct {
+	mu        sync.Mutex
+	latencies []float64
+	_         [32]byte
+}
+
+// NewLatencyCollector creates a new LatencyCollector with the given initial capacity.
+func NewLatencyCollector(capacity int) *LatencyCollector {
+	c := &LatencyCollector{}
+	perShard := (capacity + latencyShards - 1) / latencyShards
+	for i := range c.shards {
+		c.shards[i].latencies = make([]float64, 0, perShard)
+	}
+	return c
+}
+
+// SetMemoryBudget limits the memory used for raw samples to budgetBytes
+// (0 = unlimited), a budget of its own. Exceeding it switches the collector
+// to histogram-only mode. Set it before adding samples.
+func (c *LatencyCollector) SetMemoryBudget(budgetBytes int64) {
+	c.ShareMemoryBudget(NewLatencyBudget(budgetBytes))
+}
+
+// ShareMemoryBudget makes the collector's raw samples draw on budget, which
+// other collectors may share (nil = unlimited). Set it before adding samples.
+func (c *LatencyCollector) ShareMemoryBudget(budget *LatencyBudget) {
+	c.budget.Store(budget)
+}
+
+// Add adds a latency sample in milliseconds.
+func (c *LatencyCollector) Add(latencyMs float64) {
+	if c.histogramOnly.Load() {
+		c.histogram.add(latencyMs)
+		return
+	}
+
+	shard := &c.shards[c.next.Add(1)%latencyShards]
+	shard.mu.Lock()
+	// Re-check under the lock: a concurrent switch drains each shard while
+	// holding its lock, so samples added after the drain must not be appended
+	if c.histogramOnly.Load() {
+		shard.mu.Unlock()
+		c.histogram.add(latencyMs)
+		return
+	}
+	// Reserved under the shard lock, so the switch releases exactly the
+	// samples it drains
+	if !c.budget.Load().reserve() {
+		shard.mu.Unlock()
+		c.switchToHistogram()
+		c.histogram.add(latencyMs)
+		return
+	}
+	shard.latencies = append(shard.latencies, latencyMs)
+	shard.mu.Unlock()
+}
+
+// switchToHistogram folds the retained raw samples into the histogram and
+// releases their memory to the budget. Only the first caller does the work.
+func (c *LatencyCollector) switchToHistogram() {
+	if !c.histogramOnly.CompareAndSwap(false, true) {
+		return
+	}
+	budget := c.budget.Load()
+	for i := range c.shards {
+		shard := &c.shards[i]
+		shard.mu.Lock()
+		for _, latencyMs := range shard.latencies {
+			c.histogram.add(latencyMs)
+		}
+		budget.release(len(shard.latencies))
+		shard.latencies = nil
+		shard.mu.Unlock()
+	}
+	slog.Warn("Latency samples exceeded memory budget, switching to histogram-only percentiles",
+		"budget_bytes", budget.Bytes(),
+		"samples", c.histogram.count())
+}
+
+// Approximate reports whether the collector is in histogram-only mode.
+func (c *LatencyCollector) Approximate() bool {
+	return c.histogramOnly.Load()
+}
+
+// AddDuration adds a latency sample from a time.Duration.
+func (c *LatencyCollector) AddDuration(d interface{ Milliseconds() int64 }) {
+	c.Add(float64(d.Milliseconds()))
+}
+
+// Count returns the number of samples collected.
+func (c *LatencyCollector) Count() int {
+	if c.histogramOnly.Load() {
+		return c.histogram.count()
+	}
+	count := 0
+	for i := range c.shards {
+		shard := &c.shards[i]
+		shard.mu.Lock()
+		count += len(shard.latencies)
+		shard.mu.Unlock()
+	}
+	return count
+}
+
+// Percentiles computes and returns the latency percentiles.
+func (c *LatencyCollector) Percentiles() LatencyPercentiles {
+	if c.histogramOnly.Load() {
+		return c.histogram.percentiles()
+	}
+	return CalculatePercentiles(c.snapshot())
+}
+
+// snapshot copies the samples of all shards. Samples added concurrently may
+// or may not be included.
+func (c *LatencyCollector) snapshot() []float64 {
+	var all []float64
+	for i := range c.shards {
+		shard := &c.shards[i]
+		shard.mu.Lock()
+		all = append(all, shard.latencies...)
+		shard.mu.Unlock()
+	}
+	return all
+}
+
+// Reset clears all collected samples and returns to retaining raw samples.
+func (c *LatencyCollector) Reset() {
+	for i := range c.shards {
+		shard := &c.shards[i]
+		shard.mu.Lock()
+		c.budget.Load().release(len(shard.latencies))
+		shard.latencies = shard.latencies[:0]
+		shard.mu.Unlock()
+	}
+	c.histogram.reset()
+	c.histogramOnly.Store(false)
+}
+
+// ValidatePercentileOrdering checks that percentiles are in the correct order.
+// Returns true if p50 <= p95 <= p99 <= max.
+// This is Property 7 from the design document.
+func ValidatePercentileOrdering(p LatencyPercentiles) bool {
+	return p.P50 <= p.P95 && p.P95 <= p.P99 && p.P99 <= p.Max
+}
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -147,3 +148,54 @@ func TestLatencyCollector_Reset(t *testing.T) {
 	percentiles := collector.Percentiles()
 	require.Equal(t, LatencyPercentiles{}, percentiles)
 }
+
+func TestLatencyCollector_Concurrent(t *testing.T) {
+	collector := NewLatencyCollector(100)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 1; i <= 1000; i++ {
+				collector.Add(float64(i))
+				if i%100 == 0 {
+					collector.Percentiles()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 8000, collector.Count())
+	require.Equal(t, 1000.0, collector.Percentiles().Max)
+}
+
+func TestLatencyCollector_MemoryBudget(t *testing.T) {
+	collector := NewLatencyCollector(100)
+	collector.SetMemoryBudget(100 * latencySampleBytes)
+
+	for i := 1; i <= 100; i++ {
+		collector.Add(float64(i))
+	}
+	require.False(t, collector.Approximate())
+	exact := collector.Percentiles()
+
+	// Exceeding the budget folds the samples into the histogram
+	for i := 1; i <= 900; i++ {
+		collector.Add(float64(i%100 + 1))
+	}
+	require.True(t, collector.Approximate())
+	require.Equal(t, 1000, collector.Count())
+
+	approx := collector.Percentiles()
+	require.True(t, approx.Approximate)
+	require.Equal(t, 100.0, approx.Max)
+	require.InEpsilon(t, exact.P50, approx.P50, 0.02)
+	require.InEpsilon(t, exact.P99, approx.P99, 0.02)
+	require.True(t, ValidatePercentileOrdering(approx))
+
+	collector.Reset()
+	require.False(t, collector.Approximate())
+	require.Equal(t, 0, collector.Count())
+}
@@ -0,0 +1,137 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// SDK metrics without an explicit case in Counter/Gauge/Timer are not dropped:
+// they are registered on first use with the tags of that use as labels, so
+// metrics added by newer SDK versions are still exported. The label set is
+// fixed at first use; later tags outside it are ignored and missing ones are
+// exported as empty labels.
+
+// fallbackBuckets covers unknown timers from 1ms to ~500s.
+var fallbackBuckets = prometheus.ExponentialBuckets(0.001, 2, 20)
+
+// fallbackLabelNames returns the label names of a fallback metric, fixing them
+// from tags on first use. kind separates counters, gauges and timers of the
+// same SDK name.
+func (h *sdkMetrics) fallbackLabelNames(kind, name string, tags map[string]string) []string {
+	key := kind + ":" + name
+
+	h.mu.RLock()
+	names, ok := h.fallbackLabels[key]
+	h.mu.RUnlock()
+	if ok {
+		return names
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if names, ok := h.fallbackLabels[key]; ok {
+		return names
+	}
+	names = make([]string, 0, len(tags))
+	for k := range tags {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	h.fallbackLabels[key] = names
+	return names
+}
+
+// fallbackLabelValues returns the tag values for names as Prometheus labels.
+func fallbackLabelValues(names []string, tags map[string]string) prometheus.Labels {
+	labels := make(prometheus.Labels, len(names))
+	for _, name := range names {
+		labels[sanitizeLabelName(name)] = tags[name]
+	}
+	return labels
+}
+
+// sanitizeLabelNames maps tag keys to valid Prometheus label names.
+func sanitizeLabelNames(names []string) []string {
+	sanitized := make([]string, len(names))
+	for i, name := range names {
+		sanitized[i] = sanitizeLabelName(name)
+	}
+	return sanitized
+}
+
+// sanitizeLabelName replaces characters Prometheus does not allow in label
+// names with underscores.
+func sanitizeLabelName(name string) string {
+	sanitized := strings.Map(func(r rune) rune {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+	if sanitized == "" || (sanitized[0] >= '0' && sanitized[0] <= '9') {
+		sanitized = "_" + sanitized
+	}
+	return sanitized
+}
+
+// fallbackCounterInc records an SDK counter that has no explicit case.
+func (h *sdkMetrics) fallbackCounterInc(name string, tags map[string]string, delta int64) {
+	names := h.fallbackLabelNames("counter", name, tags)
+	h.getOrCreateCounter(name, sanitizeLabelNames(names)).With(fallbackLabelValues(names, tags)).Add(float64(delta))
+}
+
+// fallbackGaugeUpdate records an SDK gauge that has no explicit case.
+func (h *sdkMetrics) fallbackGaugeUpdate(name string, tags map[string]string, value float64) {
+	names := h.fallbackLabelNames("gauge", name, tags)
+	h.getOrCreateGauge(name, sanitizeLabelNames(names)).With(fallbackLabelValues(names, tags)).Set(value)
+}
+
+// fallbackTimerRecord records an SDK timer that has no explicit case.
+func (h *sdkMetrics) fallbackTimerRecord(name string, tags map[string]string, d time.Duration) {
+	names := h.fallbackLabelNames("timer", name, tags)
+	h.getOrCreateHistogram(name, sanitizeLabelNames(names)).With(fallbackLabelValues(names, tags)).Observe(d.Seconds())
+}
+
+// getOrCreateHistogram returns an existing fallback histogram or creates a new one.
+func (h *sdkMetrics) getOrCreateHistogram(name string, labelNames []string) *prometheus.HistogramVec {
+	h.mu.RLock()
+	if histogram, ok := h.histograms[name]; ok {
+		h.mu.RUnlock()
+		return histogram
+	}
+	h.mu.RUnlock()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Double-check after acquiring write lock
+	if histogram, ok := h.histograms[name]; ok {
+		return histogram
+	}
+
+	histogram := prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
+		Name:    name + "_seconds",
+		Help:    "Temporal SDK timer: " + name,
+		Buckets: h.bucketsFor(name, config.HistogramSDKLongLatency, fallbackBuckets),
+	}, h.nativeHistograms), labelNames)
+
+	// Reuse a histogram registered on the same registry by another handler
+	if err := h.registry.Register(histogram); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(*prometheus.HistogramVec); ok {
+				histogram = existing
+			}
+		}
+	}
+
+	h.histograms[name] = histogram
+	return histogram
+}
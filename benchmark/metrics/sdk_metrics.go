@@ -2,11 +2,14 @@
 package metrics
 
 import (
+	"errors"
 	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
 )
 
 // SDKMetricsHandler returns a Temporal SDK metrics handler that captures
@@ -26,6 +29,7 @@ import (
 //   - temporal_local_activity_execution_failed
 //   - temporal_sticky_cache_hit
 //   - temporal_sticky_cache_miss
+//   - temporal_sticky_cache_total_forced_eviction
 //   - temporal_request
 //   - temporal_request_failure
 //   - temporal_long_request
@@ -49,14 +53,57 @@ import (
 //   - temporal_worker_task_slots_used
 //   - temporal_num_pollers
 //   - temporal_sticky_cache_size
-func SDKMetricsHandler(registry *prometheus.Registry) client.MetricsHandler {
-	return newPrometheusMetricsHandler(registry)
+//
+// Activity latencies are labelled by activity_type, so they can be broken
+// out per type (see ActivityLatencies).
+//
+// Any other SDK metric is registered on first use with its tags as labels
+// (counters as <name>_total, timers as <name>_seconds histograms).
+func SDKMetricsHandler(registry *prometheus.Registry, opts ...SDKOption) client.MetricsHandler {
+	return newPrometheusMetricsHandler(registry, opts...)
+}
+
+// SDKOption configures the SDK metrics handler.
+type SDKOption func(*sdkMetrics)
+
+// WithSDKHistogramBuckets overrides SDK histogram bucket upper bounds (in
+// seconds) by family (config.HistogramSDKLatency, config.HistogramSDKLongLatency)
+// or by SDK timer name, which takes precedence.
+func WithSDKHistogramBuckets(buckets map[string][]float64) SDKOption {
+	return func(h *sdkMetrics) {
+		h.buckets = buckets
+	}
 }
 
 // prometheusMetricsHandler implements client.MetricsHandler for Temporal SDK metrics.
+// Handlers derived with WithTags differ only in their tags and share one
+// sdkMetrics, so metrics created through any of them are registered once.
 type prometheusMetricsHandler struct {
+	*sdkMetrics
+	tags map[string]string
+}
+
+// WithSDKNativeHistograms also exposes the SDK latency histograms as
+// Prometheus native histograms (requires Prometheus 2.40+ with native
+// histograms enabled).
+func WithSDKNativeHistograms(enabled bool) SDKOption {
+	return func(h *sdkMetrics) {
+		h.nativeHistograms = enabled
+	}
+}
+
+// WithSDKNamespaceLabel rewrites the namespace tag of SDK metrics through
+// label before it becomes a label value, e.g. to map per-run namespaces to
+// one stable value so series don't accumulate across runs.
+func WithSDKNamespaceLabel(label func(namespace string) string) SDKOption {
+	return func(h *sdkMetrics) {
+		h.namespaceLabel = label
+	}
+}
+
+// sdkMetrics is the metric state shared by a handler and all its tagged copies.
+type sdkMetrics struct {
 	registry *prometheus.Registry
-	tags     map[string]string
 
 	// Mutex for thread-safe gauge/counter registration
 	mu sync.RWMutex
@@ -67,6 +114,19 @@ type prometheusMetricsHandler struct {
 	// Dynamic counter registry - counters are created on demand
 	counters map[string]*prometheus.CounterVec
 
+	// Histogram bucket overrides (see WithSDKHistogramBuckets)
+	buckets map[string][]float64
+
+	// Native histograms (see WithSDKNativeHistograms)
+	nativeHistograms bool
+
+	// Namespace label rewrite (see WithSDKNamespaceLabel)
+	namespaceLabel func(string) string
+
+	// Histograms and label sets of SDK metrics without an explicit case
+	histograms     map[string]*prometheus.HistogramVec
+	fallbackLabels map[string][]string
+
 	// Pre-registered histograms for latency metrics
 	requestLatency                      *prometheus.HistogramVec
 	longRequestLatency                  *prometheus.HistogramVec
@@ -82,12 +142,17 @@ type prometheusMetricsHandler struct {
 }
 
 // newPrometheusMetricsHandler creates a new Temporal SDK metrics handler.
-func newPrometheusMetricsHandler(registry *prometheus.Registry) client.MetricsHandler {
-	h := &prometheusMetricsHandler{
+func newPrometheusMetricsHandler(registry *prometheus.Registry, opts ...SDKOption) client.MetricsHandler {
+	h := &sdkMetrics{
 		registry: registry,
-		tags:     make(map[string]string),
 		gauges:   make(map[string]*prometheus.GaugeVec),
 		counters: make(map[string]*prometheus.CounterVec),
+
+		histograms:     make(map[string]*prometheus.HistogramVec),
+		fallbackLabels: make(map[string][]string),
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
 
 	// Standard latency buckets: 1ms to ~32s
@@ -96,74 +161,74 @@ func newPrometheusMetricsHandler(registry *prometheus.Registry) client.MetricsHa
 	extendedBuckets := prometheus.ExponentialBuckets(0.001, 2, 20)
 
 	// Request latencies
-	h.requestLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.requestLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_request_latency_seconds",
 		Help:    "Latency of Temporal API requests in seconds",
-		Buckets: latencyBuckets,
-	}, []string{"operation", "namespace"})
+		Buckets: h.bucketsFor("temporal_request_latency", config.HistogramSDKLatency, latencyBuckets),
+	}, h.nativeHistograms), []string{"operation", "namespace"})
 
-	h.longRequestLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.longRequestLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_long_request_latency_seconds",
 		Help:    "Latency of long-running Temporal API requests (polls) in seconds",
-		Buckets: extendedBuckets,
-	}, []string{"operation", "namespace"})
+		Buckets: h.bucketsFor("temporal_long_request_latency", config.HistogramSDKLongLatency, extendedBuckets),
+	}, h.nativeHistograms), []string{"operation", "namespace"})
 
 	// Workflow latencies
-	h.workflowEndToEndLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.workflowEndToEndLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_workflow_endtoend_latency_seconds",
 		Help:    "End-to-end workflow execution latency in seconds",
-		Buckets: extendedBuckets,
-	}, []string{"namespace", "workflow_type"})
+		Buckets: h.bucketsFor("temporal_workflow_endtoend_latency", config.HistogramSDKLongLatency, extendedBuckets),
+	}, h.nativeHistograms), []string{"namespace", "workflow_type"})
 
-	h.workflowTaskScheduleToStartLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.workflowTaskScheduleToStartLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_workflow_task_schedule_to_start_latency_seconds",
 		Help:    "Time from workflow task scheduling to start in seconds",
-		Buckets: latencyBuckets,
-	}, []string{"namespace", "task_queue"})
+		Buckets: h.bucketsFor("temporal_workflow_task_schedule_to_start_latency", config.HistogramSDKLatency, latencyBuckets),
+	}, h.nativeHistograms), []string{"namespace", "task_queue"})
 
-	h.workflowTaskExecutionLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.workflowTaskExecutionLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_workflow_task_execution_latency_seconds",
 		Help:    "Time to execute a workflow task in seconds",
-		Buckets: latencyBuckets,
-	}, []string{"namespace", "task_queue", "workflow_type"})
+		Buckets: h.bucketsFor("temporal_workflow_task_execution_latency", config.HistogramSDKLatency, latencyBuckets),
+	}, h.nativeHistograms), []string{"namespace", "task_queue", "workflow_type"})
 
-	h.workflowTaskReplayLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.workflowTaskReplayLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_workflow_task_replay_latency_seconds",
 		Help:    "Time to replay workflow history in seconds",
-		Buckets: latencyBuckets,
-	}, []string{"namespace", "task_queue", "workflow_type"})
+		Buckets: h.bucketsFor("temporal_workflow_task_replay_latency", config.HistogramSDKLatency, latencyBuckets),
+	}, h.nativeHistograms), []string{"namespace", "task_queue", "workflow_type"})
 
 	// Activity latencies
-	h.activityScheduleToStartLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.activityScheduleToStartLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_activity_schedule_to_start_latency_seconds",
 		Help:    "Time from activity scheduling to start in seconds",
-		Buckets: latencyBuckets,
-	}, []string{"namespace", "task_queue"})
+		Buckets: h.bucketsFor("temporal_activity_schedule_to_start_latency", config.HistogramSDKLatency, latencyBuckets),
+	}, h.nativeHistograms), []string{"namespace", "task_queue", "activity_type"})
 
-	h.activityExecutionLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.activityExecutionLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_activity_execution_latency_seconds",
 		Help:    "Time to execute an activity in seconds",
-		Buckets: latencyBuckets,
-	}, []string{"namespace", "task_queue", "activity_type"})
+		Buckets: h.bucketsFor("temporal_activity_execution_latency", config.HistogramSDKLatency, latencyBuckets),
+	}, h.nativeHistograms), []string{"namespace", "task_queue", "activity_type"})
 
-	h.activitySucceedEndToEndLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.activitySucceedEndToEndLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_activity_succeed_endtoend_latency_seconds",
 		Help:    "End-to-end latency of successful activities in seconds",
-		Buckets: extendedBuckets,
-	}, []string{"namespace", "task_queue", "activity_type"})
+		Buckets: h.bucketsFor("temporal_activity_succeed_endtoend_latency", config.HistogramSDKLongLatency, extendedBuckets),
+	}, h.nativeHistograms), []string{"namespace", "task_queue", "activity_type"})
 
 	// Local activity latencies
-	h.localActivityExecutionLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.localActivityExecutionLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_local_activity_execution_latency_seconds",
 		Help:    "Time to execute a local activity in seconds",
-		Buckets: latencyBuckets,
-	}, []string{"namespace", "task_queue", "activity_type"})
+		Buckets: h.bucketsFor("temporal_local_activity_execution_latency", config.HistogramSDKLatency, latencyBuckets),
+	}, h.nativeHistograms), []string{"namespace", "task_queue", "activity_type"})
 
-	h.localActivitySucceedEndToEndLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	h.localActivitySucceedEndToEndLatency = prometheus.NewHistogramVec(nativeHistogramOpts(prometheus.HistogramOpts{
 		Name:    "temporal_local_activity_succeed_endtoend_latency_seconds",
 		Help:    "End-to-end latency of successful local activities in seconds",
-		Buckets: latencyBuckets,
-	}, []string{"namespace", "task_queue", "activity_type"})
+		Buckets: h.bucketsFor("temporal_local_activity_succeed_endtoend_latency", config.HistogramSDKLatency, latencyBuckets),
+	}, h.nativeHistograms), []string{"namespace", "task_queue", "activity_type"})
 
 	// Register all histogram metrics
 	registry.MustRegister(h.requestLatency)
@@ -178,11 +243,23 @@ func newPrometheusMetricsHandler(registry *prometheus.Registry) client.MetricsHa
 	registry.MustRegister(h.localActivityExecutionLatency)
 	registry.MustRegister(h.localActivitySucceedEndToEndLatency)
 
-	return h
+	return &prometheusMetricsHandler{sdkMetrics: h, tags: make(map[string]string)}
+}
+
+// bucketsFor returns the buckets of the named SDK timer: an override for the
+// name, else one for its family, else def.
+func (h *sdkMetrics) bucketsFor(name, family string, def []float64) []float64 {
+	if b, ok := h.buckets[name]; ok {
+		return b
+	}
+	if b, ok := h.buckets[family]; ok {
+		return b
+	}
+	return def
 }
 
 // getOrCreateGauge returns an existing gauge or creates a new one.
-func (h *prometheusMetricsHandler) getOrCreateGauge(name string, labelNames []string) *prometheus.GaugeVec {
+func (h *sdkMetrics) getOrCreateGauge(name string, labelNames []string) *prometheus.GaugeVec {
 	h.mu.RLock()
 	if gauge, ok := h.gauges[name]; ok {
 		h.mu.RUnlock()
@@ -203,10 +280,13 @@ func (h *prometheusMetricsHandler) getOrCreateGauge(name string, labelNames []st
 		Help: "Temporal SDK gauge: " + name,
 	}, labelNames)
 
-	// Try to register, ignore if already registered
+	// Reuse a gauge registered on the same registry by another handler
 	if err := h.registry.Register(gauge); err != nil {
-		if existing, ok := h.gauges[name]; ok {
-			return existing
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(*prometheus.GaugeVec); ok {
+				gauge = existing
+			}
 		}
 	}
 
@@ -215,7 +295,7 @@ func (h *prometheusMetricsHandler) getOrCreateGauge(name string, labelNames []st
 }
 
 // getOrCreateCounter returns an existing counter or creates a new one.
-func (h *prometheusMetricsHandler) getOrCreateCounter(name string, labelNames []string) *prometheus.CounterVec {
+func (h *sdkMetrics) getOrCreateCounter(name string, labelNames []string) *prometheus.CounterVec {
 	h.mu.RLock()
 	if counter, ok := h.counters[name]; ok {
 		h.mu.RUnlock()
@@ -236,10 +316,13 @@ func (h *prometheusMetricsHandler) getOrCreateCounter(name string, labelNames []
 		Help: "Temporal SDK counter: " + name,
 	}, labelNames)
 
-	// Try to register, ignore if already registered
+	// Reuse a counter registered on the same registry by another handler
 	if err := h.registry.Register(counter); err != nil {
-		if existing, ok := h.counters[name]; ok {
-			return existing
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(*prometheus.CounterVec); ok {
+				counter = existing
+			}
 		}
 	}
 
@@ -256,25 +339,11 @@ func (h *prometheusMetricsHandler) WithTags(tags map[string]string) client.Metri
 	for k, v := range tags {
 		newTags[k] = v
 	}
-
-	return &prometheusMetricsHandler{
-		registry:                            h.registry,
-		tags:                                newTags,
-		gauges:                              h.gauges,
-		counters:                            h.counters,
-		mu:                                  sync.RWMutex{},
-		requestLatency:                      h.requestLatency,
-		longRequestLatency:                  h.longRequestLatency,
-		workflowEndToEndLatency:             h.workflowEndToEndLatency,
-		workflowTaskScheduleToStartLatency:  h.workflowTaskScheduleToStartLatency,
-		workflowTaskExecutionLatency:        h.workflowTaskExecutionLatency,
-		workflowTaskReplayLatency:           h.workflowTaskReplayLatency,
-		activityScheduleToStartLatency:      h.activityScheduleToStartLatency,
-		activityExecutionLatency:            h.activityExecutionLatency,
-		activitySucceedEndToEndLatency:      h.activitySucceedEndToEndLatency,
-		localActivityExecutionLatency:       h.localActivityExecutionLatency,
-		localActivitySucceedEndToEndLatency: h.localActivitySucceedEndToEndLatency,
+	if namespace, ok := tags["namespace"]; ok && h.namespaceLabel != nil {
+		newTags["namespace"] = h.namespaceLabel(namespace)
 	}
+
+	return &prometheusMetricsHandler{sdkMetrics: h.sdkMetrics, tags: newTags}
 }
 
 // Counter returns a counter for the given name.
@@ -347,6 +416,9 @@ func (c *prometheusCounter) Inc(delta int64) {
 	case "temporal_sticky_cache_miss":
 		counter := c.handler.getOrCreateCounter(c.name, []string{"namespace"})
 		counter.WithLabelValues(namespace).Add(float64(delta))
+	case "temporal_sticky_cache_total_forced_eviction":
+		counter := c.handler.getOrCreateCounter(c.name, []string{"namespace"})
+		counter.WithLabelValues(namespace).Add(float64(delta))
 
 	// Request counters
 	case "temporal_request":
@@ -363,6 +435,9 @@ func (c *prometheusCounter) Inc(delta int64) {
 		statusCode := c.getTag("status_code", "unknown")
 		counter := c.handler.getOrCreateCounter(c.name, []string{"namespace", "operation", "status_code"})
 		counter.WithLabelValues(namespace, operation, statusCode).Add(float64(delta))
+
+	default:
+		c.handler.fallbackCounterInc(c.name, c.tags, delta)
 	}
 }
 
@@ -399,6 +474,9 @@ func (g *prometheusGauge) Update(value float64) {
 	case "temporal_sticky_cache_size":
 		gauge := g.handler.getOrCreateGauge(g.name, []string{"namespace"})
 		gauge.WithLabelValues(namespace).Set(value)
+
+	default:
+		g.handler.fallbackGaugeUpdate(g.name, g.tags, value)
 	}
 }
 
@@ -443,7 +521,7 @@ func (t *prometheusTimer) Record(d time.Duration) {
 
 	// Activity latencies
 	case "temporal_activity_schedule_to_start_latency":
-		t.handler.activityScheduleToStartLatency.WithLabelValues(namespace, taskQueue).Observe(seconds)
+		t.handler.activityScheduleToStartLatency.WithLabelValues(namespace, taskQueue, activityType).Observe(seconds)
 	case "temporal_activity_execution_latency":
 		t.handler.activityExecutionLatency.WithLabelValues(namespace, taskQueue, activityType).Observe(seconds)
 	case "temporal_activity_succeed_endtoend_latency":
@@ -454,6 +532,9 @@ func (t *prometheusTimer) Record(d time.Duration) {
 		t.handler.localActivityExecutionLatency.WithLabelValues(namespace, taskQueue, activityType).Observe(seconds)
 	case "temporal_local_activity_succeed_endtoend_latency":
 		t.handler.localActivitySucceedEndToEndLatency.WithLabelValues(namespace, taskQueue, activityType).Observe(seconds)
+
+	default:
+		t.handler.fallbackTimerRecord(t.name, t.tags, d)
 	}
 }
 
@@ -0,0 +1,292 @@
+package metrics
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func TestSDKMetricsHandler(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+	require.NotNil(t, handler)
+}
+
+func TestSDKMetricsHandler_WithTags(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	// Create handler with tags
+	taggedHandler := handler.WithTags(map[string]string{
+		"namespace": "test-namespace",
+		"operation": "StartWorkflow",
+	})
+	require.NotNil(t, taggedHandler)
+
+	// Verify it's a different handler instance
+	require.NotSame(t, handler, taggedHandler)
+}
+
+func TestSDKMetricsHandler_NamespaceLabel(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry, WithSDKNamespaceLabel(func(namespace string) string {
+		if namespace == "benchmark-1" || namespace == "benchmark-2" {
+			return "steady"
+		}
+		return namespace
+	}))
+
+	for _, namespace := range []string{"benchmark-1", "benchmark-2", "control"} {
+		handler.WithTags(map[string]string{"namespace": namespace, "operation": "StartWorkflowExecution"}).
+			Timer("temporal_request_latency").Record(time.Millisecond)
+	}
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	counts := map[string]uint64{}
+	for _, mf := range families {
+		if mf.GetName() != "temporal_request_latency_seconds" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "namespace" {
+					counts[l.GetValue()] = m.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	require.Equal(t, map[string]uint64{"steady": 2, "control": 1}, counts)
+}
+
+func TestSDKMetricsHandler_Counter(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	// Get a counter
+	counter := handler.Counter("temporal_request_failure")
+	require.NotNil(t, counter)
+
+	// Increment should not panic
+	counter.Inc(1)
+}
+
+func TestSDKMetricsHandler_Timer(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	// Get a timer with tags
+	taggedHandler := handler.WithTags(map[string]string{
+		"namespace": "test-namespace",
+		"operation": "StartWorkflow",
+	})
+	timer := taggedHandler.Timer("temporal_request_latency")
+	require.NotNil(t, timer)
+
+	// Record should not panic
+	timer.Record(100 * time.Millisecond)
+}
+
+func TestSDKMetricsHandler_LongRequest(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	// Get a timer with tags
+	taggedHandler := handler.WithTags(map[string]string{
+		"namespace": "test-namespace",
+		"operation": "StartWorkflow",
+	})
+	timer := taggedHandler.Timer("temporal_request_latency")
+
+	// Record a long request (> 1 second)
+	timer.Record(2 * time.Second)
+}
+
+func TestSDKMetricsHandler_Gauge(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	// Get a gauge with tags (simulating worker_task_slots)
+	taggedHandler := handler.WithTags(map[string]string{
+		"worker_type": "WorkflowWorker",
+		"task_queue":  "benchmark-task-queue",
+	})
+	gauge := taggedHandler.Gauge("temporal_worker_task_slots_available")
+	require.NotNil(t, gauge)
+
+	// Update should not panic and should record the value
+	gauge.Update(42.0)
+}
+
+func TestSDKMetricsHandler_GaugeMultipleUpdates(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	// Get a gauge with tags
+	taggedHandler := handler.WithTags(map[string]string{
+		"worker_type": "ActivityWorker",
+		"task_queue":  "benchmark-task-queue",
+	})
+	gauge := taggedHandler.Gauge("temporal_worker_task_slots_used")
+	require.NotNil(t, gauge)
+
+	// Multiple updates should work
+	gauge.Update(10.0)
+	gauge.Update(20.0)
+	gauge.Update(15.0)
+}
+
+func TestSDKMetricsHandler_WorkflowTaskLatency(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	taggedHandler := handler.WithTags(map[string]string{
+		"namespace":  "test-namespace",
+		"task_queue": "test-queue",
+	})
+	timer := taggedHandler.Timer("workflow_task_schedule_to_start_latency")
+
+	// Record should not panic
+	timer.Record(50 * time.Millisecond)
+}
+
+func TestSDKMetricsHandler_ActivityTaskLatency(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	taggedHandler := handler.WithTags(map[string]string{
+		"namespace":  "test-namespace",
+		"task_queue": "test-queue",
+	})
+	timer := taggedHandler.Timer("activity_task_schedule_to_start_latency")
+
+	// Record should not panic
+	timer.Record(75 * time.Millisecond)
+}
+
+func TestSDKMetricsHandler_WorkflowEndToEndLatency(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	taggedHandler := handler.WithTags(map[string]string{
+		"namespace":     "test-namespace",
+		"workflow_type": "SimpleWorkflow",
+	})
+	timer := taggedHandler.Timer("workflow_endtoend_latency")
+
+	// Record should not panic
+	timer.Record(500 * time.Millisecond)
+}
+
+func TestSDKMetricsHandler_RequestFailure(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	taggedHandler := handler.WithTags(map[string]string{
+		"namespace":    "test-namespace",
+		"operation":    "StartWorkflow",
+		"failure_type": "timeout",
+	})
+	counter := taggedHandler.Counter("temporal_request_failure")
+
+	// Increment should not panic
+	counter.Inc(1)
+}
+
+func TestSDKMetricsHandler_ConcurrentTaggedHandlers(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	// Tagged copies share metric state, so concurrent first use from many
+	// copies must create each metric once
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tagged := handler.WithTags(map[string]string{"namespace": "test-namespace"})
+			for i := 0; i < 100; i++ {
+				tagged.Counter("temporal_request").Inc(1)
+				tagged.Gauge("temporal_num_pollers").Update(float64(i))
+			}
+		}()
+	}
+	wg.Wait()
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, mf := range families {
+		if mf.GetName() == "temporal_request_total" {
+			require.Equal(t, 800.0, mf.GetMetric()[0].GetCounter().GetValue())
+			return
+		}
+	}
+	t.Fatal("temporal_request_total not registered")
+}
+
+func TestSDKMetricsHandler_UnknownMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	tagged := handler.WithTags(map[string]string{"namespace": "test-namespace", "task-queue": "tq"})
+	tagged.Counter("temporal_new_counter").Inc(2)
+	tagged.Gauge("temporal_new_gauge").Update(7)
+	tagged.Timer("temporal_new_latency").Record(50 * time.Millisecond)
+
+	// A later use with different tags keeps the label set of the first use
+	handler.WithTags(map[string]string{"namespace": "other", "extra": "dropped"}).Counter("temporal_new_counter").Inc(1)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	byName := make(map[string]*dto.MetricFamily)
+	for _, mf := range families {
+		byName[mf.GetName()] = mf
+	}
+
+	counter := byName["temporal_new_counter_total"]
+	require.NotNil(t, counter)
+	require.Len(t, counter.GetMetric(), 2)
+	for _, m := range counter.GetMetric() {
+		labels := make(map[string]string)
+		for _, l := range m.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		require.Contains(t, labels, "task_queue")
+		require.NotContains(t, labels, "extra")
+	}
+
+	require.NotNil(t, byName["temporal_new_gauge"])
+	require.Equal(t, 7.0, byName["temporal_new_gauge"].GetMetric()[0].GetGauge().GetValue())
+	require.NotNil(t, byName["temporal_new_latency_seconds"])
+	require.Equal(t, uint64(1), byName["temporal_new_latency_seconds"].GetMetric()[0].GetHistogram().GetSampleCount())
+}
+
+func TestSDKMetricsHandler_HistogramBuckets(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry, WithSDKHistogramBuckets(map[string][]float64{
+		config.HistogramSDKLatency:            {0.0001, 0.0005},
+		"temporal_activity_execution_latency": {0.001, 0.01, 0.1},
+	}))
+
+	handler.Timer("temporal_request_latency").Record(time.Millisecond)
+	handler.Timer("temporal_activity_execution_latency").Record(time.Millisecond)
+	handler.Timer("temporal_workflow_endtoend_latency").Record(time.Millisecond)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	buckets := make(map[string]int)
+	for _, mf := range families {
+		buckets[mf.GetName()] = len(mf.GetMetric()[0].GetHistogram().GetBucket())
+	}
+
+	// Family override, name override, and the untouched long-latency default
+	require.Equal(t, 2, buckets["temporal_request_latency_seconds"])
+	require.Equal(t, 3, buckets["temporal_activity_execution_latency_seconds"])
+	require.Equal(t, 20, buckets["temporal_workflow_endtoend_latency_seconds"])
+}
@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// scrapeStats measures the metrics endpoint itself: how long scrapes take,
+// how large the registry is and whether any metric family has more label
+// combinations than the cardinality limit. At high poller counts and label
+// cardinality scraping can lag, and these make that visible.
+type scrapeStats struct {
+	gatherer prometheus.Gatherer
+	limit    int // Series per metric family before warning (0 disables the guard)
+
+	duration prometheus.Histogram
+	families prometheus.Gauge
+	series   prometheus.Gauge
+	exceeded prometheus.Gauge
+
+	mu     sync.Mutex
+	warned map[string]bool // Families already reported over the limit
+}
+
+func newScrapeStats(g prometheus.Gatherer, limit int) *scrapeStats {
+	return &scrapeStats{
+		gatherer: g,
+		limit:    limit,
+		duration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "benchmark_scrape_duration_seconds",
+			Help:    "Time to gather and encode a scrape of the benchmark metrics endpoint",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 14), // 1ms to ~8s
+		}),
+		families: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "benchmark_registry_metric_families",
+			Help: "Metric families in the benchmark registry at the last scrape",
+		}),
+		series: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "benchmark_registry_series",
+			Help: "Series (label combinations) in the benchmark registry at the last scrape",
+		}),
+		exceeded: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "benchmark_cardinality_limit_exceeded",
+			Help: "Metric families with more series than the cardinality limit at the last scrape",
+		}),
+		warned: map[string]bool{},
+	}
+}
+
+// register adds the self-metrics to r.
+func (s *scrapeStats) register(r prometheus.Registerer) {
+	r.MustRegister(s.duration, s.families, s.series, s.exceeded)
+}
+
+// Gather gathers the wrapped registry and records its size. The values are
+// exported by the next scrape.
+func (s *scrapeStats) Gather() ([]*dto.MetricFamily, error) {
+	families, err := s.gatherer.Gather()
+	s.observe(families)
+	return families, err
+}
+
+func (s *scrapeStats) observe(families []*dto.MetricFamily) {
+	series, exceeded := 0, 0
+	for _, mf := range families {
+		n := len(mf.GetMetric())
+		series += n
+		if s.limit > 0 && n > s.limit {
+			exceeded++
+			s.warnOnce(mf.GetName(), n)
+		}
+	}
+	s.families.Set(float64(len(families)))
+	s.series.Set(float64(series))
+	s.exceeded.Set(float64(exceeded))
+}
+
+// warnOnce logs the first time a family exceeds the cardinality limit.
+func (s *scrapeStats) warnOnce(family string, series int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.warned[family] {
+		return
+	}
+	s.warned[family] = true
+	slog.Warn("Metric family exceeds the cardinality limit; scrapes may lag",
+		"family", family, "series", series, "limit", s.limit)
+}
+
+// instrument wraps next, the scrape handler, to record scrape durations.
+func (s *scrapeStats) instrument(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		next.ServeHTTP(w, r)
+		s.duration.Observe(time.Since(start).Seconds())
+	})
+}
+
+// WithCardinalityLimit warns when a metric family exceeds limit series
+// (label combinations) at a scrape, and counts such families in
+// benchmark_cardinality_limit_exceeded (0 disables the guard).
+func WithCardinalityLimit(limit int) HandlerOption {
+	return func(h *handler) {
+		h.cardinalityLimit = limit
+	}
+}
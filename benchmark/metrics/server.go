@@ -0,0 +1,389 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// serverScrapeTimeout bounds each server metrics request.
+const serverScrapeTimeout = 10 * time.Second
+
+// ServerScraper reads Prometheus metrics from Temporal server endpoints so
+// server-side behaviour can be reported alongside client-side results.
+type ServerScraper struct {
+	urls   []string
+	client *http.Client
+}
+
+// NewServerScraper creates a scraper for the given Prometheus endpoints
+// (e.g. http://temporal-history:9090/metrics).
+func NewServerScraper(urls []string) *ServerScraper {
+	return &ServerScraper{
+		urls:   urls,
+		client: &http.Client{Timeout: serverScrapeTimeout},
+	}
+}
+
+// ServerSnapshot is the set of metric families read from all endpoints in one scrape.
+type ServerSnapshot []*dto.MetricFamily
+
+// Scrape reads every endpoint. Endpoints that fail are logged and skipped; an
+// error is returned only if no endpoint could be read.
+func (s *ServerScraper) Scrape(ctx context.Context) (ServerSnapshot, error) {
+	var snapshot ServerSnapshot
+	var lastErr error
+	scraped := 0
+	for _, url := range s.urls {
+		families, err := s.scrapeOne(ctx, url)
+		if err != nil {
+			slog.Warn("Failed to scrape server metrics", "url", url, "error", err)
+			lastErr = err
+			continue
+		}
+		scraped++
+		for _, mf := range families {
+			snapshot = append(snapshot, mf)
+		}
+	}
+	if scraped == 0 && lastErr != nil {
+		return nil, fmt.Errorf("failed to scrape server metrics: %w", lastErr)
+	}
+	return snapshot, nil
+}
+
+// scrapeOne reads and parses the text exposition format from url.
+func (s *ServerScraper) scrapeOne(ctx context.Context, url string) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics: %w", err)
+	}
+	return families, nil
+}
+
+// CounterSum returns the sum of all series of the named counter. ok is false
+// if no endpoint reported the counter.
+func (s ServerSnapshot) CounterSum(name string) (sum float64, ok bool) {
+	for _, mf := range s {
+		if mf.GetName() != name {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			ok = true
+			sum += m.GetCounter().GetValue()
+		}
+	}
+	return sum, ok
+}
+
+// CounterDelta returns how much the named counter grew between two snapshots.
+// A decrease means the server restarted, in which case the later value is used.
+func CounterDelta(before, after ServerSnapshot, name string) (delta float64, ok bool) {
+	end, ok := after.CounterSum(name)
+	if !ok {
+		return 0, false
+	}
+	start, _ := before.CounterSum(name)
+	if end < start {
+		return end, true
+	}
+	return end - start, true
+}
+
+// Temporal server counters of visibility store requests and of the requests
+// that failed, labelled by operation.
+const (
+	VisibilityRequestsMetric = "visibility_persistence_requests_total"
+	VisibilityErrorsMetric   = "visibility_persistence_errors_total"
+)
+
+// VisibilityWriteOperations are the visibility store operations that record
+// workflow executions: their start and close, and search attribute upserts.
+var VisibilityWriteOperations = []string{
+	"RecordWorkflowExecutionStarted",
+	"RecordWorkflowExecutionClosed",
+	"UpsertWorkflowExecution",
+}
+
+// CounterDeltaByLabel returns how much the named counter's series grew
+// between two snapshots, summed by the value of label. Like CounterDelta, a
+// decrease means the server restarted and the later value is used. ok is
+// false if no endpoint reported the counter.
+func CounterDeltaByLabel(before, after ServerSnapshot, name, label string) (deltas map[string]float64, ok bool) {
+	end, ok := after.counterByLabel(name, label)
+	if !ok {
+		return nil, false
+	}
+	start, _ := before.counterByLabel(name, label)
+	deltas = make(map[string]float64, len(end))
+	for value, sum := range end {
+		if sum < start[value] {
+			deltas[value] = sum
+			continue
+		}
+		deltas[value] = sum - start[value]
+	}
+	return deltas, true
+}
+
+// counterByLabel sums the series of the named counter by the value of label.
+func (s ServerSnapshot) counterByLabel(name, label string) (sums map[string]float64, ok bool) {
+	sums = make(map[string]float64)
+	for _, mf := range s {
+		if mf.GetName() != name {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			ok = true
+			var value string
+			for _, l := range m.GetLabel() {
+				if l.GetName() == label {
+					value = l.GetValue()
+				}
+			}
+			sums[value] += m.GetCounter().GetValue()
+		}
+	}
+	return sums, ok
+}
+
+// persistenceLatencyMetrics maps the Temporal persistence latency histogram
+// names to the factor that converts their unit to milliseconds.
+var persistenceLatencyMetrics = map[string]float64{
+	"persistence_latency":              1000, // seconds
+	"persistence_latency_milliseconds": 1,
+}
+
+// visibilityLatencyMetrics is persistenceLatencyMetrics for the visibility store.
+var visibilityLatencyMetrics = map[string]float64{
+	"visibility_persistence_latency":              1000, // seconds
+	"visibility_persistence_latency_milliseconds": 1,
+}
+
+// histogramBuckets is a cumulative histogram with upper bounds in milliseconds.
+type histogramBuckets struct {
+	counts map[float64]float64 // upper bound -> cumulative count
+	total  float64
+}
+
+// PersistenceLatencyQuantiles returns the q quantile (0-1) of each persistence
+// operation's latency in milliseconds over the interval between two snapshots,
+// for the given Temporal service (all services if empty). Quantiles are
+// interpolated within buckets the same way as PromQL's histogram_quantile.
+// Operations with no observations in the interval are omitted.
+func PersistenceLatencyQuantiles(before, after ServerSnapshot, service string, q float64) map[string]float64 {
+	start := latencyHistograms(before, persistenceLatencyMetrics, service)
+	quantiles := make(map[string]float64)
+	for operation, end := range latencyHistograms(after, persistenceLatencyMetrics, service) {
+		if window := end.since(start[operation]); window.total > 0 {
+			quantiles[operation] = window.quantile(q)
+		}
+	}
+	return quantiles
+}
+
+// HistoryReadOperations are the persistence operations that read workflow
+// history branches, which workers cause when a workflow task replays.
+var HistoryReadOperations = []string{
+	"ReadHistoryBranch",
+	"ReadHistoryBranchByBatch",
+	"ReadHistoryBranchReverse",
+	"ReadRawHistoryBranch",
+}
+
+// PersistenceRequests returns the requests of each persistence operation over
+// the interval between two snapshots, counted from the persistence latency
+// histograms, for the given Temporal service (all services if empty). ok is
+// false if no endpoint reported persistence latencies.
+func PersistenceRequests(before, after ServerSnapshot, service string) (requests map[string]float64, ok bool) {
+	end := latencyHistograms(after, persistenceLatencyMetrics, service)
+	if len(end) == 0 {
+		return nil, false
+	}
+	start := latencyHistograms(before, persistenceLatencyMetrics, service)
+	requests = make(map[string]float64, len(end))
+	for operation, h := range end {
+		requests[operation] = h.total
+		if prev, ok := start[operation]; ok && prev.total <= h.total {
+			requests[operation] -= prev.total
+		}
+	}
+	return requests, true
+}
+
+// latencyHistograms sums the series of service of the latency histograms
+// named by names, which map to the factor converting their unit to
+// milliseconds, by operation.
+func latencyHistograms(s ServerSnapshot, names map[string]float64, service string) map[string]*histogramBuckets {
+	byOperation := make(map[string]*histogramBuckets)
+	for _, mf := range s {
+		toMillis, ok := names[mf.GetName()]
+		if !ok {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			var operation, serviceName string
+			for _, label := range m.GetLabel() {
+				switch label.GetName() {
+				case "operation":
+					operation = label.GetValue()
+				case "service_name":
+					serviceName = label.GetValue()
+				}
+			}
+			if operation == "" || (service != "" && serviceName != service) {
+				continue
+			}
+
+			h, exists := byOperation[operation]
+			if !exists {
+				h = &histogramBuckets{counts: make(map[float64]float64)}
+				byOperation[operation] = h
+			}
+			h.total += float64(m.GetHistogram().GetSampleCount())
+			for _, b := range m.GetHistogram().GetBucket() {
+				h.counts[b.GetUpperBound()*toMillis] += float64(b.GetCumulativeCount())
+			}
+		}
+	}
+	return byOperation
+}
+
+// Persistence stores whose load PersistenceStoreLoad reports. The history and
+// matching stores are the persistence requests made by those services; the
+// visibility store is reported by every service separately.
+const (
+	PersistenceStoreHistory    = "history"
+	PersistenceStoreMatching   = "matching"
+	PersistenceStoreVisibility = "visibility"
+)
+
+// PersistenceStores lists the persistence stores in report order.
+var PersistenceStores = []string{PersistenceStoreHistory, PersistenceStoreMatching, PersistenceStoreVisibility}
+
+// Temporal server counters of persistence requests, of the requests that
+// failed and of those rejected by the persistence rate limiter, labelled by
+// service. Expected failures such as conditional update conflicts and shard
+// ownership changes are not counted as errors.
+const (
+	PersistenceRequestsMetric          = "persistence_requests_total"
+	PersistenceErrorsMetric            = "persistence_errors_total"
+	PersistenceResourceExhaustedMetric = "persistence_errors_resource_exhausted_total"
+	VisibilityResourceExhaustedMetric  = "visibility_persistence_resource_exhausted_total"
+)
+
+// StoreLoad is the load a persistence store served over an interval: its
+// requests, the failed ones (including rate-limited requests) and a latency
+// quantile in milliseconds across all of its operations.
+type StoreLoad struct {
+	Requests  float64
+	Errors    float64
+	LatencyMs float64
+}
+
+// PersistenceStoreLoad returns the load of store (one of PersistenceStores)
+// over the interval between two snapshots, with the latency at quantile q
+// (0-1). ok is false if no endpoint reported the store's requests.
+func PersistenceStoreLoad(before, after ServerSnapshot, store string, q float64) (load StoreLoad, ok bool) {
+	var latencies map[string]float64
+	service := store
+	if store == PersistenceStoreVisibility {
+		if load.Requests, ok = CounterDelta(before, after, VisibilityRequestsMetric); !ok {
+			return StoreLoad{}, false
+		}
+		// The error counters have no series until a request fails
+		errors, _ := CounterDelta(before, after, VisibilityErrorsMetric)
+		exhausted, _ := CounterDelta(before, after, VisibilityResourceExhaustedMetric)
+		load.Errors = errors + exhausted
+		latencies, service = visibilityLatencyMetrics, ""
+	} else {
+		requests, found := CounterDeltaByLabel(before, after, PersistenceRequestsMetric, "service_name")
+		if load.Requests, ok = requests[store]; !found || !ok {
+			return StoreLoad{}, false
+		}
+		errors, _ := CounterDeltaByLabel(before, after, PersistenceErrorsMetric, "service_name")
+		exhausted, _ := CounterDeltaByLabel(before, after, PersistenceResourceExhaustedMetric, "service_name")
+		load.Errors = errors[store] + exhausted[store]
+		latencies = persistenceLatencyMetrics
+	}
+
+	end := mergeHistograms(latencyHistograms(after, latencies, service))
+	if window := end.since(mergeHistograms(latencyHistograms(before, latencies, service))); window.total > 0 {
+		load.LatencyMs = window.quantile(q)
+	}
+	return load, true
+}
+
+// mergeHistograms sums the histograms of every operation.
+func mergeHistograms(byOperation map[string]*histogramBuckets) *histogramBuckets {
+	merged := &histogramBuckets{counts: make(map[float64]float64)}
+	for _, h := range byOperation {
+		merged.total += h.total
+		for bound, count := range h.counts {
+			merged.counts[bound] += count
+		}
+	}
+	return merged
+}
+
+// since returns the observations made after prev, an earlier reading of the
+// same histogram. A nil prev, or one with more observations (the histogram
+// was reset, e.g. by a restart), leaves h as is.
+func (h *histogramBuckets) since(prev *histogramBuckets) *histogramBuckets {
+	if prev == nil || prev.total > h.total {
+		return h
+	}
+	window := &histogramBuckets{counts: make(map[float64]float64, len(h.counts)), total: h.total - prev.total}
+	for bound, count := range h.counts {
+		window.counts[bound] = count - prev.counts[bound]
+	}
+	return window
+}
+
+// quantile interpolates the q quantile within the bucket containing it. If it
+// falls in the implicit +Inf bucket, the highest finite bound is returned.
+func (h *histogramBuckets) quantile(q float64) float64 {
+	bounds := make([]float64, 0, len(h.counts))
+	for bound := range h.counts {
+		if !math.IsInf(bound, 1) {
+			bounds = append(bounds, bound)
+		}
+	}
+	sort.Float64s(bounds)
+
+	rank := q * h.total
+	lower, below := 0.0, 0.0
+	for _, upper := range bounds {
+		count := h.counts[upper]
+		if count >= rank {
+			if count == below {
+				return upper
+			}
+			return lower + (upper-lower)*(rank-below)/(count-below)
+		}
+		lower, below = upper, count
+	}
+	return lower
+}
@@ -0,0 +1,212 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerScraper_CounterDelta(t *testing.T) {
+	var conflicts atomic.Int64
+	conflicts.Store(10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "# TYPE dsql_tx_conflict_total counter")
+		fmt.Fprintf(w, "dsql_tx_conflict_total{service_name=\"history\"} %d\n", conflicts.Load())
+		fmt.Fprintln(w, "dsql_tx_conflict_total{service_name=\"matching\"} 1")
+	}))
+	defer server.Close()
+
+	// An unreachable endpoint is skipped as long as another one answers
+	scraper := NewServerScraper([]string{server.URL, "http://127.0.0.1:1/metrics"})
+
+	before, err := scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	sum, ok := before.CounterSum(ServerOCCConflictMetric)
+	require.True(t, ok)
+	require.Equal(t, 11.0, sum)
+
+	conflicts.Store(25)
+	after, err := scraper.Scrape(context.Background())
+	require.NoError(t, err)
+
+	delta, ok := CounterDelta(before, after, ServerOCCConflictMetric)
+	require.True(t, ok)
+	require.Equal(t, 15.0, delta)
+
+	_, ok = CounterDelta(before, after, "missing_total")
+	require.False(t, ok)
+}
+
+func TestPersistenceLatencyQuantiles(t *testing.T) {
+	parse := func(text string) ServerSnapshot {
+		var parser expfmt.TextParser
+		families, err := parser.TextToMetricFamilies(strings.NewReader(text))
+		require.NoError(t, err)
+		var snapshot ServerSnapshot
+		for _, mf := range families {
+			snapshot = append(snapshot, mf)
+		}
+		return snapshot
+	}
+	histogram := func(service string, b10, b50, b100, count int) string {
+		labels := fmt.Sprintf("operation=\"UpdateWorkflowExecution\",service_name=%q", service)
+		return fmt.Sprintf(`persistence_latency_bucket{%[1]s,le="0.01"} %[2]d
+persistence_latency_bucket{%[1]s,le="0.05"} %[3]d
+persistence_latency_bucket{%[1]s,le="0.1"} %[4]d
+persistence_latency_bucket{%[1]s,le="+Inf"} %[5]d
+persistence_latency_sum{%[1]s} 0
+persistence_latency_count{%[1]s} %[5]d
+`, labels, b10, b50, b100, count)
+	}
+	header := "# TYPE persistence_latency histogram\n"
+
+	before := parse(header + histogram("history", 100, 100, 100, 100) + histogram("matching", 0, 0, 0, 0))
+	// 100 new observations in history: 50 under 10ms, 40 in 10-50ms, 10 in 50-100ms
+	after := parse(header + histogram("history", 150, 190, 200, 200) + histogram("matching", 0, 0, 0, 0))
+
+	p50 := PersistenceLatencyQuantiles(before, after, "history", 0.5)
+	require.InDelta(t, 10.0, p50["UpdateWorkflowExecution"], 0.001)
+
+	p95 := PersistenceLatencyQuantiles(before, after, "history", 0.95)
+	require.InDelta(t, 75.0, p95["UpdateWorkflowExecution"], 0.001)
+
+	// No observations in the window for matching
+	require.Empty(t, PersistenceLatencyQuantiles(before, after, "matching", 0.99))
+}
+
+func TestPersistenceRequests(t *testing.T) {
+	parse := func(text string) ServerSnapshot {
+		var parser expfmt.TextParser
+		families, err := parser.TextToMetricFamilies(strings.NewReader(text))
+		require.NoError(t, err)
+		var snapshot ServerSnapshot
+		for _, mf := range families {
+			snapshot = append(snapshot, mf)
+		}
+		return snapshot
+	}
+	histogram := func(operation string, count int) string {
+		labels := fmt.Sprintf("operation=%q,service_name=\"history\"", operation)
+		return fmt.Sprintf(`persistence_latency_bucket{%[1]s,le="+Inf"} %[2]d
+persistence_latency_sum{%[1]s} 0
+persistence_latency_count{%[1]s} %[2]d
+`, labels, count)
+	}
+	header := "# TYPE persistence_latency histogram\n"
+
+	_, ok := PersistenceRequests(nil, parse("# TYPE other counter\nother 1\n"), "")
+	require.False(t, ok)
+
+	before := parse(header + histogram("ReadHistoryBranch", 40))
+	after := parse(header + histogram("ReadHistoryBranch", 100) + histogram("UpdateWorkflowExecution", 7))
+	requests, ok := PersistenceRequests(before, after, "")
+	require.True(t, ok)
+	require.Equal(t, map[string]float64{"ReadHistoryBranch": 60, "UpdateWorkflowExecution": 7}, requests)
+
+	// A server restart resets the histograms: the later count is used
+	requests, _ = PersistenceRequests(after, before, "history")
+	require.Equal(t, 40.0, requests["ReadHistoryBranch"])
+}
+
+func TestCounterDeltaByLabel(t *testing.T) {
+	parse := func(text string) ServerSnapshot {
+		var parser expfmt.TextParser
+		families, err := parser.TextToMetricFamilies(strings.NewReader(text))
+		require.NoError(t, err)
+		var snapshot ServerSnapshot
+		for _, mf := range families {
+			snapshot = append(snapshot, mf)
+		}
+		return snapshot
+	}
+	counter := func(operation string, history, frontend int) string {
+		return fmt.Sprintf(`visibility_persistence_requests_total{operation=%[1]q,service_name="history"} %[2]d
+visibility_persistence_requests_total{operation=%[1]q,service_name="frontend"} %[3]d
+`, operation, history, frontend)
+	}
+	header := "# TYPE visibility_persistence_requests_total counter\n"
+
+	_, ok := CounterDeltaByLabel(nil, parse("# TYPE other counter\nother 1\n"), VisibilityRequestsMetric, "operation")
+	require.False(t, ok)
+
+	before := parse(header + counter("UpsertWorkflowExecution", 10, 0))
+	after := parse(header + counter("UpsertWorkflowExecution", 40, 0) + counter("ListWorkflowExecutions", 0, 5))
+	deltas, ok := CounterDeltaByLabel(before, after, VisibilityRequestsMetric, "operation")
+	require.True(t, ok)
+	require.Equal(t, map[string]float64{"UpsertWorkflowExecution": 30, "ListWorkflowExecutions": 5}, deltas)
+
+	// A server restart resets the counters: the later value is used
+	deltas, _ = CounterDeltaByLabel(after, before, VisibilityRequestsMetric, "operation")
+	require.Equal(t, 10.0, deltas["UpsertWorkflowExecution"])
+}
+
+func TestPersistenceStoreLoad(t *testing.T) {
+	parse := func(text string) ServerSnapshot {
+		var parser expfmt.TextParser
+		families, err := parser.TextToMetricFamilies(strings.NewReader(text))
+		require.NoError(t, err)
+		var snapshot ServerSnapshot
+		for _, mf := range families {
+			snapshot = append(snapshot, mf)
+		}
+		return snapshot
+	}
+	snapshot := func(history, historyErrors, matching, visibility, visibilityErrors, fast, slow int) ServerSnapshot {
+		return parse(fmt.Sprintf(`# TYPE persistence_requests_total counter
+persistence_requests_total{operation="UpdateWorkflowExecution",service_name="history"} %[1]d
+persistence_requests_total{operation="CreateTasks",service_name="matching"} %[3]d
+# TYPE persistence_errors_total counter
+persistence_errors_total{operation="UpdateWorkflowExecution",service_name="history"} %[2]d
+# TYPE visibility_persistence_requests_total counter
+visibility_persistence_requests_total{operation="RecordWorkflowExecutionStarted",service_name="history"} %[4]d
+# TYPE visibility_persistence_resource_exhausted_total counter
+visibility_persistence_resource_exhausted_total{operation="RecordWorkflowExecutionStarted",service_name="history"} %[5]d
+# TYPE persistence_latency histogram
+persistence_latency_bucket{operation="UpdateWorkflowExecution",service_name="history",le="0.01"} %[6]d
+persistence_latency_bucket{operation="UpdateWorkflowExecution",service_name="history",le="0.1"} %[7]d
+persistence_latency_bucket{operation="UpdateWorkflowExecution",service_name="history",le="+Inf"} %[7]d
+persistence_latency_sum{operation="UpdateWorkflowExecution",service_name="history"} 0
+persistence_latency_count{operation="UpdateWorkflowExecution",service_name="history"} %[7]d
+persistence_latency_bucket{operation="GetWorkflowExecution",service_name="history",le="0.01"} %[6]d
+persistence_latency_bucket{operation="GetWorkflowExecution",service_name="history",le="0.1"} %[6]d
+persistence_latency_bucket{operation="GetWorkflowExecution",service_name="history",le="+Inf"} %[6]d
+persistence_latency_sum{operation="GetWorkflowExecution",service_name="history"} 0
+persistence_latency_count{operation="GetWorkflowExecution",service_name="history"} %[6]d
+`, history, historyErrors, matching, visibility, visibilityErrors, fast, slow))
+	}
+
+	before := snapshot(100, 0, 50, 20, 0, 10, 10)
+	// 20 fast GetWorkflowExecution calls, 20 fast and 20 slow updates
+	after := snapshot(400, 3, 80, 120, 5, 30, 50)
+
+	history, ok := PersistenceStoreLoad(before, after, PersistenceStoreHistory, 0.5)
+	require.True(t, ok)
+	require.Equal(t, 300.0, history.Requests)
+	require.Equal(t, 3.0, history.Errors)
+	require.InDelta(t, 7.5, history.LatencyMs, 0.001, "the median spans both operations")
+
+	matching, ok := PersistenceStoreLoad(before, after, PersistenceStoreMatching, 0.99)
+	require.True(t, ok)
+	require.Equal(t, StoreLoad{Requests: 30}, matching, "no matching latencies were observed")
+
+	visibility, ok := PersistenceStoreLoad(before, after, PersistenceStoreVisibility, 0.99)
+	require.True(t, ok)
+	require.Equal(t, 100.0, visibility.Requests)
+	require.Equal(t, 5.0, visibility.Errors, "rate-limited requests count as errors")
+
+	_, ok = PersistenceStoreLoad(before, after, "frontend", 0.99)
+	require.False(t, ok)
+}
+
+func TestServerScraper_AllEndpointsFail(t *testing.T) {
+	scraper := NewServerScraper([]string{"http://127.0.0.1:1/metrics"})
+	_, err := scraper.Scrape(context.Background())
+	require.Error(t, err)
+}
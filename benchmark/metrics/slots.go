@@ -0,0 +1,70 @@
+// Package metrics provides Prometheus metrics collection for the benchmark.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SDK worker slot gauges, labelled by namespace, task_queue and worker_type.
+const (
+	slotsAvailableMetric = "temporal_worker_task_slots_available"
+	slotsUsedMetric      = "temporal_worker_task_slots_used"
+)
+
+// WorkerSlotUtilization returns the highest task slot utilization
+// (used / (used + available)) across worker types for the namespace and task
+// queue, read from the SDK slot gauges in g. ok is false when no worker has
+// reported slot gauges yet (e.g. all workers run in a separate service).
+func WorkerSlotUtilization(g prometheus.Gatherer, namespace, taskQueue string) (utilization float64, ok bool, err error) {
+	families, err := g.Gather()
+	if err != nil {
+		return 0, false, err
+	}
+
+	type slots struct{ used, available float64 }
+	byWorkerType := make(map[string]*slots)
+
+	for _, mf := range families {
+		name := mf.GetName()
+		if name != slotsAvailableMetric && name != slotsUsedMetric {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			var ns, tq, workerType string
+			for _, label := range m.GetLabel() {
+				switch label.GetName() {
+				case "namespace":
+					ns = label.GetValue()
+				case "task_queue":
+					tq = label.GetValue()
+				case "worker_type":
+					workerType = label.GetValue()
+				}
+			}
+			if ns != namespace || tq != taskQueue {
+				continue
+			}
+
+			s, exists := byWorkerType[workerType]
+			if !exists {
+				s = &slots{}
+				byWorkerType[workerType] = s
+			}
+			if name == slotsUsedMetric {
+				s.used = m.GetGauge().GetValue()
+			} else {
+				s.available = m.GetGauge().GetValue()
+			}
+		}
+	}
+
+	for _, s := range byWorkerType {
+		total := s.used + s.available
+		if total <= 0 {
+			continue
+		}
+		ok = true
+		utilization = max(utilization, s.used/total)
+	}
+	return utilization, ok, nil
+}
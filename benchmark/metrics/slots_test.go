@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerSlotUtilization(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := SDKMetricsHandler(registry)
+
+	_, ok, err := WorkerSlotUtilization(registry, "bench", "benchmark-task-queue")
+	require.NoError(t, err)
+	require.False(t, ok, "no slot gauges reported yet")
+
+	workflowWorker := handler.WithTags(map[string]string{
+		"namespace":   "bench",
+		"task_queue":  "benchmark-task-queue",
+		"worker_type": "WorkflowWorker",
+	})
+	workflowWorker.Gauge("temporal_worker_task_slots_used").Update(50)
+	workflowWorker.Gauge("temporal_worker_task_slots_available").Update(150)
+
+	activityWorker := handler.WithTags(map[string]string{
+		"namespace":   "bench",
+		"task_queue":  "benchmark-task-queue",
+		"worker_type": "ActivityWorker",
+	})
+	activityWorker.Gauge("temporal_worker_task_slots_used").Update(190)
+	activityWorker.Gauge("temporal_worker_task_slots_available").Update(10)
+
+	// A saturated worker in another namespace is ignored
+	other := handler.WithTags(map[string]string{
+		"namespace":   "other",
+		"task_queue":  "benchmark-task-queue",
+		"worker_type": "WorkflowWorker",
+	})
+	other.Gauge("temporal_worker_task_slots_used").Update(200)
+	other.Gauge("temporal_worker_task_slots_available").Update(0)
+
+	utilization, ok, err := WorkerSlotUtilization(registry, "bench", "benchmark-task-queue")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.InDelta(t, 0.95, utilization, 1e-9)
+}
@@ -0,0 +1,66 @@
+package results
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// BaselineThresholds records thresholds evaluated relative to a baseline run:
+// the percentages configured and the absolute limits they resolved to.
+type BaselineThresholds struct {
+	Timestamp            time.Time `json:"timestamp"` // When the baseline run was recorded
+	MaxP99Percent        float64   `json:"maxP99Percent,omitempty"`
+	MaxP99LatencyMs      float64   `json:"maxP99LatencyMs,omitempty"`
+	MinThroughputPercent float64   `json:"minThroughputPercent,omitempty"`
+	MinThroughput        float64   `json:"minThroughput,omitempty"`
+}
+
+// LoadBaseline reads a results JSON artifact to compare later runs against.
+// The baseline must have recorded the p99 latency and throughput.
+func LoadBaseline(path string) (*BenchmarkResultJSON, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+	baseline, err := FromJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("baseline %s: %w", path, err)
+	}
+	if baseline.Results.Latency.P99 <= 0 || baseline.Results.ActualRate <= 0 {
+		return nil, fmt.Errorf("baseline %s has no p99 latency or throughput", path)
+	}
+	return baseline, nil
+}
+
+// EvaluateBaselineThresholds checks the result against limits relative to
+// the baseline: p99 latency at most maxP99Percent of the baseline's p99, and
+// throughput at least minThroughputPercent of the baseline's. A percentage of
+// 0 skips that check. It runs after the absolute thresholds, adding to their
+// failure reasons.
+func EvaluateBaselineThresholds(result *BenchmarkResult, baseline *BenchmarkResultJSON, maxP99Percent, minThroughputPercent float64) {
+	if baseline == nil || (maxP99Percent <= 0 && minThroughputPercent <= 0) {
+		return
+	}
+
+	b := &BaselineThresholds{Timestamp: baseline.Timestamp}
+	if maxP99Percent > 0 {
+		b.MaxP99Percent = maxP99Percent
+		b.MaxP99LatencyMs = baseline.Results.Latency.P99 * maxP99Percent / 100
+		if result.LatencyP99 > b.MaxP99LatencyMs {
+			result.Passed = false
+			result.FailureReasons = append(result.FailureReasons,
+				fmt.Sprintf("p99 latency %.2fms exceeds %g%% of baseline (%.2fms)", result.LatencyP99, maxP99Percent, b.MaxP99LatencyMs))
+		}
+	}
+	if minThroughputPercent > 0 {
+		b.MinThroughputPercent = minThroughputPercent
+		b.MinThroughput = baseline.Results.ActualRate * minThroughputPercent / 100
+		if result.ActualRate < b.MinThroughput {
+			result.Passed = false
+			result.FailureReasons = append(result.FailureReasons,
+				fmt.Sprintf("throughput %.2f/s below %g%% of baseline (%.2f/s)", result.ActualRate, minThroughputPercent, b.MinThroughput))
+		}
+	}
+	result.Baseline = b
+}
@@ -0,0 +1,198 @@
+package results
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Files written into an artifact bundle.
+const (
+	BundleResultFile      = "results.json"
+	BundleSummaryFile     = "summary.txt"
+	BundleDiagnosticsFile = "diagnostics.json"
+	BundleLogTailFile     = "log-tail.txt"
+	BundleHeatmapFile     = "heatmap.csv"
+	BundleTimelineFile    = "timeline.jsonl"
+	BundleEventsFile      = "events.jsonl"
+)
+
+// Artifact is a file of a run's raw data, such as its timeline or recorded
+// events, published in the run's artifact bundle next to the result.
+type Artifact struct {
+	Name string
+	Data []byte
+}
+
+// Bundle collects a run's outputs into a single tar.gz artifact, so a run
+// produces one object in the sink instead of scattered files.
+type Bundle struct {
+	name    string
+	modTime time.Time
+	files   []bundleFile
+}
+
+type bundleFile struct {
+	name string
+	data []byte
+}
+
+// NewBundle creates an empty bundle named after the namespace and run start
+// time, e.g. benchmark-bench-123-20250115T100000Z.tar.gz.
+func NewBundle(namespace string, start time.Time) *Bundle {
+	name := "benchmark"
+	if namespace != "" {
+		name += "-" + namespace
+	}
+	return &Bundle{
+		name:    fmt.Sprintf("%s-%s.tar.gz", name, start.UTC().Format("20060102T150405Z")),
+		modTime: start,
+	}
+}
+
+// NewResultBundle creates a bundle holding the result JSON, the
+// human-readable summary, the latency heatmap as CSV (if the result has one)
+// and the run's artifacts.
+func NewResultBundle(result *BenchmarkResultJSON, artifacts ...Artifact) (*Bundle, error) {
+	data, err := result.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize result: %w", err)
+	}
+
+	b := NewBundle(result.Config.Namespace, result.Timestamp)
+	b.Add(BundleResultFile, append(data, '\n'))
+	b.Add(BundleSummaryFile, []byte(result.FormatSummary()))
+	if result.LatencyHeatmap != nil {
+		b.Add(BundleHeatmapFile, result.LatencyHeatmap.CSV())
+	}
+	for _, a := range artifacts {
+		b.Add(a.Name, a.Data)
+	}
+	return b, nil
+}
+
+// NewDiagnosticsBundle creates a bundle holding failure diagnostics and, as
+// plain text, the log lines leading up to the failure.
+func NewDiagnosticsBundle(d *Diagnostics, start time.Time) (*Bundle, error) {
+	data, err := d.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize diagnostics: %w", err)
+	}
+
+	b := NewBundle(d.Namespace, start)
+	b.Add(BundleDiagnosticsFile, append(data, '\n'))
+	if len(d.LogTail) > 0 {
+		b.Add(BundleLogTailFile, []byte(strings.Join(d.LogTail, "\n")+"\n"))
+	}
+	return b, nil
+}
+
+// CSV returns the heatmap as CSV for spreadsheets and plotting tools: one row
+// per window with its start time, the count of each latency bucket (headed
+// by the bucket's upper bound, "le_inf" for the overflow bucket) and the
+// failed workflows.
+func (h *LatencyHeatmap) CSV() []byte {
+	header := []string{"windowStart"}
+	for _, b := range h.BucketsMs {
+		header = append(header, "le_"+strconv.FormatFloat(b, 'f', -1, 64)+"ms")
+	}
+	header = append(header, "le_inf", "failed")
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(header)
+	for _, win := range h.Windows {
+		row := []string{win.Start.UTC().Format(time.RFC3339)}
+		for i := range len(h.BucketsMs) + 1 {
+			var count int64
+			if i < len(win.Counts) {
+				count = win.Counts[i]
+			}
+			row = append(row, strconv.FormatInt(count, 10))
+		}
+		row = append(row, strconv.FormatInt(win.Failed, 10))
+		w.Write(row)
+	}
+	w.Flush()
+	return buf.Bytes()
+}
+
+// Name is the bundle's object or file name.
+func (b *Bundle) Name() string {
+	return b.name
+}
+
+// Add adds a file to the bundle, replacing any earlier file with the same name.
+func (b *Bundle) Add(name string, data []byte) {
+	for i := range b.files {
+		if b.files[i].name == name {
+			b.files[i].data = data
+			return
+		}
+	}
+	b.files = append(b.files, bundleFile{name: name, data: data})
+}
+
+// Bytes returns the bundle as a gzip-compressed tar archive, with the files
+// in the order they were added.
+func (b *Bundle) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for _, f := range b.files {
+		header := &tar.Header{
+			Name:    f.name,
+			Mode:    0o644,
+			Size:    int64(len(f.data)),
+			ModTime: b.modTime,
+			Format:  tar.FormatPAX,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, fmt.Errorf("failed to write %s header: %w", f.name, err)
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// BundleSink is implemented by sinks that can store artifact bundles.
+type BundleSink interface {
+	PublishBundle(ctx context.Context, name string, data []byte) error
+}
+
+// PublishBundleAll delivers the bundle to every sink that supports bundles,
+// returning the joined errors.
+func PublishBundleAll(ctx context.Context, sinks []Sink, b *Bundle) error {
+	data, err := b.Bytes()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, s := range sinks {
+		bs, ok := s.(BundleSink)
+		if !ok {
+			continue
+		}
+		if err := bs.PublishBundle(ctx, b.Name(), data); err != nil {
+			errs = append(errs, fmt.Errorf("%s sink: %w", s.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
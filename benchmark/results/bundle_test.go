@@ -0,0 +1,116 @@
+package results
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// readBundle returns the files in a tar.gz bundle by name, in archive order.
+func readBundle(t *testing.T, data []byte) ([]string, map[string]string) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	var names []string
+	files := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		names = append(names, header.Name)
+		files[header.Name] = string(content)
+	}
+	return names, files
+}
+
+func TestResultBundle_FileSink(t *testing.T) {
+	result := sampleSinkResult()
+	result.Config.Namespace = "bench-123"
+	bundle, err := NewResultBundle(result)
+	require.NoError(t, err)
+	bundle.Add("extra.txt", []byte("first"))
+	bundle.Add("extra.txt", []byte("second"))
+	require.Equal(t, "benchmark-bench-123-20250115T100000Z.tar.gz", bundle.Name())
+
+	dir := t.TempDir()
+	sinks := []Sink{NewStdoutSink(io.Discard), NewFileSink(filepath.Join(dir, "results.jsonl"))}
+	require.NoError(t, PublishBundleAll(context.Background(), sinks, bundle))
+
+	data, err := os.ReadFile(filepath.Join(dir, bundle.Name()))
+	require.NoError(t, err)
+	names, files := readBundle(t, data)
+	require.Equal(t, []string{BundleResultFile, BundleSummaryFile, "extra.txt"}, names)
+	require.Contains(t, files[BundleSummaryFile], "BENCHMARK RESULTS SUMMARY")
+	require.Equal(t, "second", files["extra.txt"])
+
+	parsed, err := FromJSON([]byte(files[BundleResultFile]))
+	require.NoError(t, err)
+	require.Equal(t, "bench-123", parsed.Config.Namespace)
+}
+
+func TestResultBundle_RunData(t *testing.T) {
+	result := sampleSinkResult()
+	start := result.Timestamp
+	result.LatencyHeatmap = &LatencyHeatmap{
+		WindowSeconds: 10,
+		BucketsMs:     []float64{100, 250.5},
+		Windows: []HeatmapWindow{
+			{Start: start, Counts: []int64{3, 2, 1}, Failed: 1},
+			{Start: start.Add(10 * time.Second)},
+		},
+	}
+	bundle, err := NewResultBundle(result,
+		Artifact{Name: BundleTimelineFile, Data: []byte("{\"event\":\"run_started\"}\n")},
+		Artifact{Name: BundleEventsFile, Data: []byte("{\"type\":\"run\"}\n")})
+	require.NoError(t, err)
+
+	data, err := bundle.Bytes()
+	require.NoError(t, err)
+	names, files := readBundle(t, data)
+	require.Equal(t, []string{BundleResultFile, BundleSummaryFile, BundleHeatmapFile, BundleTimelineFile, BundleEventsFile}, names)
+	require.Equal(t, "windowStart,le_100ms,le_250.5ms,le_inf,failed\n"+
+		"2025-01-15T10:00:00Z,3,2,1,1\n"+
+		"2025-01-15T10:00:10Z,0,0,0,0\n", files[BundleHeatmapFile])
+	require.Equal(t, "{\"type\":\"run\"}\n", files[BundleEventsFile])
+}
+
+func TestDiagnosticsBundle_HTTPSink(t *testing.T) {
+	var name, payload string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/gzip", r.Header.Get("Content-Type"))
+		name = r.Header.Get(BundleNameHeader)
+		payload = r.Header.Get(PayloadHeader)
+		var err error
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	d := &Diagnostics{Category: CategoryTimeout, Phase: PhaseRun, LogTail: []string{"line 1", "line 2"}}
+	bundle, err := NewDiagnosticsBundle(d, sampleSinkResult().Timestamp)
+	require.NoError(t, err)
+	require.NoError(t, PublishBundleAll(context.Background(), []Sink{NewHTTPSink(server.URL)}, bundle))
+
+	require.Equal(t, "benchmark-20250115T100000Z.tar.gz", name)
+	require.Equal(t, "bundle", payload)
+	names, files := readBundle(t, body)
+	require.Equal(t, []string{BundleDiagnosticsFile, BundleLogTailFile}, names)
+	require.Equal(t, "line 1\nline 2\n", files[BundleLogTailFile])
+}
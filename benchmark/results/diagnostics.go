@@ -0,0 +1,146 @@
+// Package results provides result reporting and serialization.
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DiagnosticsFileName is the file written next to a file sink's results on failure.
+const DiagnosticsFileName = "diagnostics.json"
+
+// ErrorCategory classifies why a run failed.
+type ErrorCategory string
+
+// Error categories for failed runs.
+const (
+	CategoryConfig     ErrorCategory = "config"     // Invalid configuration or input files
+	CategoryConnection ErrorCategory = "connection" // Temporal unreachable or unhealthy
+	CategoryNamespace  ErrorCategory = "namespace"  // Namespace creation or registration
+	CategoryWorker     ErrorCategory = "worker"     // Embedded worker or metrics server failed to start
+	CategoryExecution  ErrorCategory = "execution"  // Workflow generation or the run itself failed
+	CategoryTimeout    ErrorCategory = "timeout"    // A deadline expired before the run finished
+	CategoryCancelled  ErrorCategory = "cancelled"  // The run was cancelled (e.g. SIGTERM)
+	CategoryLocked     ErrorCategory = "locked"     // Another run holds the concurrent-run lock
+	CategoryInternal   ErrorCategory = "internal"   // Anything not classified above
+)
+
+// Phase is the step of a run in which an error occurred.
+type Phase string
+
+// Run phases, in order.
+const (
+	PhaseStartup Phase = "startup" // Loading and validating configuration
+	PhaseConnect Phase = "connect" // Dialing and health-checking Temporal
+	PhaseSetup   Phase = "setup"   // Namespace, metrics server and worker setup
+	PhaseRun     Phase = "run"     // Generating load and draining in-flight workflows
+	PhaseReport  Phase = "report"  // Publishing results
+	PhaseCleanup Phase = "cleanup" // Terminating workflows after the run
+)
+
+// RunError is an error tagged with its category and the phase it occurred in.
+type RunError struct {
+	Category ErrorCategory
+	Phase    Phase
+	Err      error
+}
+
+// NewRunError tags err with a category and phase. It returns nil if err is nil.
+func NewRunError(category ErrorCategory, phase Phase, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &RunError{Category: category, Phase: phase, Err: err}
+}
+
+func (e *RunError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RunError) Unwrap() error {
+	return e.Err
+}
+
+// Classify returns the category and phase of err. The phase is empty when err
+// carries no RunError; the category then falls back to timeout, cancelled or internal.
+func Classify(err error) (ErrorCategory, Phase) {
+	var runErr *RunError
+	if errors.As(err, &runErr) {
+		return runErr.Category, runErr.Phase
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return CategoryTimeout, ""
+	case errors.Is(err, context.Canceled):
+		return CategoryCancelled, ""
+	default:
+		return CategoryInternal, ""
+	}
+}
+
+// PartialStats are the workflow counts and latencies recorded before a failure.
+type PartialStats struct {
+	WorkflowsCompleted int64         `json:"workflowsCompleted"`
+	WorkflowsFailed    int64         `json:"workflowsFailed"`
+	ActualRate         float64       `json:"actualRate"`
+	Latency            ResultLatency `json:"latency"`
+}
+
+// Diagnostics describes a failed run so it can be debugged from artifacts.
+type Diagnostics struct {
+	Timestamp    time.Time     `json:"timestamp"`
+	Category     ErrorCategory `json:"category"`
+	Phase        Phase         `json:"phase"`
+	Error        string        `json:"error"`
+	Mode         string        `json:"mode,omitempty"`
+	Namespace    string        `json:"namespace,omitempty"`
+	Elapsed      string        `json:"elapsed"`
+	LogTail      []string      `json:"logTail"`
+	PartialStats *PartialStats `json:"partialStats,omitempty"`
+}
+
+// NewDiagnostics builds diagnostics for err. fallbackPhase is used when err
+// does not record the phase it occurred in.
+func NewDiagnostics(err error, fallbackPhase Phase, elapsed time.Duration) *Diagnostics {
+	category, phase := Classify(err)
+	if phase == "" {
+		phase = fallbackPhase
+	}
+	return &Diagnostics{
+		Timestamp: time.Now().UTC(),
+		Category:  category,
+		Phase:     phase,
+		Error:     err.Error(),
+		Elapsed:   elapsed.Round(time.Millisecond).String(),
+		LogTail:   []string{},
+	}
+}
+
+// ToJSON serializes the diagnostics to JSON bytes.
+func (d *Diagnostics) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// DiagnosticsSink is implemented by sinks that can also deliver failure diagnostics.
+type DiagnosticsSink interface {
+	PublishDiagnostics(ctx context.Context, d *Diagnostics) error
+}
+
+// PublishDiagnosticsAll delivers diagnostics to every sink that supports them,
+// returning the joined errors.
+func PublishDiagnosticsAll(ctx context.Context, sinks []Sink, d *Diagnostics) error {
+	var errs []error
+	for _, s := range sinks {
+		ds, ok := s.(DiagnosticsSink)
+		if !ok {
+			continue
+		}
+		if err := ds.PublishDiagnostics(ctx, d); err != nil {
+			errs = append(errs, fmt.Errorf("%s sink: %w", s.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
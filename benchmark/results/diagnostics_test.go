@@ -0,0 +1,80 @@
+// Package results provides result reporting and serialization.
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassify(t *testing.T) {
+	tagged := fmt.Errorf("iteration 1 failed: %w",
+		NewRunError(CategoryNamespace, PhaseSetup, errors.New("register failed")))
+	category, phase := Classify(tagged)
+	require.Equal(t, CategoryNamespace, category)
+	require.Equal(t, PhaseSetup, phase)
+
+	category, phase = Classify(fmt.Errorf("wait: %w", context.DeadlineExceeded))
+	require.Equal(t, CategoryTimeout, category)
+	require.Empty(t, phase)
+
+	category, _ = Classify(context.Canceled)
+	require.Equal(t, CategoryCancelled, category)
+
+	category, _ = Classify(errors.New("boom"))
+	require.Equal(t, CategoryInternal, category)
+
+	require.NoError(t, NewRunError(CategoryConfig, PhaseStartup, nil))
+}
+
+func TestNewDiagnostics_FallbackPhase(t *testing.T) {
+	d := NewDiagnostics(errors.New("boom"), PhaseRun, 1500*time.Millisecond)
+	require.Equal(t, CategoryInternal, d.Category)
+	require.Equal(t, PhaseRun, d.Phase)
+	require.Equal(t, "boom", d.Error)
+	require.Equal(t, "1.5s", d.Elapsed)
+
+	d = NewDiagnostics(NewRunError(CategoryConnection, PhaseConnect, errors.New("dial")), PhaseRun, 0)
+	require.Equal(t, PhaseConnect, d.Phase)
+}
+
+func TestFileSink_PublishDiagnostics(t *testing.T) {
+	dir := t.TempDir()
+	sink := NewFileSink(filepath.Join(dir, "results.jsonl")).(DiagnosticsSink)
+
+	d := NewDiagnostics(NewRunError(CategoryConnection, PhaseConnect, errors.New("dial")), PhaseStartup, time.Second)
+	d.LogTail = []string{`{"msg":"Connecting to Temporal"}`}
+	require.NoError(t, sink.PublishDiagnostics(context.Background(), d))
+
+	data, err := os.ReadFile(filepath.Join(dir, DiagnosticsFileName))
+	require.NoError(t, err)
+	var parsed Diagnostics
+	require.NoError(t, json.Unmarshal(data, &parsed))
+	require.Equal(t, CategoryConnection, parsed.Category)
+	require.Equal(t, d.LogTail, parsed.LogTail)
+}
+
+func TestPublishDiagnosticsAll_HTTP(t *testing.T) {
+	var payload string
+	var received Diagnostics
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload = r.Header.Get(PayloadHeader)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	d := NewDiagnostics(errors.New("boom"), PhaseRun, time.Second)
+	require.NoError(t, PublishDiagnosticsAll(context.Background(), []Sink{NewHTTPSink(server.URL)}, d))
+	require.Equal(t, "diagnostics", payload)
+	require.Equal(t, PhaseRun, received.Phase)
+}
@@ -0,0 +1,89 @@
+package results
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// ThresholdEvaluator is custom pass/fail logic, such as a composite SLO
+// formula or anomaly detection against historical runs, evaluated after the
+// built-in thresholds.
+type ThresholdEvaluator interface {
+	// Name identifies the evaluator in logs and failure reasons
+	Name() string
+
+	// Evaluate returns the reasons result fails, none if it passes. An error
+	// means the result could not be evaluated, which fails it.
+	Evaluate(ctx context.Context, result *BenchmarkResult) ([]string, error)
+}
+
+// EvaluatorFactory builds a threshold evaluator from the benchmark configuration.
+type EvaluatorFactory func(cfg config.BenchmarkConfig) (ThresholdEvaluator, error)
+
+var (
+	evaluatorsMu       sync.RWMutex
+	evaluatorFactories = map[string]EvaluatorFactory{}
+)
+
+// RegisterThresholdEvaluator makes an evaluator selectable by name with
+// BENCHMARK_THRESHOLD_EVALUATORS, replacing any evaluator of that name. Call
+// it from an init function of a package linked into the benchmark binary.
+func RegisterThresholdEvaluator(name string, factory EvaluatorFactory) {
+	evaluatorsMu.Lock()
+	defer evaluatorsMu.Unlock()
+	evaluatorFactories[name] = factory
+}
+
+// ThresholdEvaluatorsFromConfig builds the evaluators selected by
+// cfg.ThresholdEvaluators, in order.
+func ThresholdEvaluatorsFromConfig(cfg config.BenchmarkConfig) ([]ThresholdEvaluator, error) {
+	evaluators := make([]ThresholdEvaluator, 0, len(cfg.ThresholdEvaluators))
+	for _, name := range cfg.ThresholdEvaluators {
+		evaluatorsMu.RLock()
+		factory, ok := evaluatorFactories[name]
+		names := make([]string, 0, len(evaluatorFactories))
+		for registered := range evaluatorFactories {
+			names = append(names, registered)
+		}
+		evaluatorsMu.RUnlock()
+
+		if !ok {
+			if len(names) == 0 {
+				return nil, fmt.Errorf("unknown threshold evaluator %q: no evaluators are registered", name)
+			}
+			sort.Strings(names)
+			return nil, fmt.Errorf("unknown threshold evaluator %q: must be one of: %s", name, strings.Join(names, ", "))
+		}
+		e, err := factory(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%s threshold evaluator: %w", name, err)
+		}
+		evaluators = append(evaluators, e)
+	}
+	return evaluators, nil
+}
+
+// EvaluateCustomThresholds runs each evaluator against the result, failing
+// it with the evaluator's reasons, prefixed with its name. It runs after the
+// built-in and baseline thresholds, adding to their failure reasons; an
+// evaluator that errors fails the result rather than passing it unchecked.
+func EvaluateCustomThresholds(ctx context.Context, result *BenchmarkResult, evaluators []ThresholdEvaluator) {
+	for _, e := range evaluators {
+		reasons, err := e.Evaluate(ctx, result)
+		if err != nil {
+			result.Passed = false
+			result.FailureReasons = append(result.FailureReasons,
+				fmt.Sprintf("%s threshold not evaluated: %v", e.Name(), err))
+			continue
+		}
+		for _, reason := range reasons {
+			result.Passed = false
+			result.FailureReasons = append(result.FailureReasons, e.Name()+": "+reason)
+		}
+	}
+}
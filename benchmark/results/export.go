@@ -0,0 +1,68 @@
+package results
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/redact"
+)
+
+// accountIDPattern matches 12-digit AWS account IDs, which appear in error
+// messages outside of ARNs.
+var accountIDPattern = regexp.MustCompile(`\b\d{12}\b`)
+
+// Anonymize returns a copy of r for publishing outside the deployment, such
+// as DSQL benchmark numbers from internal runs. Fields that identify the
+// environment are removed: the namespace, workflow IDs and their template,
+// stuck workflow, outcome mismatch and integrity violation samples and the
+// Grafana links. Endpoints, addresses, ARNs
+// and account IDs are replaced in the remaining text, e.g. failure reasons.
+// Metrics, thresholds, timings and the workflow settings are kept.
+func Anonymize(r *BenchmarkResultJSON) (*BenchmarkResultJSON, error) {
+	// Copy through JSON so r's slices and pointers are not shared
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize result: %w", err)
+	}
+	out, err := FromJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := []string{out.Config.Namespace}
+	for _, ids := range out.Run.WorkflowIDs {
+		identifiers = append(identifiers, ids.Prefix)
+	}
+	if out.StuckWorkflows != nil {
+		identifiers = append(identifiers, out.StuckWorkflows.SampleIDs...)
+		out.StuckWorkflows.SampleIDs = nil
+	}
+	if out.Outcomes != nil {
+		identifiers = append(identifiers, out.Outcomes.SampleIDs...)
+		out.Outcomes.SampleIDs = nil
+	}
+	if out.Integrity != nil {
+		for i, v := range out.Integrity.Violations {
+			identifiers = append(identifiers, v.WorkflowID)
+			out.Integrity.Violations[i].WorkflowID = ""
+		}
+	}
+	out.Config.Namespace = ""
+	out.Config.WorkflowIDTemplate = ""
+	out.Run.WorkflowIDs = nil
+	out.GrafanaSnapshot = nil
+
+	redact.New(identifiers...).Value(out)
+	for i, reason := range out.FailureReasons {
+		out.FailureReasons[i] = accountIDPattern.ReplaceAllString(reason, redact.Placeholder)
+	}
+	for i, event := range out.ScalingEvents {
+		out.ScalingEvents[i].Error = accountIDPattern.ReplaceAllString(event.Error, redact.Placeholder)
+	}
+	for i, restart := range out.ShardRestarts {
+		out.ShardRestarts[i].Error = accountIDPattern.ReplaceAllString(restart.Error, redact.Placeholder)
+	}
+	out.Anonymized = true
+	return out, nil
+}
@@ -0,0 +1,75 @@
+package results
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnonymize(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	result := &BenchmarkResultJSON{
+		Timestamp: start,
+		Config: ResultConfig{
+			WorkflowType:       "simple",
+			TargetRate:         100,
+			Duration:           "5m0s",
+			Namespace:          "benchmark-1736935200000000000",
+			WorkflowIDTemplate: "acme-prod-{type}-{time}",
+		},
+		Results: ResultMetrics{
+			WorkflowsStarted:   30000,
+			WorkflowsCompleted: 29990,
+			ActualRate:         99.9,
+			Latency:            ResultLatency{P50: 40, P95: 90, P99: 150, Max: 800},
+		},
+		StuckWorkflows:  &StuckWorkflows{Threshold: "5m0s", Checked: 100, Count: 1, SampleIDs: []string{"acme-prod-simple-20250115-17"}},
+		Outcomes:        &OutcomeVerification{SampleRate: 0.1, Checked: 3000, Mismatches: 1, SampleIDs: []string{"acme-prod-simple-20250115-9"}},
+		GrafanaSnapshot: &GrafanaSnapshot{URL: "https://grafana.acme.internal/dashboard/snapshot/x"},
+		ScalingEvents:   []ScalingEvent{{Offset: "5m", FromCount: 2, ToCount: 4, Error: "AccessDenied for account 123456789012"}},
+		System:          ResultSystem{InstanceType: "m7g.large", HistoryShards: 4},
+		Run: ResultRun{
+			StartTime:   start,
+			EndTime:     start.Add(5 * time.Minute),
+			WorkflowIDs: []WorkflowIDRange{{Prefix: "acme-prod-simple-20250115", Count: 30000}},
+		},
+		FailureReasons: []string{
+			"p99 latency 150ms exceeds threshold",
+			"workflow acme-prod-simple-20250115-17 stuck in benchmark-1736935200000000000 on abc.dsql.us-east-1.on.aws",
+			"AccessDenied: arn:aws:iam::123456789012:role/bench in account 123456789012",
+		},
+	}
+
+	exported, err := Anonymize(result)
+	require.NoError(t, err)
+	require.True(t, exported.Anonymized)
+
+	// Identifying fields are removed
+	require.Empty(t, exported.Config.Namespace)
+	require.Empty(t, exported.Config.WorkflowIDTemplate)
+	require.Empty(t, exported.Run.WorkflowIDs)
+	require.Empty(t, exported.StuckWorkflows.SampleIDs)
+	require.Empty(t, exported.Outcomes.SampleIDs)
+	require.Nil(t, exported.GrafanaSnapshot)
+	require.Equal(t, []string{
+		"p99 latency 150ms exceeds threshold",
+		"workflow [REDACTED] stuck in [REDACTED] on [REDACTED]",
+		"AccessDenied: [REDACTED] in account [REDACTED]",
+	}, exported.FailureReasons)
+	require.Equal(t, "AccessDenied for account [REDACTED]", exported.ScalingEvents[0].Error)
+
+	// Metrics and settings are kept
+	require.Equal(t, result.Results, exported.Results)
+	require.Equal(t, "simple", exported.Config.WorkflowType)
+	require.Equal(t, "5m0s", exported.Config.Duration)
+	require.Equal(t, 1, exported.StuckWorkflows.Count)
+	require.Equal(t, int64(1), exported.Outcomes.Mismatches)
+	require.Equal(t, result.System, exported.System)
+	require.True(t, exported.Run.StartTime.Equal(start))
+
+	// The original is untouched
+	require.Equal(t, "benchmark-1736935200000000000", result.Config.Namespace)
+	require.Len(t, result.StuckWorkflows.SampleIDs, 1)
+	require.Contains(t, result.FailureReasons[1], "acme-prod-simple-20250115-17")
+}
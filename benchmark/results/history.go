@@ -0,0 +1,93 @@
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+)
+
+// SinkHistory names the results history sink in logs.
+const SinkHistory = "history"
+
+// historySink appends each result as a JSON line to a local history file,
+// rotating it by size so repeated runs on the same volume can be trended
+// without external storage. Rotated files are named <path>.1 (newest) to
+// <path>.<keep>.
+type historySink struct {
+	path     string
+	maxBytes int64
+	keep     int
+	mu       sync.Mutex
+}
+
+// NewHistorySink creates a sink that appends results to the history file at
+// path. Before a write would grow the file beyond maxBytes, the file is
+// rotated and at most keep rotated files are retained (0 discards the old
+// history on rotation).
+func NewHistorySink(path string, maxBytes int64, keep int) Sink {
+	return &historySink{path: path, maxBytes: maxBytes, keep: keep}
+}
+
+func (s *historySink) Name() string {
+	return SinkHistory + ":" + s.path
+}
+
+func (s *historySink) Publish(_ context.Context, result *BenchmarkResultJSON) error {
+	line, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to serialize result: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if info, err := os.Stat(s.path); err == nil && info.Size() > 0 && info.Size()+int64(len(line)) > s.maxBytes {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", s.path, err)
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", s.path, err)
+	}
+	return f.Close()
+}
+
+// rotate shifts <path>.N to <path>.N+1, dropping the oldest beyond keep, and
+// moves the current file to <path>.1. s.mu must be held.
+func (s *historySink) rotate() error {
+	if s.keep == 0 {
+		if err := os.Remove(s.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", s.path, err)
+		}
+		return nil
+	}
+
+	oldest := s.rotatedPath(s.keep)
+	if err := os.Remove(oldest); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove %s: %w", oldest, err)
+	}
+	for n := s.keep - 1; n >= 1; n-- {
+		if err := os.Rename(s.rotatedPath(n), s.rotatedPath(n+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to rotate %s: %w", s.rotatedPath(n), err)
+		}
+	}
+	if err := os.Rename(s.path, s.rotatedPath(1)); err != nil {
+		return fmt.Errorf("failed to rotate %s: %w", s.path, err)
+	}
+	return nil
+}
+
+func (s *historySink) rotatedPath(n int) string {
+	return fmt.Sprintf("%s.%d", s.path, n)
+}
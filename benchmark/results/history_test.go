@@ -0,0 +1,51 @@
+package results
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistorySink_Rotates(t *testing.T) {
+	line, err := json.Marshal(sampleSinkResult())
+	require.NoError(t, err)
+	lineSize := int64(len(line) + 1)
+
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	// Two results fit per file, so five publishes leave 1 current and 2 rotated files
+	sink := NewHistorySink(path, 2*lineSize, 2)
+	require.Equal(t, "history:"+path, sink.Name())
+	for i := 0; i < 5; i++ {
+		result := sampleSinkResult()
+		result.Config.TargetRate = float64(i)
+		require.NoError(t, sink.Publish(context.Background(), result))
+	}
+
+	rates := func(p string) []float64 {
+		data, err := os.ReadFile(p)
+		require.NoError(t, err)
+		var out []float64
+		for _, l := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			parsed, err := FromJSON([]byte(l))
+			require.NoError(t, err)
+			out = append(out, parsed.Config.TargetRate)
+		}
+		return out
+	}
+	require.Equal(t, []float64{4}, rates(path))
+	require.Equal(t, []float64{2, 3}, rates(path+".1"))
+	require.Equal(t, []float64{0, 1}, rates(path+".2"))
+
+	// The oldest rotated file is dropped beyond keep
+	for i := 5; i < 7; i++ {
+		require.NoError(t, sink.Publish(context.Background(), sampleSinkResult()))
+	}
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+	require.Equal(t, []float64{2, 3}, rates(path+".2"))
+}
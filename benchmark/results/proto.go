@@ -0,0 +1,496 @@
+package results
+
+//go:generate protoc --go_out=. --go_opt=module=github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results results.proto
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results/resultspb"
+)
+
+// ProtoContentType is the content type of protobuf results posted to HTTP sinks.
+const ProtoContentType = "application/x-protobuf"
+
+// protoMarshal sorts map entries by key so the encoding is deterministic.
+var protoMarshal = proto.MarshalOptions{Deterministic: true}
+
+// ToProto serializes the result as a temporal.benchmark.results.v1.BenchmarkResult
+// message (see results.proto). As in proto3, zero values are omitted.
+func (r *BenchmarkResultJSON) ToProto() ([]byte, error) {
+	b, err := protoMarshal.Marshal(r.protoMessage())
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize result: %w", err)
+	}
+	return b, nil
+}
+
+// AppendDelimitedProto appends the result's ToProto encoding prefixed with its
+// varint length, the framing for a stream of results in one file.
+func (r *BenchmarkResultJSON) AppendDelimitedProto(b []byte) ([]byte, error) {
+	msg, err := r.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return protowire.AppendBytes(b, msg), nil
+}
+
+// protoMessage maps the result onto the generated BenchmarkResult.
+func (r *BenchmarkResultJSON) protoMessage() *resultspb.BenchmarkResult {
+	m := &resultspb.BenchmarkResult{
+		Timestamp:      protoTimestamp(r.Timestamp),
+		Config:         protoConfig(r.Config),
+		Results:        protoMetrics(r.Results),
+		System:         protoSystem(r.System),
+		Thresholds:     protoThresholds(r.Thresholds),
+		Run:            protoRun(r.Run),
+		Passed:         r.Passed,
+		FailureReasons: r.FailureReasons,
+		Anonymized:     r.Anonymized,
+	}
+	for _, p := range r.Phases {
+		m.Phases = append(m.Phases, protoPhase(p))
+	}
+	for _, b := range r.Backpressure {
+		m.Backpressure = append(m.Backpressure, &resultspb.BackpressureInterval{
+			StartTime:       protoTimestamp(b.StartTime),
+			EndTime:         protoTimestamp(b.EndTime),
+			PeakUtilization: b.PeakUtilization,
+			Held:            b.Held,
+		})
+	}
+	for _, a := range r.BurnRateAlerts {
+		m.BurnRateAlerts = append(m.BurnRateAlerts, &resultspb.BurnRateAlert{
+			StartTime:    protoTimestamp(a.StartTime),
+			EndTime:      protoTimestamp(a.EndTime),
+			PeakBurnRate: a.PeakBurnRate,
+		})
+	}
+	for _, s := range r.ScalingEvents {
+		m.ScalingEvents = append(m.ScalingEvents, &resultspb.ScalingEvent{
+			Time:       protoTimestamp(s.Time),
+			Offset:     s.Offset,
+			FromCount:  int64(s.FromCount),
+			ToCount:    int64(s.ToCount),
+			ReadyAfter: s.ReadyAfter,
+			Error:      s.Error,
+		})
+	}
+	for _, s := range r.ShardRestarts {
+		m.ShardRestarts = append(m.ShardRestarts, &resultspb.ShardRestart{
+			Time:           protoTimestamp(s.Time),
+			Offset:         s.Offset,
+			Tasks:          s.Tasks,
+			Error:          s.Error,
+			BaselineP99Ms:  s.BaselineP99Ms,
+			PeakP99Ms:      s.PeakP99Ms,
+			SpikeSeconds:   s.SpikeSeconds,
+			Recovered:      s.Recovered,
+			FailedRequests: s.FailedRequests,
+		})
+	}
+	for _, p := range r.Persistence {
+		m.PersistenceLatency = append(m.PersistenceLatency, &resultspb.PersistenceLatency{
+			Service:    p.Service,
+			Operation:  p.Operation,
+			Percentile: p.Percentile,
+			LatencyMs:  p.LatencyMs,
+		})
+	}
+	for _, c := range r.Ceilings {
+		ceiling := &resultspb.PersistenceCeiling{
+			Store:      c.Store,
+			Qps:        c.QPS,
+			TargetRate: c.TargetRate,
+			Reached:    c.Reached,
+			LimitedBy:  c.LimitedBy,
+		}
+		for _, step := range c.Steps {
+			ceiling.Steps = append(ceiling.Steps, &resultspb.PersistenceStep{
+				TargetRate: step.TargetRate,
+				Qps:        step.QPS,
+				ErrorRate:  step.ErrorRate,
+				P99Ms:      step.P99Ms,
+			})
+		}
+		m.PersistenceCeilings = append(m.PersistenceCeilings, ceiling)
+	}
+	if l := r.ReadLatency; l != nil {
+		m.ReadLatency = &resultspb.ReadLatency{
+			Qps:        l.QPS,
+			Describe:   protoReadAPILatency(l.Describe),
+			GetHistory: protoReadAPILatency(l.GetHistory),
+		}
+	}
+	for _, h := range r.HistorySize {
+		m.HistorySize = append(m.HistorySize, protoHistorySize(h))
+	}
+	for _, a := range r.ActivityLatency {
+		m.ActivityLatency = append(m.ActivityLatency, &resultspb.ActivityLatency{
+			ActivityType:    a.ActivityType,
+			Executions:      a.Executions,
+			Execution:       protoHistogramLatency(a.Execution),
+			ScheduleToStart: protoHistogramLatency(a.ScheduleToStart),
+		})
+	}
+	for _, t := range r.TypeLatency {
+		m.WorkflowTypeLatency = append(m.WorkflowTypeLatency, &resultspb.WorkflowTypeLatency{
+			WorkflowType: t.WorkflowType,
+			Completed:    t.Completed,
+			Failed:       t.Failed,
+			Latency:      protoLatency(t.Latency),
+		})
+	}
+	if s := r.StuckWorkflows; s != nil {
+		m.StuckWorkflows = &resultspb.StuckWorkflows{
+			Threshold:  s.Threshold,
+			Checked:    int64(s.Checked),
+			Count:      int64(s.Count),
+			SampleIds:  s.SampleIDs,
+			Terminated: int64(s.Terminated),
+		}
+	}
+	if o := r.Outcomes; o != nil {
+		m.OutcomeVerification = &resultspb.OutcomeVerification{
+			SampleRate: o.SampleRate,
+			Checked:    o.Checked,
+			Mismatches: o.Mismatches,
+			SampleIds:  o.SampleIDs,
+		}
+	}
+	if i := r.Integrity; i != nil {
+		m.HistoryIntegrity = &resultspb.HistoryIntegrity{
+			Checked:    int64(i.Checked),
+			Events:     i.Events,
+			Unreadable: int64(i.Unreadable),
+			Violated:   int64(i.Violated),
+		}
+		for _, v := range i.Violations {
+			m.HistoryIntegrity.Violations = append(m.HistoryIntegrity.Violations, &resultspb.IntegrityViolation{
+				WorkflowId: v.WorkflowID,
+				Reason:     v.Reason,
+			})
+		}
+	}
+	if d := r.Duplicates; d != nil {
+		m.DuplicateExecutions = &resultspb.DuplicateExecutions{
+			Activities:           d.Activities,
+			Workflows:            d.Workflows,
+			DuplicateActivities:  d.DuplicateActivities,
+			DuplicateWorkflows:   d.DuplicateWorkflows,
+			ActivitiesPerMillion: d.ActivitiesPerMillion,
+			WorkflowsPerMillion:  d.WorkflowsPerMillion,
+		}
+	}
+	if c := r.Cancellation; c != nil {
+		m.Cancellation = &resultspb.Cancellation{
+			Requested:             c.Requested,
+			Canceled:              c.Canceled,
+			Completed:             c.Completed,
+			CancelToClosedLatency: protoLatency(c.Latency),
+		}
+	}
+	if d := r.Drain; d != nil {
+		m.Drain = protoDrain(*d)
+	}
+	if c := r.ClockSkew; c != nil {
+		m.ClockSkew = &resultspb.ClockSkew{
+			Samples:       int64(c.Samples),
+			OffsetMs:      c.OffsetMs,
+			UncertaintyMs: c.UncertaintyMs,
+		}
+	}
+	if t := r.Topology; t != nil {
+		m.Topology = &resultspb.Topology{
+			ClientAz:    t.ClientAZ,
+			FrontendAzs: t.FrontendAZs,
+			Placement:   t.Placement,
+		}
+	}
+	if c := r.Connection; c != nil {
+		m.Connection = &resultspb.Connection{
+			Preference:    c.Preference,
+			AddressFamily: c.AddressFamily,
+			Dials:         c.Dials,
+			Fallbacks:     c.Fallbacks,
+		}
+	}
+	if d := r.DynamicConfig; d != nil {
+		m.DynamicConfig = &resultspb.DynamicConfig{
+			Services: d.Services,
+			Reverted: d.Reverted,
+			Error:    d.Error,
+		}
+		if len(d.Overrides) > 0 {
+			m.DynamicConfig.Overrides = make(map[string]string, len(d.Overrides))
+			for key, values := range d.Overrides {
+				m.DynamicConfig.Overrides[key] = string(values)
+			}
+		}
+	}
+	if c := r.ClusterState; c != nil {
+		m.ClusterState = &resultspb.ClusterState{
+			Before: protoClusterSnapshot(c.Before),
+			After:  protoClusterSnapshot(c.After),
+			Diff: &resultspb.ClusterSnapshotDiff{
+				Namespaces:          int64(c.Diff.Namespaces),
+				OpenWorkflows:       c.Diff.OpenWorkflows,
+				ClosedWorkflows:     c.Diff.ClosedWorkflows,
+				WorkflowTaskBacklog: c.Diff.WorkflowTaskBacklog,
+				ActivityTaskBacklog: c.Diff.ActivityTaskBacklog,
+			},
+		}
+	}
+	if h := r.LatencyHeatmap; h != nil {
+		m.LatencyHeatmap = &resultspb.LatencyHeatmap{
+			WindowSeconds: h.WindowSeconds,
+			BucketsMs:     h.BucketsMs,
+		}
+		for _, w := range h.Windows {
+			m.LatencyHeatmap.Windows = append(m.LatencyHeatmap.Windows, &resultspb.HeatmapWindow{
+				Start:  protoTimestamp(w.Start),
+				Counts: w.Counts,
+				Failed: w.Failed,
+			})
+		}
+	}
+	if g := r.GrafanaSnapshot; g != nil {
+		m.GrafanaSnapshot = &resultspb.GrafanaSnapshot{
+			Url:          g.URL,
+			DashboardUrl: g.DashboardURL,
+		}
+	}
+	return m
+}
+
+// protoTimestamp converts t, leaving the zero time unset.
+func protoTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func protoConfig(c ResultConfig) *resultspb.Config {
+	m := &resultspb.Config{
+		WorkflowType:                 c.WorkflowType,
+		ActivityCount:                int64(c.ActivityCount),
+		TimerDuration:                c.TimerDuration,
+		ChildCount:                   int64(c.ChildCount),
+		TargetRate:                   c.TargetRate,
+		Duration:                     c.Duration,
+		RampUpDuration:               c.RampUpDuration,
+		WorkerCount:                  int64(c.WorkerCount),
+		Iterations:                   int64(c.Iterations),
+		Namespace:                    c.Namespace,
+		Scenario:                     c.Scenario,
+		LatencySemantics:             c.LatencySemantics,
+		WorkflowIdTemplate:           c.WorkflowIDTemplate,
+		TargetStateTransitions:       c.TargetStateTransitions,
+		StateTransitionsPerWorkflow:  c.StateTransitionsPerWorkflow,
+		StateTransitionCostSource:    c.StateTransitionCostSource,
+		FanIn:                        int64(c.FanIn),
+		StickyDisabled:               c.StickyDisabled,
+		HeartbeatDuration:            c.HeartbeatDuration,
+		HeartbeatInterval:            c.HeartbeatInterval,
+		FailureRate:                  c.FailureRate,
+		RetryMaxAttempts:             int64(c.RetryMaxAttempts),
+		RetryInitialInterval:         c.RetryInitialInterval,
+		RetryBackoffCoefficient:      c.RetryBackoffCoefficient,
+		WorkerActivitiesPerSecond:    c.WorkerActivitiesPerSecond,
+		TaskQueueActivitiesPerSecond: c.TaskQueueActivitiesPerSecond,
+		SearchAttributeUpserts:       int64(c.SearchAttributeUpserts),
+		SideEffects:                  int64(c.SideEffects),
+		LongHistoryEvents:            int64(c.LongHistoryEvents),
+		FanoutWidth:                  int64(c.FanoutWidth),
+		FanoutDepth:                  int64(c.FanoutDepth),
+		FanoutJoin:                   c.FanoutJoin,
+		ChildDepth:                   int64(c.ChildDepth),
+		CancelRate:                   c.CancelRate,
+		CancelAfter:                  c.CancelAfter,
+		MemoSizeBytes:                int64(c.MemoSizeBytes),
+		HeaderSizeBytes:              int64(c.HeaderSizeBytes),
+		ActivityWork:                 c.ActivityWork,
+		ActivityCpuIterations:        int64(c.ActivityCPUIterations),
+	}
+	if len(c.WorkflowMix) > 0 {
+		m.WorkflowMix = make(map[string]int64, len(c.WorkflowMix))
+		for workflowType, weight := range c.WorkflowMix {
+			m.WorkflowMix[workflowType] = int64(weight)
+		}
+	}
+	return m
+}
+
+func protoLatency(l ResultLatency) *resultspb.Latency {
+	return &resultspb.Latency{
+		P50:         l.P50,
+		P95:         l.P95,
+		P99:         l.P99,
+		Max:         l.Max,
+		Approximate: l.Approximate,
+	}
+}
+
+func protoMetrics(m ResultMetrics) *resultspb.Metrics {
+	return &resultspb.Metrics{
+		WorkflowsStarted:   m.WorkflowsStarted,
+		WorkflowsCompleted: m.WorkflowsCompleted,
+		WorkflowsFailed:    m.WorkflowsFailed,
+		ActualRate:         m.ActualRate,
+		Latency:            protoLatency(m.Latency),
+		OccConflicts: &resultspb.OCCConflicts{
+			Count:           m.OCCConflicts.Count,
+			Rate:            m.OCCConflicts.Rate,
+			ClientErrors:    m.OCCConflicts.ClientErrors,
+			ServerConflicts: m.OCCConflicts.ServerConflicts,
+		},
+		AlreadyStarted:              m.AlreadyStarted,
+		StateTransitionRate:         m.StateTransitionRate,
+		HistoryReads:                m.HistoryReads,
+		HistoryReadsPerWorkflow:     m.HistoryReadsPerWorkflow,
+		FailedAttempts:              m.FailedAttempts,
+		FailedAttemptsPerWorkflow:   m.FailedAttemptsPerWorkflow,
+		VisibilityWrites:            m.VisibilityWrites,
+		VisibilityWritesPerWorkflow: m.VisibilityWritesPerWorkflow,
+		VisibilityWriteErrors:       m.VisibilityWriteErrors,
+		AvgHistoryLength:            m.AvgHistoryLength,
+		LatencyFallbacks:            m.LatencyFallbacks,
+	}
+}
+
+func protoPhase(p PhaseResult) *resultspb.Phase {
+	return &resultspb.Phase{
+		Name:               p.Name,
+		WorkflowType:       p.WorkflowType,
+		TargetRate:         p.TargetRate,
+		StartTime:          protoTimestamp(p.StartTime),
+		EndTime:            protoTimestamp(p.EndTime),
+		WorkflowsStarted:   p.WorkflowsStarted,
+		WorkflowsCompleted: p.WorkflowsCompleted,
+		WorkflowsFailed:    p.WorkflowsFailed,
+		ActualRate:         p.ActualRate,
+		Latency:            protoLatency(p.Latency),
+	}
+}
+
+func protoReadAPILatency(l ReadAPILatency) *resultspb.ReadAPILatency {
+	return &resultspb.ReadAPILatency{
+		Requests: l.Requests,
+		Errors:   l.Errors,
+		P50:      l.P50,
+		P95:      l.P95,
+		P99:      l.P99,
+		Max:      l.Max,
+	}
+}
+
+func protoHistorySize(h HistorySize) *resultspb.HistorySize {
+	return &resultspb.HistorySize{
+		WorkflowType: h.WorkflowType,
+		Samples:      int64(h.Samples),
+		AvgEvents:    h.AvgEvents,
+		P50Events:    h.P50Events,
+		P95Events:    h.P95Events,
+		MaxEvents:    h.MaxEvents,
+		AvgBytes:     h.AvgBytes,
+		P50Bytes:     h.P50Bytes,
+		P95Bytes:     h.P95Bytes,
+		MaxBytes:     h.MaxBytes,
+	}
+}
+
+func protoHistogramLatency(l HistogramLatency) *resultspb.HistogramLatency {
+	return &resultspb.HistogramLatency{
+		P50: l.P50,
+		P95: l.P95,
+		P99: l.P99,
+	}
+}
+
+func protoDrain(d DrainStats) *resultspb.DrainStats {
+	return &resultspb.DrainStats{
+		Outcome:         d.Outcome,
+		Adaptive:        d.Adaptive,
+		Duration:        d.Duration,
+		Deadline:        d.Deadline,
+		InFlightAtStart: d.InFlightAtStart,
+		Completed:       d.Completed,
+		Failed:          d.Failed,
+		Remaining:       d.Remaining,
+		CompletionRate:  d.CompletionRate,
+	}
+}
+
+func protoClusterSnapshot(s ClusterSnapshot) *resultspb.ClusterSnapshot {
+	return &resultspb.ClusterSnapshot{
+		Time:                protoTimestamp(s.Time),
+		Namespaces:          int64(s.Namespaces),
+		OpenWorkflows:       s.OpenWorkflows,
+		ClosedWorkflows:     s.ClosedWorkflows,
+		WorkflowTaskBacklog: s.WorkflowTaskBacklog,
+		ActivityTaskBacklog: s.ActivityTaskBacklog,
+		Incomplete:          s.Incomplete,
+	}
+}
+
+func protoSystem(s ResultSystem) *resultspb.System {
+	m := &resultspb.System{
+		InstanceType:  s.InstanceType,
+		HistoryShards: int64(s.HistoryShards),
+	}
+	if len(s.Services) > 0 {
+		m.Services = make(map[string]int64, len(s.Services))
+		for name, count := range s.Services {
+			m.Services[name] = int64(count)
+		}
+	}
+	if c := s.WorkflowCache; c != nil {
+		m.WorkflowCache = &resultspb.WorkflowCache{
+			Size:            int64(c.Size),
+			Hits:            c.Hits,
+			Misses:          c.Misses,
+			ForcedEvictions: c.ForcedEvictions,
+		}
+	}
+	return m
+}
+
+func protoThresholds(t ResultThresholds) *resultspb.Thresholds {
+	m := &resultspb.Thresholds{
+		Profile:            t.Profile,
+		MaxP99LatencyMs:    t.MaxP99LatencyMs,
+		MinThroughput:      t.MinThroughput,
+		Persistence:        t.Persistence,
+		MaxDescribeP99Ms:   t.MaxDescribeP99Ms,
+		MaxGetHistoryP99Ms: t.MaxGetHistoryP99Ms,
+	}
+	if b := t.Baseline; b != nil {
+		m.Baseline = &resultspb.BaselineThresholds{
+			Timestamp:            protoTimestamp(b.Timestamp),
+			MaxP99Percent:        b.MaxP99Percent,
+			MaxP99LatencyMs:      b.MaxP99LatencyMs,
+			MinThroughputPercent: b.MinThroughputPercent,
+			MinThroughput:        b.MinThroughput,
+		}
+	}
+	return m
+}
+
+func protoRun(r ResultRun) *resultspb.Run {
+	m := &resultspb.Run{
+		StartTime: protoTimestamp(r.StartTime),
+		EndTime:   protoTimestamp(r.EndTime),
+	}
+	for _, ids := range r.WorkflowIDs {
+		m.WorkflowIds = append(m.WorkflowIds, &resultspb.WorkflowIDRange{
+			Prefix: ids.Prefix,
+			Count:  ids.Count,
+		})
+	}
+	return m
+}
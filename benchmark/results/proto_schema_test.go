@@ -0,0 +1,228 @@
+package results
+
+import (
+	"bufio"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb" // Registers google/protobuf/timestamp.proto
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results/resultspb"
+)
+
+var (
+	protoMessageRE = regexp.MustCompile(`^message (\w+) \{$`)
+	protoFieldRE   = regexp.MustCompile(`^(repeated |optional )?([\w.]+) (\w+) = (\d+);$`)
+	protoMapRE     = regexp.MustCompile(`^map<(\w+), (\w+)> (\w+) = (\d+);$`)
+	protoHeaderRE  = regexp.MustCompile(`^(syntax|package|import|option) `)
+)
+
+var protoScalars = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"double": descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":  descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"int32":  descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"int64":  descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"uint64": descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"bool":   descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"string": descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bytes":  descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+}
+
+// protoJSONName is the lowerCamelCase JSON name protoc derives from a field name.
+func protoJSONName(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// parseResultsProto parses results.proto into a file descriptor. It only
+// understands the subset of the language the file uses (top-level messages
+// of scalar, message, repeated, optional and map fields) and fails on
+// anything else, so the schema cannot outgrow the check unnoticed.
+func parseResultsProto(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+	f, err := os.Open("results.proto")
+	require.NoError(t, err)
+	defer f.Close()
+
+	const pkg = "temporal.benchmark.results.v1"
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("results.proto"),
+		Package:    proto.String(pkg),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto"},
+	}
+	typeName := func(name string) string {
+		if strings.Contains(name, ".") {
+			return "." + name
+		}
+		return "." + pkg + "." + name
+	}
+	field := func(name string, number string, typ string) *descriptorpb.FieldDescriptorProto {
+		n, err := strconv.Atoi(number)
+		require.NoError(t, err)
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(int32(n)),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			JsonName: proto.String(protoJSONName(name)),
+		}
+		if scalar, ok := protoScalars[typ]; ok {
+			fd.Type = scalar.Enum()
+		} else {
+			fd.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			fd.TypeName = proto.String(typeName(typ))
+		}
+		return fd
+	}
+
+	var msg *descriptorpb.DescriptorProto
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.Index(text, "//"); i >= 0 {
+			text = text[:i]
+		}
+		text = strings.TrimSpace(text)
+		switch m := protoMessageRE.FindStringSubmatch(text); {
+		case text == "" || (msg == nil && protoHeaderRE.MatchString(text)):
+		case m != nil && msg == nil:
+			msg = &descriptorpb.DescriptorProto{Name: proto.String(m[1])}
+			file.MessageType = append(file.MessageType, msg)
+		case text == "}" && msg != nil:
+			msg = nil
+		case msg != nil && protoFieldRE.MatchString(text):
+			m := protoFieldRE.FindStringSubmatch(text)
+			fd := field(m[3], m[4], m[2])
+			switch m[1] {
+			case "repeated ":
+				fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			case "optional ":
+				fd.Proto3Optional = proto.Bool(true)
+				fd.OneofIndex = proto.Int32(int32(len(msg.OneofDecl)))
+				msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String("_" + m[3])})
+			}
+			msg.Field = append(msg.Field, fd)
+		case msg != nil && protoMapRE.MatchString(text):
+			m := protoMapRE.FindStringSubmatch(text)
+			var entry string
+			for _, part := range strings.Split(m[3], "_") {
+				entry += strings.ToUpper(part[:1]) + part[1:]
+			}
+			entry += "Entry"
+			msg.NestedType = append(msg.NestedType, &descriptorpb.DescriptorProto{
+				Name:    proto.String(entry),
+				Field:   []*descriptorpb.FieldDescriptorProto{field("key", "1", m[1]), field("value", "2", m[2])},
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+			})
+			fd := field(m[3], m[4], pkg+"."+msg.GetName()+"."+entry)
+			fd.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+			msg.Field = append(msg.Field, fd)
+		default:
+			t.Fatalf("results.proto:%d: unsupported declaration %q", line, text)
+		}
+	}
+	require.NoError(t, scanner.Err())
+
+	fd, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	return fd
+}
+
+// fillValue sets every field reachable from v to a non-zero value: one
+// element per slice and map, 1 for numbers, true for bools.
+func fillValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillValue(v.Elem())
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Date(2025, 1, 15, 10, 0, 0, 5, time.UTC)))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fillValue(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fillValue(v.Index(0))
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		elem := reflect.New(v.Type().Elem()).Elem()
+		fillValue(key)
+		fillValue(elem)
+		v.SetMapIndex(key, elem)
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	}
+}
+
+// requireAllFieldsSet fails if a field of m, or of any message within it,
+// was not encoded: with every result field filled, each schema field should
+// carry a value.
+func requireAllFieldsSet(t *testing.T, m protoreflect.Message, path string) {
+	t.Helper()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := path + "." + string(fd.Name())
+		if !m.Has(fd) {
+			t.Errorf("%s is declared in results.proto but not encoded by ToProto", name)
+			continue
+		}
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			requireAllFieldsSet(t, m.Get(fd).List().Get(0).Message(), name+"[0]")
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil && fd.Message().FullName() != "google.protobuf.Timestamp":
+			requireAllFieldsSet(t, m.Get(fd).Message(), name)
+		}
+	}
+}
+
+// TestResultsProto_MatchesGenerated checks that resultspb was regenerated
+// after the last change to results.proto.
+func TestResultsProto_MatchesGenerated(t *testing.T) {
+	parsed := protodesc.ToFileDescriptorProto(parseResultsProto(t)).GetMessageType()
+	generated := protodesc.ToFileDescriptorProto(resultspb.File_results_proto).GetMessageType()
+	require.Len(t, generated, len(parsed), "resultspb is out of date: run go generate ./results")
+	for i, msg := range parsed {
+		require.True(t, proto.Equal(msg, generated[i]),
+			"message %s differs from resultspb: run go generate ./results", msg.GetName())
+	}
+}
+
+// TestToProto_SetsEveryField checks that ToProto maps every result field: a
+// result with every field filled must set every field the schema declares.
+func TestToProto_SetsEveryField(t *testing.T) {
+	var result BenchmarkResultJSON
+	fillValue(reflect.ValueOf(&result).Elem())
+
+	var msg resultspb.BenchmarkResult
+	require.NoError(t, proto.Unmarshal(toProto(t, &result), &msg))
+	requireAllFieldsSet(t, msg.ProtoReflect(), "BenchmarkResult")
+}
@@ -0,0 +1,206 @@
+package results
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// decodeProto splits a message into its raw field values by field number.
+func decodeProto(t *testing.T, b []byte) map[protowire.Number][][]byte {
+	t.Helper()
+	fields := make(map[protowire.Number][][]byte)
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0, "bad tag")
+		b = b[n:]
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		require.GreaterOrEqual(t, n, 0, "bad field %d", num)
+		value := b[:n]
+		if typ == protowire.BytesType {
+			value, _ = protowire.ConsumeBytes(value)
+		}
+		fields[num] = append(fields[num], value)
+		b = b[n:]
+	}
+	return fields
+}
+
+func protoVarint(t *testing.T, b []byte) int64 {
+	t.Helper()
+	v, n := protowire.ConsumeVarint(b)
+	require.GreaterOrEqual(t, n, 0)
+	return int64(v)
+}
+
+func protoDouble(t *testing.T, b []byte) float64 {
+	t.Helper()
+	v, n := protowire.ConsumeFixed64(b)
+	require.GreaterOrEqual(t, n, 0)
+	return math.Float64frombits(v)
+}
+
+// toProto encodes result, failing the test on error.
+func toProto(t *testing.T, result *BenchmarkResultJSON) []byte {
+	t.Helper()
+	b, err := result.ToProto()
+	require.NoError(t, err)
+	return b
+}
+
+func TestToProto(t *testing.T) {
+	result := sampleSinkResult()
+	zero := int64(0)
+	result.Results.WorkflowsCompleted = 42
+	result.Results.OCCConflicts.ServerConflicts = &zero
+	result.FailureReasons = []string{"p99 too high", "throughput too low"}
+
+	msg := decodeProto(t, toProto(t, result))
+
+	ts := decodeProto(t, msg[1][0])
+	require.Equal(t, result.Timestamp.Unix(), protoVarint(t, ts[1][0]))
+	require.NotContains(t, ts, protowire.Number(2), "zero nanos are omitted")
+
+	cfg := decodeProto(t, msg[2][0])
+	require.Equal(t, "simple", string(cfg[1][0]))
+	require.Equal(t, 100.0, protoDouble(t, cfg[5][0]))
+	require.Equal(t, "5m0s", string(cfg[6][0]))
+
+	metrics := decodeProto(t, msg[3][0])
+	require.Equal(t, int64(42), protoVarint(t, metrics[2][0]))
+	require.NotContains(t, metrics, protowire.Number(1), "zero counts are omitted")
+	occ := decodeProto(t, metrics[6][0])
+	require.Equal(t, int64(0), protoVarint(t, occ[4][0]), "set optional field is present when zero")
+
+	system := decodeProto(t, msg[14][0])
+	entry := decodeProto(t, system[3][0])
+	require.Equal(t, "history", string(entry[1][0]))
+	require.Equal(t, int64(1), protoVarint(t, entry[2][0]))
+
+	require.Equal(t, int64(1), protoVarint(t, msg[17][0]))
+	require.Len(t, msg[18], 2)
+	require.Equal(t, "throughput too low", string(msg[18][1]))
+	require.NotContains(t, msg, protowire.Number(11), "nil drain stats are omitted")
+	require.NotContains(t, msg, protowire.Number(19), "nil heatmap is omitted")
+
+	// Strings must be valid UTF-8 in proto3
+	result.FailureReasons = []string{"\xff"}
+	_, err := result.ToProto()
+	require.ErrorContains(t, err, "failed to serialize result")
+}
+
+func TestToProto_LatencyHeatmap(t *testing.T) {
+	result := sampleSinkResult()
+	result.LatencyHeatmap = &LatencyHeatmap{
+		WindowSeconds: 10,
+		BucketsMs:     []float64{1, 2},
+		Windows:       []HeatmapWindow{{Start: result.Timestamp, Counts: []int64{3, 0, 1}, Failed: 2}},
+	}
+
+	heatmap := decodeProto(t, decodeProto(t, toProto(t, result))[19][0])
+	require.Equal(t, 10.0, protoDouble(t, heatmap[1][0]))
+	buckets := heatmap[2][0]
+	require.Equal(t, 1.0, protoDouble(t, buckets[:8]))
+	require.Equal(t, 2.0, protoDouble(t, buckets[8:]))
+
+	window := decodeProto(t, heatmap[3][0])
+	require.Equal(t, []byte{3, 0, 1}, window[2][0], "packed counts keep zeros")
+	require.Equal(t, int64(2), protoVarint(t, window[3][0]))
+}
+
+func TestToProto_GrafanaSnapshot(t *testing.T) {
+	result := sampleSinkResult()
+	require.NotContains(t, decodeProto(t, toProto(t, result)), protowire.Number(20), "nil snapshot is omitted")
+
+	result.GrafanaSnapshot = &GrafanaSnapshot{URL: "http://grafana/dashboard/snapshot/abc", DashboardURL: "http://grafana/d/x"}
+	snapshot := decodeProto(t, decodeProto(t, toProto(t, result))[20][0])
+	require.Equal(t, "http://grafana/dashboard/snapshot/abc", string(snapshot[1][0]))
+	require.Equal(t, "http://grafana/d/x", string(snapshot[2][0]))
+	require.Contains(t, result.FormatSummary(), "Grafana:   http://grafana/dashboard/snapshot/abc")
+}
+
+func TestToProto_WorkflowCache(t *testing.T) {
+	result := sampleSinkResult()
+	system := decodeProto(t, decodeProto(t, toProto(t, result))[14][0])
+	require.NotContains(t, system, protowire.Number(4), "nil workflow cache is omitted")
+
+	hits, misses, evictions := int64(900), int64(100), int64(0)
+	result.System.WorkflowCache = &WorkflowCache{Size: 5000, Hits: &hits, Misses: &misses, ForcedEvictions: &evictions}
+	system = decodeProto(t, decodeProto(t, toProto(t, result))[14][0])
+	cache := decodeProto(t, system[4][0])
+	require.Equal(t, int64(5000), protoVarint(t, cache[1][0]))
+	require.Equal(t, int64(900), protoVarint(t, cache[2][0]))
+	require.Equal(t, int64(100), protoVarint(t, cache[3][0]))
+	require.Equal(t, int64(0), protoVarint(t, cache[4][0]), "optional zero is present")
+	summary := result.FormatSummary()
+	require.Contains(t, summary, "Workflow Cache:       5000")
+	require.Contains(t, summary, "Cache Hits/Misses:    900 / 100 (0 forced evictions)")
+
+	result.System.WorkflowCache = &WorkflowCache{}
+	require.Contains(t, result.FormatSummary(), "Workflow Cache:       disabled")
+}
+
+func TestToProto_ActivityLatency(t *testing.T) {
+	result := sampleSinkResult()
+	require.NotContains(t, decodeProto(t, toProto(t, result)), protowire.Number(22), "no activity latencies are omitted")
+
+	result.ActivityLatency = []ActivityLatency{{
+		ActivityType:    "NoOpActivity",
+		Executions:      1000,
+		Execution:       HistogramLatency{P50: 1.5, P95: 3, P99: 6},
+		ScheduleToStart: HistogramLatency{P50: 2, P95: 4, P99: 8},
+	}}
+	activity := decodeProto(t, decodeProto(t, toProto(t, result))[22][0])
+	require.Equal(t, "NoOpActivity", string(activity[1][0]))
+	require.Equal(t, int64(1000), protoVarint(t, activity[2][0]))
+	execution := decodeProto(t, activity[3][0])
+	require.Equal(t, 1.5, protoDouble(t, execution[1][0]))
+	require.Equal(t, 6.0, protoDouble(t, execution[3][0]))
+	scheduleToStart := decodeProto(t, activity[4][0])
+	require.Equal(t, 4.0, protoDouble(t, scheduleToStart[2][0]))
+
+	summary := result.FormatSummary()
+	require.Contains(t, summary, "NoOpActivity (1000 executions)")
+	require.Contains(t, summary, "Schedule-to-Start P50/P95/P99: 2.00/4.00/8.00 ms")
+}
+
+func TestFileSink_Proto(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.pb")
+	sink := NewFileSink(path, WithFormat(config.ResultsFormatProto))
+
+	require.NoError(t, sink.Publish(context.Background(), sampleSinkResult()))
+	require.NoError(t, sink.Publish(context.Background(), sampleSinkResult()))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		msg, n := protowire.ConsumeBytes(data)
+		require.GreaterOrEqual(t, n, 0)
+		require.Equal(t, toProto(t, sampleSinkResult()), msg)
+		data = data[n:]
+	}
+	require.Empty(t, data)
+}
+
+func TestHTTPSink_Proto(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, ProtoContentType, r.Header.Get("Content-Type"))
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, WithFormat(config.ResultsFormatProto))
+	require.NoError(t, sink.Publish(context.Background(), sampleSinkResult()))
+	require.Equal(t, toProto(t, sampleSinkResult()), body)
+}
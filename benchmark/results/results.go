@@ -0,0 +1,1869 @@
+// Package results provides result reporting and serialization.
+package results
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// ResultConfig contains the configuration used for the benchmark.
+// Requirement 6.5: WHEN results are generated, THE Benchmark_Runner SHALL include
+// timestamp and test parameters for reproducibility.
+type ResultConfig struct {
+	WorkflowType   string  `json:"workflowType"`
+	ActivityCount  int     `json:"activityCount,omitempty"`
+	TimerDuration  string  `json:"timerDuration,omitempty"`
+	ChildCount     int     `json:"childCount,omitempty"`
+	ChildDepth     int     `json:"childDepth,omitempty"`
+	FanIn          int     `json:"fanIn,omitempty"`
+	StickyDisabled bool    `json:"stickyDisabled,omitempty"`
+	TargetRate     float64 `json:"targetRate"`
+	Duration       string  `json:"duration"`
+	RampUpDuration string  `json:"rampUpDuration,omitempty"`
+	WorkerCount    int     `json:"workerCount"`
+	Iterations     int     `json:"iterations"`
+	Namespace      string  `json:"namespace,omitempty"`
+	Scenario       string  `json:"scenario,omitempty"`
+
+	// LatencySemantics records what the latency figures measure so runs
+	// compare like-for-like (see config.LatencySubmitToComplete and friends)
+	LatencySemantics string `json:"latencySemantics,omitempty"`
+
+	// WorkflowIDTemplate is the template the workflow ID prefixes in
+	// run.workflowIds were expanded from
+	WorkflowIDTemplate string `json:"workflowIdTemplate,omitempty"`
+
+	// HeartbeatDuration and HeartbeatInterval are the heartbeat workflow's
+	// activity run time and time between heartbeats
+	HeartbeatDuration string `json:"heartbeatDuration,omitempty"`
+	HeartbeatInterval string `json:"heartbeatInterval,omitempty"`
+
+	// The flaky workflow's injected failure rate and activity retry policy
+	FailureRate             float64 `json:"failureRate,omitempty"`
+	RetryMaxAttempts        int     `json:"retryMaxAttempts,omitempty"`
+	RetryInitialInterval    string  `json:"retryInitialInterval,omitempty"`
+	RetryBackoffCoefficient float64 `json:"retryBackoffCoefficient,omitempty"`
+
+	// SearchAttributeUpserts is the search attribute workflow's upserts per workflow
+	SearchAttributeUpserts int `json:"searchAttributeUpserts,omitempty"`
+
+	// SideEffects is the side effect workflow's workflow.SideEffect calls per workflow
+	SideEffects int `json:"sideEffects,omitempty"`
+
+	// LongHistoryEvents is the long-history workflow's configured history events
+	LongHistoryEvents int `json:"longHistoryEvents,omitempty"`
+
+	// The fanout workflow's parallel activities per stage, stages and join strategy
+	FanoutWidth int    `json:"fanoutWidth,omitempty"`
+	FanoutDepth int    `json:"fanoutDepth,omitempty"`
+	FanoutJoin  string `json:"fanoutJoin,omitempty"`
+
+	// The work of the multi-activity, side-effects and fanout workflows'
+	// activities ("sleep" or "cpu") and the SHA-256 iterations of each CPU
+	// activity
+	ActivityWork          string `json:"activityWork,omitempty"`
+	ActivityCPUIterations int    `json:"activityCpuIterations,omitempty"`
+
+	// The fraction of workflows the generator cancelled and how long after
+	// their start
+	CancelRate  float64 `json:"cancelRate,omitempty"`
+	CancelAfter string  `json:"cancelAfter,omitempty"`
+
+	// The relative weight of each workflow type started by a mixed run
+	WorkflowMix map[string]int `json:"workflowMix,omitempty"`
+
+	// The memo and header padding of every generated workflow start, in bytes
+	MemoSizeBytes   int `json:"memoSizeBytes,omitempty"`
+	HeaderSizeBytes int `json:"headerSizeBytes,omitempty"`
+
+	// Activity rate limits of the workers, per worker and per task queue;
+	// unset when the SDK defaults applied. A run whose throughput plateaus
+	// near them was limited by configuration rather than the cluster
+	WorkerActivitiesPerSecond    float64 `json:"workerActivitiesPerSecond,omitempty"`
+	TaskQueueActivitiesPerSecond float64 `json:"taskQueueActivitiesPerSecond,omitempty"`
+
+	// TargetStateTransitions is the state transition target TargetRate was
+	// translated from, using StateTransitionsPerWorkflow from the calibration
+	// table or the cost model, as StateTransitionCostSource records
+	// (see config.BenchmarkConfig.TransitionCost; unset for scenario runs)
+	TargetStateTransitions      float64 `json:"targetStateTransitions,omitempty"`
+	StateTransitionsPerWorkflow float64 `json:"stateTransitionsPerWorkflow,omitempty"`
+	StateTransitionCostSource   string  `json:"stateTransitionCostSource,omitempty"`
+}
+
+// ResultLatency contains latency percentiles in milliseconds.
+type ResultLatency struct {
+	P50 float64 `json:"p50"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+
+	// Approximate is set when percentiles were estimated from a histogram
+	// because raw samples exceeded the latency memory budget
+	Approximate bool `json:"approximate,omitempty"`
+}
+
+// ResultMetrics contains the benchmark metrics.
+type ResultMetrics struct {
+	WorkflowsStarted   int64         `json:"workflowsStarted"`
+	WorkflowsCompleted int64         `json:"workflowsCompleted"`
+	WorkflowsFailed    int64         `json:"workflowsFailed"`
+	ActualRate         float64       `json:"actualRate"`
+	Latency            ResultLatency `json:"latency"`
+	OCCConflicts       OCCConflicts  `json:"occConflicts"`
+
+	// AlreadyStarted counts starts rejected because the workflow ID was
+	// already started; they are not failures (see config.AlreadyStartedAsSuccess)
+	AlreadyStarted int64 `json:"alreadyStarted"`
+
+	// StateTransitionRate estimates the achieved state transitions per second
+	// from ActualRate and the cost model (unset for scenario runs)
+	StateTransitionRate float64 `json:"stateTransitionRate,omitempty"`
+
+	// HistoryReads counts the server's history branch reads during the run,
+	// which grow when workflow tasks replay their history (e.g. with sticky
+	// execution disabled); only set when server metrics are scraped
+	HistoryReads            *int64  `json:"historyReads,omitempty"`
+	HistoryReadsPerWorkflow float64 `json:"historyReadsPerWorkflow,omitempty"`
+
+	// FailedAttempts counts the injected activity failures that completed
+	// flaky workflows retried; only set for runs of the flaky workflow type
+	FailedAttempts            *int64  `json:"failedAttempts,omitempty"`
+	FailedAttemptsPerWorkflow float64 `json:"failedAttemptsPerWorkflow,omitempty"`
+
+	// AvgHistoryLength is the average history events of the completed
+	// workflows; only set for runs of the long-history workflow type
+	AvgHistoryLength float64 `json:"avgHistoryLength,omitempty"`
+
+	// VisibilityWrites counts the server's visibility store writes during
+	// the run (execution starts, closes and search attribute upserts) and
+	// VisibilityWriteErrors those that failed; only set when server metrics
+	// are scraped
+	VisibilityWrites            *int64  `json:"visibilityWrites,omitempty"`
+	VisibilityWritesPerWorkflow float64 `json:"visibilityWritesPerWorkflow,omitempty"`
+	VisibilityWriteErrors       *int64  `json:"visibilityWriteErrors,omitempty"`
+
+	// LatencyFallbacks counts the completed workflows whose latency is the
+	// client's submit-to-complete latency because their server latency could
+	// not be read; they are included in Latency. Only set for server latency
+	// semantics
+	LatencyFallbacks *int64 `json:"latencyFallbacks,omitempty"`
+}
+
+// OCCConflicts counts DSQL optimistic concurrency conflicts during the run.
+// ClientErrors are conflicts surfaced to the benchmark client as start or
+// workflow errors. ServerConflicts is the growth of the server's conflict
+// counter, including conflicts the server retried, and is only set when server
+// metrics are scraped. Count is ServerConflicts when available, otherwise
+// ClientErrors; Rate is Count per second of run time.
+type OCCConflicts struct {
+	Count           int64   `json:"count"`
+	Rate            float64 `json:"rate"`
+	ClientErrors    int64   `json:"clientErrors"`
+	ServerConflicts *int64  `json:"serverConflicts,omitempty"`
+}
+
+// Total returns the best available conflict count: the server's count when
+// scraped, since it includes retried conflicts, otherwise the client's.
+func (o OCCConflicts) Total() int64 {
+	if o.ServerConflicts != nil {
+		return *o.ServerConflicts
+	}
+	return o.ClientErrors
+}
+
+// ResultSystem contains system information.
+// Requirement 6.3: THE Benchmark_Runner SHALL include system configuration in the results
+// (instance types, service counts, shard count).
+type ResultSystem struct {
+	InstanceType  string         `json:"instanceType"`
+	HistoryShards int            `json:"historyShards"`
+	Services      map[string]int `json:"services"`
+	// WorkflowCache is the embedded workers' workflow cache, nil for the
+	// generate role's results
+	WorkflowCache *WorkflowCache `json:"workflowCache,omitempty"`
+}
+
+// WorkflowCache describes the SDK workflow (sticky) cache of the benchmark's
+// workers. Every miss replays a workflow's history from the server, so cache
+// pressure shows up directly as DSQL read volume. The counts are nil when
+// the SDK metrics could not be read.
+type WorkflowCache struct {
+	// Size is the cache capacity in workflows, 0 if sticky execution is disabled
+	Size            int    `json:"size"`
+	Hits            *int64 `json:"hits,omitempty"`
+	Misses          *int64 `json:"misses,omitempty"`
+	ForcedEvictions *int64 `json:"forcedEvictions,omitempty"`
+}
+
+// ResultThresholds contains the threshold configuration used for pass/fail evaluation.
+type ResultThresholds struct {
+	Profile         string   `json:"profile,omitempty"`
+	MaxP99LatencyMs float64  `json:"maxP99LatencyMs"`
+	MinThroughput   float64  `json:"minThroughput"`
+	Persistence     []string `json:"persistence,omitempty"`
+
+	MaxDescribeP99Ms   float64 `json:"maxDescribeP99Ms,omitempty"`
+	MaxGetHistoryP99Ms float64 `json:"maxGetHistoryP99Ms,omitempty"`
+
+	Baseline *BaselineThresholds `json:"baseline,omitempty"`
+}
+
+// WorkflowIDRange identifies the workflow IDs generated by one iteration:
+// "<Prefix>-<n>" for n in [1, Count].
+type WorkflowIDRange struct {
+	Prefix string `json:"prefix"`
+	Count  int64  `json:"count"`
+}
+
+// ResultRun records when the run happened and which workflow IDs it generated,
+// so the run's data can be located again later (e.g. for retention verification).
+type ResultRun struct {
+	StartTime   time.Time         `json:"startTime"`
+	EndTime     time.Time         `json:"endTime"`
+	WorkflowIDs []WorkflowIDRange `json:"workflowIds,omitempty"`
+}
+
+// PhaseResult contains the metrics for one phase of a phased scenario.
+// Workflows are attributed to the phase that started them, even if they
+// complete after the phase has ended.
+type PhaseResult struct {
+	Name               string        `json:"name"`
+	WorkflowType       string        `json:"workflowType"`
+	TargetRate         float64       `json:"targetRate"`
+	StartTime          time.Time     `json:"startTime"`
+	EndTime            time.Time     `json:"endTime"`
+	WorkflowsStarted   int64         `json:"workflowsStarted"`
+	WorkflowsCompleted int64         `json:"workflowsCompleted"`
+	WorkflowsFailed    int64         `json:"workflowsFailed"`
+	ActualRate         float64       `json:"actualRate"`
+	Latency            ResultLatency `json:"latency"`
+}
+
+// BackpressureInterval is a period during which worker task slots were saturated.
+// Held is true if the generator's rate was held for the interval rather than
+// continuing to increase; intervals distinguish worker-fleet limits from cluster limits.
+type BackpressureInterval struct {
+	StartTime       time.Time `json:"startTime"`
+	EndTime         time.Time `json:"endTime"`
+	PeakUtilization float64   `json:"peakUtilization"`
+	Held            bool      `json:"held"`
+}
+
+// BurnRateAlert is a period during which the p99 latency threshold's error
+// budget burned at or above the alert threshold over both burn-rate windows
+// (see config.BenchmarkConfig.BurnRateWindow). PeakBurnRate is the highest
+// long-window burn rate while it fired.
+type BurnRateAlert struct {
+	StartTime    time.Time `json:"startTime"`
+	EndTime      time.Time `json:"endTime"`
+	PeakBurnRate float64   `json:"peakBurnRate"`
+}
+
+// ScalingEvent records one step of a worker scaling experiment. ReadyAfter is
+// how long the worker service took to reach ToCount running workers; it is empty
+// if the service had not converged before the next step or the end of the run.
+type ScalingEvent struct {
+	Time       time.Time `json:"time"`
+	Offset     string    `json:"offset"`
+	FromCount  int       `json:"fromCount"`
+	ToCount    int       `json:"toCount"`
+	ReadyAfter string    `json:"readyAfter,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// ShardRestart records a restart of history service tasks by a shard churn
+// scenario and the workflow latency spike that followed. BaselineP99Ms is the
+// p99 latency of the minute before the restart, and the spike lasts until
+// the p99 latency is back within 1.5 times the baseline with no failures;
+// Recovered is false if it was not before the next restart or the end of the
+// run. FailedRequests counts the failed workflow starts and executions during
+// the spike. Latencies are the upper bounds of histogram buckets.
+type ShardRestart struct {
+	Time           time.Time `json:"time"`
+	Offset         string    `json:"offset"`
+	Tasks          []string  `json:"tasks,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	BaselineP99Ms  float64   `json:"baselineP99Ms"`
+	PeakP99Ms      float64   `json:"peakP99Ms"`
+	SpikeSeconds   float64   `json:"spikeSeconds"`
+	Recovered      bool      `json:"recovered"`
+	FailedRequests int64     `json:"failedRequests"`
+}
+
+// Placements of the benchmark client relative to the frontend tasks it calls.
+const (
+	PlacementSameAZ  = "same-az"  // Every frontend task is in the client's AZ
+	PlacementCrossAZ = "cross-az" // No frontend task is in the client's AZ
+	PlacementMixed   = "mixed"    // Some calls stay in the client's AZ, others cross
+)
+
+// Topology records the availability zones of the benchmark client (the ECS
+// task it ran in) and of the frontend tasks its calls reached, from ECS task
+// metadata. FrontendAZs are those of the tasks the frontend address resolves
+// to, or of all the frontend service's tasks when it resolves to a proxy or
+// load balancer. Either side is empty where it could not be resolved, and
+// Placement is then unknown.
+type Topology struct {
+	ClientAZ    string   `json:"clientAz,omitempty"`
+	FrontendAZs []string `json:"frontendAzs,omitempty"`
+	Placement   string   `json:"placement,omitempty"`
+}
+
+// Address families of the connections to the frontend.
+const (
+	AddressFamilyIPv4  = "ipv4"
+	AddressFamilyIPv6  = "ipv6"
+	AddressFamilyMixed = "mixed" // Some connections over each family
+)
+
+// Connection records how the benchmark's clients reached the frontend: the
+// configured address family preference, the family the connections were
+// made over, and how many of them fell back from the preferred family to
+// the other (see config.AddressFamilyAuto and friends).
+type Connection struct {
+	Preference    string `json:"preference"`
+	AddressFamily string `json:"addressFamily"`
+	Dials         int64  `json:"dials"`
+	Fallbacks     int64  `json:"fallbacks,omitempty"`
+}
+
+// DynamicConfig records the Temporal dynamic config overrides a scenario
+// applied for the run: each overridden key with its value (the dynamic
+// config file's list of constrained values, as JSON), the ECS services
+// redeployed with them, and whether the services were redeployed without
+// them after the run. Error says why reverting failed.
+type DynamicConfig struct {
+	Overrides map[string]json.RawMessage `json:"overrides"`
+	Services  []string                   `json:"services"`
+	Reverted  bool                       `json:"reverted"`
+	Error     string                     `json:"error,omitempty"`
+}
+
+// StuckWorkflows reports open workflows that made no history progress within
+// the stuck threshold after the drain. Checked is how many open workflows had
+// their history inspected; SampleIDs lists up to MaxStuckSamples stuck workflow IDs.
+type StuckWorkflows struct {
+	Threshold  string   `json:"threshold"`
+	Checked    int      `json:"checked"`
+	Count      int      `json:"count"`
+	SampleIDs  []string `json:"sampleIds,omitempty"`
+	Terminated int      `json:"terminated,omitempty"`
+}
+
+// OutcomeVerification reports the sampled completed workflows whose returned
+// outcome (steps and their checksum) was compared with a correct run's.
+// Mismatches are workflows that skipped, repeated or lost a step while still
+// completing; SampleIDs lists up to MaxOutcomeSamples of them.
+type OutcomeVerification struct {
+	SampleRate float64  `json:"sampleRate"`
+	Checked    int64    `json:"checked"`
+	Mismatches int64    `json:"mismatches"`
+	SampleIDs  []string `json:"sampleIds,omitempty"`
+}
+
+// Drain outcomes.
+const (
+	DrainCompleted = "drained"   // Every in-flight workflow finished
+	DrainStalled   = "stalled"   // No workflow finished within the stall timeout
+	DrainDeadline  = "deadline"  // The drain deadline passed with workflows still in flight
+	DrainCancelled = "cancelled" // The run was cancelled during the drain
+)
+
+// DrainStats describes the wait for in-flight workflows after generation
+// stopped. Deadline is the final deadline measured from the start of the
+// drain; with an adaptive drain it grows while workflows keep finishing.
+// Completed and Failed count the workflows that finished during the drain;
+// they are included in the run's totals but not in its throughput, which
+// covers only the measurement window.
+type DrainStats struct {
+	Outcome         string  `json:"outcome"`
+	Adaptive        bool    `json:"adaptive"`
+	Duration        string  `json:"duration"`
+	Deadline        string  `json:"deadline"`
+	InFlightAtStart int64   `json:"inFlightAtStart"`
+	Completed       int64   `json:"completed"`
+	Failed          int64   `json:"failed"`
+	Remaining       int64   `json:"remaining"`
+	CompletionRate  float64 `json:"completionRate"` // Workflows finished per second during the drain
+}
+
+// MaxStuckSamples bounds the stuck workflow IDs included in results.
+const MaxStuckSamples = 10
+
+// MaxOutcomeSamples bounds the mismatched workflow IDs included in results.
+const MaxOutcomeSamples = 10
+
+// HistoryIntegrity reports the structural audit of the full histories of a
+// sample of completed workflows, a consistency check on the persistence
+// layer: event IDs must run 1, 2, 3, ...; every started, completed, fired or
+// failed event must refer to the open scheduling event of its kind, and every
+// scheduled activity, timer, child and workflow task must be closed; and the
+// workflow must complete without a failed activity or child. Violated counts
+// the workflows with at least one violation; Violations lists up to
+// MaxIntegrityViolations of them.
+type HistoryIntegrity struct {
+	Checked    int                  `json:"checked"`
+	Events     int64                `json:"events"`
+	Unreadable int                  `json:"unreadable,omitempty"` // Sampled histories that could not be read
+	Violated   int                  `json:"violated"`
+	Violations []IntegrityViolation `json:"violations,omitempty"`
+}
+
+// IntegrityViolation is the first invariant a sampled history violated.
+type IntegrityViolation struct {
+	WorkflowID string `json:"workflowId"`
+	Reason     string `json:"reason"`
+}
+
+// MaxIntegrityViolations bounds the integrity violations included in results.
+const MaxIntegrityViolations = 10
+
+// DuplicateExecutions reports the activity and workflow executions the
+// embedded workers saw more than once. Each NoOpActivity and FastActivity
+// execution is recorded by workflow run and activity index: a repeat of an
+// activity that already executed is a duplicate activity (these activities
+// never fail, so it was redelivered rather than retried), and activities of a
+// second run of a workflow ID reveal a duplicate workflow execution. Activities
+// run by external workers or other activity types are not covered.
+type DuplicateExecutions struct {
+	Activities           int64   `json:"activities"` // Activity executions recorded
+	Workflows            int64   `json:"workflows"`  // Workflow runs the recorded activities belonged to
+	DuplicateActivities  int64   `json:"duplicateActivities"`
+	DuplicateWorkflows   int64   `json:"duplicateWorkflows"`
+	ActivitiesPerMillion float64 `json:"activitiesPerMillion"` // Duplicate activities per million recorded
+	WorkflowsPerMillion  float64 `json:"workflowsPerMillion"`  // Duplicate workflows per million runs
+}
+
+// NewDuplicateExecutions returns the duplicate executions among the given
+// activity executions and workflow runs, or nil if none were recorded.
+func NewDuplicateExecutions(activities, workflows, duplicateActivities, duplicateWorkflows int64) *DuplicateExecutions {
+	if activities <= 0 {
+		return nil
+	}
+	d := &DuplicateExecutions{
+		Activities:           activities,
+		Workflows:            workflows,
+		DuplicateActivities:  duplicateActivities,
+		DuplicateWorkflows:   duplicateWorkflows,
+		ActivitiesPerMillion: float64(duplicateActivities) / float64(activities) * 1e6,
+	}
+	if workflows > 0 {
+		d.WorkflowsPerMillion = float64(duplicateWorkflows) / float64(workflows) * 1e6
+	}
+	return d
+}
+
+// WorkflowTypeLatency reports the workflows of one type started by a mixed
+// run (see config.WorkflowTypeMixed) and their latencies.
+type WorkflowTypeLatency struct {
+	WorkflowType string        `json:"workflowType"`
+	Completed    int64         `json:"completed"`
+	Failed       int64         `json:"failed"`
+	Latency      ResultLatency `json:"latency"`
+}
+
+// Cancellation reports the workflows the generator cancelled mid-flight (see
+// config.BenchmarkConfig.CancelRate). Latency is the time from the cancel
+// request to the close of the workflows that closed as cancelled; Completed
+// counts those that completed or failed before the cancellation took effect.
+type Cancellation struct {
+	Requested int64         `json:"requested"`
+	Canceled  int64         `json:"canceled"`
+	Completed int64         `json:"completed"`
+	Latency   ResultLatency `json:"cancelToClosedLatency"`
+}
+
+// ClockSkew is the measured offset of the Temporal server's clock from the
+// benchmark client's clock (positive when the server is ahead), taken from the
+// canary workflow with the shortest round trip. The true offset lies within
+// OffsetMs ± UncertaintyMs.
+type ClockSkew struct {
+	Samples       int     `json:"samples"`
+	OffsetMs      float64 `json:"offsetMs"`
+	UncertaintyMs float64 `json:"uncertaintyMs"`
+}
+
+// PersistenceLatency is a server-side persistence latency percentile for one
+// operation over the run, read from scraped server metrics.
+type PersistenceLatency struct {
+	Service    string  `json:"service,omitempty"`
+	Operation  string  `json:"operation"`
+	Percentile float64 `json:"percentile"`
+	LatencyMs  float64 `json:"latencyMs"`
+}
+
+// PersistenceCeiling is the persistence QPS one store (history, matching or
+// visibility) sustained in a ceiling search scenario: the highest QPS of a
+// step before the first in which it exceeded the search's error rate or
+// latency limit. If it never did, Reached is false and QPS is a lower bound.
+type PersistenceCeiling struct {
+	Store      string            `json:"store"`
+	QPS        float64           `json:"qps"`
+	TargetRate float64           `json:"targetRate"` // Workflow rate of the step that served QPS
+	Reached    bool              `json:"reached"`
+	LimitedBy  string            `json:"limitedBy,omitempty"` // "errors" or "latency", if reached
+	Steps      []PersistenceStep `json:"steps"`
+}
+
+// PersistenceStep is a store's load during one step of a ceiling search.
+type PersistenceStep struct {
+	TargetRate float64 `json:"targetRate"`
+	QPS        float64 `json:"qps"`
+	ErrorRate  float64 `json:"errorRate"`
+	P99Ms      float64 `json:"p99Ms"`
+}
+
+// ReadAPILatency is the client-measured latency of one read API called by the
+// background read workload. Percentiles cover successful calls.
+type ReadAPILatency struct {
+	Requests int64   `json:"requests"`
+	Errors   int64   `json:"errors"`
+	P50      float64 `json:"p50"`
+	P95      float64 `json:"p95"`
+	P99      float64 `json:"p99"`
+	Max      float64 `json:"max"`
+}
+
+// ReadLatency reports the read-path latencies measured during the run by the
+// background read workload.
+type ReadLatency struct {
+	QPS        float64        `json:"qps"`
+	Describe   ReadAPILatency `json:"describe"`
+	GetHistory ReadAPILatency `json:"getHistory"`
+}
+
+// LatencyHeatmap is the workflow latency distribution over time: for each
+// window of the run, a histogram of the latencies of the workflows that
+// completed in it. Degradations tied to time, such as DSQL compaction or
+// token refresh, show up here but not in the end-of-run percentiles.
+type LatencyHeatmap struct {
+	WindowSeconds float64 `json:"windowSeconds"`
+
+	// Bucket upper bounds in milliseconds, those of the workflow latency
+	// histogram; each window has one more count, for slower workflows
+	BucketsMs []float64 `json:"bucketsMs"`
+
+	// Consecutive windows from the start of the run, including empty ones
+	Windows []HeatmapWindow `json:"windows"`
+}
+
+// HeatmapWindow is one window of a LatencyHeatmap.
+type HeatmapWindow struct {
+	Start  time.Time `json:"start"`
+	Counts []int64   `json:"counts"`           // Completed workflows per latency bucket
+	Failed int64     `json:"failed,omitempty"` // Failed workflows, which have no latency bucket
+}
+
+// PercentileMs returns the upper bound in milliseconds of the bucket holding
+// percentile p (0-100) of the window's latencies, +Inf if it is the overflow
+// bucket, or 0 if the window has no completions.
+func (h *LatencyHeatmap) PercentileMs(w HeatmapWindow, p float64) float64 {
+	var total int64
+	for _, c := range w.Counts {
+		total += c
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(p / 100 * float64(total)))
+	var seen int64
+	for i, c := range w.Counts[:min(len(w.Counts), len(h.BucketsMs))] {
+		if seen += c; seen >= rank {
+			return h.BucketsMs[i]
+		}
+	}
+	return math.Inf(1)
+}
+
+// GrafanaSnapshot links the Grafana dashboard over the run's time window.
+type GrafanaSnapshot struct {
+	URL          string `json:"url"`          // Snapshot of the dashboard
+	DashboardURL string `json:"dashboardUrl"` // Live dashboard over the same window
+}
+
+// HistorySize summarizes the histories of a sample of completed workflows of
+// one type, to translate workflow rates into persistence storage and IO.
+type HistorySize struct {
+	WorkflowType string  `json:"workflowType"`
+	Samples      int     `json:"samples"`
+	AvgEvents    float64 `json:"avgEvents"`
+	P50Events    float64 `json:"p50Events"`
+	P95Events    float64 `json:"p95Events"`
+	MaxEvents    float64 `json:"maxEvents"`
+	AvgBytes     float64 `json:"avgBytes"`
+	P50Bytes     float64 `json:"p50Bytes"`
+	P95Bytes     float64 `json:"p95Bytes"`
+	MaxBytes     float64 `json:"maxBytes"`
+}
+
+// ActivityLatency reports the latencies of one activity type during the run,
+// in milliseconds, from the SDK metrics of the benchmark's workers. Workflow
+// latency alone hides which activity, or the wait for a poller, is slow.
+type ActivityLatency struct {
+	ActivityType    string           `json:"activityType"`
+	Executions      int64            `json:"executions"`
+	Execution       HistogramLatency `json:"execution"`
+	ScheduleToStart HistogramLatency `json:"scheduleToStart"`
+}
+
+// HistogramLatency contains latency percentiles in milliseconds interpolated
+// within histogram buckets, which bound their precision.
+type HistogramLatency struct {
+	P50 float64 `json:"p50"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+}
+
+// ClusterSnapshot is the load on the cluster at one point in time: the
+// namespaces, the workflows across them (visibility counts, so eventually
+// consistent) and the backlog of the benchmark task queue in each.
+type ClusterSnapshot struct {
+	Time                time.Time `json:"time"`
+	Namespaces          int       `json:"namespaces"`
+	OpenWorkflows       int64     `json:"openWorkflows"`
+	ClosedWorkflows     int64     `json:"closedWorkflows"`
+	WorkflowTaskBacklog int64     `json:"workflowTaskBacklog"`
+	ActivityTaskBacklog int64     `json:"activityTaskBacklog"`
+	Incomplete          bool      `json:"incomplete,omitempty"` // Some namespaces could not be read
+}
+
+// ClusterSnapshotDiff is the change between two cluster snapshots.
+type ClusterSnapshotDiff struct {
+	Namespaces          int   `json:"namespaces"`
+	OpenWorkflows       int64 `json:"openWorkflows"`
+	ClosedWorkflows     int64 `json:"closedWorkflows"`
+	WorkflowTaskBacklog int64 `json:"workflowTaskBacklog"`
+	ActivityTaskBacklog int64 `json:"activityTaskBacklog"`
+}
+
+// ClusterState compares the cluster before and after the run, making it
+// obvious when a run started on an already-loaded cluster.
+type ClusterState struct {
+	Before ClusterSnapshot     `json:"before"`
+	After  ClusterSnapshot     `json:"after"`
+	Diff   ClusterSnapshotDiff `json:"diff"`
+}
+
+// NewClusterState compares the snapshots taken before and after a run.
+func NewClusterState(before, after ClusterSnapshot) *ClusterState {
+	return &ClusterState{
+		Before: before,
+		After:  after,
+		Diff: ClusterSnapshotDiff{
+			Namespaces:          after.Namespaces - before.Namespaces,
+			OpenWorkflows:       after.OpenWorkflows - before.OpenWorkflows,
+			ClosedWorkflows:     after.ClosedWorkflows - before.ClosedWorkflows,
+			WorkflowTaskBacklog: after.WorkflowTaskBacklog - before.WorkflowTaskBacklog,
+			ActivityTaskBacklog: after.ActivityTaskBacklog - before.ActivityTaskBacklog,
+		},
+	}
+}
+
+// Preloaded reports whether the cluster already had open workflows or a task
+// backlog when the run started.
+func (s *ClusterState) Preloaded() bool {
+	b := s.Before
+	return b.OpenWorkflows > 0 || b.WorkflowTaskBacklog > 0 || b.ActivityTaskBacklog > 0
+}
+
+// BenchmarkResultJSON is the JSON-serializable benchmark result.
+// Requirement 6.1: THE Benchmark_Runner SHALL output results in JSON format for programmatic consumption.
+// Requirement 6.5: WHEN results are generated, THE Benchmark_Runner SHALL include
+// timestamp and test parameters for reproducibility.
+type BenchmarkResultJSON struct {
+	Timestamp       time.Time              `json:"timestamp"`
+	Config          ResultConfig           `json:"config"`
+	Results         ResultMetrics          `json:"results"`
+	Phases          []PhaseResult          `json:"phases,omitempty"`
+	Backpressure    []BackpressureInterval `json:"backpressure,omitempty"`
+	BurnRateAlerts  []BurnRateAlert        `json:"burnRateAlerts,omitempty"`
+	ScalingEvents   []ScalingEvent         `json:"scalingEvents,omitempty"`
+	ShardRestarts   []ShardRestart         `json:"shardRestarts,omitempty"`
+	Persistence     []PersistenceLatency   `json:"persistenceLatency,omitempty"`
+	Ceilings        []PersistenceCeiling   `json:"persistenceCeilings,omitempty"`
+	ReadLatency     *ReadLatency           `json:"readLatency,omitempty"`
+	HistorySize     []HistorySize          `json:"historySize,omitempty"`
+	ActivityLatency []ActivityLatency      `json:"activityLatency,omitempty"`
+	TypeLatency     []WorkflowTypeLatency  `json:"workflowTypeLatency,omitempty"`
+	StuckWorkflows  *StuckWorkflows        `json:"stuckWorkflows,omitempty"`
+	Outcomes        *OutcomeVerification   `json:"outcomeVerification,omitempty"`
+	Integrity       *HistoryIntegrity      `json:"historyIntegrity,omitempty"`
+	Duplicates      *DuplicateExecutions   `json:"duplicateExecutions,omitempty"`
+	Cancellation    *Cancellation          `json:"cancellation,omitempty"`
+	Drain           *DrainStats            `json:"drain,omitempty"`
+	ClockSkew       *ClockSkew             `json:"clockSkew,omitempty"`
+	Topology        *Topology              `json:"topology,omitempty"`
+	Connection      *Connection            `json:"connection,omitempty"`
+	DynamicConfig   *DynamicConfig         `json:"dynamicConfig,omitempty"`
+	ClusterState    *ClusterState          `json:"clusterState,omitempty"`
+	LatencyHeatmap  *LatencyHeatmap        `json:"latencyHeatmap,omitempty"`
+	GrafanaSnapshot *GrafanaSnapshot       `json:"grafanaSnapshot,omitempty"`
+	System          ResultSystem           `json:"system"`
+	Thresholds      ResultThresholds       `json:"thresholds"`
+	Run             ResultRun              `json:"run"`
+	Passed          bool                   `json:"passed"`
+	FailureReasons  []string               `json:"failureReasons"`
+
+	// Anonymized is set on copies stripped of environment-identifying
+	// fields for publication (see Anonymize)
+	Anonymized bool `json:"anonymized,omitempty"`
+}
+
+// BenchmarkResult contains the internal benchmark results (used by runner).
+type BenchmarkResult struct {
+	// Timing
+	StartTime time.Time
+	EndTime   time.Time
+	Duration  time.Duration
+
+	// Throughput
+	WorkflowsStarted   int64
+	WorkflowsCompleted int64
+	WorkflowsFailed    int64
+	AlreadyStarted     int64 // Starts rejected as already started, not counted as failures
+	ActualRate         float64
+
+	// Latency (in milliseconds)
+	LatencyP50 float64
+	LatencyP95 float64
+	LatencyP99 float64
+	LatencyMax float64
+
+	// LatencyApproximate is set when percentiles were estimated from a
+	// histogram because raw samples exceeded the latency memory budget
+	LatencyApproximate bool
+
+	// System info
+	InstanceType  string
+	ServiceCounts map[string]int
+	HistoryShards int
+
+	// Generated workflow IDs, one range per generator (iteration or phase)
+	WorkflowIDs []WorkflowIDRange
+
+	// Phased scenario results (empty for single-phase runs)
+	Scenario string
+	Phases   []PhaseResult
+
+	// Intervals during which workers were saturated
+	Backpressure []BackpressureInterval
+
+	// Periods the latency SLA burn-rate alert fired (nil if it never did)
+	BurnRateAlerts []BurnRateAlert
+
+	// Worker scaling experiment steps
+	ScalingEvents []ScalingEvent
+
+	// History task restarts of a shard churn scenario
+	ShardRestarts []ShardRestart
+
+	// DSQL optimistic concurrency conflicts
+	OCCConflicts OCCConflicts
+
+	// Server-side history branch reads, nil if server metrics were not scraped
+	HistoryReads *int64
+
+	// Retried activity failures injected into flaky workflows
+	FailedAttempts int64
+
+	// History events of completed long-history workflows
+	HistoryEvents int64
+
+	// Completed workflows reported with client latency because their server
+	// latency could not be read
+	LatencyFallbacks int64
+
+	// Server-side visibility store writes and failed writes, nil if server
+	// metrics were not scraped
+	VisibilityWrites      *int64
+	VisibilityWriteErrors *int64
+
+	// Embedded workers' workflow cache configuration and counters
+	WorkflowCache *WorkflowCache
+
+	// Server-side persistence latency percentiles, nil if server metrics were
+	// not scraped
+	Persistence []PersistenceLatency
+
+	// Per-store persistence QPS ceilings of a ceiling search scenario
+	Ceilings []PersistenceCeiling
+
+	// Workflows cancelled mid-flight (nil if none were)
+	Cancellation *Cancellation
+
+	// Read API latencies from the background read workload (nil if disabled)
+	ReadLatency *ReadLatency
+
+	// History length and size of sampled completed workflows, per type
+	HistorySize []HistorySize
+
+	// Activity latencies of the embedded workers, per activity type (nil
+	// for the generate role, whose process runs no workers)
+	ActivityLatency []ActivityLatency
+
+	// Workflow latencies per type of a mixed run (nil otherwise)
+	TypeLatency []WorkflowTypeLatency
+
+	// Workflows stuck after the drain (nil if detection is disabled)
+	StuckWorkflows *StuckWorkflows
+
+	// Verified outcomes of sampled completed workflows (nil if disabled)
+	Outcomes *OutcomeVerification
+
+	// Audit of the histories of sampled completed workflows (nil if disabled)
+	Integrity *HistoryIntegrity
+
+	// Activities and workflows the embedded workers executed more than once
+	// (nil for the generate role)
+	Duplicates *DuplicateExecutions
+
+	// Cluster load before and after the run (nil if snapshots are disabled)
+	ClusterState *ClusterState
+
+	// Wait for in-flight workflows after generation stopped (last iteration)
+	Drain *DrainStats
+
+	// Client/server clock offset (nil if not measured)
+	ClockSkew *ClockSkew
+
+	// Availability zones of the client and the frontend (nil off ECS)
+	Topology *Topology
+
+	// Address family of the frontend connections (nil if none were dialed)
+	Connection *Connection
+
+	// Dynamic config overrides applied for the run (nil if none)
+	DynamicConfig *DynamicConfig
+
+	// Thresholds relative to a baseline run (nil if no baseline was compared)
+	Baseline *BaselineThresholds
+
+	// Latency distribution per time window (nil if disabled)
+	LatencyHeatmap *LatencyHeatmap
+
+	// Grafana dashboard snapshot of the run (nil if not captured)
+	GrafanaSnapshot *GrafanaSnapshot
+
+	// Raw run data published in the artifact bundle only (nil unless
+	// BENCHMARK_ARTIFACT_BUNDLE is set)
+	Artifacts []Artifact
+
+	// Pass/Fail
+	Passed         bool
+	FailureReasons []string
+}
+
+// ToJSON serializes the result to JSON bytes.
+func (r *BenchmarkResultJSON) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// FromJSON deserializes JSON bytes into a BenchmarkResultJSON.
+func FromJSON(data []byte) (*BenchmarkResultJSON, error) {
+	var result BenchmarkResultJSON
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal benchmark result: %w", err)
+	}
+	return &result, nil
+}
+
+// WriteJSON writes the result as JSON to the provided writer.
+func (r *BenchmarkResultJSON) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// NewBenchmarkResultJSON creates a JSON-serializable result from internal result and config.
+// This converts the internal BenchmarkResult to the JSON format specified in the design document.
+func NewBenchmarkResultJSON(result *BenchmarkResult, cfg config.BenchmarkConfig, namespace string) *BenchmarkResultJSON {
+	// Build config section with all test parameters for reproducibility
+	resultConfig := ResultConfig{
+		WorkflowType:   cfg.WorkflowType,
+		TargetRate:     cfg.EffectiveTargetRate(),
+		Duration:       cfg.Duration.String(),
+		WorkerCount:    cfg.WorkerCount,
+		Iterations:     cfg.Iterations,
+		RampUpDuration: cfg.RampUpDuration.String(),
+		Namespace:      namespace,
+		Scenario:       result.Scenario,
+		StickyDisabled: cfg.DisableSticky,
+	}
+	resultConfig.LatencySemantics = cfg.LatencySemantics
+	resultConfig.WorkflowIDTemplate = cfg.WorkflowIDTemplate
+	resultConfig.WorkerActivitiesPerSecond = cfg.WorkerActivitiesPerSecond
+	resultConfig.TaskQueueActivitiesPerSecond = cfg.TaskQueueActivitiesPerSecond
+	if cfg.CancelRate > 0 {
+		resultConfig.CancelRate = cfg.CancelRate
+		resultConfig.CancelAfter = cfg.CancelAfter.String()
+	}
+	resultConfig.WorkflowMix = cfg.WorkflowMix
+	resultConfig.MemoSizeBytes = cfg.MemoSizeBytes
+	resultConfig.HeaderSizeBytes = cfg.HeaderSizeBytes
+	var stateTransitionRate float64
+	if result.Scenario == "" {
+		resultConfig.TargetStateTransitions = cfg.TargetStateTransitions
+		resultConfig.StateTransitionsPerWorkflow, resultConfig.StateTransitionCostSource = cfg.TransitionCost()
+		stateTransitionRate = result.ActualRate * resultConfig.StateTransitionsPerWorkflow
+	}
+	var historyReadsPerWorkflow float64
+	if result.HistoryReads != nil && result.WorkflowsCompleted > 0 {
+		historyReadsPerWorkflow = float64(*result.HistoryReads) / float64(result.WorkflowsCompleted)
+	}
+	var visibilityWritesPerWorkflow float64
+	if result.VisibilityWrites != nil && result.WorkflowsCompleted > 0 {
+		visibilityWritesPerWorkflow = float64(*result.VisibilityWrites) / float64(result.WorkflowsCompleted)
+	}
+	var failedAttempts *int64
+	var failedAttemptsPerWorkflow float64
+	if cfg.WorkflowType == config.WorkflowTypeFlaky || result.FailedAttempts > 0 {
+		failedAttempts = &result.FailedAttempts
+		if result.WorkflowsCompleted > 0 {
+			failedAttemptsPerWorkflow = float64(result.FailedAttempts) / float64(result.WorkflowsCompleted)
+		}
+	}
+	var avgHistoryLength float64
+	if result.HistoryEvents > 0 && result.WorkflowsCompleted > 0 {
+		avgHistoryLength = float64(result.HistoryEvents) / float64(result.WorkflowsCompleted)
+	}
+	if resultConfig.LatencySemantics == "" {
+		resultConfig.LatencySemantics = config.LatencySubmitToComplete
+	}
+	var latencyFallbacks *int64
+	if resultConfig.LatencySemantics != config.LatencySubmitToComplete {
+		latencyFallbacks = &result.LatencyFallbacks
+	}
+
+	// Include workflow-type-specific parameters, of every type of a mix
+	for _, workflowType := range cfg.WorkflowTypes() {
+		switch workflowType {
+		case config.WorkflowTypeMultiActivity:
+			resultConfig.ActivityCount = cfg.ActivityCount
+			resultConfig.ActivityWork = cfg.ActivityWork
+			resultConfig.ActivityCPUIterations = cfg.CPUIterations()
+		case config.WorkflowTypeTimer:
+			resultConfig.TimerDuration = cfg.TimerDuration.String()
+		case config.WorkflowTypeChildWorkflow:
+			resultConfig.ChildCount = cfg.ChildCount
+			resultConfig.ChildDepth = cfg.ChildDepth
+		case config.WorkflowTypeContention:
+			resultConfig.FanIn = cfg.FanIn
+		case config.WorkflowTypeHeartbeat:
+			resultConfig.HeartbeatDuration = cfg.HeartbeatDuration.String()
+			resultConfig.HeartbeatInterval = cfg.HeartbeatInterval.String()
+		case config.WorkflowTypeFlaky:
+			resultConfig.ActivityCount = cfg.ActivityCount
+			resultConfig.FailureRate = cfg.FailureRate
+			resultConfig.RetryMaxAttempts = cfg.RetryMaxAttempts
+			resultConfig.RetryInitialInterval = cfg.RetryInitialInterval.String()
+			resultConfig.RetryBackoffCoefficient = cfg.RetryBackoffCoefficient
+		case config.WorkflowTypeSearchAttributes:
+			resultConfig.SearchAttributeUpserts = cfg.SearchAttributeUpserts
+		case config.WorkflowTypeSideEffects:
+			resultConfig.ActivityCount = cfg.ActivityCount
+			resultConfig.SideEffects = cfg.SideEffects
+			resultConfig.ActivityWork = cfg.ActivityWork
+			resultConfig.ActivityCPUIterations = cfg.CPUIterations()
+		case config.WorkflowTypeLongHistory:
+			resultConfig.LongHistoryEvents = cfg.LongHistoryEvents
+		case config.WorkflowTypeFanout:
+			resultConfig.FanoutWidth = cfg.FanoutWidth
+			resultConfig.FanoutDepth = cfg.FanoutDepth
+			resultConfig.FanoutJoin = cfg.FanoutJoin
+			resultConfig.ActivityWork = cfg.ActivityWork
+			resultConfig.ActivityCPUIterations = cfg.CPUIterations()
+		}
+	}
+
+	// Build system info
+	services := result.ServiceCounts
+	if services == nil {
+		services = map[string]int{
+			"frontend": 1,
+			"history":  1,
+			"matching": 1,
+			"worker":   1,
+		}
+	}
+
+	return &BenchmarkResultJSON{
+		Timestamp: result.StartTime,
+		Config:    resultConfig,
+		Results: ResultMetrics{
+			WorkflowsStarted:   result.WorkflowsStarted,
+			WorkflowsCompleted: result.WorkflowsCompleted,
+			WorkflowsFailed:    result.WorkflowsFailed,
+			AlreadyStarted:     result.AlreadyStarted,
+			ActualRate:         result.ActualRate,
+
+			StateTransitionRate: stateTransitionRate,
+			HistoryReads:        result.HistoryReads,
+
+			HistoryReadsPerWorkflow: historyReadsPerWorkflow,
+
+			FailedAttempts:            failedAttempts,
+			FailedAttemptsPerWorkflow: failedAttemptsPerWorkflow,
+			AvgHistoryLength:          avgHistoryLength,
+
+			VisibilityWrites:            result.VisibilityWrites,
+			VisibilityWritesPerWorkflow: visibilityWritesPerWorkflow,
+			VisibilityWriteErrors:       result.VisibilityWriteErrors,
+			LatencyFallbacks:            latencyFallbacks,
+			Latency: ResultLatency{
+				P50:         result.LatencyP50,
+				P95:         result.LatencyP95,
+				P99:         result.LatencyP99,
+				Max:         result.LatencyMax,
+				Approximate: result.LatencyApproximate,
+			},
+			OCCConflicts: result.OCCConflicts,
+		},
+		System: ResultSystem{
+			InstanceType:  result.InstanceType,
+			HistoryShards: result.HistoryShards,
+			Services:      services,
+			WorkflowCache: result.WorkflowCache,
+		},
+		Thresholds: ResultThresholds{
+			Profile:         cfg.ThresholdProfile,
+			MaxP99LatencyMs: float64(cfg.MaxP99Latency.Milliseconds()),
+			MinThroughput:   cfg.MinThroughput,
+			Persistence:     persistenceThresholds(cfg.PersistenceThresholds),
+			Baseline:        result.Baseline,
+
+			MaxDescribeP99Ms:   float64(cfg.MaxDescribeP99) / float64(time.Millisecond),
+			MaxGetHistoryP99Ms: float64(cfg.MaxGetHistoryP99) / float64(time.Millisecond),
+		},
+		Phases:          result.Phases,
+		Backpressure:    result.Backpressure,
+		BurnRateAlerts:  result.BurnRateAlerts,
+		ScalingEvents:   result.ScalingEvents,
+		ShardRestarts:   result.ShardRestarts,
+		Persistence:     result.Persistence,
+		Ceilings:        result.Ceilings,
+		ReadLatency:     result.ReadLatency,
+		HistorySize:     result.HistorySize,
+		ActivityLatency: result.ActivityLatency,
+		TypeLatency:     result.TypeLatency,
+		StuckWorkflows:  result.StuckWorkflows,
+		Outcomes:        result.Outcomes,
+		Integrity:       result.Integrity,
+		Duplicates:      result.Duplicates,
+		Cancellation:    result.Cancellation,
+		Drain:           result.Drain,
+		ClockSkew:       result.ClockSkew,
+		Topology:        result.Topology,
+		Connection:      result.Connection,
+		DynamicConfig:   result.DynamicConfig,
+		ClusterState:    result.ClusterState,
+		LatencyHeatmap:  result.LatencyHeatmap,
+		GrafanaSnapshot: result.GrafanaSnapshot,
+		Run: ResultRun{
+			StartTime:   result.StartTime,
+			EndTime:     result.EndTime,
+			WorkflowIDs: result.WorkflowIDs,
+		},
+		Passed:         result.Passed,
+		FailureReasons: result.FailureReasons,
+	}
+}
+
+// Validate checks that the result contains all required fields.
+func (r *BenchmarkResultJSON) Validate() error {
+	if r.Timestamp.IsZero() {
+		return fmt.Errorf("timestamp is required")
+	}
+	if r.Config.WorkflowType == "" {
+		return fmt.Errorf("config.workflowType is required")
+	}
+	if r.Config.Duration == "" {
+		return fmt.Errorf("config.duration is required")
+	}
+	if r.System.InstanceType == "" {
+		return fmt.Errorf("system.instanceType is required")
+	}
+	if r.System.Services == nil {
+		return fmt.Errorf("system.services is required")
+	}
+	if r.FailureReasons == nil {
+		return fmt.Errorf("failureReasons must not be nil (use empty slice)")
+	}
+	return nil
+}
+
+// EvaluateThresholds checks if the result meets the configured thresholds.
+// Requirement 6.4: THE Benchmark_Runner SHALL compare results against configurable thresholds
+// and report pass/fail.
+//
+// The logic is:
+// - If latencyP99 > maxP99Latency OR actualRate < minThroughput, then passed = false
+// - If latencyP99 <= maxP99Latency AND actualRate >= minThroughput, then passed = true
+func EvaluateThresholds(result *BenchmarkResult, maxP99LatencyMs float64, minThroughput float64) {
+	result.Passed = true
+	result.FailureReasons = []string{}
+
+	// Check p99 latency threshold
+	// Property 10: If L > M OR T < N, then passed SHALL be false
+	if result.LatencyP99 > maxP99LatencyMs {
+		result.Passed = false
+		result.FailureReasons = append(result.FailureReasons,
+			fmt.Sprintf("p99 latency %.2fms exceeds threshold %.2fms", result.LatencyP99, maxP99LatencyMs))
+	}
+
+	// Check throughput threshold
+	if result.ActualRate < minThroughput {
+		result.Passed = false
+		result.FailureReasons = append(result.FailureReasons,
+			fmt.Sprintf("throughput %.2f/s below threshold %.2f/s", result.ActualRate, minThroughput))
+	}
+}
+
+// EvaluateThresholdsWithConfig is a convenience function that extracts thresholds from config.
+// Persistence and read-path latency thresholds are evaluated alongside the
+// client-side thresholds.
+func EvaluateThresholdsWithConfig(result *BenchmarkResult, cfg config.BenchmarkConfig) {
+	maxP99LatencyMs := float64(cfg.MaxP99Latency.Milliseconds())
+	EvaluateThresholds(result, maxP99LatencyMs, cfg.MinThroughput)
+	EvaluatePersistenceThresholds(result, cfg.PersistenceThresholds)
+	EvaluateReadThresholds(result, cfg.MaxDescribeP99, cfg.MaxGetHistoryP99)
+	EvaluateOutcomes(result)
+	EvaluateIntegrity(result)
+}
+
+// EvaluateOutcomes fails the result if any verified workflow outcome differed
+// from a correct run's: the workflows completed, but not with the steps they
+// were meant to.
+func EvaluateOutcomes(result *BenchmarkResult) {
+	if o := result.Outcomes; o != nil && o.Mismatches > 0 {
+		result.Passed = false
+		result.FailureReasons = append(result.FailureReasons,
+			fmt.Sprintf("%d of %d verified workflow outcomes mismatched", o.Mismatches, o.Checked))
+	}
+}
+
+// EvaluateIntegrity fails the result if any audited history violated an
+// integrity invariant.
+func EvaluateIntegrity(result *BenchmarkResult) {
+	if i := result.Integrity; i != nil && i.Violated > 0 {
+		result.Passed = false
+		result.FailureReasons = append(result.FailureReasons,
+			fmt.Sprintf("%d of %d audited workflow histories violated integrity invariants", i.Violated, i.Checked))
+	}
+}
+
+// EvaluateReadThresholds checks the p99 latencies of the background read
+// workload's DescribeWorkflowExecution and GetWorkflowExecutionHistory calls.
+// A limit of 0 skips that check. If a limit is set but the API was never
+// called successfully, the result fails rather than passing unchecked.
+func EvaluateReadThresholds(result *BenchmarkResult, maxDescribeP99, maxGetHistoryP99 time.Duration) {
+	var describe, getHistory ReadAPILatency
+	if result.ReadLatency != nil {
+		describe, getHistory = result.ReadLatency.Describe, result.ReadLatency.GetHistory
+	}
+	checkReadThreshold(result, "DescribeWorkflowExecution", describe, maxDescribeP99)
+	checkReadThreshold(result, "GetWorkflowExecutionHistory", getHistory, maxGetHistoryP99)
+}
+
+// checkReadThreshold fails the result if the read API's p99 latency exceeds max.
+func checkReadThreshold(result *BenchmarkResult, api string, latency ReadAPILatency, max time.Duration) {
+	if max <= 0 {
+		return
+	}
+	if latency.Requests == latency.Errors {
+		result.Passed = false
+		result.FailureReasons = append(result.FailureReasons,
+			fmt.Sprintf("%s p99 latency threshold not evaluated: no successful reads", api))
+		return
+	}
+	maxMs := float64(max) / float64(time.Millisecond)
+	if latency.P99 > maxMs {
+		result.Passed = false
+		result.FailureReasons = append(result.FailureReasons,
+			fmt.Sprintf("%s p99 latency %.2fms exceeds threshold %.2fms", api, latency.P99, maxMs))
+	}
+}
+
+// EvaluatePersistenceThresholds checks the result's server-side persistence
+// latencies against thresholds, naming each offending operation in the failure
+// reasons. If thresholds are set but server metrics were not scraped, the
+// result fails rather than passing unchecked.
+func EvaluatePersistenceThresholds(result *BenchmarkResult, thresholds []config.PersistenceThreshold) {
+	if len(thresholds) == 0 {
+		return
+	}
+	if result.Persistence == nil {
+		result.Passed = false
+		result.FailureReasons = append(result.FailureReasons,
+			"persistence latency thresholds not evaluated: server metrics unavailable")
+		return
+	}
+
+	for _, t := range thresholds {
+		maxMs := float64(t.Max) / float64(time.Millisecond)
+		for _, p := range result.Persistence {
+			if p.Service != t.Service || p.Percentile != t.Percentile || p.LatencyMs <= maxMs {
+				continue
+			}
+			name := p.Operation
+			if p.Service != "" {
+				name = p.Service + " " + p.Operation
+			}
+			result.Passed = false
+			result.FailureReasons = append(result.FailureReasons,
+				fmt.Sprintf("persistence %s p%g latency %.2fms exceeds threshold %.2fms", name, p.Percentile, p.LatencyMs, maxMs))
+		}
+	}
+}
+
+// persistenceThresholds formats thresholds for the results JSON.
+func persistenceThresholds(thresholds []config.PersistenceThreshold) []string {
+	var formatted []string
+	for _, t := range thresholds {
+		formatted = append(formatted, t.String())
+	}
+	return formatted
+}
+
+// CheckThresholds evaluates thresholds and returns the pass/fail status and reasons.
+// This is a pure function that doesn't modify the input, useful for testing.
+func CheckThresholds(latencyP99Ms float64, actualRate float64, maxP99LatencyMs float64, minThroughput float64) (passed bool, failureReasons []string) {
+	passed = true
+	failureReasons = []string{}
+
+	// Check p99 latency threshold
+	if latencyP99Ms > maxP99LatencyMs {
+		passed = false
+		failureReasons = append(failureReasons,
+			fmt.Sprintf("p99 latency %.2fms exceeds threshold %.2fms", latencyP99Ms, maxP99LatencyMs))
+	}
+
+	// Check throughput threshold
+	if actualRate < minThroughput {
+		passed = false
+		failureReasons = append(failureReasons,
+			fmt.Sprintf("throughput %.2f/s below threshold %.2f/s", actualRate, minThroughput))
+	}
+
+	return passed, failureReasons
+}
+
+// PrintSummary prints a human-readable summary of the benchmark results to the provided writer.
+// Requirement 6.2: THE Benchmark_Runner SHALL output a human-readable summary to stdout.
+func (r *BenchmarkResultJSON) PrintSummary(w io.Writer) {
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintln(w, "                    BENCHMARK RESULTS SUMMARY")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintln(w, "")
+
+	// Configuration section
+	fmt.Fprintln(w, "CONFIGURATION")
+	fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+	fmt.Fprintf(w, "  Workflow Type:    %s\n", r.Config.WorkflowType)
+	fmt.Fprintf(w, "  Target Rate:      %.2f workflows/s\n", r.Config.TargetRate)
+	if r.Config.TargetStateTransitions > 0 {
+		fmt.Fprintf(w, "                    (%.2f state transitions/s at %g per workflow, %s)\n",
+			r.Config.TargetStateTransitions, r.Config.StateTransitionsPerWorkflow, r.Config.StateTransitionCostSource)
+	}
+	fmt.Fprintf(w, "  Duration:         %s\n", r.Config.Duration)
+	fmt.Fprintf(w, "  Worker Count:     %d\n", r.Config.WorkerCount)
+	if r.Config.Iterations > 1 {
+		fmt.Fprintf(w, "  Iterations:       %d\n", r.Config.Iterations)
+	}
+	if r.Config.Namespace != "" {
+		fmt.Fprintf(w, "  Namespace:        %s\n", r.Config.Namespace)
+	}
+	if r.Config.Scenario != "" {
+		fmt.Fprintf(w, "  Scenario:         %s (%d phases)\n", r.Config.Scenario, len(r.Phases))
+	}
+	if r.Config.StickyDisabled {
+		fmt.Fprintln(w, "  Sticky Execution: disabled")
+	}
+	if r.Config.WorkerActivitiesPerSecond > 0 || r.Config.TaskQueueActivitiesPerSecond > 0 {
+		fmt.Fprintf(w, "  Activity Limits:  %s per worker, %s per task queue\n",
+			formatActivityLimit(r.Config.WorkerActivitiesPerSecond), formatActivityLimit(r.Config.TaskQueueActivitiesPerSecond))
+	}
+	if r.Config.CancelRate > 0 {
+		fmt.Fprintf(w, "  Cancellation:     %.0f%% of workflows after %s\n", r.Config.CancelRate*100, r.Config.CancelAfter)
+	}
+	if len(r.Config.WorkflowMix) > 0 {
+		mix := make([]string, 0, len(r.Config.WorkflowMix))
+		for _, workflowType := range r.Config.workflowTypes() {
+			mix = append(mix, fmt.Sprintf("%s %d", workflowType, r.Config.WorkflowMix[workflowType]))
+		}
+		fmt.Fprintf(w, "  Workflow Mix:     %s\n", strings.Join(mix, ", "))
+	}
+	if r.Config.MemoSizeBytes > 0 || r.Config.HeaderSizeBytes > 0 {
+		fmt.Fprintf(w, "  Start Padding:    %d B memo, %d B header\n", r.Config.MemoSizeBytes, r.Config.HeaderSizeBytes)
+	}
+
+	// Workflow-type specific config, of every type of a mix
+	for _, workflowType := range r.Config.workflowTypes() {
+		switch workflowType {
+		case "multi-activity":
+			if r.Config.ActivityCount > 0 {
+				fmt.Fprintf(w, "  Activity Count:   %d\n", r.Config.ActivityCount)
+			}
+		case "timer":
+			if r.Config.TimerDuration != "" {
+				fmt.Fprintf(w, "  Timer Duration:   %s\n", r.Config.TimerDuration)
+			}
+		case "child-workflow":
+			if r.Config.ChildCount > 0 {
+				fmt.Fprintf(w, "  Child Count:      %d\n", r.Config.ChildCount)
+			}
+			if r.Config.ChildDepth > 1 {
+				parents, leaves := config.ChildTree(r.Config.ChildCount, r.Config.ChildDepth)
+				fmt.Fprintf(w, "  Child Depth:      %d (%d children per workflow)\n", r.Config.ChildDepth, parents+leaves-1)
+			}
+		case "contention":
+			if r.Config.FanIn > 0 {
+				fmt.Fprintf(w, "  Fan-In:           %d\n", r.Config.FanIn)
+			}
+		case "heartbeat":
+			if r.Config.HeartbeatDuration != "" {
+				fmt.Fprintf(w, "  Heartbeats:       every %s for %s\n", r.Config.HeartbeatInterval, r.Config.HeartbeatDuration)
+			}
+		case "flaky":
+			if r.Config.ActivityCount > 0 {
+				fmt.Fprintf(w, "  Activity Count:   %d\n", r.Config.ActivityCount)
+			}
+			if r.Config.RetryMaxAttempts > 0 {
+				fmt.Fprintf(w, "  Failure Rate:     %.0f%% of attempts\n", r.Config.FailureRate*100)
+				fmt.Fprintf(w, "  Retry Policy:     %d attempts, %s initial interval, backoff %.1f\n",
+					r.Config.RetryMaxAttempts, r.Config.RetryInitialInterval, r.Config.RetryBackoffCoefficient)
+			}
+		case "search-attributes":
+			if r.Config.SearchAttributeUpserts > 0 {
+				fmt.Fprintf(w, "  Upserts:          %d per workflow\n", r.Config.SearchAttributeUpserts)
+			}
+		case "side-effects":
+			if r.Config.SideEffects > 0 {
+				fmt.Fprintf(w, "  Side Effects:     %d per workflow, %d activities\n", r.Config.SideEffects, r.Config.ActivityCount)
+			}
+		case "long-history":
+			if r.Config.LongHistoryEvents > 0 {
+				fmt.Fprintf(w, "  History Events:   ~%d per workflow\n", r.Config.LongHistoryEvents)
+			}
+		case "fanout":
+			if r.Config.FanoutWidth > 0 {
+				fmt.Fprintf(w, "  Fan-Out:          %d stages × %d activities, join %s\n", r.Config.FanoutDepth, r.Config.FanoutWidth, r.Config.FanoutJoin)
+			}
+		}
+	}
+	if r.Config.ActivityCPUIterations > 0 {
+		fmt.Fprintf(w, "  Activity Work:    %s, %d SHA-256 iterations per activity\n", r.Config.ActivityWork, r.Config.ActivityCPUIterations)
+	}
+	fmt.Fprintln(w, "")
+
+	// Results section
+	fmt.Fprintln(w, "RESULTS")
+	fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+	fmt.Fprintf(w, "  Workflows Started:    %d\n", r.Results.WorkflowsStarted)
+	fmt.Fprintf(w, "  Workflows Completed:  %d\n", r.Results.WorkflowsCompleted)
+	fmt.Fprintf(w, "  Workflows Failed:     %d\n", r.Results.WorkflowsFailed)
+	if r.Results.AlreadyStarted > 0 {
+		fmt.Fprintf(w, "  Already Started:      %d\n", r.Results.AlreadyStarted)
+	}
+	fmt.Fprintf(w, "  Actual Rate:          %.2f workflows/s\n", r.Results.ActualRate)
+	if r.Results.StateTransitionRate > 0 {
+		fmt.Fprintf(w, "  State Transitions:    ~%.2f/s (estimated)\n", r.Results.StateTransitionRate)
+	}
+	if r.Results.HistoryReads != nil {
+		fmt.Fprintf(w, "  History Reads:        %d (%.2f/workflow)\n", *r.Results.HistoryReads, r.Results.HistoryReadsPerWorkflow)
+	}
+	if r.Results.FailedAttempts != nil {
+		fmt.Fprintf(w, "  Failed Attempts:      %d (%.2f/workflow)\n", *r.Results.FailedAttempts, r.Results.FailedAttemptsPerWorkflow)
+	}
+	if r.Results.AvgHistoryLength > 0 {
+		fmt.Fprintf(w, "  Avg History Length:   %.1f events\n", r.Results.AvgHistoryLength)
+	}
+	if r.Results.VisibilityWrites != nil {
+		var errors int64
+		if r.Results.VisibilityWriteErrors != nil {
+			errors = *r.Results.VisibilityWriteErrors
+		}
+		fmt.Fprintf(w, "  Visibility Writes:    %d (%.2f/workflow), %d failed\n",
+			*r.Results.VisibilityWrites, r.Results.VisibilityWritesPerWorkflow, errors)
+	}
+	fmt.Fprintf(w, "  OCC Conflicts:        %d (%.2f/s)\n", r.Results.OCCConflicts.Count, r.Results.OCCConflicts.Rate)
+	fmt.Fprintln(w, "")
+
+	// Latency section
+	if r.Config.LatencySemantics != "" {
+		fmt.Fprintf(w, "LATENCY (milliseconds, %s)\n", r.Config.LatencySemantics)
+	} else {
+		fmt.Fprintln(w, "LATENCY (milliseconds)")
+	}
+	fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+	fmt.Fprintf(w, "  P50:    %10.2f ms\n", r.Results.Latency.P50)
+	fmt.Fprintf(w, "  P95:    %10.2f ms\n", r.Results.Latency.P95)
+	fmt.Fprintf(w, "  P99:    %10.2f ms\n", r.Results.Latency.P99)
+	fmt.Fprintf(w, "  Max:    %10.2f ms\n", r.Results.Latency.Max)
+	if r.Results.Latency.Approximate {
+		fmt.Fprintln(w, "  (percentiles approximate: latency memory budget exceeded)")
+	}
+	if r.Results.LatencyFallbacks != nil && *r.Results.LatencyFallbacks > 0 {
+		fmt.Fprintf(w, "  (%d workflows use client latency: server latency unavailable)\n", *r.Results.LatencyFallbacks)
+	}
+	fmt.Fprintln(w, "")
+
+	// Per-phase section for phased scenarios
+	if len(r.Phases) > 0 {
+		fmt.Fprintln(w, "PHASES")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, p := range r.Phases {
+			fmt.Fprintf(w, "  %s: %s @ %.2f/s\n", p.Name, p.WorkflowType, p.TargetRate)
+			fmt.Fprintf(w, "    Started/Completed/Failed: %d/%d/%d  Rate: %.2f/s\n",
+				p.WorkflowsStarted, p.WorkflowsCompleted, p.WorkflowsFailed, p.ActualRate)
+			fmt.Fprintf(w, "    P50/P95/P99/Max: %.2f/%.2f/%.2f/%.2f ms\n",
+				p.Latency.P50, p.Latency.P95, p.Latency.P99, p.Latency.Max)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Worker saturation intervals
+	if len(r.Backpressure) > 0 {
+		fmt.Fprintln(w, "BACKPRESSURE (worker slots saturated)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, b := range r.Backpressure {
+			held := ""
+			if b.Held {
+				held = "  rate held"
+			}
+			fmt.Fprintf(w, "  %s - %s  (%s)  peak %.0f%%%s\n",
+				b.StartTime.Format("15:04:05"), b.EndTime.Format("15:04:05"),
+				b.EndTime.Sub(b.StartTime).Round(time.Second), b.PeakUtilization*100, held)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Latency SLA burn-rate alerts
+	if len(r.BurnRateAlerts) > 0 {
+		fmt.Fprintln(w, "BURN RATE ALERTS (p99 latency SLA)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, a := range r.BurnRateAlerts {
+			fmt.Fprintf(w, "  %s - %s  (%s)  peak burn rate %.1f×\n",
+				a.StartTime.Format("15:04:05"), a.EndTime.Format("15:04:05"),
+				a.EndTime.Sub(a.StartTime).Round(time.Second), a.PeakBurnRate)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Worker scaling experiment
+	if len(r.ScalingEvents) > 0 {
+		fmt.Fprintln(w, "WORKER SCALING")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, e := range r.ScalingEvents {
+			outcome := "not ready"
+			switch {
+			case e.Error != "":
+				outcome = "error: " + e.Error
+			case e.ReadyAfter != "":
+				outcome = "ready after " + e.ReadyAfter
+			}
+			fmt.Fprintf(w, "  +%s  %d -> %d workers  %s\n", e.Offset, e.FromCount, e.ToCount, outcome)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Shard churn: history task restarts
+	if len(r.ShardRestarts) > 0 {
+		fmt.Fprintln(w, "SHARD CHURN")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, s := range r.ShardRestarts {
+			if s.Error != "" && len(s.Tasks) == 0 {
+				fmt.Fprintf(w, "  +%s  error: %s\n", s.Offset, s.Error)
+				continue
+			}
+			recovery := fmt.Sprintf("recovered after %.0fs", s.SpikeSeconds)
+			if !s.Recovered {
+				recovery = fmt.Sprintf("not recovered after %.0fs", s.SpikeSeconds)
+			}
+			fmt.Fprintf(w, "  +%s  %d tasks  p99 %.0f -> %.0f ms  %s  %d failed\n",
+				s.Offset, len(s.Tasks), s.BaselineP99Ms, s.PeakP99Ms, recovery, s.FailedRequests)
+			if s.Error != "" {
+				fmt.Fprintf(w, "      error: %s\n", s.Error)
+			}
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Drain of in-flight workflows
+	if d := r.Drain; d != nil {
+		fmt.Fprintln(w, "DRAIN")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Outcome:              %s after %s (deadline %s)\n", d.Outcome, d.Duration, d.Deadline)
+		fmt.Fprintf(w, "  In Flight:            %d at start, %d remaining\n", d.InFlightAtStart, d.Remaining)
+		fmt.Fprintf(w, "  Drained:              %d completed, %d failed (not counted in throughput)\n", d.Completed, d.Failed)
+		fmt.Fprintf(w, "  Completion Rate:      %.2f workflows/s\n", d.CompletionRate)
+		fmt.Fprintln(w, "")
+	}
+
+	// Workflows stuck after the drain
+	if r.StuckWorkflows != nil && r.StuckWorkflows.Count > 0 {
+		s := r.StuckWorkflows
+		fmt.Fprintln(w, "STUCK WORKFLOWS")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Stuck:                %d of %d checked (no progress for %s)\n", s.Count, s.Checked, s.Threshold)
+		if s.Terminated > 0 {
+			fmt.Fprintf(w, "  Terminated:           %d\n", s.Terminated)
+		}
+		for _, id := range s.SampleIDs {
+			fmt.Fprintf(w, "    %s\n", id)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Verified workflow outcomes
+	if o := r.Outcomes; o != nil {
+		fmt.Fprintln(w, "OUTCOME VERIFICATION")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Checked:              %d (%.1f%% sampled)\n", o.Checked, o.SampleRate*100)
+		fmt.Fprintf(w, "  Mismatches:           %d\n", o.Mismatches)
+		for _, id := range o.SampleIDs {
+			fmt.Fprintf(w, "    %s\n", id)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Audited workflow histories
+	if h := r.Integrity; h != nil {
+		fmt.Fprintln(w, "HISTORY INTEGRITY (sampled completed workflows)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Audited:              %d histories, %d events\n", h.Checked, h.Events)
+		if h.Unreadable > 0 {
+			fmt.Fprintf(w, "  Unreadable:           %d\n", h.Unreadable)
+		}
+		fmt.Fprintf(w, "  Violated:             %d\n", h.Violated)
+		for _, v := range h.Violations {
+			fmt.Fprintf(w, "    %s: %s\n", v.WorkflowID, v.Reason)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Activities and workflows executed more than once
+	if d := r.Duplicates; d != nil {
+		fmt.Fprintln(w, "DUPLICATE EXECUTIONS (embedded workers)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Activities:           %d of %d (%.1f per million)\n", d.DuplicateActivities, d.Activities, d.ActivitiesPerMillion)
+		fmt.Fprintf(w, "  Workflows:            %d of %d (%.1f per million)\n", d.DuplicateWorkflows, d.Workflows, d.WorkflowsPerMillion)
+		fmt.Fprintln(w, "")
+	}
+
+	// Workflows cancelled mid-flight
+	if c := r.Cancellation; c != nil {
+		fmt.Fprintln(w, "CANCELLATION")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Requested:            %d\n", c.Requested)
+		fmt.Fprintf(w, "  Canceled:             %d\n", c.Canceled)
+		fmt.Fprintf(w, "  Completed First:      %d\n", c.Completed)
+		fmt.Fprintf(w, "  Cancel to Closed P50/P95/P99/Max: %.2f/%.2f/%.2f/%.2f ms\n",
+			c.Latency.P50, c.Latency.P95, c.Latency.P99, c.Latency.Max)
+		fmt.Fprintln(w, "")
+	}
+
+	// Server-side persistence latency
+	if len(r.Persistence) > 0 {
+		fmt.Fprintln(w, "PERSISTENCE LATENCY (server)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, p := range r.Persistence {
+			service := ""
+			if p.Service != "" {
+				service = p.Service + " "
+			}
+			fmt.Fprintf(w, "  %s%s p%g: %.2f ms\n", service, p.Operation, p.Percentile, p.LatencyMs)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Persistence QPS ceilings of a ceiling search
+	if len(r.Ceilings) > 0 {
+		fmt.Fprintln(w, "PERSISTENCE QPS CEILING (server)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, c := range r.Ceilings {
+			if c.Reached {
+				fmt.Fprintf(w, "  %-10s %10.1f QPS @ %.2f/s  (limited by %s)\n", c.Store, c.QPS, c.TargetRate, c.LimitedBy)
+			} else {
+				fmt.Fprintf(w, "  %-10s ≥%9.1f QPS @ %.2f/s  (not reached)\n", c.Store, c.QPS, c.TargetRate)
+			}
+			for _, step := range c.Steps {
+				fmt.Fprintf(w, "    %8.2f/s: %10.1f QPS  errors %.2f%%  p99 %.2f ms\n",
+					step.TargetRate, step.QPS, step.ErrorRate*100, step.P99Ms)
+			}
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// History size of sampled completed workflows
+	if len(r.HistorySize) > 0 {
+		fmt.Fprintln(w, "HISTORY SIZE (sampled completed workflows)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, h := range r.HistorySize {
+			fmt.Fprintf(w, "  %s (%d sampled)\n", h.WorkflowType, h.Samples)
+			fmt.Fprintf(w, "    Events Avg/P50/P95/Max: %.1f/%.0f/%.0f/%.0f\n", h.AvgEvents, h.P50Events, h.P95Events, h.MaxEvents)
+			fmt.Fprintf(w, "    Bytes  Avg/P50/P95/Max: %.0f/%.0f/%.0f/%.0f\n", h.AvgBytes, h.P50Bytes, h.P95Bytes, h.MaxBytes)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Workflow latencies per type of a mixed run
+	if len(r.TypeLatency) > 0 {
+		var total int64
+		for _, t := range r.TypeLatency {
+			total += t.Completed + t.Failed
+		}
+		fmt.Fprintln(w, "LATENCY BY WORKFLOW TYPE")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, t := range r.TypeLatency {
+			var share float64
+			if total > 0 {
+				share = float64(t.Completed+t.Failed) / float64(total) * 100
+			}
+			fmt.Fprintf(w, "  %s (%.1f%%: %d completed, %d failed)\n", t.WorkflowType, share, t.Completed, t.Failed)
+			fmt.Fprintf(w, "    P50/P95/P99/Max: %.2f/%.2f/%.2f/%.2f ms\n", t.Latency.P50, t.Latency.P95, t.Latency.P99, t.Latency.Max)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Activity latencies per type
+	if len(r.ActivityLatency) > 0 {
+		fmt.Fprintln(w, "ACTIVITY LATENCY (per type)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, a := range r.ActivityLatency {
+			fmt.Fprintf(w, "  %s (%d executions)\n", a.ActivityType, a.Executions)
+			fmt.Fprintf(w, "    Execution         P50/P95/P99: %.2f/%.2f/%.2f ms\n", a.Execution.P50, a.Execution.P95, a.Execution.P99)
+			fmt.Fprintf(w, "    Schedule-to-Start P50/P95/P99: %.2f/%.2f/%.2f ms\n", a.ScheduleToStart.P50, a.ScheduleToStart.P95, a.ScheduleToStart.P99)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Latency over time: the slowest window stands out from the run's percentiles
+	if h := r.LatencyHeatmap; h != nil && len(h.Windows) > 0 {
+		slowest, slowestP99 := -1, 0.0
+		for i, window := range h.Windows {
+			if p99 := h.PercentileMs(window, 99); p99 > slowestP99 {
+				slowest, slowestP99 = i, p99
+			}
+		}
+		fmt.Fprintln(w, "LATENCY HEATMAP")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Windows:              %d × %gs\n", len(h.Windows), h.WindowSeconds)
+		if slowest >= 0 {
+			bound := fmt.Sprintf("≤ %.0f ms", slowestP99)
+			if math.IsInf(slowestP99, 1) && len(h.BucketsMs) > 0 {
+				bound = fmt.Sprintf("> %.0f ms", h.BucketsMs[len(h.BucketsMs)-1])
+			}
+			fmt.Fprintf(w, "  Slowest Window P99:   %s at +%s\n", bound, h.Windows[slowest].Start.Sub(h.Windows[0].Start))
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Background read workload
+	if rl := r.ReadLatency; rl != nil {
+		fmt.Fprintf(w, "READ PATH (%.2f reads/s)\n", rl.QPS)
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		for _, api := range []struct {
+			name string
+			l    ReadAPILatency
+		}{{"Describe", rl.Describe}, {"GetHistory", rl.GetHistory}} {
+			fmt.Fprintf(w, "  %-11s %6d calls %4d errors  P50/P95/P99/Max: %.2f/%.2f/%.2f/%.2f ms\n",
+				api.name+":", api.l.Requests, api.l.Errors, api.l.P50, api.l.P95, api.l.P99, api.l.Max)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Cluster load before and after the run
+	if cs := r.ClusterState; cs != nil {
+		fmt.Fprintln(w, "CLUSTER STATE (before → after)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Namespaces:           %d → %d (%+d)\n", cs.Before.Namespaces, cs.After.Namespaces, cs.Diff.Namespaces)
+		fmt.Fprintf(w, "  Open Workflows:       %d → %d (%+d)\n", cs.Before.OpenWorkflows, cs.After.OpenWorkflows, cs.Diff.OpenWorkflows)
+		fmt.Fprintf(w, "  Closed Workflows:     %d → %d (%+d)\n", cs.Before.ClosedWorkflows, cs.After.ClosedWorkflows, cs.Diff.ClosedWorkflows)
+		fmt.Fprintf(w, "  Workflow Backlog:     %d → %d (%+d)\n", cs.Before.WorkflowTaskBacklog, cs.After.WorkflowTaskBacklog, cs.Diff.WorkflowTaskBacklog)
+		fmt.Fprintf(w, "  Activity Backlog:     %d → %d (%+d)\n", cs.Before.ActivityTaskBacklog, cs.After.ActivityTaskBacklog, cs.Diff.ActivityTaskBacklog)
+		if cs.Before.Incomplete || cs.After.Incomplete {
+			fmt.Fprintln(w, "  (some namespaces could not be read)")
+		}
+		if cs.Preloaded() {
+			fmt.Fprintln(w, "  ⚠ Run started on an already-loaded cluster")
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Client/server clock offset
+	if r.ClockSkew != nil {
+		fmt.Fprintln(w, "CLOCK SKEW (server - client)")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Offset:               %.2f ms ± %.2f ms (%d canaries)\n",
+			r.ClockSkew.OffsetMs, r.ClockSkew.UncertaintyMs, r.ClockSkew.Samples)
+		fmt.Fprintln(w, "")
+	}
+
+	// Client and frontend availability zones
+	if t := r.Topology; t != nil {
+		placement := t.Placement
+		if placement == "" {
+			placement = "unknown"
+		}
+		frontend := strings.Join(t.FrontendAZs, ", ")
+		if frontend == "" {
+			frontend = "unresolved"
+		}
+		client := t.ClientAZ
+		if client == "" {
+			client = "unresolved"
+		}
+		fmt.Fprintln(w, "TOPOLOGY")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Client AZ:            %s\n", client)
+		fmt.Fprintf(w, "  Frontend AZs:         %s\n", frontend)
+		fmt.Fprintf(w, "  Placement:            %s\n", placement)
+		fmt.Fprintln(w, "")
+	}
+
+	// Address family of the frontend connections
+	if c := r.Connection; c != nil {
+		fmt.Fprintln(w, "CONNECTION")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		fmt.Fprintf(w, "  Address Family:       %s (preference %s)\n", c.AddressFamily, c.Preference)
+		connections := fmt.Sprintf("%d", c.Dials)
+		if c.Fallbacks > 0 {
+			connections += fmt.Sprintf(", %d fell back to the other family", c.Fallbacks)
+		}
+		fmt.Fprintf(w, "  Connections:          %s\n", connections)
+		fmt.Fprintln(w, "")
+	}
+
+	// Dynamic config overrides applied for the run
+	if d := r.DynamicConfig; d != nil {
+		fmt.Fprintln(w, "DYNAMIC CONFIG")
+		fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+		keys := make([]string, 0, len(d.Overrides))
+		for key := range d.Overrides {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			fmt.Fprintf(w, "  %s: %s\n", key, d.Overrides[key])
+		}
+		fmt.Fprintf(w, "  Services:             %s\n", strings.Join(d.Services, ", "))
+		if d.Reverted {
+			fmt.Fprintln(w, "  Reverted:             yes")
+		} else {
+			fmt.Fprintf(w, "  Reverted:             NO (%s)\n", d.Error)
+		}
+		fmt.Fprintln(w, "")
+	}
+
+	// Thresholds section
+	fmt.Fprintln(w, "THRESHOLDS")
+	fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+	if r.Thresholds.Profile != "" {
+		fmt.Fprintf(w, "  Profile:              %s\n", r.Thresholds.Profile)
+	}
+	fmt.Fprintf(w, "  Max P99 Latency:      %.2f ms\n", r.Thresholds.MaxP99LatencyMs)
+	fmt.Fprintf(w, "  Min Throughput:       %.2f workflows/s\n", r.Thresholds.MinThroughput)
+	for _, t := range r.Thresholds.Persistence {
+		fmt.Fprintf(w, "  Persistence:          %s\n", t)
+	}
+	if r.Thresholds.MaxDescribeP99Ms > 0 {
+		fmt.Fprintf(w, "  Max Describe P99:     %.2f ms\n", r.Thresholds.MaxDescribeP99Ms)
+	}
+	if r.Thresholds.MaxGetHistoryP99Ms > 0 {
+		fmt.Fprintf(w, "  Max GetHistory P99:   %.2f ms\n", r.Thresholds.MaxGetHistoryP99Ms)
+	}
+	if b := r.Thresholds.Baseline; b != nil {
+		fmt.Fprintf(w, "  Baseline:             %s\n", b.Timestamp.Format(time.RFC3339))
+		if b.MaxP99Percent > 0 {
+			fmt.Fprintf(w, "  Max P99 vs Baseline:  %.2f ms (%g%%)\n", b.MaxP99LatencyMs, b.MaxP99Percent)
+		}
+		if b.MinThroughputPercent > 0 {
+			fmt.Fprintf(w, "  Min Rate vs Baseline: %.2f workflows/s (%g%%)\n", b.MinThroughput, b.MinThroughputPercent)
+		}
+	}
+	fmt.Fprintln(w, "")
+
+	// System info section
+	fmt.Fprintln(w, "SYSTEM")
+	fmt.Fprintln(w, "─────────────────────────────────────────────────────────────────")
+	fmt.Fprintf(w, "  Instance Type:        %s\n", r.System.InstanceType)
+	fmt.Fprintf(w, "  History Shards:       %d\n", r.System.HistoryShards)
+	if len(r.System.Services) > 0 {
+		fmt.Fprint(w, "  Services:             ")
+		first := true
+		for service, count := range r.System.Services {
+			if !first {
+				fmt.Fprint(w, ", ")
+			}
+			fmt.Fprintf(w, "%s=%d", service, count)
+			first = false
+		}
+		fmt.Fprintln(w, "")
+	}
+	if c := r.System.WorkflowCache; c != nil {
+		if c.Size == 0 {
+			fmt.Fprintln(w, "  Workflow Cache:       disabled")
+		} else {
+			fmt.Fprintf(w, "  Workflow Cache:       %d\n", c.Size)
+		}
+		if c.Hits != nil && c.Misses != nil && c.ForcedEvictions != nil {
+			fmt.Fprintf(w, "  Cache Hits/Misses:    %d / %d (%d forced evictions)\n", *c.Hits, *c.Misses, *c.ForcedEvictions)
+		}
+	}
+	fmt.Fprintln(w, "")
+
+	// Pass/Fail status
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	if r.Passed {
+		fmt.Fprintln(w, "                         ✓ PASSED")
+	} else {
+		fmt.Fprintln(w, "                         ✗ FAILED")
+		fmt.Fprintln(w, "")
+		fmt.Fprintln(w, "  Failure Reasons:")
+		for _, reason := range r.FailureReasons {
+			fmt.Fprintf(w, "    • %s\n", reason)
+		}
+	}
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, "  Timestamp: %s\n", r.Timestamp.Format(time.RFC3339))
+	if r.GrafanaSnapshot != nil {
+		fmt.Fprintf(w, "  Grafana:   %s\n", r.GrafanaSnapshot.URL)
+	}
+	fmt.Fprintln(w, "")
+}
+
+// FormatSummary returns the human-readable summary as a string.
+func (r *BenchmarkResultJSON) FormatSummary() string {
+	var buf bytes.Buffer
+	r.PrintSummary(&buf)
+	return buf.String()
+}
+
+// workflowTypes returns the workflow types the run started: the types of
+// its mix in name order, otherwise its workflow type alone.
+func (c ResultConfig) workflowTypes() []string {
+	if len(c.WorkflowMix) == 0 {
+		return []string{c.WorkflowType}
+	}
+	return slices.Sorted(maps.Keys(c.WorkflowMix))
+}
+
+// formatActivityLimit formats an activity rate limit, 0 being the SDK default.
+func formatActivityLimit(perSecond float64) string {
+	if perSecond == 0 {
+		return "default"
+	}
+	return fmt.Sprintf("%g/s", perSecond)
+}
@@ -0,0 +1,1544 @@
+// Package results provides result reporting and serialization.
+package results
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func TestBenchmarkResultJSON_ToJSON(t *testing.T) {
+	result := &BenchmarkResultJSON{
+		Timestamp: time.Date(2026, 1, 13, 20, 0, 0, 0, time.UTC),
+		Config: ResultConfig{
+			WorkflowType: "simple",
+			TargetRate:   100,
+			Duration:     "5m0s",
+			WorkerCount:  4,
+			Iterations:   1,
+		},
+		Results: ResultMetrics{
+			WorkflowsStarted:   30000,
+			WorkflowsCompleted: 29950,
+			WorkflowsFailed:    50,
+			ActualRate:         99.83,
+			Latency: ResultLatency{
+				P50: 45.2,
+				P95: 120.5,
+				P99: 250.3,
+				Max: 1250.0,
+			},
+		},
+		System: ResultSystem{
+			InstanceType:  "m7g.large",
+			HistoryShards: 4,
+			Services:      map[string]int{"frontend": 1, "history": 1, "matching": 1, "worker": 1},
+		},
+		Thresholds: ResultThresholds{
+			MaxP99LatencyMs: 5000,
+			MinThroughput:   50,
+		},
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+
+	jsonBytes, err := result.ToJSON()
+	require.NoError(t, err)
+	require.NotEmpty(t, jsonBytes)
+
+	// Verify it's valid JSON
+	var parsed map[string]interface{}
+	err = json.Unmarshal(jsonBytes, &parsed)
+	require.NoError(t, err)
+
+	// Verify required fields exist
+	require.Contains(t, parsed, "timestamp")
+	require.Contains(t, parsed, "config")
+	require.Contains(t, parsed, "results")
+	require.Contains(t, parsed, "system")
+	require.Contains(t, parsed, "passed")
+	require.Contains(t, parsed, "failureReasons")
+	require.Contains(t, parsed, "thresholds")
+}
+
+func TestBenchmarkResultJSON_WriteJSON(t *testing.T) {
+	result := &BenchmarkResultJSON{
+		Timestamp: time.Date(2026, 1, 13, 20, 0, 0, 0, time.UTC),
+		Config: ResultConfig{
+			WorkflowType: "simple",
+			TargetRate:   100,
+			Duration:     "5m0s",
+			WorkerCount:  4,
+			Iterations:   1,
+		},
+		Results: ResultMetrics{
+			WorkflowsStarted:   1000,
+			WorkflowsCompleted: 1000,
+			WorkflowsFailed:    0,
+			ActualRate:         100.0,
+			Latency: ResultLatency{
+				P50: 10.0,
+				P95: 50.0,
+				P99: 100.0,
+				Max: 200.0,
+			},
+		},
+		System: ResultSystem{
+			InstanceType:  "m7g.large",
+			HistoryShards: 4,
+			Services:      map[string]int{"frontend": 1, "history": 1},
+		},
+		Thresholds: ResultThresholds{
+			MaxP99LatencyMs: 5000,
+			MinThroughput:   50,
+		},
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+
+	var buf bytes.Buffer
+	err := result.WriteJSON(&buf)
+	require.NoError(t, err)
+	require.NotEmpty(t, buf.String())
+
+	// Verify it's valid JSON
+	var parsed BenchmarkResultJSON
+	err = json.Unmarshal(buf.Bytes(), &parsed)
+	require.NoError(t, err)
+	require.Equal(t, result.Config.WorkflowType, parsed.Config.WorkflowType)
+}
+
+func TestFromJSON(t *testing.T) {
+	jsonStr := `{
+		"timestamp": "2026-01-13T20:00:00Z",
+		"config": {
+			"workflowType": "simple",
+			"targetRate": 100,
+			"duration": "5m0s",
+			"workerCount": 4,
+			"iterations": 1
+		},
+		"results": {
+			"workflowsStarted": 30000,
+			"workflowsCompleted": 29950,
+			"workflowsFailed": 50,
+			"actualRate": 99.83,
+			"latency": {
+				"p50": 45.2,
+				"p95": 120.5,
+				"p99": 250.3,
+				"max": 1250.0
+			}
+		},
+		"system": {
+			"instanceType": "m7g.large",
+			"historyShards": 4,
+			"services": {"frontend": 1, "history": 1}
+		},
+		"thresholds": {
+			"maxP99LatencyMs": 5000,
+			"minThroughput": 50
+		},
+		"passed": true,
+		"failureReasons": []
+	}`
+
+	result, err := FromJSON([]byte(jsonStr))
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, "simple", result.Config.WorkflowType)
+	require.Equal(t, float64(100), result.Config.TargetRate)
+	require.Equal(t, int64(30000), result.Results.WorkflowsStarted)
+	require.Equal(t, 45.2, result.Results.Latency.P50)
+	require.True(t, result.Passed)
+}
+
+func TestNewBenchmarkResultJSON(t *testing.T) {
+	cfg := config.BenchmarkConfig{
+		WorkflowType:   config.WorkflowTypeMultiActivity,
+		ActivityCount:  10,
+		TargetRate:     100,
+		Duration:       5 * time.Minute,
+		RampUpDuration: 30 * time.Second,
+		WorkerCount:    4,
+		Iterations:     1,
+		MaxP99Latency:  5 * time.Second,
+		MinThroughput:  50,
+	}
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Date(2026, 1, 13, 20, 0, 0, 0, time.UTC),
+		EndTime:            time.Date(2026, 1, 13, 20, 5, 0, 0, time.UTC),
+		Duration:           5 * time.Minute,
+		WorkflowsStarted:   30000,
+		WorkflowsCompleted: 29950,
+		WorkflowsFailed:    50,
+		AlreadyStarted:     7,
+		ActualRate:         99.83,
+		LatencyP50:         45.2,
+		LatencyP95:         120.5,
+		LatencyP99:         250.3,
+		LatencyMax:         1250.0,
+		InstanceType:       "m7g.large",
+		ServiceCounts:      map[string]int{"frontend": 1, "history": 1, "matching": 1, "worker": 1},
+		HistoryShards:      4,
+		Passed:             true,
+		FailureReasons:     []string{},
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-123")
+
+	require.Equal(t, "multi-activity", jsonResult.Config.WorkflowType)
+	require.Equal(t, 10, jsonResult.Config.ActivityCount)
+	require.Equal(t, "5m0s", jsonResult.Config.Duration)
+	require.Equal(t, "benchmark-123", jsonResult.Config.Namespace)
+	require.Equal(t, config.LatencySubmitToComplete, jsonResult.Config.LatencySemantics)
+	require.Equal(t, int64(30000), jsonResult.Results.WorkflowsStarted)
+	require.Equal(t, int64(7), jsonResult.Results.AlreadyStarted)
+	require.Equal(t, 45.2, jsonResult.Results.Latency.P50)
+	require.Equal(t, "m7g.large", jsonResult.System.InstanceType)
+	require.Equal(t, float64(5000), jsonResult.Thresholds.MaxP99LatencyMs)
+	require.True(t, jsonResult.Passed)
+}
+
+func TestNewBenchmarkResultJSON_TimerWorkflow(t *testing.T) {
+	cfg := config.BenchmarkConfig{
+		WorkflowType:   config.WorkflowTypeTimer,
+		TimerDuration:  2 * time.Second,
+		TargetRate:     50,
+		Duration:       1 * time.Minute,
+		RampUpDuration: 10 * time.Second,
+		WorkerCount:    2,
+		Iterations:     1,
+		MaxP99Latency:  10 * time.Second,
+		MinThroughput:  25,
+	}
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(time.Minute),
+		Duration:           time.Minute,
+		WorkflowsStarted:   3000,
+		WorkflowsCompleted: 3000,
+		WorkflowsFailed:    0,
+		ActualRate:         50.0,
+		LatencyP50:         2100.0,
+		LatencyP95:         2200.0,
+		LatencyP99:         2300.0,
+		LatencyMax:         2500.0,
+		InstanceType:       "m7g.large",
+		ServiceCounts:      map[string]int{"frontend": 1, "history": 1},
+		HistoryShards:      4,
+		Passed:             true,
+		FailureReasons:     []string{},
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-timer")
+
+	require.Equal(t, "timer", jsonResult.Config.WorkflowType)
+	require.Equal(t, "2s", jsonResult.Config.TimerDuration)
+	require.Equal(t, 0, jsonResult.Config.ActivityCount) // Should be zero for timer workflow
+}
+
+func TestNewBenchmarkResultJSON_ChildWorkflow(t *testing.T) {
+	cfg := config.BenchmarkConfig{
+		WorkflowType:   config.WorkflowTypeChildWorkflow,
+		ChildCount:     5,
+		TargetRate:     20,
+		Duration:       1 * time.Minute,
+		RampUpDuration: 10 * time.Second,
+		WorkerCount:    2,
+		Iterations:     1,
+		MaxP99Latency:  10 * time.Second,
+		MinThroughput:  10,
+	}
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(time.Minute),
+		Duration:           time.Minute,
+		WorkflowsStarted:   1200,
+		WorkflowsCompleted: 1200,
+		WorkflowsFailed:    0,
+		ActualRate:         20.0,
+		LatencyP50:         500.0,
+		LatencyP95:         800.0,
+		LatencyP99:         1000.0,
+		LatencyMax:         1500.0,
+		InstanceType:       "m7g.large",
+		ServiceCounts:      map[string]int{"frontend": 1, "history": 1},
+		HistoryShards:      4,
+		Passed:             true,
+		FailureReasons:     []string{},
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-child")
+
+	require.Equal(t, "child-workflow", jsonResult.Config.WorkflowType)
+	require.Equal(t, 5, jsonResult.Config.ChildCount)
+	require.Equal(t, 0, jsonResult.Config.ActivityCount) // Should be zero for child workflow
+}
+
+func TestNewBenchmarkResultJSON_Contention(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeContention
+	cfg.FanIn = 250
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(time.Minute),
+		Duration:           time.Minute,
+		WorkflowsStarted:   600,
+		WorkflowsCompleted: 600,
+		ActualRate:         10.0,
+		Passed:             true,
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-contention")
+
+	require.Equal(t, "contention", jsonResult.Config.WorkflowType)
+	require.Equal(t, 250, jsonResult.Config.FanIn)
+	require.Equal(t, 0, jsonResult.Config.ChildCount)
+	require.Equal(t, 14.0, jsonResult.Config.StateTransitionsPerWorkflow)
+	require.Contains(t, jsonResult.FormatSummary(), "Fan-In:           250")
+}
+
+func TestNewBenchmarkResultJSON_Heartbeat(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeHeartbeat
+	cfg.HeartbeatDuration = time.Minute
+	cfg.HeartbeatInterval = 2 * time.Second
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(time.Minute),
+		Duration:           time.Minute,
+		WorkflowsStarted:   600,
+		WorkflowsCompleted: 600,
+		ActualRate:         10.0,
+		Passed:             true,
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-heartbeat")
+
+	require.Equal(t, "1m0s", jsonResult.Config.HeartbeatDuration)
+	require.Equal(t, "2s", jsonResult.Config.HeartbeatInterval)
+	require.Empty(t, jsonResult.Config.TimerDuration)
+	// The base cost plus one transition per heartbeat
+	require.Equal(t, 39.0, jsonResult.Config.StateTransitionsPerWorkflow)
+	require.Contains(t, jsonResult.FormatSummary(), "Heartbeats:       every 2s for 1m0s")
+}
+
+func TestNewBenchmarkResultJSON_Flaky(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeFlaky
+	cfg.ActivityCount = 4
+	cfg.FailureRate = 0.5
+	cfg.RetryMaxAttempts = 3
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(time.Minute),
+		Duration:           time.Minute,
+		WorkflowsStarted:   500,
+		WorkflowsCompleted: 500,
+		ActualRate:         8.3,
+		FailedAttempts:     1500,
+		Passed:             true,
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-flaky")
+
+	require.Equal(t, 4, jsonResult.Config.ActivityCount)
+	require.Equal(t, 0.5, jsonResult.Config.FailureRate)
+	require.Equal(t, 3, jsonResult.Config.RetryMaxAttempts)
+	require.Equal(t, "1s", jsonResult.Config.RetryInitialInterval)
+	require.Equal(t, 2.0, jsonResult.Config.RetryBackoffCoefficient)
+	require.NotNil(t, jsonResult.Results.FailedAttempts)
+	require.Equal(t, int64(1500), *jsonResult.Results.FailedAttempts)
+	require.Equal(t, 3.0, jsonResult.Results.FailedAttemptsPerWorkflow)
+	// 5 + 4 activities * (6 + 2 transitions * 0.75 expected failed attempts)
+	require.Equal(t, 35.0, jsonResult.Config.StateTransitionsPerWorkflow)
+
+	summary := jsonResult.FormatSummary()
+	require.Contains(t, summary, "Failure Rate:     50% of attempts")
+	require.Contains(t, summary, "Retry Policy:     3 attempts, 1s initial interval, backoff 2.0")
+	require.Contains(t, summary, "Failed Attempts:      1500 (3.00/workflow)")
+
+	// Other workflow types don't report failed attempts
+	cfg.WorkflowType = config.WorkflowTypeSimple
+	internalResult.FailedAttempts = 0
+	require.Nil(t, NewBenchmarkResultJSON(internalResult, cfg, "benchmark-simple").Results.FailedAttempts)
+}
+
+func TestNewBenchmarkResultJSON_LatencyFallbacks(t *testing.T) {
+	cfg := config.DefaultConfig()
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(time.Minute),
+		Duration:           time.Minute,
+		WorkflowsStarted:   500,
+		WorkflowsCompleted: 500,
+		LatencyFallbacks:   7,
+		Passed:             true,
+	}
+
+	// Client latency needs no fallback
+	require.Nil(t, NewBenchmarkResultJSON(internalResult, cfg, "benchmark-simple").Results.LatencyFallbacks)
+
+	cfg.LatencySemantics = config.LatencyServerStartToComplete
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-simple")
+	require.NotNil(t, jsonResult.Results.LatencyFallbacks)
+	require.Equal(t, int64(7), *jsonResult.Results.LatencyFallbacks)
+	require.Contains(t, jsonResult.FormatSummary(), "(7 workflows use client latency: server latency unavailable)")
+}
+
+func TestNewBenchmarkResultJSON_SearchAttributes(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeSearchAttributes
+	cfg.SearchAttributeUpserts = 10
+	writes, writeErrors := int64(6000), int64(3)
+
+	internalResult := &BenchmarkResult{
+		StartTime:             time.Now(),
+		EndTime:               time.Now().Add(time.Minute),
+		Duration:              time.Minute,
+		WorkflowsStarted:      500,
+		WorkflowsCompleted:    500,
+		ActualRate:            8.3,
+		VisibilityWrites:      &writes,
+		VisibilityWriteErrors: &writeErrors,
+		Passed:                true,
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-search-attributes")
+
+	require.Equal(t, 10, jsonResult.Config.SearchAttributeUpserts)
+	require.Equal(t, &writes, jsonResult.Results.VisibilityWrites)
+	require.Equal(t, 12.0, jsonResult.Results.VisibilityWritesPerWorkflow)
+	require.Equal(t, &writeErrors, jsonResult.Results.VisibilityWriteErrors)
+	// 5 + 1 transition per upsert
+	require.Equal(t, 15.0, jsonResult.Config.StateTransitionsPerWorkflow)
+
+	summary := jsonResult.FormatSummary()
+	require.Contains(t, summary, "Upserts:          10 per workflow")
+	require.Contains(t, summary, "Visibility Writes:    6000 (12.00/workflow), 3 failed")
+
+	// Without server metrics there is no visibility write count
+	internalResult.VisibilityWrites = nil
+	internalResult.VisibilityWriteErrors = nil
+	require.NotContains(t, NewBenchmarkResultJSON(internalResult, cfg, "benchmark-search-attributes").FormatSummary(), "Visibility Writes")
+}
+
+func TestNewBenchmarkResultJSON_SideEffects(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeSideEffects
+	cfg.SideEffects = 50
+	cfg.ActivityCount = 2
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(time.Minute),
+		Duration:           time.Minute,
+		WorkflowsStarted:   100,
+		WorkflowsCompleted: 100,
+		ActualRate:         1.7,
+		Passed:             true,
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-side-effects")
+
+	require.Equal(t, 50, jsonResult.Config.SideEffects)
+	require.Equal(t, 2, jsonResult.Config.ActivityCount)
+	// 5 + 6 transitions per activity; markers add none
+	require.Equal(t, 17.0, jsonResult.Config.StateTransitionsPerWorkflow)
+	require.Contains(t, jsonResult.FormatSummary(), "Side Effects:     50 per workflow, 2 activities")
+}
+
+func TestNewBenchmarkResultJSON_LongHistory(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeLongHistory
+	cfg.LongHistoryEvents = 1000
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(time.Minute),
+		Duration:           time.Minute,
+		WorkflowsStarted:   100,
+		WorkflowsCompleted: 80,
+		HistoryEvents:      80 * 1003,
+		ActualRate:         1.3,
+		Passed:             true,
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-long-history")
+
+	require.Equal(t, 1000, jsonResult.Config.LongHistoryEvents)
+	// 5 + 90 activity and timer pairs of 11, then one more activity
+	require.Equal(t, 1001.0, jsonResult.Config.StateTransitionsPerWorkflow)
+	require.Equal(t, 1003.0, jsonResult.Results.AvgHistoryLength)
+	summary := jsonResult.FormatSummary()
+	require.Contains(t, summary, "History Events:   ~1000 per workflow")
+	require.Contains(t, summary, "Avg History Length:   1003.0 events")
+}
+
+func TestNewBenchmarkResultJSON_StickyDisabled(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.DisableSticky = true
+	reads := int64(1500)
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(time.Minute),
+		Duration:           time.Minute,
+		WorkflowsStarted:   600,
+		WorkflowsCompleted: 500,
+		ActualRate:         10.0,
+		HistoryReads:       &reads,
+		Passed:             true,
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-non-sticky")
+
+	require.True(t, jsonResult.Config.StickyDisabled)
+	require.Equal(t, &reads, jsonResult.Results.HistoryReads)
+	require.Equal(t, 3.0, jsonResult.Results.HistoryReadsPerWorkflow)
+	summary := jsonResult.FormatSummary()
+	require.Contains(t, summary, "Sticky Execution: disabled")
+	require.Contains(t, summary, "History Reads:        1500 (3.00/workflow)")
+
+	// Without server metrics there is no history read count
+	internalResult.HistoryReads = nil
+	jsonResult = NewBenchmarkResultJSON(internalResult, config.DefaultConfig(), "benchmark-sticky")
+	require.False(t, jsonResult.Config.StickyDisabled)
+	require.Zero(t, jsonResult.Results.HistoryReadsPerWorkflow)
+	require.NotContains(t, jsonResult.FormatSummary(), "History Reads")
+}
+
+func TestNewBenchmarkResultJSON_ActivityRateLimits(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.TaskQueueActivitiesPerSecond = 500
+	internalResult := &BenchmarkResult{StartTime: time.Now(), ActualRate: 10, Passed: true}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-limited")
+	require.Zero(t, jsonResult.Config.WorkerActivitiesPerSecond)
+	require.Equal(t, 500.0, jsonResult.Config.TaskQueueActivitiesPerSecond)
+	require.Contains(t, jsonResult.FormatSummary(), "Activity Limits:  default per worker, 500/s per task queue")
+
+	// Unlimited runs don't mention the limits
+	jsonResult = NewBenchmarkResultJSON(internalResult, config.DefaultConfig(), "benchmark-unlimited")
+	require.NotContains(t, jsonResult.FormatSummary(), "Activity Limits")
+}
+
+func TestNewBenchmarkResultJSON_StateTransitionTarget(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeStateTransitions
+	cfg.TargetStateTransitions = 3000
+
+	internalResult := &BenchmarkResult{StartTime: time.Now(), ActualRate: 48, Passed: true}
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-st")
+
+	// 60 transitions per workflow: 3000 st/s is 50 workflows/s
+	require.Equal(t, float64(50), jsonResult.Config.TargetRate)
+	require.Equal(t, float64(3000), jsonResult.Config.TargetStateTransitions)
+	require.Equal(t, float64(60), jsonResult.Config.StateTransitionsPerWorkflow)
+	require.Equal(t, float64(2880), jsonResult.Results.StateTransitionRate)
+
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "3000.00 state transitions/s at 60 per workflow, model")
+
+	// A calibrated cost replaces the model's; a child-workflow entry measured
+	// with another child count does not
+	cfg.Calibration = &config.CalibrationTable{Types: map[string]config.CalibratedWorkflow{
+		config.WorkflowTypeStateTransitions: {Samples: 10, StateTransitions: 75},
+		config.WorkflowTypeChildWorkflow:    {Samples: 10, StateTransitions: 90, ChildCount: cfg.ChildCount + 1},
+	}}
+	jsonResult = NewBenchmarkResultJSON(internalResult, cfg, "benchmark-st")
+	require.Equal(t, float64(40), jsonResult.Config.TargetRate)
+	require.Equal(t, float64(75), jsonResult.Config.StateTransitionsPerWorkflow)
+	require.Equal(t, config.TransitionCostCalibrated, jsonResult.Config.StateTransitionCostSource)
+
+	cfg.WorkflowType = config.WorkflowTypeChildWorkflow
+	jsonResult = NewBenchmarkResultJSON(internalResult, cfg, "benchmark-st")
+	require.Equal(t, config.TransitionCostModel, jsonResult.Config.StateTransitionCostSource)
+}
+
+func TestBenchmarkResultJSON_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  *BenchmarkResultJSON
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid result",
+			result: &BenchmarkResultJSON{
+				Timestamp: time.Now(),
+				Config: ResultConfig{
+					WorkflowType: "simple",
+					Duration:     "5m0s",
+				},
+				System: ResultSystem{
+					InstanceType: "m7g.large",
+					Services:     map[string]int{"frontend": 1},
+				},
+				FailureReasons: []string{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "missing timestamp",
+			result: &BenchmarkResultJSON{
+				Config: ResultConfig{
+					WorkflowType: "simple",
+					Duration:     "5m0s",
+				},
+				System: ResultSystem{
+					InstanceType: "m7g.large",
+					Services:     map[string]int{"frontend": 1},
+				},
+				FailureReasons: []string{},
+			},
+			wantErr: true,
+			errMsg:  "timestamp is required",
+		},
+		{
+			name: "missing workflow type",
+			result: &BenchmarkResultJSON{
+				Timestamp: time.Now(),
+				Config: ResultConfig{
+					Duration: "5m0s",
+				},
+				System: ResultSystem{
+					InstanceType: "m7g.large",
+					Services:     map[string]int{"frontend": 1},
+				},
+				FailureReasons: []string{},
+			},
+			wantErr: true,
+			errMsg:  "config.workflowType is required",
+		},
+		{
+			name: "missing duration",
+			result: &BenchmarkResultJSON{
+				Timestamp: time.Now(),
+				Config: ResultConfig{
+					WorkflowType: "simple",
+				},
+				System: ResultSystem{
+					InstanceType: "m7g.large",
+					Services:     map[string]int{"frontend": 1},
+				},
+				FailureReasons: []string{},
+			},
+			wantErr: true,
+			errMsg:  "config.duration is required",
+		},
+		{
+			name: "missing instance type",
+			result: &BenchmarkResultJSON{
+				Timestamp: time.Now(),
+				Config: ResultConfig{
+					WorkflowType: "simple",
+					Duration:     "5m0s",
+				},
+				System: ResultSystem{
+					Services: map[string]int{"frontend": 1},
+				},
+				FailureReasons: []string{},
+			},
+			wantErr: true,
+			errMsg:  "system.instanceType is required",
+		},
+		{
+			name: "nil services",
+			result: &BenchmarkResultJSON{
+				Timestamp: time.Now(),
+				Config: ResultConfig{
+					WorkflowType: "simple",
+					Duration:     "5m0s",
+				},
+				System: ResultSystem{
+					InstanceType: "m7g.large",
+				},
+				FailureReasons: []string{},
+			},
+			wantErr: true,
+			errMsg:  "system.services is required",
+		},
+		{
+			name: "nil failure reasons",
+			result: &BenchmarkResultJSON{
+				Timestamp: time.Now(),
+				Config: ResultConfig{
+					WorkflowType: "simple",
+					Duration:     "5m0s",
+				},
+				System: ResultSystem{
+					InstanceType: "m7g.large",
+					Services:     map[string]int{"frontend": 1},
+				},
+				FailureReasons: nil,
+			},
+			wantErr: true,
+			errMsg:  "failureReasons must not be nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.result.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFromJSON_InvalidJSON(t *testing.T) {
+	_, err := FromJSON([]byte("invalid json"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to unmarshal")
+}
+
+func TestNewBenchmarkResultJSON_NilServiceCounts(t *testing.T) {
+	cfg := config.BenchmarkConfig{
+		WorkflowType:   config.WorkflowTypeSimple,
+		TargetRate:     100,
+		Duration:       5 * time.Minute,
+		RampUpDuration: 30 * time.Second,
+		WorkerCount:    4,
+		Iterations:     1,
+		MaxP99Latency:  5 * time.Second,
+		MinThroughput:  50,
+	}
+
+	internalResult := &BenchmarkResult{
+		StartTime:          time.Now(),
+		EndTime:            time.Now().Add(5 * time.Minute),
+		Duration:           5 * time.Minute,
+		WorkflowsStarted:   30000,
+		WorkflowsCompleted: 30000,
+		WorkflowsFailed:    0,
+		ActualRate:         100.0,
+		LatencyP50:         45.2,
+		LatencyP95:         120.5,
+		LatencyP99:         250.3,
+		LatencyMax:         1250.0,
+		InstanceType:       "m7g.large",
+		ServiceCounts:      nil, // nil service counts
+		HistoryShards:      4,
+		Passed:             true,
+		FailureReasons:     []string{},
+	}
+
+	jsonResult := NewBenchmarkResultJSON(internalResult, cfg, "benchmark-123")
+
+	// Should have default service counts
+	require.NotNil(t, jsonResult.System.Services)
+	require.Equal(t, 1, jsonResult.System.Services["frontend"])
+	require.Equal(t, 1, jsonResult.System.Services["history"])
+	require.Equal(t, 1, jsonResult.System.Services["matching"])
+	require.Equal(t, 1, jsonResult.System.Services["worker"])
+}
+
+func TestEvaluateThresholds_Pass(t *testing.T) {
+	result := &BenchmarkResult{
+		LatencyP99: 100.0, // 100ms
+		ActualRate: 100.0, // 100 workflows/s
+	}
+
+	// Thresholds: max p99 = 200ms, min throughput = 50/s
+	EvaluateThresholds(result, 200.0, 50.0)
+
+	require.True(t, result.Passed)
+	require.Empty(t, result.FailureReasons)
+}
+
+func TestEvaluateThresholds_FailLatency(t *testing.T) {
+	result := &BenchmarkResult{
+		LatencyP99: 300.0, // 300ms - exceeds threshold
+		ActualRate: 100.0, // 100 workflows/s - meets threshold
+	}
+
+	// Thresholds: max p99 = 200ms, min throughput = 50/s
+	EvaluateThresholds(result, 200.0, 50.0)
+
+	require.False(t, result.Passed)
+	require.Len(t, result.FailureReasons, 1)
+	require.Contains(t, result.FailureReasons[0], "p99 latency")
+	require.Contains(t, result.FailureReasons[0], "exceeds threshold")
+}
+
+func TestEvaluateThresholds_FailThroughput(t *testing.T) {
+	result := &BenchmarkResult{
+		LatencyP99: 100.0, // 100ms - meets threshold
+		ActualRate: 30.0,  // 30 workflows/s - below threshold
+	}
+
+	// Thresholds: max p99 = 200ms, min throughput = 50/s
+	EvaluateThresholds(result, 200.0, 50.0)
+
+	require.False(t, result.Passed)
+	require.Len(t, result.FailureReasons, 1)
+	require.Contains(t, result.FailureReasons[0], "throughput")
+	require.Contains(t, result.FailureReasons[0], "below threshold")
+}
+
+func TestEvaluateThresholds_FailBoth(t *testing.T) {
+	result := &BenchmarkResult{
+		LatencyP99: 300.0, // 300ms - exceeds threshold
+		ActualRate: 30.0,  // 30 workflows/s - below threshold
+	}
+
+	// Thresholds: max p99 = 200ms, min throughput = 50/s
+	EvaluateThresholds(result, 200.0, 50.0)
+
+	require.False(t, result.Passed)
+	require.Len(t, result.FailureReasons, 2)
+	require.Contains(t, result.FailureReasons[0], "p99 latency")
+	require.Contains(t, result.FailureReasons[1], "throughput")
+}
+
+func TestEvaluateThresholds_ExactlyAtThreshold(t *testing.T) {
+	result := &BenchmarkResult{
+		LatencyP99: 200.0, // Exactly at threshold
+		ActualRate: 50.0,  // Exactly at threshold
+	}
+
+	// Thresholds: max p99 = 200ms, min throughput = 50/s
+	EvaluateThresholds(result, 200.0, 50.0)
+
+	// At threshold should pass (not exceed, not below)
+	require.True(t, result.Passed)
+	require.Empty(t, result.FailureReasons)
+}
+
+func TestEvaluateThresholdsWithConfig(t *testing.T) {
+	cfg := config.BenchmarkConfig{
+		MaxP99Latency: 5 * time.Second, // 5000ms
+		MinThroughput: 50.0,
+	}
+
+	result := &BenchmarkResult{
+		LatencyP99: 4000.0, // 4000ms - within threshold
+		ActualRate: 60.0,   // 60/s - above threshold
+	}
+
+	EvaluateThresholdsWithConfig(result, cfg)
+
+	require.True(t, result.Passed)
+	require.Empty(t, result.FailureReasons)
+}
+
+func TestEvaluatePersistenceThresholds(t *testing.T) {
+	thresholds, err := config.ParsePersistenceThresholds("history:p99<50ms")
+	require.NoError(t, err)
+
+	result := &BenchmarkResult{
+		Passed: true,
+		Persistence: []PersistenceLatency{
+			{Service: "history", Operation: "CreateWorkflowExecution", Percentile: 99, LatencyMs: 20},
+			{Service: "history", Operation: "UpdateWorkflowExecution", Percentile: 99, LatencyMs: 72},
+		},
+	}
+	EvaluatePersistenceThresholds(result, thresholds)
+
+	require.False(t, result.Passed)
+	require.Equal(t, []string{
+		"persistence history UpdateWorkflowExecution p99 latency 72.00ms exceeds threshold 50.00ms",
+	}, result.FailureReasons)
+}
+
+func TestEvaluatePersistenceThresholds_NoServerMetrics(t *testing.T) {
+	result := &BenchmarkResult{Passed: true}
+	EvaluatePersistenceThresholds(result, []config.PersistenceThreshold{{Percentile: 99, Max: 50 * time.Millisecond}})
+
+	require.False(t, result.Passed)
+	require.Len(t, result.FailureReasons, 1)
+	require.Contains(t, result.FailureReasons[0], "server metrics unavailable")
+}
+
+func TestEvaluateReadThresholds(t *testing.T) {
+	result := &BenchmarkResult{
+		Passed: true,
+		ReadLatency: &ReadLatency{
+			Describe:   ReadAPILatency{Requests: 100, P99: 12},
+			GetHistory: ReadAPILatency{Requests: 100, Errors: 2, P99: 80},
+		},
+	}
+	EvaluateReadThresholds(result, 20*time.Millisecond, 50*time.Millisecond)
+	require.False(t, result.Passed)
+	require.Equal(t, []string{
+		"GetWorkflowExecutionHistory p99 latency 80.00ms exceeds threshold 50.00ms",
+	}, result.FailureReasons)
+
+	// A threshold without successful reads fails rather than passing unchecked
+	result = &BenchmarkResult{Passed: true}
+	EvaluateReadThresholds(result, 20*time.Millisecond, 0)
+	require.False(t, result.Passed)
+	require.Equal(t, []string{
+		"DescribeWorkflowExecution p99 latency threshold not evaluated: no successful reads",
+	}, result.FailureReasons)
+}
+
+func TestEvaluateOutcomes(t *testing.T) {
+	result := &BenchmarkResult{Passed: true, Outcomes: &OutcomeVerification{SampleRate: 0.1, Checked: 50}}
+	EvaluateOutcomes(result)
+	require.True(t, result.Passed)
+
+	result.Outcomes.Mismatches = 2
+	result.Outcomes.SampleIDs = []string{"simple-42-7", "simple-42-19"}
+	EvaluateOutcomes(result)
+	require.False(t, result.Passed)
+	require.Equal(t, []string{"2 of 50 verified workflow outcomes mismatched"}, result.FailureReasons)
+
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "Checked:              50 (10.0% sampled)")
+	require.Contains(t, buf.String(), "simple-42-19")
+}
+
+func TestEvaluateIntegrity(t *testing.T) {
+	result := &BenchmarkResult{Passed: true, Integrity: &HistoryIntegrity{Checked: 20, Events: 460, Unreadable: 1}}
+	EvaluateIntegrity(result)
+	require.True(t, result.Passed)
+
+	result.Integrity.Violated = 1
+	result.Integrity.Violations = []IntegrityViolation{{WorkflowID: "simple-42-3", Reason: "event 7 has ID 8"}}
+	EvaluateIntegrity(result)
+	require.False(t, result.Passed)
+	require.Equal(t, []string{"1 of 20 audited workflow histories violated integrity invariants"}, result.FailureReasons)
+
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "Audited:              20 histories, 460 events")
+	require.Contains(t, buf.String(), "simple-42-3: event 7 has ID 8")
+}
+
+func TestPrintSummary_BurnRateAlerts(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	result := &BenchmarkResult{
+		StartTime:      start,
+		BurnRateAlerts: []BurnRateAlert{{StartTime: start.Add(time.Minute), EndTime: start.Add(3 * time.Minute), PeakBurnRate: 4.3}},
+	}
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "BURN RATE ALERTS")
+	require.Contains(t, buf.String(), "10:01:00 - 10:03:00  (2m0s)  peak burn rate 4.3×")
+}
+
+func TestPrintSummary_PersistenceCeilings(t *testing.T) {
+	result := &BenchmarkResult{
+		Ceilings: []PersistenceCeiling{
+			{
+				Store: "history", QPS: 2400, TargetRate: 200, Reached: true, LimitedBy: "latency",
+				Steps: []PersistenceStep{{TargetRate: 200, QPS: 2400, P99Ms: 40}, {TargetRate: 300, QPS: 2600, ErrorRate: 0.002, P99Ms: 180}},
+			},
+			{Store: "visibility", QPS: 600, TargetRate: 300, Steps: []PersistenceStep{{TargetRate: 300, QPS: 600, P99Ms: 12}}},
+		},
+	}
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "PERSISTENCE QPS CEILING")
+	require.Contains(t, buf.String(), "history        2400.0 QPS @ 200.00/s  (limited by latency)")
+	require.Contains(t, buf.String(), "300.00/s:     2600.0 QPS  errors 0.20%  p99 180.00 ms")
+	require.Contains(t, buf.String(), "visibility ≥    600.0 QPS @ 300.00/s  (not reached)")
+}
+
+func TestPrintSummary_Cancellation(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.CancelRate = 0.25
+	result := &BenchmarkResult{
+		Cancellation: &Cancellation{Requested: 40, Canceled: 38, Completed: 2, Latency: ResultLatency{P50: 12, P95: 30, P99: 45, Max: 60}},
+	}
+	jsonResult := NewBenchmarkResultJSON(result, cfg, "ns")
+	require.Equal(t, "5s", jsonResult.Config.CancelAfter)
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "Cancellation:     25% of workflows after 5s")
+	require.Contains(t, buf.String(), "Canceled:             38")
+	require.Contains(t, buf.String(), "Cancel to Closed P50/P95/P99/Max: 12.00/30.00/45.00/60.00 ms")
+}
+
+func TestPrintSummary_StartPadding(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.MemoSizeBytes = 4096
+	jsonResult := NewBenchmarkResultJSON(&BenchmarkResult{}, cfg, "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "Start Padding:    4096 B memo, 0 B header")
+
+	jsonResult = NewBenchmarkResultJSON(&BenchmarkResult{}, config.DefaultConfig(), "ns")
+	buf.Reset()
+	jsonResult.PrintSummary(&buf)
+	require.NotContains(t, buf.String(), "Start Padding")
+}
+
+func TestPrintSummary_WorkflowMix(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeMixed
+	cfg.WorkflowMix = map[string]int{config.WorkflowTypeSimple: 50, config.WorkflowTypeTimer: 30, config.WorkflowTypeChildWorkflow: 20}
+	result := &BenchmarkResult{
+		TypeLatency: []WorkflowTypeLatency{
+			{WorkflowType: config.WorkflowTypeSimple, Completed: 49, Failed: 1, Latency: ResultLatency{P50: 40, P95: 80, P99: 95, Max: 120}},
+			{WorkflowType: config.WorkflowTypeTimer, Completed: 30, Latency: ResultLatency{P50: 1010, P95: 1040, P99: 1060, Max: 1100}},
+			{WorkflowType: config.WorkflowTypeChildWorkflow, Completed: 20, Latency: ResultLatency{P50: 300, P95: 450, P99: 500, Max: 520}},
+		},
+	}
+	jsonResult := NewBenchmarkResultJSON(result, cfg, "ns")
+	require.Equal(t, cfg.ChildCount, jsonResult.Config.ChildCount, "parameters of each type of the mix")
+	require.Equal(t, cfg.TimerDuration.String(), jsonResult.Config.TimerDuration)
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "Workflow Mix:     child-workflow 20, simple 50, timer 30")
+	require.Contains(t, buf.String(), "Timer Duration:")
+	require.Contains(t, buf.String(), "LATENCY BY WORKFLOW TYPE")
+	require.Contains(t, buf.String(), "simple (50.0%: 49 completed, 1 failed)")
+	require.Contains(t, buf.String(), "P50/P95/P99/Max: 1010.00/1040.00/1060.00/1100.00 ms")
+}
+
+func TestPrintSummary_ShardRestarts(t *testing.T) {
+	result := &BenchmarkResult{
+		ShardRestarts: []ShardRestart{
+			{Offset: "3m0s", Tasks: []string{"abc"}, BaselineP99Ms: 100, PeakP99Ms: 2500, SpikeSeconds: 35, Recovered: true, FailedRequests: 12},
+			{Offset: "7m0s", Tasks: []string{"def"}, BaselineP99Ms: 100, PeakP99Ms: 5000, SpikeSeconds: 180},
+			{Offset: "9m0s", Error: "service has 1 running tasks"},
+		},
+	}
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "SHARD CHURN")
+	require.Contains(t, buf.String(), "+3m0s  1 tasks  p99 100 -> 2500 ms  recovered after 35s  12 failed")
+	require.Contains(t, buf.String(), "+7m0s  1 tasks  p99 100 -> 5000 ms  not recovered after 180s  0 failed")
+	require.Contains(t, buf.String(), "+9m0s  error: service has 1 running tasks")
+}
+
+func TestPrintSummary_ActivityWork(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.WorkflowType = config.WorkflowTypeFanout
+	cfg.ActivityWork = config.ActivityWorkCPU
+	cfg.ActivityCPUIterations = 50000
+	jsonResult := NewBenchmarkResultJSON(&BenchmarkResult{}, cfg, "ns")
+	require.Equal(t, config.ActivityWorkCPU, jsonResult.Config.ActivityWork)
+	require.Equal(t, 50000, jsonResult.Config.ActivityCPUIterations)
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "Activity Work:    cpu, 50000 SHA-256 iterations per activity")
+
+	cfg.WorkflowType = config.WorkflowTypeTimer
+	jsonResult = NewBenchmarkResultJSON(&BenchmarkResult{}, cfg, "ns")
+	require.Empty(t, jsonResult.Config.ActivityWork, "timer workflows run no generic activities")
+	require.Zero(t, jsonResult.Config.ActivityCPUIterations)
+}
+
+func TestPrintSummary_Topology(t *testing.T) {
+	result := &BenchmarkResult{
+		Topology: &Topology{ClientAZ: "us-east-1a", FrontendAZs: []string{"us-east-1a", "us-east-1b"}, Placement: PlacementMixed},
+	}
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "TOPOLOGY")
+	require.Contains(t, buf.String(), "Client AZ:            us-east-1a")
+	require.Contains(t, buf.String(), "Frontend AZs:         us-east-1a, us-east-1b")
+	require.Contains(t, buf.String(), "Placement:            mixed")
+
+	jsonResult.Topology = &Topology{ClientAZ: "us-east-1a"}
+	buf.Reset()
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "Frontend AZs:         unresolved")
+	require.Contains(t, buf.String(), "Placement:            unknown")
+}
+
+func TestPrintSummary_Connection(t *testing.T) {
+	result := &BenchmarkResult{
+		Connection: &Connection{Preference: config.AddressFamilyIPv6, AddressFamily: AddressFamilyMixed, Dials: 4, Fallbacks: 1},
+	}
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "CONNECTION")
+	require.Contains(t, buf.String(), "Address Family:       mixed (preference ipv6)")
+	require.Contains(t, buf.String(), "Connections:          4, 1 fell back to the other family")
+}
+
+func TestPrintSummary_DynamicConfig(t *testing.T) {
+	result := &BenchmarkResult{
+		DynamicConfig: &DynamicConfig{
+			Overrides: map[string]json.RawMessage{"matching.rps": json.RawMessage(`[{"value":5000}]`)},
+			Services:  []string{"temporal-frontend", "temporal-matching"},
+			Error:     "temporal-matching: service did not stabilize",
+		},
+	}
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "DYNAMIC CONFIG")
+	require.Contains(t, buf.String(), `matching.rps: [{"value":5000}]`)
+	require.Contains(t, buf.String(), "Services:             temporal-frontend, temporal-matching")
+	require.Contains(t, buf.String(), "Reverted:             NO (temporal-matching: service did not stabilize)")
+}
+
+func TestNewDuplicateExecutions(t *testing.T) {
+	require.Nil(t, NewDuplicateExecutions(0, 0, 0, 0))
+
+	d := NewDuplicateExecutions(2_000_000, 400_000, 3, 1)
+	require.InDelta(t, 1.5, d.ActivitiesPerMillion, 1e-9)
+	require.InDelta(t, 2.5, d.WorkflowsPerMillion, 1e-9)
+
+	jsonResult := NewBenchmarkResultJSON(&BenchmarkResult{Passed: true, Duplicates: d}, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "Activities:           3 of 2000000 (1.5 per million)")
+	require.Contains(t, buf.String(), "Workflows:            1 of 400000 (2.5 per million)")
+}
+
+func TestNewClusterState(t *testing.T) {
+	state := NewClusterState(
+		ClusterSnapshot{Namespaces: 3, OpenWorkflows: 40, ClosedWorkflows: 1000},
+		ClusterSnapshot{Namespaces: 4, OpenWorkflows: 45, ClosedWorkflows: 3000, ActivityTaskBacklog: 12},
+	)
+	require.Equal(t, ClusterSnapshotDiff{Namespaces: 1, OpenWorkflows: 5, ClosedWorkflows: 2000, ActivityTaskBacklog: 12}, state.Diff)
+	require.True(t, state.Preloaded())
+
+	jsonResult := NewBenchmarkResultJSON(&BenchmarkResult{StartTime: time.Now(), ClusterState: state}, config.DefaultConfig(), "ns")
+	var buf bytes.Buffer
+	jsonResult.PrintSummary(&buf)
+	require.Contains(t, buf.String(), "Open Workflows:       40 → 45 (+5)")
+	require.Contains(t, buf.String(), "already-loaded cluster")
+
+	require.False(t, NewClusterState(ClusterSnapshot{ClosedWorkflows: 10}, ClusterSnapshot{}).Preloaded())
+}
+
+func TestEvaluateBaselineThresholds(t *testing.T) {
+	baseline := &BenchmarkResultJSON{
+		Timestamp: time.Date(2026, 1, 13, 20, 0, 0, 0, time.UTC),
+		Results:   ResultMetrics{ActualRate: 100, Latency: ResultLatency{P99: 200}},
+	}
+
+	result := &BenchmarkResult{Passed: true, LatencyP99: 230, ActualRate: 96}
+	EvaluateBaselineThresholds(result, baseline, 110, 95)
+	require.False(t, result.Passed)
+	require.Equal(t, []string{"p99 latency 230.00ms exceeds 110% of baseline (220.00ms)"}, result.FailureReasons)
+	require.Equal(t, 220.0, result.Baseline.MaxP99LatencyMs)
+	require.Equal(t, 95.0, result.Baseline.MinThroughput)
+
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "bench")
+	require.Equal(t, baseline.Timestamp, jsonResult.Thresholds.Baseline.Timestamp)
+	require.Contains(t, jsonResult.FormatSummary(), "Max P99 vs Baseline:  220.00 ms (110%)")
+
+	// Without percentages (or a baseline) nothing is evaluated
+	result = &BenchmarkResult{Passed: true, LatencyP99: 1000}
+	EvaluateBaselineThresholds(result, baseline, 0, 0)
+	EvaluateBaselineThresholds(result, nil, 110, 95)
+	require.True(t, result.Passed)
+	require.Nil(t, result.Baseline)
+}
+
+// sloEvaluator fails results whose p99 latency per workflow/s exceeds max.
+type sloEvaluator struct {
+	max float64
+	err error
+}
+
+func (e sloEvaluator) Name() string { return "slo" }
+
+func (e sloEvaluator) Evaluate(_ context.Context, result *BenchmarkResult) ([]string, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	if score := result.LatencyP99 / result.ActualRate; score > e.max {
+		return []string{fmt.Sprintf("score %.2f exceeds %.2f", score, e.max)}, nil
+	}
+	return nil, nil
+}
+
+func TestEvaluateCustomThresholds(t *testing.T) {
+	result := &BenchmarkResult{Passed: true, FailureReasons: []string{}, LatencyP99: 300, ActualRate: 100}
+	EvaluateCustomThresholds(context.Background(), result, []ThresholdEvaluator{sloEvaluator{max: 5}})
+	require.True(t, result.Passed)
+
+	EvaluateCustomThresholds(context.Background(), result, []ThresholdEvaluator{
+		sloEvaluator{max: 2},
+		sloEvaluator{err: errors.New("no history")},
+	})
+	require.False(t, result.Passed)
+	require.Equal(t, []string{"slo: score 3.00 exceeds 2.00", "slo threshold not evaluated: no history"}, result.FailureReasons)
+}
+
+func TestThresholdEvaluatorsFromConfig(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ThresholdEvaluators = []string{"test-slo"}
+	_, err := ThresholdEvaluatorsFromConfig(cfg)
+	require.ErrorContains(t, err, `unknown threshold evaluator "test-slo"`)
+
+	RegisterThresholdEvaluator("test-slo", func(cfg config.BenchmarkConfig) (ThresholdEvaluator, error) {
+		return sloEvaluator{max: cfg.MinThroughput}, nil
+	})
+	evaluators, err := ThresholdEvaluatorsFromConfig(cfg)
+	require.NoError(t, err)
+	require.Equal(t, []ThresholdEvaluator{sloEvaluator{max: cfg.MinThroughput}}, evaluators)
+
+	RegisterThresholdEvaluator("test-broken", func(config.BenchmarkConfig) (ThresholdEvaluator, error) {
+		return nil, errors.New("missing history file")
+	})
+	cfg.ThresholdEvaluators = []string{"test-slo", "test-broken"}
+	_, err = ThresholdEvaluatorsFromConfig(cfg)
+	require.EqualError(t, err, "test-broken threshold evaluator: missing history file")
+}
+
+func TestLatencyHeatmap_PercentileMs(t *testing.T) {
+	h := &LatencyHeatmap{WindowSeconds: 10, BucketsMs: []float64{10, 100}}
+	require.Equal(t, 0.0, h.PercentileMs(HeatmapWindow{Counts: []int64{0, 0, 0}}, 99))
+	require.Equal(t, 10.0, h.PercentileMs(HeatmapWindow{Counts: []int64{99, 1, 0}}, 99))
+	require.Equal(t, 100.0, h.PercentileMs(HeatmapWindow{Counts: []int64{98, 2, 0}}, 99))
+	require.True(t, math.IsInf(h.PercentileMs(HeatmapWindow{Counts: []int64{90, 0, 10}}, 99), 1))
+}
+
+func TestLatencyHeatmap_Summary(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	result := &BenchmarkResult{Passed: true, LatencyHeatmap: &LatencyHeatmap{
+		WindowSeconds: 10,
+		BucketsMs:     []float64{10, 100},
+		Windows: []HeatmapWindow{
+			{Start: start, Counts: []int64{10, 0, 0}},
+			{Start: start.Add(10 * time.Second), Counts: []int64{5, 5, 0}},
+		},
+	}}
+	jsonResult := NewBenchmarkResultJSON(result, config.DefaultConfig(), "bench")
+	require.Same(t, result.LatencyHeatmap, jsonResult.LatencyHeatmap)
+	summary := jsonResult.FormatSummary()
+	require.Contains(t, summary, "Windows:              2 × 10s")
+	require.Contains(t, summary, "Slowest Window P99:   ≤ 100 ms at +10s")
+}
+
+func TestCheckThresholds_Pass(t *testing.T) {
+	passed, reasons := CheckThresholds(100.0, 100.0, 200.0, 50.0)
+	require.True(t, passed)
+	require.Empty(t, reasons)
+}
+
+func TestCheckThresholds_FailLatency(t *testing.T) {
+	passed, reasons := CheckThresholds(300.0, 100.0, 200.0, 50.0)
+	require.False(t, passed)
+	require.Len(t, reasons, 1)
+	require.Contains(t, reasons[0], "p99 latency")
+}
+
+func TestCheckThresholds_FailThroughput(t *testing.T) {
+	passed, reasons := CheckThresholds(100.0, 30.0, 200.0, 50.0)
+	require.False(t, passed)
+	require.Len(t, reasons, 1)
+	require.Contains(t, reasons[0], "throughput")
+}
+
+func TestCheckThresholds_FailBoth(t *testing.T) {
+	passed, reasons := CheckThresholds(300.0, 30.0, 200.0, 50.0)
+	require.False(t, passed)
+	require.Len(t, reasons, 2)
+}
+
+func TestPrintSummary_Passed(t *testing.T) {
+	result := &BenchmarkResultJSON{
+		Timestamp: time.Date(2026, 1, 13, 20, 0, 0, 0, time.UTC),
+		Config: ResultConfig{
+			WorkflowType: "simple",
+			TargetRate:   100,
+			Duration:     "5m0s",
+			WorkerCount:  4,
+			Iterations:   1,
+			Namespace:    "benchmark-123",
+		},
+		Results: ResultMetrics{
+			WorkflowsStarted:   30000,
+			WorkflowsCompleted: 29950,
+			WorkflowsFailed:    50,
+			ActualRate:         99.83,
+			Latency: ResultLatency{
+				P50: 45.2,
+				P95: 120.5,
+				P99: 250.3,
+				Max: 1250.0,
+			},
+		},
+		System: ResultSystem{
+			InstanceType:  "m7g.large",
+			HistoryShards: 4,
+			Services:      map[string]int{"frontend": 1, "history": 1, "matching": 1, "worker": 1},
+		},
+		Thresholds: ResultThresholds{
+			MaxP99LatencyMs: 5000,
+			MinThroughput:   50,
+		},
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+
+	var buf bytes.Buffer
+	result.PrintSummary(&buf)
+	summary := buf.String()
+
+	// Verify key sections are present
+	require.Contains(t, summary, "BENCHMARK RESULTS SUMMARY")
+	require.Contains(t, summary, "CONFIGURATION")
+	require.Contains(t, summary, "RESULTS")
+	require.Contains(t, summary, "LATENCY")
+	require.Contains(t, summary, "THRESHOLDS")
+	require.Contains(t, summary, "SYSTEM")
+
+	// Verify key values are present
+	require.Contains(t, summary, "simple")
+	require.Contains(t, summary, "100.00 workflows/s")
+	require.Contains(t, summary, "5m0s")
+	require.Contains(t, summary, "30000")
+	require.Contains(t, summary, "29950")
+	require.Contains(t, summary, "99.83")
+	require.Contains(t, summary, "45.20")
+	require.Contains(t, summary, "250.30")
+	require.Contains(t, summary, "m7g.large")
+	require.Contains(t, summary, "benchmark-123")
+
+	// Verify pass status
+	require.Contains(t, summary, "PASSED")
+	require.NotContains(t, summary, "FAILED")
+}
+
+func TestPrintSummary_Failed(t *testing.T) {
+	result := &BenchmarkResultJSON{
+		Timestamp: time.Date(2026, 1, 13, 20, 0, 0, 0, time.UTC),
+		Config: ResultConfig{
+			WorkflowType: "simple",
+			TargetRate:   100,
+			Duration:     "5m0s",
+			WorkerCount:  4,
+			Iterations:   1,
+		},
+		Results: ResultMetrics{
+			WorkflowsStarted:   30000,
+			WorkflowsCompleted: 29950,
+			WorkflowsFailed:    50,
+			ActualRate:         40.0, // Below threshold
+			Latency: ResultLatency{
+				P50: 45.2,
+				P95: 120.5,
+				P99: 6000.0, // Above threshold
+				Max: 10000.0,
+			},
+		},
+		System: ResultSystem{
+			InstanceType:  "m7g.large",
+			HistoryShards: 4,
+			Services:      map[string]int{"frontend": 1, "history": 1},
+		},
+		Thresholds: ResultThresholds{
+			MaxP99LatencyMs: 5000,
+			MinThroughput:   50,
+		},
+		Passed: false,
+		FailureReasons: []string{
+			"p99 latency 6000.00ms exceeds threshold 5000.00ms",
+			"throughput 40.00/s below threshold 50.00/s",
+		},
+	}
+
+	var buf bytes.Buffer
+	result.PrintSummary(&buf)
+	summary := buf.String()
+
+	// Verify fail status
+	require.Contains(t, summary, "FAILED")
+	require.Contains(t, summary, "Failure Reasons")
+	require.Contains(t, summary, "p99 latency 6000.00ms exceeds threshold 5000.00ms")
+	require.Contains(t, summary, "throughput 40.00/s below threshold 50.00/s")
+}
+
+func TestPrintSummary_MultiActivity(t *testing.T) {
+	result := &BenchmarkResultJSON{
+		Timestamp: time.Now(),
+		Config: ResultConfig{
+			WorkflowType:  "multi-activity",
+			ActivityCount: 10,
+			TargetRate:    50,
+			Duration:      "1m0s",
+			WorkerCount:   2,
+			Iterations:    1,
+		},
+		Results: ResultMetrics{
+			WorkflowsStarted:   3000,
+			WorkflowsCompleted: 3000,
+			WorkflowsFailed:    0,
+			ActualRate:         50.0,
+			Latency: ResultLatency{
+				P50: 100.0,
+				P95: 200.0,
+				P99: 300.0,
+				Max: 500.0,
+			},
+		},
+		System: ResultSystem{
+			InstanceType:  "m7g.large",
+			HistoryShards: 4,
+			Services:      map[string]int{"frontend": 1, "history": 1},
+		},
+		Thresholds: ResultThresholds{
+			MaxP99LatencyMs: 5000,
+			MinThroughput:   25,
+		},
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+
+	var buf bytes.Buffer
+	result.PrintSummary(&buf)
+	summary := buf.String()
+
+	require.Contains(t, summary, "multi-activity")
+	require.Contains(t, summary, "Activity Count:   10")
+}
+
+func TestPrintSummary_Timer(t *testing.T) {
+	result := &BenchmarkResultJSON{
+		Timestamp: time.Now(),
+		Config: ResultConfig{
+			WorkflowType:  "timer",
+			TimerDuration: "2s",
+			TargetRate:    20,
+			Duration:      "1m0s",
+			WorkerCount:   2,
+			Iterations:    1,
+		},
+		Results: ResultMetrics{
+			WorkflowsStarted:   1200,
+			WorkflowsCompleted: 1200,
+			WorkflowsFailed:    0,
+			ActualRate:         20.0,
+			Latency: ResultLatency{
+				P50: 2100.0,
+				P95: 2200.0,
+				P99: 2300.0,
+				Max: 2500.0,
+			},
+		},
+		System: ResultSystem{
+			InstanceType:  "m7g.large",
+			HistoryShards: 4,
+			Services:      map[string]int{"frontend": 1, "history": 1},
+		},
+		Thresholds: ResultThresholds{
+			MaxP99LatencyMs: 5000,
+			MinThroughput:   10,
+		},
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+
+	var buf bytes.Buffer
+	result.PrintSummary(&buf)
+	summary := buf.String()
+
+	require.Contains(t, summary, "timer")
+	require.Contains(t, summary, "Timer Duration:   2s")
+}
+
+func TestPrintSummary_ChildWorkflow(t *testing.T) {
+	result := &BenchmarkResultJSON{
+		Timestamp: time.Now(),
+		Config: ResultConfig{
+			WorkflowType: "child-workflow",
+			ChildCount:   5,
+			TargetRate:   10,
+			Duration:     "1m0s",
+			WorkerCount:  2,
+			Iterations:   1,
+		},
+		Results: ResultMetrics{
+			WorkflowsStarted:   600,
+			WorkflowsCompleted: 600,
+			WorkflowsFailed:    0,
+			ActualRate:         10.0,
+			Latency: ResultLatency{
+				P50: 500.0,
+				P95: 800.0,
+				P99: 1000.0,
+				Max: 1500.0,
+			},
+		},
+		System: ResultSystem{
+			InstanceType:  "m7g.large",
+			HistoryShards: 4,
+			Services:      map[string]int{"frontend": 1, "history": 1},
+		},
+		Thresholds: ResultThresholds{
+			MaxP99LatencyMs: 5000,
+			MinThroughput:   5,
+		},
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+
+	var buf bytes.Buffer
+	result.PrintSummary(&buf)
+	summary := buf.String()
+
+	require.Contains(t, summary, "child-workflow")
+	require.Contains(t, summary, "Child Count:      5")
+}
+
+func TestFormatSummary(t *testing.T) {
+	result := &BenchmarkResultJSON{
+		Timestamp: time.Date(2026, 1, 13, 20, 0, 0, 0, time.UTC),
+		Config: ResultConfig{
+			WorkflowType: "simple",
+			TargetRate:   100,
+			Duration:     "5m0s",
+			WorkerCount:  4,
+			Iterations:   1,
+		},
+		Results: ResultMetrics{
+			WorkflowsStarted:   30000,
+			WorkflowsCompleted: 30000,
+			WorkflowsFailed:    0,
+			ActualRate:         100.0,
+			Latency: ResultLatency{
+				P50: 45.2,
+				P95: 120.5,
+				P99: 250.3,
+				Max: 1250.0,
+			},
+		},
+		System: ResultSystem{
+			InstanceType:  "m7g.large",
+			HistoryShards: 4,
+			Services:      map[string]int{"frontend": 1, "history": 1},
+		},
+		Thresholds: ResultThresholds{
+			MaxP99LatencyMs: 5000,
+			MinThroughput:   50,
+		},
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+
+	summary := result.FormatSummary()
+	require.NotEmpty(t, summary)
+	require.Contains(t, summary, "BENCHMARK RESULTS SUMMARY")
+	require.Contains(t, summary, "PASSED")
+}
@@ -0,0 +1,5355 @@
+// Benchmark result schema, the protobuf form of BenchmarkResultJSON written
+// when BENCHMARK_RESULTS_FORMAT=proto. Field names and meanings follow the
+// JSON result; see results.go for their documentation. Durations that the
+// JSON result reports as Go duration strings (e.g. "5m0s") stay strings.
+//
+// The Go types in resultspb are generated from this file (go generate
+// ./results) and ToProto in proto.go fills them in: never reuse or renumber a
+// field. TestResultsProto_MatchesGenerated checks that the generated code is
+// up to date, parsing only the declarations used here.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: results.proto
+
+package resultspb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BenchmarkResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp           *timestamppb.Timestamp  `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Config              *Config                 `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Results             *Metrics                `protobuf:"bytes,3,opt,name=results,proto3" json:"results,omitempty"`
+	Phases              []*Phase                `protobuf:"bytes,4,rep,name=phases,proto3" json:"phases,omitempty"`
+	Backpressure        []*BackpressureInterval `protobuf:"bytes,5,rep,name=backpressure,proto3" json:"backpressure,omitempty"`
+	ScalingEvents       []*ScalingEvent         `protobuf:"bytes,6,rep,name=scaling_events,json=scalingEvents,proto3" json:"scaling_events,omitempty"`
+	PersistenceLatency  []*PersistenceLatency   `protobuf:"bytes,7,rep,name=persistence_latency,json=persistenceLatency,proto3" json:"persistence_latency,omitempty"`
+	ReadLatency         *ReadLatency            `protobuf:"bytes,8,opt,name=read_latency,json=readLatency,proto3" json:"read_latency,omitempty"`
+	HistorySize         []*HistorySize          `protobuf:"bytes,9,rep,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	StuckWorkflows      *StuckWorkflows         `protobuf:"bytes,10,opt,name=stuck_workflows,json=stuckWorkflows,proto3" json:"stuck_workflows,omitempty"`
+	Drain               *DrainStats             `protobuf:"bytes,11,opt,name=drain,proto3" json:"drain,omitempty"`
+	ClockSkew           *ClockSkew              `protobuf:"bytes,12,opt,name=clock_skew,json=clockSkew,proto3" json:"clock_skew,omitempty"`
+	ClusterState        *ClusterState           `protobuf:"bytes,13,opt,name=cluster_state,json=clusterState,proto3" json:"cluster_state,omitempty"`
+	System              *System                 `protobuf:"bytes,14,opt,name=system,proto3" json:"system,omitempty"`
+	Thresholds          *Thresholds             `protobuf:"bytes,15,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	Run                 *Run                    `protobuf:"bytes,16,opt,name=run,proto3" json:"run,omitempty"`
+	Passed              bool                    `protobuf:"varint,17,opt,name=passed,proto3" json:"passed,omitempty"`
+	FailureReasons      []string                `protobuf:"bytes,18,rep,name=failure_reasons,json=failureReasons,proto3" json:"failure_reasons,omitempty"`
+	LatencyHeatmap      *LatencyHeatmap         `protobuf:"bytes,19,opt,name=latency_heatmap,json=latencyHeatmap,proto3" json:"latency_heatmap,omitempty"`
+	GrafanaSnapshot     *GrafanaSnapshot        `protobuf:"bytes,20,opt,name=grafana_snapshot,json=grafanaSnapshot,proto3" json:"grafana_snapshot,omitempty"`
+	Anonymized          bool                    `protobuf:"varint,21,opt,name=anonymized,proto3" json:"anonymized,omitempty"`
+	ActivityLatency     []*ActivityLatency      `protobuf:"bytes,22,rep,name=activity_latency,json=activityLatency,proto3" json:"activity_latency,omitempty"`
+	OutcomeVerification *OutcomeVerification    `protobuf:"bytes,23,opt,name=outcome_verification,json=outcomeVerification,proto3" json:"outcome_verification,omitempty"`
+	HistoryIntegrity    *HistoryIntegrity       `protobuf:"bytes,24,opt,name=history_integrity,json=historyIntegrity,proto3" json:"history_integrity,omitempty"`
+	DuplicateExecutions *DuplicateExecutions    `protobuf:"bytes,25,opt,name=duplicate_executions,json=duplicateExecutions,proto3" json:"duplicate_executions,omitempty"`
+	BurnRateAlerts      []*BurnRateAlert        `protobuf:"bytes,26,rep,name=burn_rate_alerts,json=burnRateAlerts,proto3" json:"burn_rate_alerts,omitempty"`
+	PersistenceCeilings []*PersistenceCeiling   `protobuf:"bytes,27,rep,name=persistence_ceilings,json=persistenceCeilings,proto3" json:"persistence_ceilings,omitempty"`
+	Cancellation        *Cancellation           `protobuf:"bytes,28,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	ShardRestarts       []*ShardRestart         `protobuf:"bytes,29,rep,name=shard_restarts,json=shardRestarts,proto3" json:"shard_restarts,omitempty"`
+	WorkflowTypeLatency []*WorkflowTypeLatency  `protobuf:"bytes,30,rep,name=workflow_type_latency,json=workflowTypeLatency,proto3" json:"workflow_type_latency,omitempty"`
+	Topology            *Topology               `protobuf:"bytes,31,opt,name=topology,proto3" json:"topology,omitempty"`
+	Connection          *Connection             `protobuf:"bytes,32,opt,name=connection,proto3" json:"connection,omitempty"`
+	DynamicConfig       *DynamicConfig          `protobuf:"bytes,33,opt,name=dynamic_config,json=dynamicConfig,proto3" json:"dynamic_config,omitempty"`
+}
+
+func (x *BenchmarkResult) Reset() {
+	*x = BenchmarkResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkResult) ProtoMessage() {}
+
+func (x *BenchmarkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkResult.ProtoReflect.Descriptor instead.
+func (*BenchmarkResult) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BenchmarkResult) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetResults() *Metrics {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetPhases() []*Phase {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetBackpressure() []*BackpressureInterval {
+	if x != nil {
+		return x.Backpressure
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetScalingEvents() []*ScalingEvent {
+	if x != nil {
+		return x.ScalingEvents
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetPersistenceLatency() []*PersistenceLatency {
+	if x != nil {
+		return x.PersistenceLatency
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetReadLatency() *ReadLatency {
+	if x != nil {
+		return x.ReadLatency
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetHistorySize() []*HistorySize {
+	if x != nil {
+		return x.HistorySize
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetStuckWorkflows() *StuckWorkflows {
+	if x != nil {
+		return x.StuckWorkflows
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetDrain() *DrainStats {
+	if x != nil {
+		return x.Drain
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetClockSkew() *ClockSkew {
+	if x != nil {
+		return x.ClockSkew
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetClusterState() *ClusterState {
+	if x != nil {
+		return x.ClusterState
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetSystem() *System {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetThresholds() *Thresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetRun() *Run {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *BenchmarkResult) GetFailureReasons() []string {
+	if x != nil {
+		return x.FailureReasons
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetLatencyHeatmap() *LatencyHeatmap {
+	if x != nil {
+		return x.LatencyHeatmap
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetGrafanaSnapshot() *GrafanaSnapshot {
+	if x != nil {
+		return x.GrafanaSnapshot
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetAnonymized() bool {
+	if x != nil {
+		return x.Anonymized
+	}
+	return false
+}
+
+func (x *BenchmarkResult) GetActivityLatency() []*ActivityLatency {
+	if x != nil {
+		return x.ActivityLatency
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetOutcomeVerification() *OutcomeVerification {
+	if x != nil {
+		return x.OutcomeVerification
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetHistoryIntegrity() *HistoryIntegrity {
+	if x != nil {
+		return x.HistoryIntegrity
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetDuplicateExecutions() *DuplicateExecutions {
+	if x != nil {
+		return x.DuplicateExecutions
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetBurnRateAlerts() []*BurnRateAlert {
+	if x != nil {
+		return x.BurnRateAlerts
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetPersistenceCeilings() []*PersistenceCeiling {
+	if x != nil {
+		return x.PersistenceCeilings
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetCancellation() *Cancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetShardRestarts() []*ShardRestart {
+	if x != nil {
+		return x.ShardRestarts
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetWorkflowTypeLatency() []*WorkflowTypeLatency {
+	if x != nil {
+		return x.WorkflowTypeLatency
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetTopology() *Topology {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetConnection() *Connection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+func (x *BenchmarkResult) GetDynamicConfig() *DynamicConfig {
+	if x != nil {
+		return x.DynamicConfig
+	}
+	return nil
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkflowType                 string           `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	ActivityCount                int64            `protobuf:"varint,2,opt,name=activity_count,json=activityCount,proto3" json:"activity_count,omitempty"`
+	TimerDuration                string           `protobuf:"bytes,3,opt,name=timer_duration,json=timerDuration,proto3" json:"timer_duration,omitempty"`
+	ChildCount                   int64            `protobuf:"varint,4,opt,name=child_count,json=childCount,proto3" json:"child_count,omitempty"`
+	TargetRate                   float64          `protobuf:"fixed64,5,opt,name=target_rate,json=targetRate,proto3" json:"target_rate,omitempty"`
+	Duration                     string           `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	RampUpDuration               string           `protobuf:"bytes,7,opt,name=ramp_up_duration,json=rampUpDuration,proto3" json:"ramp_up_duration,omitempty"`
+	WorkerCount                  int64            `protobuf:"varint,8,opt,name=worker_count,json=workerCount,proto3" json:"worker_count,omitempty"`
+	Iterations                   int64            `protobuf:"varint,9,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Namespace                    string           `protobuf:"bytes,10,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Scenario                     string           `protobuf:"bytes,11,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	LatencySemantics             string           `protobuf:"bytes,12,opt,name=latency_semantics,json=latencySemantics,proto3" json:"latency_semantics,omitempty"`
+	WorkflowIdTemplate           string           `protobuf:"bytes,13,opt,name=workflow_id_template,json=workflowIdTemplate,proto3" json:"workflow_id_template,omitempty"`
+	TargetStateTransitions       float64          `protobuf:"fixed64,14,opt,name=target_state_transitions,json=targetStateTransitions,proto3" json:"target_state_transitions,omitempty"`
+	StateTransitionsPerWorkflow  float64          `protobuf:"fixed64,15,opt,name=state_transitions_per_workflow,json=stateTransitionsPerWorkflow,proto3" json:"state_transitions_per_workflow,omitempty"`
+	StateTransitionCostSource    string           `protobuf:"bytes,16,opt,name=state_transition_cost_source,json=stateTransitionCostSource,proto3" json:"state_transition_cost_source,omitempty"`
+	FanIn                        int64            `protobuf:"varint,17,opt,name=fan_in,json=fanIn,proto3" json:"fan_in,omitempty"`
+	StickyDisabled               bool             `protobuf:"varint,18,opt,name=sticky_disabled,json=stickyDisabled,proto3" json:"sticky_disabled,omitempty"`
+	HeartbeatDuration            string           `protobuf:"bytes,19,opt,name=heartbeat_duration,json=heartbeatDuration,proto3" json:"heartbeat_duration,omitempty"`
+	HeartbeatInterval            string           `protobuf:"bytes,20,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+	FailureRate                  float64          `protobuf:"fixed64,21,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+	RetryMaxAttempts             int64            `protobuf:"varint,22,opt,name=retry_max_attempts,json=retryMaxAttempts,proto3" json:"retry_max_attempts,omitempty"`
+	RetryInitialInterval         string           `protobuf:"bytes,23,opt,name=retry_initial_interval,json=retryInitialInterval,proto3" json:"retry_initial_interval,omitempty"`
+	RetryBackoffCoefficient      float64          `protobuf:"fixed64,24,opt,name=retry_backoff_coefficient,json=retryBackoffCoefficient,proto3" json:"retry_backoff_coefficient,omitempty"`
+	WorkerActivitiesPerSecond    float64          `protobuf:"fixed64,25,opt,name=worker_activities_per_second,json=workerActivitiesPerSecond,proto3" json:"worker_activities_per_second,omitempty"`
+	TaskQueueActivitiesPerSecond float64          `protobuf:"fixed64,26,opt,name=task_queue_activities_per_second,json=taskQueueActivitiesPerSecond,proto3" json:"task_queue_activities_per_second,omitempty"`
+	SearchAttributeUpserts       int64            `protobuf:"varint,27,opt,name=search_attribute_upserts,json=searchAttributeUpserts,proto3" json:"search_attribute_upserts,omitempty"`
+	SideEffects                  int64            `protobuf:"varint,28,opt,name=side_effects,json=sideEffects,proto3" json:"side_effects,omitempty"`
+	LongHistoryEvents            int64            `protobuf:"varint,29,opt,name=long_history_events,json=longHistoryEvents,proto3" json:"long_history_events,omitempty"`
+	FanoutWidth                  int64            `protobuf:"varint,30,opt,name=fanout_width,json=fanoutWidth,proto3" json:"fanout_width,omitempty"`
+	FanoutDepth                  int64            `protobuf:"varint,31,opt,name=fanout_depth,json=fanoutDepth,proto3" json:"fanout_depth,omitempty"`
+	FanoutJoin                   string           `protobuf:"bytes,32,opt,name=fanout_join,json=fanoutJoin,proto3" json:"fanout_join,omitempty"`
+	ChildDepth                   int64            `protobuf:"varint,33,opt,name=child_depth,json=childDepth,proto3" json:"child_depth,omitempty"`
+	CancelRate                   float64          `protobuf:"fixed64,34,opt,name=cancel_rate,json=cancelRate,proto3" json:"cancel_rate,omitempty"`
+	CancelAfter                  string           `protobuf:"bytes,35,opt,name=cancel_after,json=cancelAfter,proto3" json:"cancel_after,omitempty"`
+	MemoSizeBytes                int64            `protobuf:"varint,36,opt,name=memo_size_bytes,json=memoSizeBytes,proto3" json:"memo_size_bytes,omitempty"`
+	HeaderSizeBytes              int64            `protobuf:"varint,37,opt,name=header_size_bytes,json=headerSizeBytes,proto3" json:"header_size_bytes,omitempty"`
+	WorkflowMix                  map[string]int64 `protobuf:"bytes,38,rep,name=workflow_mix,json=workflowMix,proto3" json:"workflow_mix,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ActivityWork                 string           `protobuf:"bytes,39,opt,name=activity_work,json=activityWork,proto3" json:"activity_work,omitempty"`
+	ActivityCpuIterations        int64            `protobuf:"varint,40,opt,name=activity_cpu_iterations,json=activityCpuIterations,proto3" json:"activity_cpu_iterations,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Config) GetWorkflowType() string {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return ""
+}
+
+func (x *Config) GetActivityCount() int64 {
+	if x != nil {
+		return x.ActivityCount
+	}
+	return 0
+}
+
+func (x *Config) GetTimerDuration() string {
+	if x != nil {
+		return x.TimerDuration
+	}
+	return ""
+}
+
+func (x *Config) GetChildCount() int64 {
+	if x != nil {
+		return x.ChildCount
+	}
+	return 0
+}
+
+func (x *Config) GetTargetRate() float64 {
+	if x != nil {
+		return x.TargetRate
+	}
+	return 0
+}
+
+func (x *Config) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *Config) GetRampUpDuration() string {
+	if x != nil {
+		return x.RampUpDuration
+	}
+	return ""
+}
+
+func (x *Config) GetWorkerCount() int64 {
+	if x != nil {
+		return x.WorkerCount
+	}
+	return 0
+}
+
+func (x *Config) GetIterations() int64 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *Config) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Config) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *Config) GetLatencySemantics() string {
+	if x != nil {
+		return x.LatencySemantics
+	}
+	return ""
+}
+
+func (x *Config) GetWorkflowIdTemplate() string {
+	if x != nil {
+		return x.WorkflowIdTemplate
+	}
+	return ""
+}
+
+func (x *Config) GetTargetStateTransitions() float64 {
+	if x != nil {
+		return x.TargetStateTransitions
+	}
+	return 0
+}
+
+func (x *Config) GetStateTransitionsPerWorkflow() float64 {
+	if x != nil {
+		return x.StateTransitionsPerWorkflow
+	}
+	return 0
+}
+
+func (x *Config) GetStateTransitionCostSource() string {
+	if x != nil {
+		return x.StateTransitionCostSource
+	}
+	return ""
+}
+
+func (x *Config) GetFanIn() int64 {
+	if x != nil {
+		return x.FanIn
+	}
+	return 0
+}
+
+func (x *Config) GetStickyDisabled() bool {
+	if x != nil {
+		return x.StickyDisabled
+	}
+	return false
+}
+
+func (x *Config) GetHeartbeatDuration() string {
+	if x != nil {
+		return x.HeartbeatDuration
+	}
+	return ""
+}
+
+func (x *Config) GetHeartbeatInterval() string {
+	if x != nil {
+		return x.HeartbeatInterval
+	}
+	return ""
+}
+
+func (x *Config) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+func (x *Config) GetRetryMaxAttempts() int64 {
+	if x != nil {
+		return x.RetryMaxAttempts
+	}
+	return 0
+}
+
+func (x *Config) GetRetryInitialInterval() string {
+	if x != nil {
+		return x.RetryInitialInterval
+	}
+	return ""
+}
+
+func (x *Config) GetRetryBackoffCoefficient() float64 {
+	if x != nil {
+		return x.RetryBackoffCoefficient
+	}
+	return 0
+}
+
+func (x *Config) GetWorkerActivitiesPerSecond() float64 {
+	if x != nil {
+		return x.WorkerActivitiesPerSecond
+	}
+	return 0
+}
+
+func (x *Config) GetTaskQueueActivitiesPerSecond() float64 {
+	if x != nil {
+		return x.TaskQueueActivitiesPerSecond
+	}
+	return 0
+}
+
+func (x *Config) GetSearchAttributeUpserts() int64 {
+	if x != nil {
+		return x.SearchAttributeUpserts
+	}
+	return 0
+}
+
+func (x *Config) GetSideEffects() int64 {
+	if x != nil {
+		return x.SideEffects
+	}
+	return 0
+}
+
+func (x *Config) GetLongHistoryEvents() int64 {
+	if x != nil {
+		return x.LongHistoryEvents
+	}
+	return 0
+}
+
+func (x *Config) GetFanoutWidth() int64 {
+	if x != nil {
+		return x.FanoutWidth
+	}
+	return 0
+}
+
+func (x *Config) GetFanoutDepth() int64 {
+	if x != nil {
+		return x.FanoutDepth
+	}
+	return 0
+}
+
+func (x *Config) GetFanoutJoin() string {
+	if x != nil {
+		return x.FanoutJoin
+	}
+	return ""
+}
+
+func (x *Config) GetChildDepth() int64 {
+	if x != nil {
+		return x.ChildDepth
+	}
+	return 0
+}
+
+func (x *Config) GetCancelRate() float64 {
+	if x != nil {
+		return x.CancelRate
+	}
+	return 0
+}
+
+func (x *Config) GetCancelAfter() string {
+	if x != nil {
+		return x.CancelAfter
+	}
+	return ""
+}
+
+func (x *Config) GetMemoSizeBytes() int64 {
+	if x != nil {
+		return x.MemoSizeBytes
+	}
+	return 0
+}
+
+func (x *Config) GetHeaderSizeBytes() int64 {
+	if x != nil {
+		return x.HeaderSizeBytes
+	}
+	return 0
+}
+
+func (x *Config) GetWorkflowMix() map[string]int64 {
+	if x != nil {
+		return x.WorkflowMix
+	}
+	return nil
+}
+
+func (x *Config) GetActivityWork() string {
+	if x != nil {
+		return x.ActivityWork
+	}
+	return ""
+}
+
+func (x *Config) GetActivityCpuIterations() int64 {
+	if x != nil {
+		return x.ActivityCpuIterations
+	}
+	return 0
+}
+
+// Latency percentiles in milliseconds.
+type Latency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	P50         float64 `protobuf:"fixed64,1,opt,name=p50,proto3" json:"p50,omitempty"`
+	P95         float64 `protobuf:"fixed64,2,opt,name=p95,proto3" json:"p95,omitempty"`
+	P99         float64 `protobuf:"fixed64,3,opt,name=p99,proto3" json:"p99,omitempty"`
+	Max         float64 `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Approximate bool    `protobuf:"varint,5,opt,name=approximate,proto3" json:"approximate,omitempty"`
+}
+
+func (x *Latency) Reset() {
+	*x = Latency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Latency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Latency) ProtoMessage() {}
+
+func (x *Latency) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Latency.ProtoReflect.Descriptor instead.
+func (*Latency) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Latency) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *Latency) GetP95() float64 {
+	if x != nil {
+		return x.P95
+	}
+	return 0
+}
+
+func (x *Latency) GetP99() float64 {
+	if x != nil {
+		return x.P99
+	}
+	return 0
+}
+
+func (x *Latency) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *Latency) GetApproximate() bool {
+	if x != nil {
+		return x.Approximate
+	}
+	return false
+}
+
+type Metrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkflowsStarted            int64         `protobuf:"varint,1,opt,name=workflows_started,json=workflowsStarted,proto3" json:"workflows_started,omitempty"`
+	WorkflowsCompleted          int64         `protobuf:"varint,2,opt,name=workflows_completed,json=workflowsCompleted,proto3" json:"workflows_completed,omitempty"`
+	WorkflowsFailed             int64         `protobuf:"varint,3,opt,name=workflows_failed,json=workflowsFailed,proto3" json:"workflows_failed,omitempty"`
+	ActualRate                  float64       `protobuf:"fixed64,4,opt,name=actual_rate,json=actualRate,proto3" json:"actual_rate,omitempty"`
+	Latency                     *Latency      `protobuf:"bytes,5,opt,name=latency,proto3" json:"latency,omitempty"`
+	OccConflicts                *OCCConflicts `protobuf:"bytes,6,opt,name=occ_conflicts,json=occConflicts,proto3" json:"occ_conflicts,omitempty"`
+	AlreadyStarted              int64         `protobuf:"varint,7,opt,name=already_started,json=alreadyStarted,proto3" json:"already_started,omitempty"`
+	StateTransitionRate         float64       `protobuf:"fixed64,8,opt,name=state_transition_rate,json=stateTransitionRate,proto3" json:"state_transition_rate,omitempty"`
+	HistoryReads                *int64        `protobuf:"varint,9,opt,name=history_reads,json=historyReads,proto3,oneof" json:"history_reads,omitempty"`
+	HistoryReadsPerWorkflow     float64       `protobuf:"fixed64,10,opt,name=history_reads_per_workflow,json=historyReadsPerWorkflow,proto3" json:"history_reads_per_workflow,omitempty"`
+	FailedAttempts              *int64        `protobuf:"varint,11,opt,name=failed_attempts,json=failedAttempts,proto3,oneof" json:"failed_attempts,omitempty"`
+	FailedAttemptsPerWorkflow   float64       `protobuf:"fixed64,12,opt,name=failed_attempts_per_workflow,json=failedAttemptsPerWorkflow,proto3" json:"failed_attempts_per_workflow,omitempty"`
+	VisibilityWrites            *int64        `protobuf:"varint,13,opt,name=visibility_writes,json=visibilityWrites,proto3,oneof" json:"visibility_writes,omitempty"`
+	VisibilityWritesPerWorkflow float64       `protobuf:"fixed64,14,opt,name=visibility_writes_per_workflow,json=visibilityWritesPerWorkflow,proto3" json:"visibility_writes_per_workflow,omitempty"`
+	VisibilityWriteErrors       *int64        `protobuf:"varint,15,opt,name=visibility_write_errors,json=visibilityWriteErrors,proto3,oneof" json:"visibility_write_errors,omitempty"`
+	AvgHistoryLength            float64       `protobuf:"fixed64,16,opt,name=avg_history_length,json=avgHistoryLength,proto3" json:"avg_history_length,omitempty"`
+	LatencyFallbacks            *int64        `protobuf:"varint,17,opt,name=latency_fallbacks,json=latencyFallbacks,proto3,oneof" json:"latency_fallbacks,omitempty"`
+}
+
+func (x *Metrics) Reset() {
+	*x = Metrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metrics) ProtoMessage() {}
+
+func (x *Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Metrics) GetWorkflowsStarted() int64 {
+	if x != nil {
+		return x.WorkflowsStarted
+	}
+	return 0
+}
+
+func (x *Metrics) GetWorkflowsCompleted() int64 {
+	if x != nil {
+		return x.WorkflowsCompleted
+	}
+	return 0
+}
+
+func (x *Metrics) GetWorkflowsFailed() int64 {
+	if x != nil {
+		return x.WorkflowsFailed
+	}
+	return 0
+}
+
+func (x *Metrics) GetActualRate() float64 {
+	if x != nil {
+		return x.ActualRate
+	}
+	return 0
+}
+
+func (x *Metrics) GetLatency() *Latency {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *Metrics) GetOccConflicts() *OCCConflicts {
+	if x != nil {
+		return x.OccConflicts
+	}
+	return nil
+}
+
+func (x *Metrics) GetAlreadyStarted() int64 {
+	if x != nil {
+		return x.AlreadyStarted
+	}
+	return 0
+}
+
+func (x *Metrics) GetStateTransitionRate() float64 {
+	if x != nil {
+		return x.StateTransitionRate
+	}
+	return 0
+}
+
+func (x *Metrics) GetHistoryReads() int64 {
+	if x != nil && x.HistoryReads != nil {
+		return *x.HistoryReads
+	}
+	return 0
+}
+
+func (x *Metrics) GetHistoryReadsPerWorkflow() float64 {
+	if x != nil {
+		return x.HistoryReadsPerWorkflow
+	}
+	return 0
+}
+
+func (x *Metrics) GetFailedAttempts() int64 {
+	if x != nil && x.FailedAttempts != nil {
+		return *x.FailedAttempts
+	}
+	return 0
+}
+
+func (x *Metrics) GetFailedAttemptsPerWorkflow() float64 {
+	if x != nil {
+		return x.FailedAttemptsPerWorkflow
+	}
+	return 0
+}
+
+func (x *Metrics) GetVisibilityWrites() int64 {
+	if x != nil && x.VisibilityWrites != nil {
+		return *x.VisibilityWrites
+	}
+	return 0
+}
+
+func (x *Metrics) GetVisibilityWritesPerWorkflow() float64 {
+	if x != nil {
+		return x.VisibilityWritesPerWorkflow
+	}
+	return 0
+}
+
+func (x *Metrics) GetVisibilityWriteErrors() int64 {
+	if x != nil && x.VisibilityWriteErrors != nil {
+		return *x.VisibilityWriteErrors
+	}
+	return 0
+}
+
+func (x *Metrics) GetAvgHistoryLength() float64 {
+	if x != nil {
+		return x.AvgHistoryLength
+	}
+	return 0
+}
+
+func (x *Metrics) GetLatencyFallbacks() int64 {
+	if x != nil && x.LatencyFallbacks != nil {
+		return *x.LatencyFallbacks
+	}
+	return 0
+}
+
+type OCCConflicts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count           int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Rate            float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	ClientErrors    int64   `protobuf:"varint,3,opt,name=client_errors,json=clientErrors,proto3" json:"client_errors,omitempty"`
+	ServerConflicts *int64  `protobuf:"varint,4,opt,name=server_conflicts,json=serverConflicts,proto3,oneof" json:"server_conflicts,omitempty"`
+}
+
+func (x *OCCConflicts) Reset() {
+	*x = OCCConflicts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OCCConflicts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OCCConflicts) ProtoMessage() {}
+
+func (x *OCCConflicts) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OCCConflicts.ProtoReflect.Descriptor instead.
+func (*OCCConflicts) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OCCConflicts) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *OCCConflicts) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *OCCConflicts) GetClientErrors() int64 {
+	if x != nil {
+		return x.ClientErrors
+	}
+	return 0
+}
+
+func (x *OCCConflicts) GetServerConflicts() int64 {
+	if x != nil && x.ServerConflicts != nil {
+		return *x.ServerConflicts
+	}
+	return 0
+}
+
+type System struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceType  string           `protobuf:"bytes,1,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	HistoryShards int64            `protobuf:"varint,2,opt,name=history_shards,json=historyShards,proto3" json:"history_shards,omitempty"`
+	Services      map[string]int64 `protobuf:"bytes,3,rep,name=services,proto3" json:"services,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	WorkflowCache *WorkflowCache   `protobuf:"bytes,4,opt,name=workflow_cache,json=workflowCache,proto3" json:"workflow_cache,omitempty"`
+}
+
+func (x *System) Reset() {
+	*x = System{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *System) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*System) ProtoMessage() {}
+
+func (x *System) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use System.ProtoReflect.Descriptor instead.
+func (*System) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *System) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *System) GetHistoryShards() int64 {
+	if x != nil {
+		return x.HistoryShards
+	}
+	return 0
+}
+
+func (x *System) GetServices() map[string]int64 {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *System) GetWorkflowCache() *WorkflowCache {
+	if x != nil {
+		return x.WorkflowCache
+	}
+	return nil
+}
+
+type WorkflowCache struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size            int64  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Hits            *int64 `protobuf:"varint,2,opt,name=hits,proto3,oneof" json:"hits,omitempty"`
+	Misses          *int64 `protobuf:"varint,3,opt,name=misses,proto3,oneof" json:"misses,omitempty"`
+	ForcedEvictions *int64 `protobuf:"varint,4,opt,name=forced_evictions,json=forcedEvictions,proto3,oneof" json:"forced_evictions,omitempty"`
+}
+
+func (x *WorkflowCache) Reset() {
+	*x = WorkflowCache{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkflowCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowCache) ProtoMessage() {}
+
+func (x *WorkflowCache) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowCache.ProtoReflect.Descriptor instead.
+func (*WorkflowCache) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WorkflowCache) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *WorkflowCache) GetHits() int64 {
+	if x != nil && x.Hits != nil {
+		return *x.Hits
+	}
+	return 0
+}
+
+func (x *WorkflowCache) GetMisses() int64 {
+	if x != nil && x.Misses != nil {
+		return *x.Misses
+	}
+	return 0
+}
+
+func (x *WorkflowCache) GetForcedEvictions() int64 {
+	if x != nil && x.ForcedEvictions != nil {
+		return *x.ForcedEvictions
+	}
+	return 0
+}
+
+type Thresholds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile            string              `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	MaxP99LatencyMs    float64             `protobuf:"fixed64,2,opt,name=max_p99_latency_ms,json=maxP99LatencyMs,proto3" json:"max_p99_latency_ms,omitempty"`
+	MinThroughput      float64             `protobuf:"fixed64,3,opt,name=min_throughput,json=minThroughput,proto3" json:"min_throughput,omitempty"`
+	Persistence        []string            `protobuf:"bytes,4,rep,name=persistence,proto3" json:"persistence,omitempty"`
+	MaxDescribeP99Ms   float64             `protobuf:"fixed64,5,opt,name=max_describe_p99_ms,json=maxDescribeP99Ms,proto3" json:"max_describe_p99_ms,omitempty"`
+	MaxGetHistoryP99Ms float64             `protobuf:"fixed64,6,opt,name=max_get_history_p99_ms,json=maxGetHistoryP99Ms,proto3" json:"max_get_history_p99_ms,omitempty"`
+	Baseline           *BaselineThresholds `protobuf:"bytes,7,opt,name=baseline,proto3" json:"baseline,omitempty"`
+}
+
+func (x *Thresholds) Reset() {
+	*x = Thresholds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Thresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thresholds) ProtoMessage() {}
+
+func (x *Thresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thresholds.ProtoReflect.Descriptor instead.
+func (*Thresholds) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Thresholds) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *Thresholds) GetMaxP99LatencyMs() float64 {
+	if x != nil {
+		return x.MaxP99LatencyMs
+	}
+	return 0
+}
+
+func (x *Thresholds) GetMinThroughput() float64 {
+	if x != nil {
+		return x.MinThroughput
+	}
+	return 0
+}
+
+func (x *Thresholds) GetPersistence() []string {
+	if x != nil {
+		return x.Persistence
+	}
+	return nil
+}
+
+func (x *Thresholds) GetMaxDescribeP99Ms() float64 {
+	if x != nil {
+		return x.MaxDescribeP99Ms
+	}
+	return 0
+}
+
+func (x *Thresholds) GetMaxGetHistoryP99Ms() float64 {
+	if x != nil {
+		return x.MaxGetHistoryP99Ms
+	}
+	return 0
+}
+
+func (x *Thresholds) GetBaseline() *BaselineThresholds {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+type BaselineThresholds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	MaxP99Percent        float64                `protobuf:"fixed64,2,opt,name=max_p99_percent,json=maxP99Percent,proto3" json:"max_p99_percent,omitempty"`
+	MaxP99LatencyMs      float64                `protobuf:"fixed64,3,opt,name=max_p99_latency_ms,json=maxP99LatencyMs,proto3" json:"max_p99_latency_ms,omitempty"`
+	MinThroughputPercent float64                `protobuf:"fixed64,4,opt,name=min_throughput_percent,json=minThroughputPercent,proto3" json:"min_throughput_percent,omitempty"`
+	MinThroughput        float64                `protobuf:"fixed64,5,opt,name=min_throughput,json=minThroughput,proto3" json:"min_throughput,omitempty"`
+}
+
+func (x *BaselineThresholds) Reset() {
+	*x = BaselineThresholds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaselineThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaselineThresholds) ProtoMessage() {}
+
+func (x *BaselineThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaselineThresholds.ProtoReflect.Descriptor instead.
+func (*BaselineThresholds) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BaselineThresholds) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BaselineThresholds) GetMaxP99Percent() float64 {
+	if x != nil {
+		return x.MaxP99Percent
+	}
+	return 0
+}
+
+func (x *BaselineThresholds) GetMaxP99LatencyMs() float64 {
+	if x != nil {
+		return x.MaxP99LatencyMs
+	}
+	return 0
+}
+
+func (x *BaselineThresholds) GetMinThroughputPercent() float64 {
+	if x != nil {
+		return x.MinThroughputPercent
+	}
+	return 0
+}
+
+func (x *BaselineThresholds) GetMinThroughput() float64 {
+	if x != nil {
+		return x.MinThroughput
+	}
+	return 0
+}
+
+type WorkflowIDRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Count  int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *WorkflowIDRange) Reset() {
+	*x = WorkflowIDRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkflowIDRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowIDRange) ProtoMessage() {}
+
+func (x *WorkflowIDRange) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowIDRange.ProtoReflect.Descriptor instead.
+func (*WorkflowIDRange) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkflowIDRange) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *WorkflowIDRange) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Run struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	WorkflowIds []*WorkflowIDRange     `protobuf:"bytes,3,rep,name=workflow_ids,json=workflowIds,proto3" json:"workflow_ids,omitempty"`
+}
+
+func (x *Run) Reset() {
+	*x = Run{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Run) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Run) ProtoMessage() {}
+
+func (x *Run) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Run.ProtoReflect.Descriptor instead.
+func (*Run) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Run) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Run) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Run) GetWorkflowIds() []*WorkflowIDRange {
+	if x != nil {
+		return x.WorkflowIds
+	}
+	return nil
+}
+
+type Phase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	WorkflowType       string                 `protobuf:"bytes,2,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TargetRate         float64                `protobuf:"fixed64,3,opt,name=target_rate,json=targetRate,proto3" json:"target_rate,omitempty"`
+	StartTime          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	WorkflowsStarted   int64                  `protobuf:"varint,6,opt,name=workflows_started,json=workflowsStarted,proto3" json:"workflows_started,omitempty"`
+	WorkflowsCompleted int64                  `protobuf:"varint,7,opt,name=workflows_completed,json=workflowsCompleted,proto3" json:"workflows_completed,omitempty"`
+	WorkflowsFailed    int64                  `protobuf:"varint,8,opt,name=workflows_failed,json=workflowsFailed,proto3" json:"workflows_failed,omitempty"`
+	ActualRate         float64                `protobuf:"fixed64,9,opt,name=actual_rate,json=actualRate,proto3" json:"actual_rate,omitempty"`
+	Latency            *Latency               `protobuf:"bytes,10,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *Phase) Reset() {
+	*x = Phase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Phase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Phase) ProtoMessage() {}
+
+func (x *Phase) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Phase.ProtoReflect.Descriptor instead.
+func (*Phase) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Phase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Phase) GetWorkflowType() string {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return ""
+}
+
+func (x *Phase) GetTargetRate() float64 {
+	if x != nil {
+		return x.TargetRate
+	}
+	return 0
+}
+
+func (x *Phase) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Phase) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Phase) GetWorkflowsStarted() int64 {
+	if x != nil {
+		return x.WorkflowsStarted
+	}
+	return 0
+}
+
+func (x *Phase) GetWorkflowsCompleted() int64 {
+	if x != nil {
+		return x.WorkflowsCompleted
+	}
+	return 0
+}
+
+func (x *Phase) GetWorkflowsFailed() int64 {
+	if x != nil {
+		return x.WorkflowsFailed
+	}
+	return 0
+}
+
+func (x *Phase) GetActualRate() float64 {
+	if x != nil {
+		return x.ActualRate
+	}
+	return 0
+}
+
+func (x *Phase) GetLatency() *Latency {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+type BackpressureInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PeakUtilization float64                `protobuf:"fixed64,3,opt,name=peak_utilization,json=peakUtilization,proto3" json:"peak_utilization,omitempty"`
+	Held            bool                   `protobuf:"varint,4,opt,name=held,proto3" json:"held,omitempty"`
+}
+
+func (x *BackpressureInterval) Reset() {
+	*x = BackpressureInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackpressureInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackpressureInterval) ProtoMessage() {}
+
+func (x *BackpressureInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackpressureInterval.ProtoReflect.Descriptor instead.
+func (*BackpressureInterval) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BackpressureInterval) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *BackpressureInterval) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *BackpressureInterval) GetPeakUtilization() float64 {
+	if x != nil {
+		return x.PeakUtilization
+	}
+	return 0
+}
+
+func (x *BackpressureInterval) GetHeld() bool {
+	if x != nil {
+		return x.Held
+	}
+	return false
+}
+
+type BurnRateAlert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PeakBurnRate float64                `protobuf:"fixed64,3,opt,name=peak_burn_rate,json=peakBurnRate,proto3" json:"peak_burn_rate,omitempty"`
+}
+
+func (x *BurnRateAlert) Reset() {
+	*x = BurnRateAlert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnRateAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnRateAlert) ProtoMessage() {}
+
+func (x *BurnRateAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BurnRateAlert.ProtoReflect.Descriptor instead.
+func (*BurnRateAlert) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BurnRateAlert) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *BurnRateAlert) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *BurnRateAlert) GetPeakBurnRate() float64 {
+	if x != nil {
+		return x.PeakBurnRate
+	}
+	return 0
+}
+
+type ScalingEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Offset     string                 `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	FromCount  int64                  `protobuf:"varint,3,opt,name=from_count,json=fromCount,proto3" json:"from_count,omitempty"`
+	ToCount    int64                  `protobuf:"varint,4,opt,name=to_count,json=toCount,proto3" json:"to_count,omitempty"`
+	ReadyAfter string                 `protobuf:"bytes,5,opt,name=ready_after,json=readyAfter,proto3" json:"ready_after,omitempty"`
+	Error      string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ScalingEvent) Reset() {
+	*x = ScalingEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScalingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingEvent) ProtoMessage() {}
+
+func (x *ScalingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingEvent.ProtoReflect.Descriptor instead.
+func (*ScalingEvent) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScalingEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ScalingEvent) GetOffset() string {
+	if x != nil {
+		return x.Offset
+	}
+	return ""
+}
+
+func (x *ScalingEvent) GetFromCount() int64 {
+	if x != nil {
+		return x.FromCount
+	}
+	return 0
+}
+
+func (x *ScalingEvent) GetToCount() int64 {
+	if x != nil {
+		return x.ToCount
+	}
+	return 0
+}
+
+func (x *ScalingEvent) GetReadyAfter() string {
+	if x != nil {
+		return x.ReadyAfter
+	}
+	return ""
+}
+
+func (x *ScalingEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ShardRestart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Offset         string                 `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Tasks          []string               `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Error          string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	BaselineP99Ms  float64                `protobuf:"fixed64,5,opt,name=baseline_p99_ms,json=baselineP99Ms,proto3" json:"baseline_p99_ms,omitempty"`
+	PeakP99Ms      float64                `protobuf:"fixed64,6,opt,name=peak_p99_ms,json=peakP99Ms,proto3" json:"peak_p99_ms,omitempty"`
+	SpikeSeconds   float64                `protobuf:"fixed64,7,opt,name=spike_seconds,json=spikeSeconds,proto3" json:"spike_seconds,omitempty"`
+	Recovered      bool                   `protobuf:"varint,8,opt,name=recovered,proto3" json:"recovered,omitempty"`
+	FailedRequests int64                  `protobuf:"varint,9,opt,name=failed_requests,json=failedRequests,proto3" json:"failed_requests,omitempty"`
+}
+
+func (x *ShardRestart) Reset() {
+	*x = ShardRestart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShardRestart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardRestart) ProtoMessage() {}
+
+func (x *ShardRestart) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardRestart.ProtoReflect.Descriptor instead.
+func (*ShardRestart) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ShardRestart) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ShardRestart) GetOffset() string {
+	if x != nil {
+		return x.Offset
+	}
+	return ""
+}
+
+func (x *ShardRestart) GetTasks() []string {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ShardRestart) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ShardRestart) GetBaselineP99Ms() float64 {
+	if x != nil {
+		return x.BaselineP99Ms
+	}
+	return 0
+}
+
+func (x *ShardRestart) GetPeakP99Ms() float64 {
+	if x != nil {
+		return x.PeakP99Ms
+	}
+	return 0
+}
+
+func (x *ShardRestart) GetSpikeSeconds() float64 {
+	if x != nil {
+		return x.SpikeSeconds
+	}
+	return 0
+}
+
+func (x *ShardRestart) GetRecovered() bool {
+	if x != nil {
+		return x.Recovered
+	}
+	return false
+}
+
+func (x *ShardRestart) GetFailedRequests() int64 {
+	if x != nil {
+		return x.FailedRequests
+	}
+	return 0
+}
+
+type StuckWorkflows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Threshold  string   `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Checked    int64    `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	Count      int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	SampleIds  []string `protobuf:"bytes,4,rep,name=sample_ids,json=sampleIds,proto3" json:"sample_ids,omitempty"`
+	Terminated int64    `protobuf:"varint,5,opt,name=terminated,proto3" json:"terminated,omitempty"`
+}
+
+func (x *StuckWorkflows) Reset() {
+	*x = StuckWorkflows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StuckWorkflows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StuckWorkflows) ProtoMessage() {}
+
+func (x *StuckWorkflows) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StuckWorkflows.ProtoReflect.Descriptor instead.
+func (*StuckWorkflows) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StuckWorkflows) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *StuckWorkflows) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *StuckWorkflows) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StuckWorkflows) GetSampleIds() []string {
+	if x != nil {
+		return x.SampleIds
+	}
+	return nil
+}
+
+func (x *StuckWorkflows) GetTerminated() int64 {
+	if x != nil {
+		return x.Terminated
+	}
+	return 0
+}
+
+type OutcomeVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SampleRate float64  `protobuf:"fixed64,1,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	Checked    int64    `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	Mismatches int64    `protobuf:"varint,3,opt,name=mismatches,proto3" json:"mismatches,omitempty"`
+	SampleIds  []string `protobuf:"bytes,4,rep,name=sample_ids,json=sampleIds,proto3" json:"sample_ids,omitempty"`
+}
+
+func (x *OutcomeVerification) Reset() {
+	*x = OutcomeVerification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutcomeVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutcomeVerification) ProtoMessage() {}
+
+func (x *OutcomeVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutcomeVerification.ProtoReflect.Descriptor instead.
+func (*OutcomeVerification) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OutcomeVerification) GetSampleRate() float64 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *OutcomeVerification) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *OutcomeVerification) GetMismatches() int64 {
+	if x != nil {
+		return x.Mismatches
+	}
+	return 0
+}
+
+func (x *OutcomeVerification) GetSampleIds() []string {
+	if x != nil {
+		return x.SampleIds
+	}
+	return nil
+}
+
+type HistoryIntegrity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checked    int64                 `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Events     int64                 `protobuf:"varint,2,opt,name=events,proto3" json:"events,omitempty"`
+	Unreadable int64                 `protobuf:"varint,3,opt,name=unreadable,proto3" json:"unreadable,omitempty"`
+	Violated   int64                 `protobuf:"varint,4,opt,name=violated,proto3" json:"violated,omitempty"`
+	Violations []*IntegrityViolation `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *HistoryIntegrity) Reset() {
+	*x = HistoryIntegrity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryIntegrity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryIntegrity) ProtoMessage() {}
+
+func (x *HistoryIntegrity) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryIntegrity.ProtoReflect.Descriptor instead.
+func (*HistoryIntegrity) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HistoryIntegrity) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *HistoryIntegrity) GetEvents() int64 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *HistoryIntegrity) GetUnreadable() int64 {
+	if x != nil {
+		return x.Unreadable
+	}
+	return 0
+}
+
+func (x *HistoryIntegrity) GetViolated() int64 {
+	if x != nil {
+		return x.Violated
+	}
+	return 0
+}
+
+func (x *HistoryIntegrity) GetViolations() []*IntegrityViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type IntegrityViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkflowId string `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *IntegrityViolation) Reset() {
+	*x = IntegrityViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntegrityViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrityViolation) ProtoMessage() {}
+
+func (x *IntegrityViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrityViolation.ProtoReflect.Descriptor instead.
+func (*IntegrityViolation) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *IntegrityViolation) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *IntegrityViolation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DuplicateExecutions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Activities           int64   `protobuf:"varint,1,opt,name=activities,proto3" json:"activities,omitempty"`
+	Workflows            int64   `protobuf:"varint,2,opt,name=workflows,proto3" json:"workflows,omitempty"`
+	DuplicateActivities  int64   `protobuf:"varint,3,opt,name=duplicate_activities,json=duplicateActivities,proto3" json:"duplicate_activities,omitempty"`
+	DuplicateWorkflows   int64   `protobuf:"varint,4,opt,name=duplicate_workflows,json=duplicateWorkflows,proto3" json:"duplicate_workflows,omitempty"`
+	ActivitiesPerMillion float64 `protobuf:"fixed64,5,opt,name=activities_per_million,json=activitiesPerMillion,proto3" json:"activities_per_million,omitempty"`
+	WorkflowsPerMillion  float64 `protobuf:"fixed64,6,opt,name=workflows_per_million,json=workflowsPerMillion,proto3" json:"workflows_per_million,omitempty"`
+}
+
+func (x *DuplicateExecutions) Reset() {
+	*x = DuplicateExecutions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateExecutions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateExecutions) ProtoMessage() {}
+
+func (x *DuplicateExecutions) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateExecutions.ProtoReflect.Descriptor instead.
+func (*DuplicateExecutions) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DuplicateExecutions) GetActivities() int64 {
+	if x != nil {
+		return x.Activities
+	}
+	return 0
+}
+
+func (x *DuplicateExecutions) GetWorkflows() int64 {
+	if x != nil {
+		return x.Workflows
+	}
+	return 0
+}
+
+func (x *DuplicateExecutions) GetDuplicateActivities() int64 {
+	if x != nil {
+		return x.DuplicateActivities
+	}
+	return 0
+}
+
+func (x *DuplicateExecutions) GetDuplicateWorkflows() int64 {
+	if x != nil {
+		return x.DuplicateWorkflows
+	}
+	return 0
+}
+
+func (x *DuplicateExecutions) GetActivitiesPerMillion() float64 {
+	if x != nil {
+		return x.ActivitiesPerMillion
+	}
+	return 0
+}
+
+func (x *DuplicateExecutions) GetWorkflowsPerMillion() float64 {
+	if x != nil {
+		return x.WorkflowsPerMillion
+	}
+	return 0
+}
+
+type Cancellation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requested             int64    `protobuf:"varint,1,opt,name=requested,proto3" json:"requested,omitempty"`
+	Canceled              int64    `protobuf:"varint,2,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	Completed             int64    `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	CancelToClosedLatency *Latency `protobuf:"bytes,4,opt,name=cancel_to_closed_latency,json=cancelToClosedLatency,proto3" json:"cancel_to_closed_latency,omitempty"`
+}
+
+func (x *Cancellation) Reset() {
+	*x = Cancellation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cancellation) ProtoMessage() {}
+
+func (x *Cancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cancellation.ProtoReflect.Descriptor instead.
+func (*Cancellation) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Cancellation) GetRequested() int64 {
+	if x != nil {
+		return x.Requested
+	}
+	return 0
+}
+
+func (x *Cancellation) GetCanceled() int64 {
+	if x != nil {
+		return x.Canceled
+	}
+	return 0
+}
+
+func (x *Cancellation) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *Cancellation) GetCancelToClosedLatency() *Latency {
+	if x != nil {
+		return x.CancelToClosedLatency
+	}
+	return nil
+}
+
+type DrainStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome         string  `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Adaptive        bool    `protobuf:"varint,2,opt,name=adaptive,proto3" json:"adaptive,omitempty"`
+	Duration        string  `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Deadline        string  `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	InFlightAtStart int64   `protobuf:"varint,5,opt,name=in_flight_at_start,json=inFlightAtStart,proto3" json:"in_flight_at_start,omitempty"`
+	Completed       int64   `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed          int64   `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	Remaining       int64   `protobuf:"varint,8,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	CompletionRate  float64 `protobuf:"fixed64,9,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`
+}
+
+func (x *DrainStats) Reset() {
+	*x = DrainStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainStats) ProtoMessage() {}
+
+func (x *DrainStats) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainStats.ProtoReflect.Descriptor instead.
+func (*DrainStats) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DrainStats) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *DrainStats) GetAdaptive() bool {
+	if x != nil {
+		return x.Adaptive
+	}
+	return false
+}
+
+func (x *DrainStats) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *DrainStats) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *DrainStats) GetInFlightAtStart() int64 {
+	if x != nil {
+		return x.InFlightAtStart
+	}
+	return 0
+}
+
+func (x *DrainStats) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *DrainStats) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *DrainStats) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *DrainStats) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+type ClockSkew struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Samples       int64   `protobuf:"varint,1,opt,name=samples,proto3" json:"samples,omitempty"`
+	OffsetMs      float64 `protobuf:"fixed64,2,opt,name=offset_ms,json=offsetMs,proto3" json:"offset_ms,omitempty"`
+	UncertaintyMs float64 `protobuf:"fixed64,3,opt,name=uncertainty_ms,json=uncertaintyMs,proto3" json:"uncertainty_ms,omitempty"`
+}
+
+func (x *ClockSkew) Reset() {
+	*x = ClockSkew{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClockSkew) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockSkew) ProtoMessage() {}
+
+func (x *ClockSkew) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockSkew.ProtoReflect.Descriptor instead.
+func (*ClockSkew) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ClockSkew) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *ClockSkew) GetOffsetMs() float64 {
+	if x != nil {
+		return x.OffsetMs
+	}
+	return 0
+}
+
+func (x *ClockSkew) GetUncertaintyMs() float64 {
+	if x != nil {
+		return x.UncertaintyMs
+	}
+	return 0
+}
+
+type PersistenceLatency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service    string  `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Operation  string  `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Percentile float64 `protobuf:"fixed64,3,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	LatencyMs  float64 `protobuf:"fixed64,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+}
+
+func (x *PersistenceLatency) Reset() {
+	*x = PersistenceLatency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistenceLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistenceLatency) ProtoMessage() {}
+
+func (x *PersistenceLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistenceLatency.ProtoReflect.Descriptor instead.
+func (*PersistenceLatency) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PersistenceLatency) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *PersistenceLatency) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PersistenceLatency) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *PersistenceLatency) GetLatencyMs() float64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+type PersistenceCeiling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Store      string             `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Qps        float64            `protobuf:"fixed64,2,opt,name=qps,proto3" json:"qps,omitempty"`
+	TargetRate float64            `protobuf:"fixed64,3,opt,name=target_rate,json=targetRate,proto3" json:"target_rate,omitempty"`
+	Reached    bool               `protobuf:"varint,4,opt,name=reached,proto3" json:"reached,omitempty"`
+	LimitedBy  string             `protobuf:"bytes,5,opt,name=limited_by,json=limitedBy,proto3" json:"limited_by,omitempty"`
+	Steps      []*PersistenceStep `protobuf:"bytes,6,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *PersistenceCeiling) Reset() {
+	*x = PersistenceCeiling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistenceCeiling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistenceCeiling) ProtoMessage() {}
+
+func (x *PersistenceCeiling) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistenceCeiling.ProtoReflect.Descriptor instead.
+func (*PersistenceCeiling) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PersistenceCeiling) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *PersistenceCeiling) GetQps() float64 {
+	if x != nil {
+		return x.Qps
+	}
+	return 0
+}
+
+func (x *PersistenceCeiling) GetTargetRate() float64 {
+	if x != nil {
+		return x.TargetRate
+	}
+	return 0
+}
+
+func (x *PersistenceCeiling) GetReached() bool {
+	if x != nil {
+		return x.Reached
+	}
+	return false
+}
+
+func (x *PersistenceCeiling) GetLimitedBy() string {
+	if x != nil {
+		return x.LimitedBy
+	}
+	return ""
+}
+
+func (x *PersistenceCeiling) GetSteps() []*PersistenceStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type PersistenceStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetRate float64 `protobuf:"fixed64,1,opt,name=target_rate,json=targetRate,proto3" json:"target_rate,omitempty"`
+	Qps        float64 `protobuf:"fixed64,2,opt,name=qps,proto3" json:"qps,omitempty"`
+	ErrorRate  float64 `protobuf:"fixed64,3,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	P99Ms      float64 `protobuf:"fixed64,4,opt,name=p99_ms,json=p99Ms,proto3" json:"p99_ms,omitempty"`
+}
+
+func (x *PersistenceStep) Reset() {
+	*x = PersistenceStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersistenceStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistenceStep) ProtoMessage() {}
+
+func (x *PersistenceStep) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistenceStep.ProtoReflect.Descriptor instead.
+func (*PersistenceStep) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PersistenceStep) GetTargetRate() float64 {
+	if x != nil {
+		return x.TargetRate
+	}
+	return 0
+}
+
+func (x *PersistenceStep) GetQps() float64 {
+	if x != nil {
+		return x.Qps
+	}
+	return 0
+}
+
+func (x *PersistenceStep) GetErrorRate() float64 {
+	if x != nil {
+		return x.ErrorRate
+	}
+	return 0
+}
+
+func (x *PersistenceStep) GetP99Ms() float64 {
+	if x != nil {
+		return x.P99Ms
+	}
+	return 0
+}
+
+type ReadAPILatency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests int64   `protobuf:"varint,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	Errors   int64   `protobuf:"varint,2,opt,name=errors,proto3" json:"errors,omitempty"`
+	P50      float64 `protobuf:"fixed64,3,opt,name=p50,proto3" json:"p50,omitempty"`
+	P95      float64 `protobuf:"fixed64,4,opt,name=p95,proto3" json:"p95,omitempty"`
+	P99      float64 `protobuf:"fixed64,5,opt,name=p99,proto3" json:"p99,omitempty"`
+	Max      float64 `protobuf:"fixed64,6,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *ReadAPILatency) Reset() {
+	*x = ReadAPILatency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAPILatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAPILatency) ProtoMessage() {}
+
+func (x *ReadAPILatency) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAPILatency.ProtoReflect.Descriptor instead.
+func (*ReadAPILatency) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReadAPILatency) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ReadAPILatency) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *ReadAPILatency) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *ReadAPILatency) GetP95() float64 {
+	if x != nil {
+		return x.P95
+	}
+	return 0
+}
+
+func (x *ReadAPILatency) GetP99() float64 {
+	if x != nil {
+		return x.P99
+	}
+	return 0
+}
+
+func (x *ReadAPILatency) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type ReadLatency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Qps        float64         `protobuf:"fixed64,1,opt,name=qps,proto3" json:"qps,omitempty"`
+	Describe   *ReadAPILatency `protobuf:"bytes,2,opt,name=describe,proto3" json:"describe,omitempty"`
+	GetHistory *ReadAPILatency `protobuf:"bytes,3,opt,name=get_history,json=getHistory,proto3" json:"get_history,omitempty"`
+}
+
+func (x *ReadLatency) Reset() {
+	*x = ReadLatency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadLatency) ProtoMessage() {}
+
+func (x *ReadLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadLatency.ProtoReflect.Descriptor instead.
+func (*ReadLatency) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReadLatency) GetQps() float64 {
+	if x != nil {
+		return x.Qps
+	}
+	return 0
+}
+
+func (x *ReadLatency) GetDescribe() *ReadAPILatency {
+	if x != nil {
+		return x.Describe
+	}
+	return nil
+}
+
+func (x *ReadLatency) GetGetHistory() *ReadAPILatency {
+	if x != nil {
+		return x.GetHistory
+	}
+	return nil
+}
+
+type HistorySize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkflowType string  `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	Samples      int64   `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	AvgEvents    float64 `protobuf:"fixed64,3,opt,name=avg_events,json=avgEvents,proto3" json:"avg_events,omitempty"`
+	P50Events    float64 `protobuf:"fixed64,4,opt,name=p50_events,json=p50Events,proto3" json:"p50_events,omitempty"`
+	P95Events    float64 `protobuf:"fixed64,5,opt,name=p95_events,json=p95Events,proto3" json:"p95_events,omitempty"`
+	MaxEvents    float64 `protobuf:"fixed64,6,opt,name=max_events,json=maxEvents,proto3" json:"max_events,omitempty"`
+	AvgBytes     float64 `protobuf:"fixed64,7,opt,name=avg_bytes,json=avgBytes,proto3" json:"avg_bytes,omitempty"`
+	P50Bytes     float64 `protobuf:"fixed64,8,opt,name=p50_bytes,json=p50Bytes,proto3" json:"p50_bytes,omitempty"`
+	P95Bytes     float64 `protobuf:"fixed64,9,opt,name=p95_bytes,json=p95Bytes,proto3" json:"p95_bytes,omitempty"`
+	MaxBytes     float64 `protobuf:"fixed64,10,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+}
+
+func (x *HistorySize) Reset() {
+	*x = HistorySize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistorySize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistorySize) ProtoMessage() {}
+
+func (x *HistorySize) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistorySize.ProtoReflect.Descriptor instead.
+func (*HistorySize) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HistorySize) GetWorkflowType() string {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return ""
+}
+
+func (x *HistorySize) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *HistorySize) GetAvgEvents() float64 {
+	if x != nil {
+		return x.AvgEvents
+	}
+	return 0
+}
+
+func (x *HistorySize) GetP50Events() float64 {
+	if x != nil {
+		return x.P50Events
+	}
+	return 0
+}
+
+func (x *HistorySize) GetP95Events() float64 {
+	if x != nil {
+		return x.P95Events
+	}
+	return 0
+}
+
+func (x *HistorySize) GetMaxEvents() float64 {
+	if x != nil {
+		return x.MaxEvents
+	}
+	return 0
+}
+
+func (x *HistorySize) GetAvgBytes() float64 {
+	if x != nil {
+		return x.AvgBytes
+	}
+	return 0
+}
+
+func (x *HistorySize) GetP50Bytes() float64 {
+	if x != nil {
+		return x.P50Bytes
+	}
+	return 0
+}
+
+func (x *HistorySize) GetP95Bytes() float64 {
+	if x != nil {
+		return x.P95Bytes
+	}
+	return 0
+}
+
+func (x *HistorySize) GetMaxBytes() float64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+type HistogramLatency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	P50 float64 `protobuf:"fixed64,1,opt,name=p50,proto3" json:"p50,omitempty"`
+	P95 float64 `protobuf:"fixed64,2,opt,name=p95,proto3" json:"p95,omitempty"`
+	P99 float64 `protobuf:"fixed64,3,opt,name=p99,proto3" json:"p99,omitempty"`
+}
+
+func (x *HistogramLatency) Reset() {
+	*x = HistogramLatency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistogramLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistogramLatency) ProtoMessage() {}
+
+func (x *HistogramLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistogramLatency.ProtoReflect.Descriptor instead.
+func (*HistogramLatency) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HistogramLatency) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *HistogramLatency) GetP95() float64 {
+	if x != nil {
+		return x.P95
+	}
+	return 0
+}
+
+func (x *HistogramLatency) GetP99() float64 {
+	if x != nil {
+		return x.P99
+	}
+	return 0
+}
+
+type ActivityLatency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityType    string            `protobuf:"bytes,1,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	Executions      int64             `protobuf:"varint,2,opt,name=executions,proto3" json:"executions,omitempty"`
+	Execution       *HistogramLatency `protobuf:"bytes,3,opt,name=execution,proto3" json:"execution,omitempty"`
+	ScheduleToStart *HistogramLatency `protobuf:"bytes,4,opt,name=schedule_to_start,json=scheduleToStart,proto3" json:"schedule_to_start,omitempty"`
+}
+
+func (x *ActivityLatency) Reset() {
+	*x = ActivityLatency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityLatency) ProtoMessage() {}
+
+func (x *ActivityLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityLatency.ProtoReflect.Descriptor instead.
+func (*ActivityLatency) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ActivityLatency) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *ActivityLatency) GetExecutions() int64 {
+	if x != nil {
+		return x.Executions
+	}
+	return 0
+}
+
+func (x *ActivityLatency) GetExecution() *HistogramLatency {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *ActivityLatency) GetScheduleToStart() *HistogramLatency {
+	if x != nil {
+		return x.ScheduleToStart
+	}
+	return nil
+}
+
+type WorkflowTypeLatency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkflowType string   `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	Completed    int64    `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Failed       int64    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Latency      *Latency `protobuf:"bytes,4,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *WorkflowTypeLatency) Reset() {
+	*x = WorkflowTypeLatency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkflowTypeLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowTypeLatency) ProtoMessage() {}
+
+func (x *WorkflowTypeLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowTypeLatency.ProtoReflect.Descriptor instead.
+func (*WorkflowTypeLatency) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WorkflowTypeLatency) GetWorkflowType() string {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return ""
+}
+
+func (x *WorkflowTypeLatency) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *WorkflowTypeLatency) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *WorkflowTypeLatency) GetLatency() *Latency {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+type Topology struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientAz    string   `protobuf:"bytes,1,opt,name=client_az,json=clientAz,proto3" json:"client_az,omitempty"`
+	FrontendAzs []string `protobuf:"bytes,2,rep,name=frontend_azs,json=frontendAzs,proto3" json:"frontend_azs,omitempty"`
+	Placement   string   `protobuf:"bytes,3,opt,name=placement,proto3" json:"placement,omitempty"`
+}
+
+func (x *Topology) Reset() {
+	*x = Topology{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Topology) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topology) ProtoMessage() {}
+
+func (x *Topology) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topology.ProtoReflect.Descriptor instead.
+func (*Topology) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Topology) GetClientAz() string {
+	if x != nil {
+		return x.ClientAz
+	}
+	return ""
+}
+
+func (x *Topology) GetFrontendAzs() []string {
+	if x != nil {
+		return x.FrontendAzs
+	}
+	return nil
+}
+
+func (x *Topology) GetPlacement() string {
+	if x != nil {
+		return x.Placement
+	}
+	return ""
+}
+
+type Connection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preference    string `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	AddressFamily string `protobuf:"bytes,2,opt,name=address_family,json=addressFamily,proto3" json:"address_family,omitempty"`
+	Dials         int64  `protobuf:"varint,3,opt,name=dials,proto3" json:"dials,omitempty"`
+	Fallbacks     int64  `protobuf:"varint,4,opt,name=fallbacks,proto3" json:"fallbacks,omitempty"`
+}
+
+func (x *Connection) Reset() {
+	*x = Connection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connection) ProtoMessage() {}
+
+func (x *Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connection.ProtoReflect.Descriptor instead.
+func (*Connection) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Connection) GetPreference() string {
+	if x != nil {
+		return x.Preference
+	}
+	return ""
+}
+
+func (x *Connection) GetAddressFamily() string {
+	if x != nil {
+		return x.AddressFamily
+	}
+	return ""
+}
+
+func (x *Connection) GetDials() int64 {
+	if x != nil {
+		return x.Dials
+	}
+	return 0
+}
+
+func (x *Connection) GetFallbacks() int64 {
+	if x != nil {
+		return x.Fallbacks
+	}
+	return 0
+}
+
+// Values are the dynamic config file's lists of constrained values, as JSON.
+type DynamicConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Overrides map[string]string `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Services  []string          `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	Reverted  bool              `protobuf:"varint,3,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	Error     string            `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DynamicConfig) Reset() {
+	*x = DynamicConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfig) ProtoMessage() {}
+
+func (x *DynamicConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfig.ProtoReflect.Descriptor instead.
+func (*DynamicConfig) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DynamicConfig) GetOverrides() map[string]string {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *DynamicConfig) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *DynamicConfig) GetReverted() bool {
+	if x != nil {
+		return x.Reverted
+	}
+	return false
+}
+
+func (x *DynamicConfig) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ClusterSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time                *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Namespaces          int64                  `protobuf:"varint,2,opt,name=namespaces,proto3" json:"namespaces,omitempty"`
+	OpenWorkflows       int64                  `protobuf:"varint,3,opt,name=open_workflows,json=openWorkflows,proto3" json:"open_workflows,omitempty"`
+	ClosedWorkflows     int64                  `protobuf:"varint,4,opt,name=closed_workflows,json=closedWorkflows,proto3" json:"closed_workflows,omitempty"`
+	WorkflowTaskBacklog int64                  `protobuf:"varint,5,opt,name=workflow_task_backlog,json=workflowTaskBacklog,proto3" json:"workflow_task_backlog,omitempty"`
+	ActivityTaskBacklog int64                  `protobuf:"varint,6,opt,name=activity_task_backlog,json=activityTaskBacklog,proto3" json:"activity_task_backlog,omitempty"`
+	Incomplete          bool                   `protobuf:"varint,7,opt,name=incomplete,proto3" json:"incomplete,omitempty"`
+}
+
+func (x *ClusterSnapshot) Reset() {
+	*x = ClusterSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterSnapshot) ProtoMessage() {}
+
+func (x *ClusterSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterSnapshot.ProtoReflect.Descriptor instead.
+func (*ClusterSnapshot) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ClusterSnapshot) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetNamespaces() int64 {
+	if x != nil {
+		return x.Namespaces
+	}
+	return 0
+}
+
+func (x *ClusterSnapshot) GetOpenWorkflows() int64 {
+	if x != nil {
+		return x.OpenWorkflows
+	}
+	return 0
+}
+
+func (x *ClusterSnapshot) GetClosedWorkflows() int64 {
+	if x != nil {
+		return x.ClosedWorkflows
+	}
+	return 0
+}
+
+func (x *ClusterSnapshot) GetWorkflowTaskBacklog() int64 {
+	if x != nil {
+		return x.WorkflowTaskBacklog
+	}
+	return 0
+}
+
+func (x *ClusterSnapshot) GetActivityTaskBacklog() int64 {
+	if x != nil {
+		return x.ActivityTaskBacklog
+	}
+	return 0
+}
+
+func (x *ClusterSnapshot) GetIncomplete() bool {
+	if x != nil {
+		return x.Incomplete
+	}
+	return false
+}
+
+type ClusterSnapshotDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Namespaces          int64 `protobuf:"varint,1,opt,name=namespaces,proto3" json:"namespaces,omitempty"`
+	OpenWorkflows       int64 `protobuf:"varint,2,opt,name=open_workflows,json=openWorkflows,proto3" json:"open_workflows,omitempty"`
+	ClosedWorkflows     int64 `protobuf:"varint,3,opt,name=closed_workflows,json=closedWorkflows,proto3" json:"closed_workflows,omitempty"`
+	WorkflowTaskBacklog int64 `protobuf:"varint,4,opt,name=workflow_task_backlog,json=workflowTaskBacklog,proto3" json:"workflow_task_backlog,omitempty"`
+	ActivityTaskBacklog int64 `protobuf:"varint,5,opt,name=activity_task_backlog,json=activityTaskBacklog,proto3" json:"activity_task_backlog,omitempty"`
+}
+
+func (x *ClusterSnapshotDiff) Reset() {
+	*x = ClusterSnapshotDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterSnapshotDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterSnapshotDiff) ProtoMessage() {}
+
+func (x *ClusterSnapshotDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterSnapshotDiff.ProtoReflect.Descriptor instead.
+func (*ClusterSnapshotDiff) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ClusterSnapshotDiff) GetNamespaces() int64 {
+	if x != nil {
+		return x.Namespaces
+	}
+	return 0
+}
+
+func (x *ClusterSnapshotDiff) GetOpenWorkflows() int64 {
+	if x != nil {
+		return x.OpenWorkflows
+	}
+	return 0
+}
+
+func (x *ClusterSnapshotDiff) GetClosedWorkflows() int64 {
+	if x != nil {
+		return x.ClosedWorkflows
+	}
+	return 0
+}
+
+func (x *ClusterSnapshotDiff) GetWorkflowTaskBacklog() int64 {
+	if x != nil {
+		return x.WorkflowTaskBacklog
+	}
+	return 0
+}
+
+func (x *ClusterSnapshotDiff) GetActivityTaskBacklog() int64 {
+	if x != nil {
+		return x.ActivityTaskBacklog
+	}
+	return 0
+}
+
+type ClusterState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Before *ClusterSnapshot     `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After  *ClusterSnapshot     `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	Diff   *ClusterSnapshotDiff `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *ClusterState) Reset() {
+	*x = ClusterState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterState) ProtoMessage() {}
+
+func (x *ClusterState) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterState.ProtoReflect.Descriptor instead.
+func (*ClusterState) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ClusterState) GetBefore() *ClusterSnapshot {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ClusterState) GetAfter() *ClusterSnapshot {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ClusterState) GetDiff() *ClusterSnapshotDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type HeatmapWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Counts []int64                `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	Failed int64                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *HeatmapWindow) Reset() {
+	*x = HeatmapWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeatmapWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapWindow) ProtoMessage() {}
+
+func (x *HeatmapWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapWindow.ProtoReflect.Descriptor instead.
+func (*HeatmapWindow) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HeatmapWindow) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *HeatmapWindow) GetCounts() []int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *HeatmapWindow) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type LatencyHeatmap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WindowSeconds float64          `protobuf:"fixed64,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	BucketsMs     []float64        `protobuf:"fixed64,2,rep,packed,name=buckets_ms,json=bucketsMs,proto3" json:"buckets_ms,omitempty"`
+	Windows       []*HeatmapWindow `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *LatencyHeatmap) Reset() {
+	*x = LatencyHeatmap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatencyHeatmap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyHeatmap) ProtoMessage() {}
+
+func (x *LatencyHeatmap) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyHeatmap.ProtoReflect.Descriptor instead.
+func (*LatencyHeatmap) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LatencyHeatmap) GetWindowSeconds() float64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *LatencyHeatmap) GetBucketsMs() []float64 {
+	if x != nil {
+		return x.BucketsMs
+	}
+	return nil
+}
+
+func (x *LatencyHeatmap) GetWindows() []*HeatmapWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type GrafanaSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url          string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	DashboardUrl string `protobuf:"bytes,2,opt,name=dashboard_url,json=dashboardUrl,proto3" json:"dashboard_url,omitempty"`
+}
+
+func (x *GrafanaSnapshot) Reset() {
+	*x = GrafanaSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_results_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrafanaSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrafanaSnapshot) ProtoMessage() {}
+
+func (x *GrafanaSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_results_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrafanaSnapshot.ProtoReflect.Descriptor instead.
+func (*GrafanaSnapshot) Descriptor() ([]byte, []int) {
+	return file_results_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GrafanaSnapshot) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GrafanaSnapshot) GetDashboardUrl() string {
+	if x != nil {
+		return x.DashboardUrl
+	}
+	return ""
+}
+
+var File_results_proto protoreflect.FileDescriptor
+
+var file_results_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x1d, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xf8, 0x13, 0x0a, 0x0f, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x3c,
+	0x0a, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x52, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0c,
+	0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x52, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x61, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c,
+	0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x62, 0x0a, 0x13, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x12, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x4d, 0x0a,
+	0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62,
+	0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x0b, 0x72, 0x65, 0x61, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x4d, 0x0a, 0x0c,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x0b,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x73,
+	0x74, 0x75, 0x63, 0x6b, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x52, 0x0e, 0x73, 0x74, 0x75, 0x63, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x12, 0x3f, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x64,
+	0x72, 0x61, 0x69, 0x6e, 0x12, 0x47, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x6b,
+	0x65, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b,
+	0x65, 0x77, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x12, 0x50, 0x0a,
+	0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x3d, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x49,
+	0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x0a, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x03, 0x72, 0x75, 0x6e,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x12, 0x56, 0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x65, 0x61, 0x74,
+	0x6d, 0x61, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x59, 0x0a, 0x10, 0x67, 0x72, 0x61, 0x66,
+	0x61, 0x6e, 0x61, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x66, 0x61, 0x6e, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x0f, 0x67, 0x72, 0x61, 0x66, 0x61, 0x6e, 0x61, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69,
+	0x7a, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x65,
+	0x0a, 0x14, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x13, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a, 0x11, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x65, 0x0a, 0x14, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x13, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x56, 0x0a, 0x10, 0x62, 0x75,
+	0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x1a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x0e, 0x62, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x73, 0x12, 0x64, 0x0a, 0x14, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63,
+	0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x52, 0x13, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65,
+	0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x0e, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x1d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x0d,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x66, 0x0a,
+	0x15, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x43, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x52, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x49, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x0e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x64, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xae, 0x0e, 0x0a, 0x06, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x61, 0x6d, 0x70, 0x5f, 0x75,
+	0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x61, 0x6d, 0x70, 0x55, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x2b, 0x0a,
+	0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69,
+	0x63, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x49, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x1e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1b,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x50, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x3f, 0x0a, 0x1c, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x19, 0x73, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x66, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x61,
+	0x6e, 0x49, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79, 0x5f, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74,
+	0x69, 0x63, 0x6b, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x4d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f,
+	0x66, 0x66, 0x5f, 0x63, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x72, 0x65, 0x74, 0x72, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x6f,
+	0x66, 0x66, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a,
+	0x1c, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x19, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x46,
+	0x0a, 0x20, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1c, 0x74, 0x61, 0x73, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x69, 0x64, 0x65, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x6c, 0x6f, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x61, 0x6e, 0x6f, 0x75,
+	0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x61,
+	0x6e, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x6e,
+	0x6f, 0x75, 0x74, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x23, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x24, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x53, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x25, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x6d, 0x69, 0x78, 0x18, 0x26, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x78, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x57,
+	0x6f, 0x72, 0x6b, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f,
+	0x63, 0x70, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x70,
+	0x75, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a, 0x07, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x35, 0x30, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39, 0x35, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x39, 0x35, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39,
+	0x39, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x39, 0x39, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x22, 0xfc, 0x07, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x50, 0x0a, 0x0d, 0x6f, 0x63, 0x63, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x43, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x0c, 0x6f, 0x63,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x13, 0x73, 0x74, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x3b, 0x0a, 0x1a, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x61, 0x64, 0x73, 0x50, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x2c,
+	0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x1c,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x19, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x50, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x30, 0x0a,
+	0x11, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x10, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x43, 0x0a, 0x1e, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1b, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x12, 0x3b, 0x0a, 0x17, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x15, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x76, 0x67, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61,
+	0x76, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x30, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x10, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x42, 0x1a, 0x0a,
+	0x18, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22,
+	0xa2, 0x01, 0x0a, 0x0c, 0x4f, 0x43, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x2e, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x73, 0x22, 0xb7, 0x02, 0x0a, 0x06, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x4f, 0x0a, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61,
+	0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0e,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb2,
+	0x01, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
+	0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x45, 0x76,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68,
+	0x69, 0x74, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xce, 0x02, 0x0a, 0x0a, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x12,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x39, 0x39, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x39, 0x39,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e,
+	0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x5f, 0x70, 0x39, 0x39, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x10, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x39, 0x39, 0x4d,
+	0x73, 0x12, 0x32, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x39, 0x39, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x50, 0x39, 0x39, 0x4d, 0x73, 0x12, 0x4d, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x12, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x39, 0x39,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x50, 0x39, 0x39, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a,
+	0x12, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x39, 0x39, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x39,
+	0x39, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69,
+	0x6e, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x54,
+	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70,
+	0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x54, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x22, 0x3f, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x03, 0x52, 0x75, 0x6e,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x49, 0x44, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x49, 0x64, 0x73, 0x22, 0xbf, 0x03, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x75, 0x61,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x07,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xc7, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x63, 0x6b,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x65,
+	0x61, 0x6b, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x65, 0x6c,
+	0x64, 0x22, 0xa7, 0x01, 0x0a, 0x0d, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x62, 0x75,
+	0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70,
+	0x65, 0x61, 0x6b, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x0c,
+	0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb6, 0x02, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61,
+	0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x39, 0x39, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x39, 0x39,
+	0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x70, 0x39, 0x39, 0x5f, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x65, 0x61, 0x6b, 0x50, 0x39, 0x39,
+	0x4d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x70, 0x69, 0x6b, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x70, 0x69, 0x6b, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x9d,
+	0x01, 0x0a, 0x0e, 0x53, 0x74, 0x75, 0x63, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x22, 0x8f,
+	0x01, 0x0a, 0x13, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x64, 0x73,
+	0x22, 0xd3, 0x01, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74,
+	0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x69, 0x74, 0x79, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa1, 0x02, 0x0a, 0x13, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2f,
+	0x0a, 0x13, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12,
+	0x34, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x50,
+	0x65, 0x72, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x5f, 0x0a, 0x18, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x74, 0x6f, 0x5f,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x15, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0xa4, 0x02, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x2b, 0x0a, 0x12, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x61, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x41, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x22, 0x69, 0x0a, 0x09, 0x43, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x5f, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x75, 0x6e, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69,
+	0x6e, 0x74, 0x79, 0x4d, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4d, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x65, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x71, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71,
+	0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x44, 0x0a, 0x05,
+	0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0x7a, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x39, 0x39, 0x5f, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x39, 0x39, 0x4d, 0x73, 0x22, 0x8c,
+	0x01, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x35, 0x30, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39, 0x35, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x39, 0x35, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39, 0x39,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x39, 0x39, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xba, 0x01,
+	0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x71, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x70, 0x73, 0x12,
+	0x49, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x08, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x67, 0x65,
+	0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a,
+	0x67, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xbc, 0x02, 0x0a, 0x0b, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61,
+	0x76, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x35, 0x30, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x35,
+	0x30, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x39, 0x35, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x39, 0x35,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76, 0x67, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x35, 0x30, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x35, 0x30, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x39, 0x35, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x70, 0x39, 0x35, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x10, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x35, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x39, 0x35, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x39,
+	0x35, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39, 0x39, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x70, 0x39, 0x39, 0x22, 0x82, 0x02, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4d, 0x0a, 0x09,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x11, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x13, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72,
+	0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x68, 0x0a,
+	0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x41, 0x7a, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x64, 0x5f, 0x61, 0x7a, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x41, 0x7a, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x69,
+	0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x73, 0x22, 0xf6, 0x01, 0x0a, 0x0d, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x59, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3c, 0x0a, 0x0e,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbb, 0x02, 0x0a, 0x0f, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x61, 0x63,
+	0x6b, 0x6c, 0x6f, 0x67, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x61, 0x73,
+	0x6b, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x42,
+	0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54,
+	0x61, 0x73, 0x6b, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x22, 0xe4, 0x01, 0x0a, 0x0c, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b,
+	0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65,
+	0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x22, 0x71, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x09, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x4d, 0x73, 0x12, 0x46, 0x0a,
+	0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d,
+	0x61, 0x72, 0x6b, 0x2e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x48, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x66, 0x61, 0x6e, 0x61,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61,
+	0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x72, 0x6c, 0x42,
+	0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65,
+	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x69, 0x6f, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2d, 0x64, 0x73, 0x71, 0x6c, 0x2d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x2d, 0x65, 0x63,
+	0x73, 0x2f, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_results_proto_rawDescOnce sync.Once
+	file_results_proto_rawDescData = file_results_proto_rawDesc
+)
+
+func file_results_proto_rawDescGZIP() []byte {
+	file_results_proto_rawDescOnce.Do(func() {
+		file_results_proto_rawDescData = protoimpl.X.CompressGZIP(file_results_proto_rawDescData)
+	})
+	return file_results_proto_rawDescData
+}
+
+var file_results_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_results_proto_goTypes = []any{
+	(*BenchmarkResult)(nil),       // 0: temporal.benchmark.results.v1.BenchmarkResult
+	(*Config)(nil),                // 1: temporal.benchmark.results.v1.Config
+	(*Latency)(nil),               // 2: temporal.benchmark.results.v1.Latency
+	(*Metrics)(nil),               // 3: temporal.benchmark.results.v1.Metrics
+	(*OCCConflicts)(nil),          // 4: temporal.benchmark.results.v1.OCCConflicts
+	(*System)(nil),                // 5: temporal.benchmark.results.v1.System
+	(*WorkflowCache)(nil),         // 6: temporal.benchmark.results.v1.WorkflowCache
+	(*Thresholds)(nil),            // 7: temporal.benchmark.results.v1.Thresholds
+	(*BaselineThresholds)(nil),    // 8: temporal.benchmark.results.v1.BaselineThresholds
+	(*WorkflowIDRange)(nil),       // 9: temporal.benchmark.results.v1.WorkflowIDRange
+	(*Run)(nil),                   // 10: temporal.benchmark.results.v1.Run
+	(*Phase)(nil),                 // 11: temporal.benchmark.results.v1.Phase
+	(*BackpressureInterval)(nil),  // 12: temporal.benchmark.results.v1.BackpressureInterval
+	(*BurnRateAlert)(nil),         // 13: temporal.benchmark.results.v1.BurnRateAlert
+	(*ScalingEvent)(nil),          // 14: temporal.benchmark.results.v1.ScalingEvent
+	(*ShardRestart)(nil),          // 15: temporal.benchmark.results.v1.ShardRestart
+	(*StuckWorkflows)(nil),        // 16: temporal.benchmark.results.v1.StuckWorkflows
+	(*OutcomeVerification)(nil),   // 17: temporal.benchmark.results.v1.OutcomeVerification
+	(*HistoryIntegrity)(nil),      // 18: temporal.benchmark.results.v1.HistoryIntegrity
+	(*IntegrityViolation)(nil),    // 19: temporal.benchmark.results.v1.IntegrityViolation
+	(*DuplicateExecutions)(nil),   // 20: temporal.benchmark.results.v1.DuplicateExecutions
+	(*Cancellation)(nil),          // 21: temporal.benchmark.results.v1.Cancellation
+	(*DrainStats)(nil),            // 22: temporal.benchmark.results.v1.DrainStats
+	(*ClockSkew)(nil),             // 23: temporal.benchmark.results.v1.ClockSkew
+	(*PersistenceLatency)(nil),    // 24: temporal.benchmark.results.v1.PersistenceLatency
+	(*PersistenceCeiling)(nil),    // 25: temporal.benchmark.results.v1.PersistenceCeiling
+	(*PersistenceStep)(nil),       // 26: temporal.benchmark.results.v1.PersistenceStep
+	(*ReadAPILatency)(nil),        // 27: temporal.benchmark.results.v1.ReadAPILatency
+	(*ReadLatency)(nil),           // 28: temporal.benchmark.results.v1.ReadLatency
+	(*HistorySize)(nil),           // 29: temporal.benchmark.results.v1.HistorySize
+	(*HistogramLatency)(nil),      // 30: temporal.benchmark.results.v1.HistogramLatency
+	(*ActivityLatency)(nil),       // 31: temporal.benchmark.results.v1.ActivityLatency
+	(*WorkflowTypeLatency)(nil),   // 32: temporal.benchmark.results.v1.WorkflowTypeLatency
+	(*Topology)(nil),              // 33: temporal.benchmark.results.v1.Topology
+	(*Connection)(nil),            // 34: temporal.benchmark.results.v1.Connection
+	(*DynamicConfig)(nil),         // 35: temporal.benchmark.results.v1.DynamicConfig
+	(*ClusterSnapshot)(nil),       // 36: temporal.benchmark.results.v1.ClusterSnapshot
+	(*ClusterSnapshotDiff)(nil),   // 37: temporal.benchmark.results.v1.ClusterSnapshotDiff
+	(*ClusterState)(nil),          // 38: temporal.benchmark.results.v1.ClusterState
+	(*HeatmapWindow)(nil),         // 39: temporal.benchmark.results.v1.HeatmapWindow
+	(*LatencyHeatmap)(nil),        // 40: temporal.benchmark.results.v1.LatencyHeatmap
+	(*GrafanaSnapshot)(nil),       // 41: temporal.benchmark.results.v1.GrafanaSnapshot
+	nil,                           // 42: temporal.benchmark.results.v1.Config.WorkflowMixEntry
+	nil,                           // 43: temporal.benchmark.results.v1.System.ServicesEntry
+	nil,                           // 44: temporal.benchmark.results.v1.DynamicConfig.OverridesEntry
+	(*timestamppb.Timestamp)(nil), // 45: google.protobuf.Timestamp
+}
+var file_results_proto_depIdxs = []int32{
+	45, // 0: temporal.benchmark.results.v1.BenchmarkResult.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 1: temporal.benchmark.results.v1.BenchmarkResult.config:type_name -> temporal.benchmark.results.v1.Config
+	3,  // 2: temporal.benchmark.results.v1.BenchmarkResult.results:type_name -> temporal.benchmark.results.v1.Metrics
+	11, // 3: temporal.benchmark.results.v1.BenchmarkResult.phases:type_name -> temporal.benchmark.results.v1.Phase
+	12, // 4: temporal.benchmark.results.v1.BenchmarkResult.backpressure:type_name -> temporal.benchmark.results.v1.BackpressureInterval
+	14, // 5: temporal.benchmark.results.v1.BenchmarkResult.scaling_events:type_name -> temporal.benchmark.results.v1.ScalingEvent
+	24, // 6: temporal.benchmark.results.v1.BenchmarkResult.persistence_latency:type_name -> temporal.benchmark.results.v1.PersistenceLatency
+	28, // 7: temporal.benchmark.results.v1.BenchmarkResult.read_latency:type_name -> temporal.benchmark.results.v1.ReadLatency
+	29, // 8: temporal.benchmark.results.v1.BenchmarkResult.history_size:type_name -> temporal.benchmark.results.v1.HistorySize
+	16, // 9: temporal.benchmark.results.v1.BenchmarkResult.stuck_workflows:type_name -> temporal.benchmark.results.v1.StuckWorkflows
+	22, // 10: temporal.benchmark.results.v1.BenchmarkResult.drain:type_name -> temporal.benchmark.results.v1.DrainStats
+	23, // 11: temporal.benchmark.results.v1.BenchmarkResult.clock_skew:type_name -> temporal.benchmark.results.v1.ClockSkew
+	38, // 12: temporal.benchmark.results.v1.BenchmarkResult.cluster_state:type_name -> temporal.benchmark.results.v1.ClusterState
+	5,  // 13: temporal.benchmark.results.v1.BenchmarkResult.system:type_name -> temporal.benchmark.results.v1.System
+	7,  // 14: temporal.benchmark.results.v1.BenchmarkResult.thresholds:type_name -> temporal.benchmark.results.v1.Thresholds
+	10, // 15: temporal.benchmark.results.v1.BenchmarkResult.run:type_name -> temporal.benchmark.results.v1.Run
+	40, // 16: temporal.benchmark.results.v1.BenchmarkResult.latency_heatmap:type_name -> temporal.benchmark.results.v1.LatencyHeatmap
+	41, // 17: temporal.benchmark.results.v1.BenchmarkResult.grafana_snapshot:type_name -> temporal.benchmark.results.v1.GrafanaSnapshot
+	31, // 18: temporal.benchmark.results.v1.BenchmarkResult.activity_latency:type_name -> temporal.benchmark.results.v1.ActivityLatency
+	17, // 19: temporal.benchmark.results.v1.BenchmarkResult.outcome_verification:type_name -> temporal.benchmark.results.v1.OutcomeVerification
+	18, // 20: temporal.benchmark.results.v1.BenchmarkResult.history_integrity:type_name -> temporal.benchmark.results.v1.HistoryIntegrity
+	20, // 21: temporal.benchmark.results.v1.BenchmarkResult.duplicate_executions:type_name -> temporal.benchmark.results.v1.DuplicateExecutions
+	13, // 22: temporal.benchmark.results.v1.BenchmarkResult.burn_rate_alerts:type_name -> temporal.benchmark.results.v1.BurnRateAlert
+	25, // 23: temporal.benchmark.results.v1.BenchmarkResult.persistence_ceilings:type_name -> temporal.benchmark.results.v1.PersistenceCeiling
+	21, // 24: temporal.benchmark.results.v1.BenchmarkResult.cancellation:type_name -> temporal.benchmark.results.v1.Cancellation
+	15, // 25: temporal.benchmark.results.v1.BenchmarkResult.shard_restarts:type_name -> temporal.benchmark.results.v1.ShardRestart
+	32, // 26: temporal.benchmark.results.v1.BenchmarkResult.workflow_type_latency:type_name -> temporal.benchmark.results.v1.WorkflowTypeLatency
+	33, // 27: temporal.benchmark.results.v1.BenchmarkResult.topology:type_name -> temporal.benchmark.results.v1.Topology
+	34, // 28: temporal.benchmark.results.v1.BenchmarkResult.connection:type_name -> temporal.benchmark.results.v1.Connection
+	35, // 29: temporal.benchmark.results.v1.BenchmarkResult.dynamic_config:type_name -> temporal.benchmark.results.v1.DynamicConfig
+	42, // 30: temporal.benchmark.results.v1.Config.workflow_mix:type_name -> temporal.benchmark.results.v1.Config.WorkflowMixEntry
+	2,  // 31: temporal.benchmark.results.v1.Metrics.latency:type_name -> temporal.benchmark.results.v1.Latency
+	4,  // 32: temporal.benchmark.results.v1.Metrics.occ_conflicts:type_name -> temporal.benchmark.results.v1.OCCConflicts
+	43, // 33: temporal.benchmark.results.v1.System.services:type_name -> temporal.benchmark.results.v1.System.ServicesEntry
+	6,  // 34: temporal.benchmark.results.v1.System.workflow_cache:type_name -> temporal.benchmark.results.v1.WorkflowCache
+	8,  // 35: temporal.benchmark.results.v1.Thresholds.baseline:type_name -> temporal.benchmark.results.v1.BaselineThresholds
+	45, // 36: temporal.benchmark.results.v1.BaselineThresholds.timestamp:type_name -> google.protobuf.Timestamp
+	45, // 37: temporal.benchmark.results.v1.Run.start_time:type_name -> google.protobuf.Timestamp
+	45, // 38: temporal.benchmark.results.v1.Run.end_time:type_name -> google.protobuf.Timestamp
+	9,  // 39: temporal.benchmark.results.v1.Run.workflow_ids:type_name -> temporal.benchmark.results.v1.WorkflowIDRange
+	45, // 40: temporal.benchmark.results.v1.Phase.start_time:type_name -> google.protobuf.Timestamp
+	45, // 41: temporal.benchmark.results.v1.Phase.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 42: temporal.benchmark.results.v1.Phase.latency:type_name -> temporal.benchmark.results.v1.Latency
+	45, // 43: temporal.benchmark.results.v1.BackpressureInterval.start_time:type_name -> google.protobuf.Timestamp
+	45, // 44: temporal.benchmark.results.v1.BackpressureInterval.end_time:type_name -> google.protobuf.Timestamp
+	45, // 45: temporal.benchmark.results.v1.BurnRateAlert.start_time:type_name -> google.protobuf.Timestamp
+	45, // 46: temporal.benchmark.results.v1.BurnRateAlert.end_time:type_name -> google.protobuf.Timestamp
+	45, // 47: temporal.benchmark.results.v1.ScalingEvent.time:type_name -> google.protobuf.Timestamp
+	45, // 48: temporal.benchmark.results.v1.ShardRestart.time:type_name -> google.protobuf.Timestamp
+	19, // 49: temporal.benchmark.results.v1.HistoryIntegrity.violations:type_name -> temporal.benchmark.results.v1.IntegrityViolation
+	2,  // 50: temporal.benchmark.results.v1.Cancellation.cancel_to_closed_latency:type_name -> temporal.benchmark.results.v1.Latency
+	26, // 51: temporal.benchmark.results.v1.PersistenceCeiling.steps:type_name -> temporal.benchmark.results.v1.PersistenceStep
+	27, // 52: temporal.benchmark.results.v1.ReadLatency.describe:type_name -> temporal.benchmark.results.v1.ReadAPILatency
+	27, // 53: temporal.benchmark.results.v1.ReadLatency.get_history:type_name -> temporal.benchmark.results.v1.ReadAPILatency
+	30, // 54: temporal.benchmark.results.v1.ActivityLatency.execution:type_name -> temporal.benchmark.results.v1.HistogramLatency
+	30, // 55: temporal.benchmark.results.v1.ActivityLatency.schedule_to_start:type_name -> temporal.benchmark.results.v1.HistogramLatency
+	2,  // 56: temporal.benchmark.results.v1.WorkflowTypeLatency.latency:type_name -> temporal.benchmark.results.v1.Latency
+	44, // 57: temporal.benchmark.results.v1.DynamicConfig.overrides:type_name -> temporal.benchmark.results.v1.DynamicConfig.OverridesEntry
+	45, // 58: temporal.benchmark.results.v1.ClusterSnapshot.time:type_name -> google.protobuf.Timestamp
+	36, // 59: temporal.benchmark.results.v1.ClusterState.before:type_name -> temporal.benchmark.results.v1.ClusterSnapshot
+	36, // 60: temporal.benchmark.results.v1.ClusterState.after:type_name -> temporal.benchmark.results.v1.ClusterSnapshot
+	37, // 61: temporal.benchmark.results.v1.ClusterState.diff:type_name -> temporal.benchmark.results.v1.ClusterSnapshotDiff
+	45, // 62: temporal.benchmark.results.v1.HeatmapWindow.start:type_name -> google.protobuf.Timestamp
+	39, // 63: temporal.benchmark.results.v1.LatencyHeatmap.windows:type_name -> temporal.benchmark.results.v1.HeatmapWindow
+	64, // [64:64] is the sub-list for method output_type
+	64, // [64:64] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
+}
+
+func init() { file_results_proto_init() }
+func file_results_proto_init() {
+	if File_results_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_results_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*BenchmarkResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Latency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Metrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*OCCConflicts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*System); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*WorkflowCache); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Thresholds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*BaselineThresholds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*WorkflowIDRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Run); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Phase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*BackpressureInterval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*BurnRateAlert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ScalingEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ShardRestart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*StuckWorkflows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*OutcomeVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*HistoryIntegrity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*IntegrityViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*DuplicateExecutions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*Cancellation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*DrainStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ClockSkew); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*PersistenceLatency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*PersistenceCeiling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*PersistenceStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadAPILatency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadLatency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*HistorySize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*HistogramLatency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ActivityLatency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*WorkflowTypeLatency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*Topology); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*Connection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*DynamicConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ClusterSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ClusterSnapshotDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ClusterState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*HeatmapWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*LatencyHeatmap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_results_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GrafanaSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_results_proto_msgTypes[3].OneofWrappers = []any{}
+	file_results_proto_msgTypes[4].OneofWrappers = []any{}
+	file_results_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_results_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   45,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_results_proto_goTypes,
+		DependencyIndexes: file_results_proto_depIdxs,
+		MessageInfos:      file_results_proto_msgTypes,
+	}.Build()
+	File_results_proto = out.File
+	file_results_proto_rawDesc = nil
+	file_results_proto_goTypes = nil
+	file_results_proto_depIdxs = nil
+}
@@ -0,0 +1,294 @@
+// Package results provides result reporting and serialization.
+package results
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// Sink kinds accepted by ParseSinks.
+const (
+	SinkStdout = "stdout"
+	SinkFile   = "file"
+	SinkHTTP   = "http"
+)
+
+// httpSinkTimeout bounds a single result POST.
+const httpSinkTimeout = 10 * time.Second
+
+// PayloadHeader tells HTTP sink receivers whether a POST carries a result or diagnostics.
+const PayloadHeader = "X-Benchmark-Payload"
+
+// BundleNameHeader carries the artifact bundle's file name on bundle POSTs.
+const BundleNameHeader = "X-Benchmark-Bundle-Name"
+
+// Sink publishes benchmark results to a destination.
+type Sink interface {
+	// Name identifies the sink in logs
+	Name() string
+
+	// Publish delivers a single result
+	Publish(ctx context.Context, result *BenchmarkResultJSON) error
+}
+
+// SinkOption configures a file or HTTP sink.
+type SinkOption func(*sinkOptions)
+
+type sinkOptions struct {
+	format string
+}
+
+// WithFormat sets the result encoding, config.ResultsFormatJSON (the default)
+// or config.ResultsFormatProto. The stdout sink always prints JSON.
+func WithFormat(format string) SinkOption {
+	return func(o *sinkOptions) {
+		o.format = format
+	}
+}
+
+func newSinkOptions(opts []SinkOption) sinkOptions {
+	o := sinkOptions{format: config.ResultsFormatJSON}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// ParseSinks parses a comma-separated sink list. Each entry is one of:
+//
+//	stdout              human-readable summary and JSON on stdout
+//	file:<path>         append one JSON line (or length-delimited protobuf message) per result to <path>
+//	http(s)://<url>     POST each result as JSON (or protobuf) to <url>
+func ParseSinks(spec string, opts ...SinkOption) ([]Sink, error) {
+	var sinks []Sink
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == "":
+			continue
+		case entry == SinkStdout:
+			sinks = append(sinks, NewStdoutSink(os.Stdout))
+		case strings.HasPrefix(entry, SinkFile+":"):
+			path := strings.TrimPrefix(entry, SinkFile+":")
+			if path == "" {
+				return nil, fmt.Errorf("file sink requires a path")
+			}
+			sinks = append(sinks, NewFileSink(path, opts...))
+		case strings.HasPrefix(entry, "http://"), strings.HasPrefix(entry, "https://"):
+			sinks = append(sinks, NewHTTPSink(entry, opts...))
+		default:
+			return nil, fmt.Errorf("unknown result sink %q: must be stdout, file:<path> or an http(s) URL", entry)
+		}
+	}
+	if len(sinks) == 0 {
+		return nil, fmt.Errorf("no result sinks configured")
+	}
+	return sinks, nil
+}
+
+// PublishAll publishes the result to every sink, returning the joined errors.
+// A failing sink does not prevent delivery to the others.
+func PublishAll(ctx context.Context, sinks []Sink, result *BenchmarkResultJSON) error {
+	var errs []error
+	for _, s := range sinks {
+		if err := s.Publish(ctx, result); err != nil {
+			errs = append(errs, fmt.Errorf("%s sink: %w", s.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// stdoutSink writes the human-readable summary followed by the JSON result.
+// Requirement 6.1: THE Benchmark_Runner SHALL output results in JSON format for programmatic consumption.
+// Requirement 6.2: THE Benchmark_Runner SHALL output a human-readable summary to stdout.
+type stdoutSink struct {
+	w io.Writer
+}
+
+// NewStdoutSink creates a sink that prints results to w.
+func NewStdoutSink(w io.Writer) Sink {
+	return &stdoutSink{w: w}
+}
+
+func (s *stdoutSink) Name() string {
+	return SinkStdout
+}
+
+func (s *stdoutSink) Publish(_ context.Context, result *BenchmarkResultJSON) error {
+	result.PrintSummary(s.w)
+
+	jsonBytes, err := result.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to serialize results to JSON: %w", err)
+	}
+
+	fmt.Fprintln(s.w, "\nJSON Results:")
+	fmt.Fprintln(s.w, string(jsonBytes))
+	return nil
+}
+
+func (s *stdoutSink) PublishDiagnostics(_ context.Context, d *Diagnostics) error {
+	jsonBytes, err := d.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to serialize diagnostics: %w", err)
+	}
+
+	fmt.Fprintln(s.w, "\nDiagnostics JSON:")
+	fmt.Fprintln(s.w, string(jsonBytes))
+	return nil
+}
+
+// fileSink appends each result as a single JSON line, or as a varint
+// length-prefixed protobuf message in proto format.
+type fileSink struct {
+	path   string
+	format string
+	mu     sync.Mutex
+}
+
+// NewFileSink creates a sink that appends results to the file at path.
+func NewFileSink(path string, opts ...SinkOption) Sink {
+	return &fileSink{path: path, format: newSinkOptions(opts).format}
+}
+
+func (s *fileSink) Name() string {
+	return SinkFile + ":" + s.path
+}
+
+func (s *fileSink) Publish(_ context.Context, result *BenchmarkResultJSON) error {
+	var record []byte
+	if s.format == config.ResultsFormatProto {
+		var err error
+		if record, err = result.AppendDelimitedProto(nil); err != nil {
+			return err
+		}
+	} else {
+		line, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		record = append(line, '\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", s.path, err)
+	}
+	if _, err := f.Write(record); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", s.path, err)
+	}
+	return f.Close()
+}
+
+// PublishDiagnostics writes DiagnosticsFileName in the results file's directory,
+// replacing any diagnostics from an earlier run.
+func (s *fileSink) PublishDiagnostics(_ context.Context, d *Diagnostics) error {
+	data, err := d.ToJSON()
+	if err != nil {
+		return fmt.Errorf("failed to serialize diagnostics: %w", err)
+	}
+
+	path := filepath.Join(filepath.Dir(s.path), DiagnosticsFileName)
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// PublishBundle writes the bundle into the results file's directory.
+func (s *fileSink) PublishBundle(_ context.Context, name string, data []byte) error {
+	path := filepath.Join(filepath.Dir(s.path), name)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// httpSink POSTs each result as JSON, or as protobuf in proto format.
+type httpSink struct {
+	url    string
+	format string
+	client *http.Client
+}
+
+// NewHTTPSink creates a sink that POSTs results to url.
+func NewHTTPSink(url string, opts ...SinkOption) Sink {
+	return &httpSink{
+		url:    url,
+		format: newSinkOptions(opts).format,
+		client: &http.Client{Timeout: httpSinkTimeout},
+	}
+}
+
+func (s *httpSink) Name() string {
+	return SinkHTTP
+}
+
+func (s *httpSink) Publish(ctx context.Context, result *BenchmarkResultJSON) error {
+	if s.format == config.ResultsFormatProto {
+		body, err := result.ToProto()
+		if err != nil {
+			return err
+		}
+		return s.post(ctx, "result", ProtoContentType, body, nil)
+	}
+	body, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to serialize result: %w", err)
+	}
+	return s.post(ctx, "result", "application/json", body, nil)
+}
+
+func (s *httpSink) PublishDiagnostics(ctx context.Context, d *Diagnostics) error {
+	body, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Errorf("failed to serialize diagnostics: %w", err)
+	}
+	return s.post(ctx, "diagnostics", "application/json", body, nil)
+}
+
+// PublishBundle POSTs the gzip-compressed bundle, named in BundleNameHeader.
+func (s *httpSink) PublishBundle(ctx context.Context, name string, data []byte) error {
+	return s.post(ctx, "bundle", "application/gzip", data, http.Header{BundleNameHeader: {name}})
+}
+
+// post sends body to the sink URL, labelled with the payload kind, with any
+// extra headers.
+func (s *httpSink) post(ctx context.Context, payload, contentType string, body []byte, extra http.Header) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(PayloadHeader, payload)
+	for name, values := range extra {
+		req.Header[name] = values
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post %s: %w", payload, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("result endpoint returned %s", resp.Status)
+	}
+	return nil
+}
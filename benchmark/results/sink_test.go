@@ -0,0 +1,98 @@
+// Package results provides result reporting and serialization.
+package results
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func sampleSinkResult() *BenchmarkResultJSON {
+	return &BenchmarkResultJSON{
+		Timestamp:      time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC),
+		Config:         ResultConfig{WorkflowType: "simple", TargetRate: 100, Duration: "5m0s", WorkerCount: 4, Iterations: 1},
+		System:         ResultSystem{InstanceType: "m7g.large", Services: map[string]int{"history": 1}},
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+}
+
+func TestParseSinks(t *testing.T) {
+	sinks, err := ParseSinks("stdout, file:/tmp/results.jsonl,https://example.com/hook")
+	require.NoError(t, err)
+	require.Len(t, sinks, 3)
+	require.Equal(t, SinkStdout, sinks[0].Name())
+	require.Equal(t, "file:/tmp/results.jsonl", sinks[1].Name())
+	require.Equal(t, SinkHTTP, sinks[2].Name())
+
+	_, err = ParseSinks("s3://bucket")
+	require.Error(t, err)
+
+	_, err = ParseSinks("file:")
+	require.Error(t, err)
+
+	_, err = ParseSinks(" , ")
+	require.Error(t, err)
+}
+
+func TestStdoutSink_Publish(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewStdoutSink(&buf).Publish(context.Background(), sampleSinkResult()))
+	require.Contains(t, buf.String(), "BENCHMARK RESULTS SUMMARY")
+	require.Contains(t, buf.String(), "JSON Results:")
+}
+
+func TestFileSink_AppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.jsonl")
+	sink := NewFileSink(path)
+
+	require.NoError(t, sink.Publish(context.Background(), sampleSinkResult()))
+	require.NoError(t, sink.Publish(context.Background(), sampleSinkResult()))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	parsed, err := FromJSON([]byte(lines[1]))
+	require.NoError(t, err)
+	require.Equal(t, "simple", parsed.Config.WorkflowType)
+}
+
+func TestHTTPSink_Publish(t *testing.T) {
+	var received BenchmarkResultJSON
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewHTTPSink(server.URL).Publish(context.Background(), sampleSinkResult()))
+	require.Equal(t, "simple", received.Config.WorkflowType)
+}
+
+func TestPublishAll_ContinuesAfterFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "results.jsonl")
+	err := PublishAll(context.Background(), []Sink{NewHTTPSink(server.URL), NewFileSink(path)}, sampleSinkResult())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "http sink")
+
+	_, statErr := os.Stat(path)
+	require.NoError(t, statErr)
+}
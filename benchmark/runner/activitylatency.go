@@ -0,0 +1,65 @@
+package runner
+
+import (
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// activityLatencyWindow measures the embedded workers' activity latencies
+// per activity type over a run from the SDK's latency histograms at its start
+// and end. A nil activityLatencyWindow reports nothing, e.g. for the generate
+// role, which runs no workers.
+type activityLatencyWindow struct {
+	gatherer  prometheus.Gatherer
+	namespace string
+	before    metrics.ActivitySnapshot
+}
+
+// startActivityLatencyWindow reads the activity latency histograms of
+// namespace's workers at the start of a run.
+func startActivityLatencyWindow(gatherer prometheus.Gatherer, cfg config.BenchmarkConfig, namespace string) *activityLatencyWindow {
+	if cfg.Role == config.RoleGenerate {
+		return nil
+	}
+	before, err := metrics.ActivityLatencies(gatherer, namespace)
+	if err != nil {
+		slog.Warn("Activity latency metrics unavailable", "error", err)
+		return nil
+	}
+	return &activityLatencyWindow{gatherer: gatherer, namespace: namespace, before: before}
+}
+
+// result returns the latencies of each activity type executed during the run.
+func (w *activityLatencyWindow) result() []results.ActivityLatency {
+	if w == nil {
+		return nil
+	}
+	after, err := metrics.ActivityLatencies(w.gatherer, w.namespace)
+	if err != nil {
+		slog.Warn("Failed to read activity latency metrics at end of run", "error", err)
+		return nil
+	}
+	var latencies []results.ActivityLatency
+	for _, l := range metrics.ActivityLatencyDelta(w.before, after) {
+		latencies = append(latencies, results.ActivityLatency{
+			ActivityType: l.ActivityType,
+			Executions:   l.Executions,
+			Execution: results.HistogramLatency{
+				P50: l.ExecutionP50,
+				P95: l.ExecutionP95,
+				P99: l.ExecutionP99,
+			},
+			ScheduleToStart: results.HistogramLatency{
+				P50: l.ScheduleToStartP50,
+				P95: l.ScheduleToStartP95,
+				P99: l.ScheduleToStartP99,
+			},
+		})
+	}
+	return latencies
+}
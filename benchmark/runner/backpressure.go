@@ -0,0 +1,117 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// backpressurePollInterval is how often worker slot gauges are checked.
+const backpressurePollInterval = time.Second
+
+// backpressureMonitor watches the SDK worker slot gauges for saturation,
+// records saturated intervals and optionally holds the generator's rate while
+// workers are saturated. Slot gauges are only available for the embedded
+// worker; in generator-only mode the monitor records nothing.
+type backpressureMonitor struct {
+	gatherer  prometheus.Gatherer
+	namespace string
+	threshold float64
+	hold      bool
+	control   *LoadControl
+
+	mu        sync.Mutex
+	current   *results.BackpressureInterval
+	intervals []results.BackpressureInterval
+}
+
+// newBackpressureMonitor creates a monitor for the benchmark task queue in namespace.
+func newBackpressureMonitor(gatherer prometheus.Gatherer, namespace string, threshold float64, hold bool, control *LoadControl) *backpressureMonitor {
+	return &backpressureMonitor{
+		gatherer:  gatherer,
+		namespace: namespace,
+		threshold: threshold,
+		hold:      hold,
+		control:   control,
+	}
+}
+
+// run polls the slot gauges until ctx is done.
+func (m *backpressureMonitor) run(ctx context.Context) {
+	ticker := time.NewTicker(backpressurePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			utilization, ok, err := metrics.WorkerSlotUtilization(m.gatherer, m.namespace, DefaultTaskQueue)
+			if err != nil {
+				slog.Warn("Failed to read worker slot metrics", "error", err)
+				continue
+			}
+			if ok {
+				m.observe(now, utilization)
+			}
+		}
+	}
+}
+
+// observe records a utilization sample, opening or closing a saturated interval
+// as utilization crosses the threshold.
+func (m *backpressureMonitor) observe(now time.Time, utilization float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	saturated := utilization >= m.threshold
+	switch {
+	case saturated && m.current == nil:
+		m.current = &results.BackpressureInterval{
+			StartTime:       now,
+			PeakUtilization: utilization,
+			Held:            m.hold,
+		}
+		slog.Warn("Worker slots saturated",
+			"utilization", utilization,
+			"threshold", m.threshold,
+			"rate_held", m.hold)
+		if m.hold {
+			m.control.setHeld(true)
+		}
+	case saturated:
+		m.current.PeakUtilization = max(m.current.PeakUtilization, utilization)
+	case m.current != nil:
+		m.closeLocked(now)
+		slog.Info("Worker slots no longer saturated", "utilization", utilization)
+	}
+}
+
+// finish closes any open interval, releases a held rate and returns the
+// recorded intervals.
+func (m *backpressureMonitor) finish(now time.Time) []results.BackpressureInterval {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.current != nil {
+		m.closeLocked(now)
+	}
+	return m.intervals
+}
+
+// closeLocked ends the current interval. m.mu must be held.
+func (m *backpressureMonitor) closeLocked(now time.Time) {
+	m.current.EndTime = now
+	m.intervals = append(m.intervals, *m.current)
+	m.current = nil
+	if m.hold {
+		m.control.setHeld(false)
+	}
+}
@@ -0,0 +1,53 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackpressureMonitor_RecordsIntervals(t *testing.T) {
+	control := NewLoadControl()
+	gen := &stubGenerator{}
+	control.attach(gen)
+
+	m := newBackpressureMonitor(prometheus.NewRegistry(), "bench", 0.9, true, control)
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	m.observe(start, 0.5)
+	require.False(t, gen.held)
+
+	m.observe(start.Add(time.Second), 0.92)
+	require.True(t, gen.held)
+	m.observe(start.Add(2*time.Second), 0.98)
+	m.observe(start.Add(3*time.Second), 0.4)
+	require.False(t, gen.held)
+
+	// An interval still open at the end is closed by finish
+	m.observe(start.Add(4*time.Second), 1.0)
+	intervals := m.finish(start.Add(6 * time.Second))
+	require.False(t, gen.held)
+
+	require.Len(t, intervals, 2)
+	require.Equal(t, start.Add(time.Second), intervals[0].StartTime)
+	require.Equal(t, start.Add(3*time.Second), intervals[0].EndTime)
+	require.Equal(t, 0.98, intervals[0].PeakUtilization)
+	require.True(t, intervals[0].Held)
+	require.Equal(t, start.Add(6*time.Second), intervals[1].EndTime)
+}
+
+func TestBackpressureMonitor_ObserveOnly(t *testing.T) {
+	control := NewLoadControl()
+	gen := &stubGenerator{}
+	control.attach(gen)
+
+	m := newBackpressureMonitor(prometheus.NewRegistry(), "bench", 0.9, false, control)
+	m.observe(time.Now(), 0.95)
+	require.False(t, gen.held)
+
+	intervals := m.finish(time.Now())
+	require.Len(t, intervals, 1)
+	require.False(t, intervals[0].Held)
+}
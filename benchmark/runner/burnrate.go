@@ -0,0 +1,223 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// burnRateCheckInterval is how often the latency SLA burn rate is evaluated.
+const burnRateCheckInterval = 5 * time.Second
+
+// burnRateBudget is the fraction of workflows the p99 latency threshold lets
+// exceed it: its error budget.
+const burnRateBudget = 0.01
+
+// burnRateShortWindows is the long window's length in short windows, the
+// ratio of the multiwindow burn-rate alerts of the SRE workbook (1h and 5m).
+const burnRateShortWindows = 12
+
+// burnRateMonitor evaluates the p99 latency threshold continuously during a
+// run as an SLO burn rate: the fraction of completed workflows slower than
+// the threshold over the 1% a p99 allows. A burn rate of 1 over the whole run
+// fails it; the alert fires when the rate over both the long and the short
+// window reaches the configured threshold, so a trend that would fail the
+// run is flagged early and the alert clears soon after it recovers. A nil
+// burnRateMonitor records nothing.
+type burnRateMonitor struct {
+	window    time.Duration
+	short     time.Duration
+	threshold float64
+	cfg       config.BenchmarkConfig
+	control   *LoadControl
+	gauges    *burnRateGauges
+
+	mu         sync.Mutex
+	maxLatency time.Duration // Current p99 latency threshold, which may be reloaded
+	seconds    []burnRateSecond
+	current    *results.BurnRateAlert
+	alerts     []results.BurnRateAlert
+}
+
+// burnRateSecond counts the workflows completed in one second of the run.
+type burnRateSecond struct {
+	unix      int64
+	completed int64
+	slow      int64
+}
+
+// burnRateGauges are the Prometheus gauges of the burn-rate alert.
+type burnRateGauges struct {
+	burnRate *prometheus.GaugeVec
+	alert    prometheus.Gauge
+}
+
+// newBurnRateMonitor creates a monitor of cfg's p99 latency threshold, or nil
+// if burn-rate alerting is disabled. The gauges are registered with registry,
+// reusing those of an earlier run.
+func newBurnRateMonitor(registry prometheus.Registerer, cfg config.BenchmarkConfig, control *LoadControl) *burnRateMonitor {
+	if cfg.BurnRateWindow <= 0 {
+		return nil
+	}
+	m := &burnRateMonitor{
+		window:    cfg.BurnRateWindow,
+		short:     cfg.BurnRateWindow / burnRateShortWindows,
+		threshold: cfg.BurnRateThreshold,
+		cfg:       cfg,
+		control:   control,
+		gauges: &burnRateGauges{
+			burnRate: registerBurnRateGauge(registry, prometheus.NewGaugeVec(prometheus.GaugeOpts{
+				Name: "benchmark_sla_burn_rate",
+				Help: "Rate at which workflows slower than the p99 latency threshold consume its 1% error budget, by window",
+			}, []string{"window"})),
+			alert: registerBurnRateGauge(registry, prometheus.NewGauge(prometheus.GaugeOpts{
+				Name: "benchmark_sla_burn_rate_alert",
+				Help: "1 while the p99 latency burn rate of both windows is at or above the alert threshold",
+			})),
+		},
+		maxLatency: cfg.MaxP99Latency,
+		seconds:    make([]burnRateSecond, int(cfg.BurnRateWindow/time.Second)+1),
+	}
+	m.gauges.alert.Set(0)
+	return m
+}
+
+// registerBurnRateGauge registers g, returning the already-registered gauge on conflict.
+func registerBurnRateGauge[T prometheus.Collector](registry prometheus.Registerer, g T) T {
+	if err := registry.Register(g); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(T); ok {
+				return existing
+			}
+		}
+		slog.Warn("Failed to register burn rate metric", "error", err)
+	}
+	return g
+}
+
+// record adds a workflow that completed at with latency.
+func (m *burnRateMonitor) record(at time.Time, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.secondLocked(at.Unix())
+	s.completed++
+	if latency > m.maxLatency {
+		s.slow++
+	}
+}
+
+// secondLocked returns the counts of the second unix, resetting a slot last
+// used for an earlier second. m.mu must be held.
+func (m *burnRateMonitor) secondLocked(unix int64) *burnRateSecond {
+	s := &m.seconds[unix%int64(len(m.seconds))]
+	if s.unix != unix {
+		*s = burnRateSecond{unix: unix}
+	}
+	return s
+}
+
+// run evaluates the burn rate until ctx is done.
+func (m *burnRateMonitor) run(ctx context.Context) {
+	if m == nil {
+		return
+	}
+	ticker := time.NewTicker(burnRateCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.evaluate(now)
+		}
+	}
+}
+
+// evaluate computes the burn rate of both windows ending at now, opening or
+// closing an alert as they cross the threshold.
+func (m *burnRateMonitor) evaluate(now time.Time) {
+	// Thresholds may have been reloaded while the benchmark was running
+	maxLatency := m.control.ApplyThresholds(m.cfg).MaxP99Latency
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.maxLatency = maxLatency
+
+	long, longCompleted := m.burnRateLocked(now, m.window)
+	short, _ := m.burnRateLocked(now, m.short)
+	m.gauges.burnRate.WithLabelValues("long").Set(long)
+	m.gauges.burnRate.WithLabelValues("short").Set(short)
+
+	// Too few workflows to estimate a p99 from don't raise the alert
+	firing := long >= m.threshold && short >= m.threshold && longCompleted >= 1/burnRateBudget
+	switch {
+	case firing && m.current == nil:
+		m.current = &results.BurnRateAlert{
+			StartTime:    now,
+			PeakBurnRate: long,
+		}
+		m.gauges.alert.Set(1)
+		slog.Warn("Latency SLA burning error budget, run trending toward failure",
+			"burn_rate_long", long,
+			"burn_rate_short", short,
+			"window", m.window,
+			"threshold", m.threshold,
+			"max_p99_latency", maxLatency)
+	case firing:
+		m.current.PeakBurnRate = max(m.current.PeakBurnRate, long)
+	case m.current != nil:
+		m.closeLocked(now)
+		slog.Info("Latency SLA burn rate recovered", "burn_rate_long", long, "burn_rate_short", short)
+	}
+}
+
+// burnRateLocked returns the burn rate over the window ending at now and the
+// workflows completed in it. m.mu must be held.
+func (m *burnRateMonitor) burnRateLocked(now time.Time, window time.Duration) (float64, int64) {
+	var completed, slow int64
+	end := now.Unix()
+	for unix := end - int64(window/time.Second) + 1; unix <= end; unix++ {
+		if s := m.seconds[unix%int64(len(m.seconds))]; s.unix == unix {
+			completed += s.completed
+			slow += s.slow
+		}
+	}
+	if completed == 0 {
+		return 0, 0
+	}
+	return float64(slow) / float64(completed) / burnRateBudget, completed
+}
+
+// finish closes any open alert and returns the recorded alerts.
+func (m *burnRateMonitor) finish(now time.Time) []results.BurnRateAlert {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.current != nil {
+		m.closeLocked(now)
+	}
+	return m.alerts
+}
+
+// closeLocked ends the current alert. m.mu must be held.
+func (m *burnRateMonitor) closeLocked(now time.Time) {
+	m.current.EndTime = now
+	m.alerts = append(m.alerts, *m.current)
+	m.current = nil
+	m.gauges.alert.Set(0)
+}
@@ -0,0 +1,68 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func TestBurnRateMonitor_AlertsWhileBurning(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.MaxP99Latency = time.Second
+	cfg.BurnRateWindow = time.Minute
+	cfg.BurnRateThreshold = 2
+	m := newBurnRateMonitor(prometheus.NewRegistry(), cfg, NewLoadControl())
+	start := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	// 1% slow burns the budget at exactly the rate the run affords
+	for i := range 200 {
+		latency := 100 * time.Millisecond
+		if i%100 == 0 {
+			latency = 2 * time.Second
+		}
+		m.record(start.Add(time.Duration(i)*50*time.Millisecond), latency)
+	}
+	m.evaluate(start.Add(10 * time.Second))
+	require.Nil(t, m.current)
+	require.Equal(t, 1.0, testutil.ToFloat64(m.gauges.burnRate.WithLabelValues("long")))
+
+	// 5% slow in the last seconds
+	for i := range 100 {
+		latency := 100 * time.Millisecond
+		if i%20 == 0 {
+			latency = 2 * time.Second
+		}
+		m.record(start.Add(11*time.Second), latency)
+	}
+	m.evaluate(start.Add(11 * time.Second))
+	require.NotNil(t, m.current)
+	require.Equal(t, 1.0, testutil.ToFloat64(m.gauges.alert))
+
+	// Fast workflows clear the short window
+	for range 100 {
+		m.record(start.Add(20*time.Second), 100*time.Millisecond)
+	}
+	m.evaluate(start.Add(20 * time.Second))
+	require.Nil(t, m.current)
+	require.Equal(t, 0.0, testutil.ToFloat64(m.gauges.alert))
+
+	alerts := m.finish(start.Add(30 * time.Second))
+	require.Len(t, alerts, 1)
+	require.Equal(t, start.Add(11*time.Second), alerts[0].StartTime)
+	require.Equal(t, start.Add(20*time.Second), alerts[0].EndTime)
+	require.InDelta(t, 2.33, alerts[0].PeakBurnRate, 0.01)
+}
+
+func TestBurnRateMonitor_Disabled(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.BurnRateWindow = 0
+	m := newBurnRateMonitor(prometheus.NewRegistry(), cfg, NewLoadControl())
+	require.Nil(t, m)
+	m.record(time.Now(), time.Hour)
+	require.Nil(t, m.finish(time.Now()))
+}
@@ -0,0 +1,269 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+)
+
+const (
+	// CalibrationWorkflowsPerType is how many workflows of each type a
+	// calibration run measures.
+	CalibrationWorkflowsPerType = 10
+
+	// DefaultCalibrationTimeout bounds a calibration run when no completion
+	// timeout is configured.
+	DefaultCalibrationTimeout = 5 * time.Minute
+)
+
+// Calibrator is implemented by runners that can measure the state transitions
+// of each workflow type. Runners returned by NewRunner implement it.
+type Calibrator interface {
+	// Calibrate runs a few workflows of every registered type and reports
+	// their measured cost
+	Calibrate(ctx context.Context, cfg config.BenchmarkConfig) (*CalibrationResult, error)
+}
+
+// CalibrationTypeResult reports the measured cost of one workflow type.
+type CalibrationTypeResult struct {
+	WorkflowType          string  `json:"workflowType"`
+	Samples               int     `json:"samples"`
+	Failed                int     `json:"failed"`
+	HistoryEvents         float64 `json:"historyEvents"`
+	StateTransitions      float64 `json:"stateTransitions"`
+	ModelStateTransitions float64 `json:"modelStateTransitions"` // Built-in cost model, for comparison
+	Error                 string  `json:"error,omitempty"`       // First failure seen
+}
+
+// CalibrationResult is the outcome of a calibration run. Table holds the
+// measured types and is written to the calibration file when the run passed.
+type CalibrationResult struct {
+	Namespace string                   `json:"namespace"`
+	Duration  string                   `json:"duration"`
+	Types     []CalibrationTypeResult  `json:"types"`
+	Table     *config.CalibrationTable `json:"table"`
+	Passed    bool                     `json:"passed"`
+}
+
+// calibrationSample is the server-reported cost of one completed workflow.
+type calibrationSample struct {
+	historyEvents    float64
+	stateTransitions float64
+}
+
+// calibrationType collects the samples and failures of one workflow type.
+type calibrationType struct {
+	samples []calibrationSample
+	failed  int
+	err     string
+}
+
+// Calibrate runs CalibrationWorkflowsPerType workflows of every registered
+// type to completion and reads each one's history length and state
+// transition count from the server.
+func (r *runner) Calibrate(ctx context.Context, cfg config.BenchmarkConfig) (*CalibrationResult, error) {
+	startTime := time.Now()
+
+	timeout := cfg.CompletionTimeout
+	if timeout <= 0 {
+		timeout = DefaultCalibrationTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namespace, nsClient, stop, err := r.prepareNamespace(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
+
+	slog.Info("Starting transition cost calibration",
+		"namespace", namespace,
+		"workflows_per_type", CalibrationWorkflowsPerType,
+		"timeout", timeout)
+
+	types := config.ValidWorkflowTypes()
+	measured := make([]calibrationType, len(types))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i, workflowType := range types {
+		typeCfg := cfg
+		typeCfg.WorkflowType = workflowType
+		for n := range CalibrationWorkflowsPerType {
+			workflowID := fmt.Sprintf("calibrate-%s-%d-%d", workflowType, startTime.Unix(), n)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sample, err := calibrateWorkflow(ctx, nsClient, typeCfg, workflowID)
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					measured[i].failed++
+					if measured[i].err == "" {
+						measured[i].err = err.Error()
+					}
+					return
+				}
+				measured[i].samples = append(measured[i].samples, sample)
+			}()
+		}
+	}
+	wg.Wait()
+
+	byType := make(map[string]calibrationType, len(types))
+	for i, workflowType := range types {
+		byType[workflowType] = measured[i]
+	}
+	result := newCalibrationResult(namespace, cfg, types, byType)
+	result.Duration = time.Since(startTime).Round(time.Millisecond).String()
+	return result, nil
+}
+
+// calibrateWorkflow runs one workflow of cfg.WorkflowType to completion and
+// describes it.
+func calibrateWorkflow(ctx context.Context, c client.Client, cfg config.BenchmarkConfig, workflowID string) (calibrationSample, error) {
+	run, err := generator.ExecuteWorkflow(ctx, c, client.StartWorkflowOptions{
+		ID:        workflowID,
+		TaskQueue: DefaultTaskQueue,
+	}, cfg)
+	if err != nil {
+		return calibrationSample{}, fmt.Errorf("failed to start workflow: %w", err)
+	}
+	if err := run.Get(ctx, nil); err != nil {
+		return calibrationSample{}, fmt.Errorf("workflow did not complete: %w", err)
+	}
+	resp, err := c.DescribeWorkflowExecution(ctx, workflowID, run.GetRunID())
+	if err != nil {
+		return calibrationSample{}, fmt.Errorf("failed to describe workflow: %w", err)
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	return calibrationSample{
+		historyEvents:    float64(info.GetHistoryLength()),
+		stateTransitions: float64(info.GetStateTransitionCount()),
+	}, nil
+}
+
+// newCalibrationResult averages the samples of each type into the result and
+// its calibration table. A child-workflow's transitions include its children,
+// which run the simple workflow, so that type needs simple samples too. The
+// run passes only if every type was measured.
+func newCalibrationResult(namespace string, cfg config.BenchmarkConfig, types []string, measured map[string]calibrationType) *CalibrationResult {
+	childCount := cfg.ChildCount
+	result := &CalibrationResult{
+		Namespace: namespace,
+		Table: &config.CalibrationTable{
+			Timestamp: time.Now().UTC(),
+			Types:     map[string]config.CalibratedWorkflow{},
+		},
+		Passed: true,
+	}
+
+	simple := measured[config.WorkflowTypeSimple].samples
+	for _, workflowType := range types {
+		m := measured[workflowType]
+		typeCfg := cfg
+		typeCfg.WorkflowType = workflowType
+		t := CalibrationTypeResult{
+			WorkflowType:          workflowType,
+			Samples:               len(m.samples),
+			Failed:                m.failed,
+			ModelStateTransitions: typeCfg.ModelTransitionCost(),
+			Error:                 m.err,
+		}
+		if len(m.samples) > 0 {
+			events := make([]float64, len(m.samples))
+			transitions := make([]float64, len(m.samples))
+			for i, s := range m.samples {
+				events[i], transitions[i] = s.historyEvents, s.stateTransitions
+			}
+			t.HistoryEvents = mean(events)
+			t.StateTransitions = mean(transitions)
+		}
+
+		entry := config.CalibratedWorkflow{
+			Samples:          t.Samples,
+			HistoryEvents:    t.HistoryEvents,
+			StateTransitions: t.StateTransitions,
+		}
+		if workflowType == config.WorkflowTypeChildWorkflow && t.Samples > 0 {
+			if len(simple) == 0 {
+				t.Samples = 0
+				if t.Error == "" {
+					t.Error = "no simple workflow measured to cost the children"
+				}
+			} else {
+				childTransitions := make([]float64, len(simple))
+				for i, s := range simple {
+					childTransitions[i] = s.stateTransitions
+				}
+				// Every parent in the tree is costed as the measured root
+				parents, leaves := config.ChildTree(childCount, cfg.ChildDepth)
+				t.StateTransitions = float64(parents)*t.StateTransitions + float64(leaves)*mean(childTransitions)
+				entry.StateTransitions = t.StateTransitions
+				entry.ChildCount = childCount
+				if cfg.ChildDepth > 1 {
+					entry.ChildDepth = cfg.ChildDepth
+				}
+			}
+		}
+
+		if t.Samples == 0 {
+			result.Passed = false
+		} else {
+			result.Table.Types[workflowType] = entry
+		}
+		result.Types = append(result.Types, t)
+	}
+	return result
+}
+
+// FailedTypes returns the workflow types that could not be measured.
+func (c *CalibrationResult) FailedTypes() []string {
+	var failed []string
+	for _, t := range c.Types {
+		if t.Samples == 0 {
+			failed = append(failed, t.WorkflowType)
+		}
+	}
+	return failed
+}
+
+// PrintSummary prints a human-readable calibration summary.
+func (c *CalibrationResult) PrintSummary(w io.Writer) {
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintln(w, "                 TRANSITION COST CALIBRATION")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintf(w, "  Namespace:  %s\n", c.Namespace)
+	fmt.Fprintf(w, "  Duration:   %s\n", c.Duration)
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, "  %-18s %7s %8s %11s %8s\n", "Type", "Samples", "Events", "Transitions", "Model")
+	for _, t := range c.Types {
+		fmt.Fprintf(w, "  %-18s %7d %8.1f %11.1f %8.0f\n",
+			t.WorkflowType, t.Samples, t.HistoryEvents, t.StateTransitions, t.ModelStateTransitions)
+		if t.Error != "" {
+			fmt.Fprintf(w, "    • %d failed: %s\n", t.Failed, t.Error)
+		}
+	}
+	fmt.Fprintln(w, "")
+	if c.Passed {
+		fmt.Fprintln(w, "                         ✓ PASSED")
+	} else {
+		fmt.Fprintln(w, "                         ✗ FAILED")
+	}
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+}
+
+// ToJSON serializes the calibration result to JSON bytes.
+func (c *CalibrationResult) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(c, "", "  ")
+}
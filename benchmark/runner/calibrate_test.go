@@ -0,0 +1,65 @@
+package runner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// calibrationConfig returns the default config with childCount children.
+func calibrationConfig(childCount int) config.BenchmarkConfig {
+	cfg := config.DefaultConfig()
+	cfg.ChildCount = childCount
+	return cfg
+}
+
+func TestNewCalibrationResult(t *testing.T) {
+	types := []string{config.WorkflowTypeSimple, config.WorkflowTypeTimer, config.WorkflowTypeChildWorkflow}
+	result := newCalibrationResult("ns", calibrationConfig(3), types, map[string]calibrationType{
+		config.WorkflowTypeSimple:        {samples: []calibrationSample{{5, 4}, {5, 6}}},
+		config.WorkflowTypeTimer:         {samples: []calibrationSample{{11, 9}}, failed: 1, err: "timed out"},
+		config.WorkflowTypeChildWorkflow: {samples: []calibrationSample{{20, 10}}},
+	})
+	require.True(t, result.Passed)
+	require.Empty(t, result.FailedTypes())
+
+	require.Equal(t, config.CalibratedWorkflow{Samples: 2, HistoryEvents: 5, StateTransitions: 5}, result.Table.Types[config.WorkflowTypeSimple])
+	require.Equal(t, 1, result.Types[1].Failed)
+	require.Equal(t, float64(9), result.Table.Types[config.WorkflowTypeTimer].StateTransitions)
+
+	// The parent's 10 transitions plus 3 children at the simple workflow's 5
+	child := result.Table.Types[config.WorkflowTypeChildWorkflow]
+	require.Equal(t, float64(25), child.StateTransitions)
+	require.Equal(t, float64(20), child.HistoryEvents)
+	require.Equal(t, 3, child.ChildCount)
+}
+
+func TestNewCalibrationResult_NestedChildren(t *testing.T) {
+	cfg := calibrationConfig(3)
+	cfg.ChildDepth = 2
+	types := []string{config.WorkflowTypeSimple, config.WorkflowTypeChildWorkflow}
+	result := newCalibrationResult("ns", cfg, types, map[string]calibrationType{
+		config.WorkflowTypeSimple:        {samples: []calibrationSample{{5, 5}}},
+		config.WorkflowTypeChildWorkflow: {samples: []calibrationSample{{20, 10}}},
+	})
+	require.True(t, result.Passed)
+
+	// The root and its 3 children as parents at 10, and 9 leaves at 5
+	child := result.Table.Types[config.WorkflowTypeChildWorkflow]
+	require.Equal(t, float64(85), child.StateTransitions)
+	require.Equal(t, 3, child.ChildCount)
+	require.Equal(t, 2, child.ChildDepth)
+}
+
+func TestNewCalibrationResult_MissingTypes(t *testing.T) {
+	types := []string{config.WorkflowTypeSimple, config.WorkflowTypeChildWorkflow}
+	result := newCalibrationResult("ns", calibrationConfig(3), types, map[string]calibrationType{
+		config.WorkflowTypeSimple:        {failed: 10, err: "start failed"},
+		config.WorkflowTypeChildWorkflow: {samples: []calibrationSample{{20, 10}}},
+	})
+	require.False(t, result.Passed)
+	require.Equal(t, types, result.FailedTypes())
+	require.Empty(t, result.Table.Types)
+}
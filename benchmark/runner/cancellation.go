@@ -0,0 +1,58 @@
+package runner
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// cancellationTracker collects the workflows the generators cancelled
+// mid-flight during a run and their cancel-to-closed latencies. A nil
+// cancellationTracker records nothing.
+type cancellationTracker struct {
+	requested atomic.Int64
+	canceled  atomic.Int64
+	latencies *metrics.LatencyCollector
+}
+
+// newCancellationTracker creates a tracker whose latencies draw on budget.
+func newCancellationTracker(budget *metrics.LatencyBudget) *cancellationTracker {
+	t := &cancellationTracker{latencies: metrics.NewLatencyCollector(1000)}
+	t.latencies.ShareMemoryBudget(budget)
+	return t
+}
+
+// record adds a workflow that closed closeLatency after its cancel request,
+// as cancelled or, if canceled is false, completed or failed.
+func (t *cancellationTracker) record(_, _ string, closeLatency time.Duration, canceled bool) {
+	if t == nil {
+		return
+	}
+	t.requested.Add(1)
+	if canceled {
+		t.canceled.Add(1)
+		t.latencies.AddDuration(closeLatency)
+	}
+}
+
+// result returns the run's cancellations, or nil if none were requested.
+func (t *cancellationTracker) result() *results.Cancellation {
+	if t == nil || t.requested.Load() == 0 {
+		return nil
+	}
+	percentiles := t.latencies.Percentiles()
+	return &results.Cancellation{
+		Requested: t.requested.Load(),
+		Canceled:  t.canceled.Load(),
+		Completed: t.requested.Load() - t.canceled.Load(),
+		Latency: results.ResultLatency{
+			P50:         percentiles.P50,
+			P95:         percentiles.P95,
+			P99:         percentiles.P99,
+			Max:         percentiles.Max,
+			Approximate: percentiles.Approximate,
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancellationTracker(t *testing.T) {
+	tracker := newCancellationTracker(nil)
+	require.Nil(t, tracker.result(), "nothing requested")
+
+	tracker.record("wf-1", "run-1", 20*time.Millisecond, true)
+	tracker.record("wf-2", "run-2", 40*time.Millisecond, true)
+	tracker.record("wf-3", "run-3", time.Second, false)
+
+	result := tracker.result()
+	require.NotNil(t, result)
+	require.Equal(t, int64(3), result.Requested)
+	require.Equal(t, int64(2), result.Canceled)
+	require.Equal(t, int64(1), result.Completed)
+	require.InDelta(t, 40, result.Latency.Max, 0.01, "workflows that completed first have no cancel latency")
+
+	var disabled *cancellationTracker
+	disabled.record("wf-1", "run-1", time.Millisecond, true)
+	require.Nil(t, disabled.result())
+}
@@ -0,0 +1,137 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+)
+
+// ceilingSearch measures each persistence store's load over the steps of a
+// ceiling search scenario from scrapes of the Temporal server's Prometheus
+// endpoints taken between steps, and tracks the QPS each store served before
+// it first exceeded the search's limits. A nil ceilingSearch (no search, no
+// server metrics configured, or the initial scrape failed) records nothing.
+type ceilingSearch struct {
+	ceiling  *scenario.Ceiling
+	scraper  *metrics.ServerScraper
+	last     metrics.ServerSnapshot // nil if the last scrape failed
+	lastTime time.Time
+	stores   map[string]*results.PersistenceCeiling
+}
+
+// startCeilingSearch takes the snapshot the first step is measured from.
+func startCeilingSearch(ctx context.Context, c *scenario.Ceiling, urls []string) *ceilingSearch {
+	if c == nil || len(urls) == 0 {
+		return nil
+	}
+	scraper := metrics.NewServerScraper(urls)
+	before, err := scraper.Scrape(ctx)
+	if err != nil {
+		slog.Warn("Server metrics unavailable; ceiling search runs every step without measuring persistence", "error", err)
+		return nil
+	}
+	return &ceilingSearch{
+		ceiling:  c,
+		scraper:  scraper,
+		last:     before,
+		lastTime: time.Now(),
+		stores:   make(map[string]*results.PersistenceCeiling),
+	}
+}
+
+// step records each store's load over the step that just ended at
+// targetRate and reports whether the search should continue: false once
+// every store that reported load has exceeded its limits.
+func (s *ceilingSearch) step(ctx context.Context, targetRate float64) bool {
+	if s == nil {
+		return true
+	}
+	now := time.Now()
+	after, err := s.scraper.Scrape(context.WithoutCancel(ctx))
+	if err != nil {
+		// The next step can't be told apart from this one without a snapshot
+		// between them, so it is not measured either
+		slog.Warn("Failed to read server metrics after ceiling search step", "target_rate", targetRate, "error", err)
+		s.last = nil
+		return true
+	}
+	if s.last != nil {
+		s.record(s.last, after, targetRate, now.Sub(s.lastTime))
+	}
+	s.last, s.lastTime = after, time.Now()
+
+	for _, c := range s.stores {
+		if !c.Reached {
+			return true
+		}
+	}
+	return len(s.stores) == 0
+}
+
+// record adds each store's load between two snapshots, elapsed apart, as a
+// step at targetRate.
+func (s *ceilingSearch) record(before, after metrics.ServerSnapshot, targetRate float64, elapsed time.Duration) {
+	maxLatencyMs := float64(s.ceiling.LatencyLimit()) / float64(time.Millisecond)
+	for _, store := range metrics.PersistenceStores {
+		load, ok := metrics.PersistenceStoreLoad(before, after, store, 0.99)
+		if !ok {
+			continue
+		}
+		step := results.PersistenceStep{
+			TargetRate: targetRate,
+			QPS:        load.Requests / elapsed.Seconds(),
+			P99Ms:      load.LatencyMs,
+		}
+		if load.Requests > 0 {
+			step.ErrorRate = load.Errors / load.Requests
+		}
+
+		c, ok := s.stores[store]
+		if !ok {
+			c = &results.PersistenceCeiling{Store: store}
+			s.stores[store] = c
+		}
+		c.Steps = append(c.Steps, step)
+		if c.Reached {
+			continue
+		}
+		switch {
+		case step.ErrorRate > s.ceiling.ErrorRateLimit():
+			c.Reached, c.LimitedBy = true, "errors"
+		case step.P99Ms > maxLatencyMs:
+			c.Reached, c.LimitedBy = true, "latency"
+		case step.QPS > c.QPS:
+			c.QPS, c.TargetRate = step.QPS, targetRate
+		}
+		if c.Reached {
+			slog.Info("Persistence store reached its QPS ceiling",
+				"store", store,
+				"ceiling_qps", c.QPS,
+				"limited_by", c.LimitedBy,
+				"target_rate", targetRate,
+				"qps", step.QPS,
+				"error_rate", step.ErrorRate,
+				"p99_ms", step.P99Ms)
+		}
+	}
+}
+
+// result returns the ceiling of each store that reported load, in
+// metrics.PersistenceStores order.
+func (s *ceilingSearch) result() []results.PersistenceCeiling {
+	if s == nil {
+		return nil
+	}
+	var ceilings []results.PersistenceCeiling
+	for _, store := range metrics.PersistenceStores {
+		if c, ok := s.stores[store]; ok {
+			ceilings = append(ceilings, *c)
+		}
+	}
+	return ceilings
+}
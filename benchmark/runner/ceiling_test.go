@@ -0,0 +1,118 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+)
+
+// fakeStores serves cumulative persistence counters of the history and
+// matching stores and a visibility latency histogram.
+type fakeStores struct {
+	mu                         sync.Mutex
+	history, historyErrors     int
+	matching, matchingErrors   int
+	visibility, visibilityFast int
+}
+
+func (f *fakeStores) add(history, historyErrors, matching, matchingErrors, visibilityFast, visibilitySlow int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.history += history
+	f.historyErrors += historyErrors
+	f.matching += matching
+	f.matchingErrors += matchingErrors
+	f.visibility += visibilityFast + visibilitySlow
+	f.visibilityFast += visibilityFast
+}
+
+func (f *fakeStores) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fmt.Fprintf(w, `# TYPE persistence_requests_total counter
+persistence_requests_total{service_name="history"} %d
+persistence_requests_total{service_name="matching"} %d
+# TYPE persistence_errors_total counter
+persistence_errors_total{service_name="history"} %d
+persistence_errors_total{service_name="matching"} %d
+# TYPE visibility_persistence_requests_total counter
+visibility_persistence_requests_total{service_name="history"} %d
+# TYPE visibility_persistence_latency histogram
+visibility_persistence_latency_bucket{operation="RecordWorkflowExecutionStarted",le="0.01"} %d
+visibility_persistence_latency_bucket{operation="RecordWorkflowExecutionStarted",le="1"} %d
+visibility_persistence_latency_bucket{operation="RecordWorkflowExecutionStarted",le="+Inf"} %d
+visibility_persistence_latency_sum{operation="RecordWorkflowExecutionStarted"} 0
+visibility_persistence_latency_count{operation="RecordWorkflowExecutionStarted"} %d
+`, f.history, f.matching, f.historyErrors, f.matchingErrors, f.visibility,
+		f.visibilityFast, f.visibility, f.visibility, f.visibility)
+}
+
+func TestCeilingSearch_StopsOnceEveryStoreExceedsItsLimits(t *testing.T) {
+	stores := &fakeStores{}
+	server := httptest.NewServer(stores)
+	defer server.Close()
+
+	ceiling := &scenario.Ceiling{WorkflowType: "simple", StartRate: 10, StepRate: 10, MaxRate: 50, StepDuration: scenario.Duration(time.Minute)}
+	search := startCeilingSearch(context.Background(), ceiling, []string{server.URL})
+	require.NotNil(t, search)
+
+	// Each step is measured over the 10s since the previous scrape
+	step := func(rate float64) bool {
+		search.lastTime = time.Now().Add(-10 * time.Second)
+		return search.step(context.Background(), rate)
+	}
+
+	// Every store within its limits
+	stores.add(1000, 0, 500, 0, 100, 0)
+	require.True(t, step(10))
+
+	// History fails 2% of its requests, visibility slows down
+	stores.add(2000, 40, 1000, 0, 100, 100)
+	require.True(t, step(20), "matching is still within its limits")
+
+	// Matching fails too; history recovers but has already been limited
+	stores.add(1000, 0, 1500, 300, 300, 0)
+	require.False(t, step(30))
+
+	ceilings := search.result()
+	require.Len(t, ceilings, 3)
+
+	history := ceilings[0]
+	require.Equal(t, "history", history.Store)
+	require.True(t, history.Reached)
+	require.Equal(t, "errors", history.LimitedBy)
+	require.InDelta(t, 100, history.QPS, 1)
+	require.Equal(t, 10.0, history.TargetRate)
+	require.Len(t, history.Steps, 3)
+	require.InDelta(t, 0.02, history.Steps[1].ErrorRate, 0.0001)
+
+	matching := ceilings[1]
+	require.Equal(t, "matching", matching.Store)
+	require.Equal(t, "errors", matching.LimitedBy)
+	require.InDelta(t, 100, matching.QPS, 1)
+	require.Equal(t, 20.0, matching.TargetRate)
+
+	visibility := ceilings[2]
+	require.Equal(t, "visibility", visibility.Store)
+	require.Equal(t, "latency", visibility.LimitedBy)
+	require.InDelta(t, 10, visibility.QPS, 1)
+	require.Greater(t, visibility.Steps[1].P99Ms, 100.0)
+}
+
+func TestCeilingSearch_Disabled(t *testing.T) {
+	ceiling := &scenario.Ceiling{WorkflowType: "simple", StartRate: 10, StepRate: 10, MaxRate: 50}
+	require.Nil(t, startCeilingSearch(context.Background(), nil, []string{"http://127.0.0.1:1/metrics"}))
+	require.Nil(t, startCeilingSearch(context.Background(), ceiling, nil))
+
+	var search *ceilingSearch
+	require.True(t, search.step(context.Background(), 10))
+	require.Nil(t, search.result())
+}
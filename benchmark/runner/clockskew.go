@@ -0,0 +1,127 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// clockSkewWarnThreshold is the skew beyond which latencies that mix client and
+// server timestamps are flagged as unreliable.
+const clockSkewWarnThreshold = 100 * time.Millisecond
+
+// clockSample is one canary measurement: the client's wall clock when the start
+// request was sent and answered, and the server's WorkflowExecutionStarted time.
+type clockSample struct {
+	sent, received time.Time
+	server         time.Time
+}
+
+// measureClockSkew starts cfg.ClockSkewCanaries simple workflows in the
+// control namespace, compares the server's start event time with the client's
+// clock around each start request, and terminates the canaries. It returns nil
+// if no canary could be measured.
+func (r *runner) measureClockSkew(ctx context.Context, cfg config.BenchmarkConfig, control *controlPlane) *results.ClockSkew {
+	if cfg.ClockSkewCanaries <= 0 {
+		return nil
+	}
+
+	nsClient := control.client
+	if !control.dedicated {
+		c, err := r.dialNamespaceClient(control.namespace)
+		if err != nil {
+			slog.Warn("Clock skew check skipped", "error", err)
+			return nil
+		}
+		defer c.Close()
+		nsClient = c
+	}
+
+	canaryCfg := cfg
+	canaryCfg.WorkflowType = config.WorkflowTypeSimple
+
+	var samples []clockSample
+	for i := 0; i < cfg.ClockSkewCanaries; i++ {
+		workflowID := fmt.Sprintf("clock-skew-canary-%d-%d", time.Now().Unix(), i)
+		sample, err := measureCanary(ctx, nsClient, canaryCfg, workflowID)
+		if err != nil {
+			slog.Warn("Clock skew canary failed", "workflow_id", workflowID, "error", err)
+			continue
+		}
+		samples = append(samples, sample)
+	}
+
+	skew := estimateClockSkew(samples)
+	if skew == nil {
+		return nil
+	}
+	offset := time.Duration(skew.OffsetMs * float64(time.Millisecond))
+	uncertainty := time.Duration(skew.UncertaintyMs * float64(time.Millisecond))
+	if math.Abs(float64(offset))-float64(uncertainty) > float64(clockSkewWarnThreshold) {
+		slog.Warn("Client and server clocks are skewed; latencies mixing client and server timestamps are unreliable",
+			"offset", offset, "uncertainty", uncertainty)
+	} else {
+		slog.Info("Clock skew measured", "offset", offset, "uncertainty", uncertainty, "samples", skew.Samples)
+	}
+	return skew
+}
+
+// measureCanary starts one canary workflow, reads its start event time and terminates it.
+func measureCanary(ctx context.Context, c client.Client, cfg config.BenchmarkConfig, workflowID string) (clockSample, error) {
+	sent := time.Now()
+	run, err := generator.ExecuteWorkflow(ctx, c, client.StartWorkflowOptions{
+		ID:        workflowID,
+		TaskQueue: DefaultTaskQueue,
+	}, cfg)
+	received := time.Now()
+	if err != nil {
+		return clockSample{}, err
+	}
+	defer func() {
+		// The canary may already have completed; only its start event matters
+		_ = c.TerminateWorkflow(context.WithoutCancel(ctx), workflowID, run.GetRunID(), "Benchmark clock skew canary")
+	}()
+
+	history := c.GetWorkflowHistory(ctx, workflowID, run.GetRunID(), false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	if !history.HasNext() {
+		return clockSample{}, fmt.Errorf("canary %s has no history", workflowID)
+	}
+	event, err := history.Next()
+	if err != nil {
+		return clockSample{}, err
+	}
+	return clockSample{sent: sent, received: received, server: event.GetEventTime().AsTime()}, nil
+}
+
+// estimateClockSkew estimates the server clock's offset from the client's
+// using the sample with the shortest round trip, assuming the server stamped
+// the event halfway through it; the offset is accurate to half that round trip.
+func estimateClockSkew(samples []clockSample) *results.ClockSkew {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	best := samples[0]
+	for _, s := range samples[1:] {
+		if s.received.Sub(s.sent) < best.received.Sub(best.sent) {
+			best = s
+		}
+	}
+	roundTrip := best.received.Sub(best.sent)
+	midpoint := best.sent.Add(roundTrip / 2)
+	return &results.ClockSkew{
+		Samples:       len(samples),
+		OffsetMs:      float64(best.server.Sub(midpoint).Microseconds()) / 1000,
+		UncertaintyMs: float64((roundTrip / 2).Microseconds()) / 1000,
+	}
+}
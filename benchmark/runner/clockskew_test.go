@@ -0,0 +1,25 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateClockSkew(t *testing.T) {
+	require.Nil(t, estimateClockSkew(nil))
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	samples := []clockSample{
+		// Slow round trip: 200ms, server stamped 300ms after the client midpoint
+		{sent: base, received: base.Add(200 * time.Millisecond), server: base.Add(400 * time.Millisecond)},
+		// Fast round trip: 20ms, server 250ms ahead
+		{sent: base.Add(time.Second), received: base.Add(time.Second + 20*time.Millisecond), server: base.Add(time.Second + 260*time.Millisecond)},
+	}
+
+	skew := estimateClockSkew(samples)
+	require.Equal(t, 2, skew.Samples)
+	require.InDelta(t, 250.0, skew.OffsetMs, 0.001)
+	require.InDelta(t, 10.0, skew.UncertaintyMs, 0.001)
+}
@@ -0,0 +1,108 @@
+package runner
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+const (
+	// clusterSnapshotMaxNamespaces caps the namespaces a snapshot reads; the
+	// snapshot is marked incomplete beyond it
+	clusterSnapshotMaxNamespaces = 100
+
+	// clusterSnapshotTimeout bounds a whole snapshot
+	clusterSnapshotTimeout = 30 * time.Second
+)
+
+// takeClusterSnapshot counts the namespaces, the open and closed workflows in
+// each and the backlog of the benchmark task queue in each. Counts that
+// cannot be read are left out and the snapshot is marked incomplete.
+func takeClusterSnapshot(ctx context.Context, svc workflowservice.WorkflowServiceClient) results.ClusterSnapshot {
+	ctx, cancel := context.WithTimeout(ctx, clusterSnapshotTimeout)
+	defer cancel()
+
+	snapshot := results.ClusterSnapshot{Time: time.Now()}
+	var namespaces []string
+	var pageToken []byte
+	for {
+		resp, err := svc.ListNamespaces(ctx, &workflowservice.ListNamespacesRequest{NextPageToken: pageToken})
+		if err != nil {
+			slog.Warn("Failed to list namespaces for cluster snapshot", "error", err)
+			snapshot.Incomplete = true
+			break
+		}
+		for _, ns := range resp.GetNamespaces() {
+			namespaces = append(namespaces, ns.GetNamespaceInfo().GetName())
+		}
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	snapshot.Namespaces = len(namespaces)
+	if len(namespaces) > clusterSnapshotMaxNamespaces {
+		namespaces = namespaces[:clusterSnapshotMaxNamespaces]
+		snapshot.Incomplete = true
+	}
+
+	for _, namespace := range namespaces {
+		open, openErr := countWorkflows(ctx, svc, namespace, "ExecutionStatus = 'Running'")
+		closed, closedErr := countWorkflows(ctx, svc, namespace, "ExecutionStatus != 'Running'")
+		workflowBacklog, activityBacklog, backlogErr := taskQueueBacklog(ctx, svc, namespace)
+		snapshot.OpenWorkflows += open
+		snapshot.ClosedWorkflows += closed
+		snapshot.WorkflowTaskBacklog += workflowBacklog
+		snapshot.ActivityTaskBacklog += activityBacklog
+		if openErr != nil || closedErr != nil || backlogErr != nil {
+			snapshot.Incomplete = true
+		}
+	}
+	if snapshot.Incomplete {
+		slog.Warn("Cluster snapshot is incomplete", "namespaces", snapshot.Namespaces)
+	}
+	return snapshot
+}
+
+// countWorkflows counts the workflows in namespace matching query.
+func countWorkflows(ctx context.Context, svc workflowservice.WorkflowServiceClient, namespace, query string) (int64, error) {
+	resp, err := svc.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     query,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetCount(), nil
+}
+
+// taskQueueBacklog returns the approximate workflow and activity task backlog
+// of the benchmark task queue in namespace.
+func taskQueueBacklog(ctx context.Context, svc workflowservice.WorkflowServiceClient, namespace string) (workflow, activity int64, err error) {
+	resp, err := svc.DescribeTaskQueue(ctx, &workflowservice.DescribeTaskQueueRequest{
+		Namespace:   namespace,
+		TaskQueue:   &taskqueuepb.TaskQueue{Name: DefaultTaskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		ApiMode:     enumspb.DESCRIBE_TASK_QUEUE_MODE_ENHANCED,
+		ReportStats: true,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, version := range resp.GetVersionsInfo() {
+		for taskType, info := range version.GetTypesInfo() {
+			switch enumspb.TaskQueueType(taskType) {
+			case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+				workflow += info.GetStats().GetApproximateBacklogCount()
+			case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+				activity += info.GetStats().GetApproximateBacklogCount()
+			}
+		}
+	}
+	return workflow, activity, nil
+}
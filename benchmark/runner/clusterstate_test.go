@@ -0,0 +1,64 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/namespace/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeClusterService lists namespaces "a" and "b". Each holds 3 open and 7
+// closed workflows with a backlog of 2 workflow and 5 activity tasks, except
+// that counts in "b" fail.
+type fakeClusterService struct {
+	workflowservice.WorkflowServiceClient
+}
+
+func (fakeClusterService) ListNamespaces(_ context.Context, req *workflowservice.ListNamespacesRequest, _ ...grpc.CallOption) (*workflowservice.ListNamespacesResponse, error) {
+	if len(req.GetNextPageToken()) == 0 {
+		return &workflowservice.ListNamespacesResponse{
+			Namespaces:    []*workflowservice.DescribeNamespaceResponse{{NamespaceInfo: &namespace.NamespaceInfo{Name: "a"}}},
+			NextPageToken: []byte("next"),
+		}, nil
+	}
+	return &workflowservice.ListNamespacesResponse{
+		Namespaces: []*workflowservice.DescribeNamespaceResponse{{NamespaceInfo: &namespace.NamespaceInfo{Name: "b"}}},
+	}, nil
+}
+
+func (fakeClusterService) CountWorkflowExecutions(_ context.Context, req *workflowservice.CountWorkflowExecutionsRequest, _ ...grpc.CallOption) (*workflowservice.CountWorkflowExecutionsResponse, error) {
+	if req.GetNamespace() == "b" {
+		return nil, errors.New("unavailable")
+	}
+	if req.GetQuery() == "ExecutionStatus = 'Running'" {
+		return &workflowservice.CountWorkflowExecutionsResponse{Count: 3}, nil
+	}
+	return &workflowservice.CountWorkflowExecutionsResponse{Count: 7}, nil
+}
+
+func (fakeClusterService) DescribeTaskQueue(_ context.Context, _ *workflowservice.DescribeTaskQueueRequest, _ ...grpc.CallOption) (*workflowservice.DescribeTaskQueueResponse, error) {
+	return &workflowservice.DescribeTaskQueueResponse{
+		VersionsInfo: map[string]*taskqueuepb.TaskQueueVersionInfo{"": {
+			TypesInfo: map[int32]*taskqueuepb.TaskQueueTypeInfo{
+				int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW): {Stats: &taskqueuepb.TaskQueueStats{ApproximateBacklogCount: 2}},
+				int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {Stats: &taskqueuepb.TaskQueueStats{ApproximateBacklogCount: 5}},
+			},
+		}},
+	}, nil
+}
+
+func TestTakeClusterSnapshot(t *testing.T) {
+	snapshot := takeClusterSnapshot(context.Background(), fakeClusterService{})
+	require.Equal(t, 2, snapshot.Namespaces)
+	require.Equal(t, int64(3), snapshot.OpenWorkflows)
+	require.Equal(t, int64(7), snapshot.ClosedWorkflows)
+	require.Equal(t, int64(4), snapshot.WorkflowTaskBacklog)
+	require.Equal(t, int64(10), snapshot.ActivityTaskBacklog)
+	require.True(t, snapshot.Incomplete)
+}
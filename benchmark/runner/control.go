@@ -0,0 +1,130 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+)
+
+// LoadControl lets operators pause and resume workflow generation and replace the
+// pass/fail thresholds while a benchmark is running. It is safe for concurrent use
+// and may be shared across runs (e.g. in daemon mode): a pause persists into
+// subsequent iterations until Resume is called.
+type LoadControl struct {
+	mu         sync.Mutex
+	paused     bool
+	held       bool // rate held by the backpressure monitor
+	generator  generator.WorkflowGenerator
+	thresholds *thresholdOverride
+}
+
+// thresholdOverride holds thresholds set at runtime.
+type thresholdOverride struct {
+	maxP99Latency time.Duration
+	minThroughput float64
+}
+
+// NewLoadControl creates a LoadControl with generation running.
+func NewLoadControl() *LoadControl {
+	return &LoadControl{}
+}
+
+// Pause stops workflow generation. In-flight workflows continue to completion.
+func (c *LoadControl) Pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.paused = true
+	if c.generator != nil {
+		c.generator.Pause()
+	} else {
+		slog.Info("Load paused; generation will start paused")
+	}
+}
+
+// Resume continues workflow generation.
+func (c *LoadControl) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.paused = false
+	if c.generator != nil {
+		c.generator.Resume()
+	} else {
+		slog.Info("Load resumed")
+	}
+}
+
+// Paused reports whether generation is currently paused.
+func (c *LoadControl) Paused() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.paused
+}
+
+// setHeld holds or releases the generator's rate on behalf of the backpressure monitor.
+func (c *LoadControl) setHeld(held bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.held = held
+	if c.generator == nil {
+		return
+	}
+	if held {
+		c.generator.Hold()
+	} else {
+		c.generator.Release()
+	}
+}
+
+// SetThresholds replaces the pass/fail thresholds used when the current and
+// subsequent runs are evaluated.
+func (c *LoadControl) SetThresholds(maxP99Latency time.Duration, minThroughput float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.thresholds = &thresholdOverride{maxP99Latency: maxP99Latency, minThroughput: minThroughput}
+	slog.Info("Thresholds updated", "max_p99_latency", maxP99Latency, "min_throughput", minThroughput)
+}
+
+// ApplyThresholds returns cfg with any runtime threshold override applied.
+func (c *LoadControl) ApplyThresholds(cfg config.BenchmarkConfig) config.BenchmarkConfig {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.thresholds != nil {
+		cfg.MaxP99Latency = c.thresholds.maxP99Latency
+		cfg.MinThroughput = c.thresholds.minThroughput
+	}
+	return cfg
+}
+
+// attach makes gen the generator controlled by Pause and Resume, applying the
+// current pause and hold state to it.
+func (c *LoadControl) attach(gen generator.WorkflowGenerator) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generator = gen
+	if c.paused {
+		gen.Pause()
+	}
+	if c.held {
+		gen.Hold()
+	}
+}
+
+// detach releases gen once its iteration has finished.
+func (c *LoadControl) detach(gen generator.WorkflowGenerator) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generator == gen {
+		c.generator = nil
+	}
+}
@@ -0,0 +1,83 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+)
+
+// stubGenerator records pause and hold state for LoadControl tests.
+type stubGenerator struct {
+	paused bool
+	held   bool
+}
+
+func (g *stubGenerator) Start(context.Context) error { return nil }
+func (g *stubGenerator) Stop() error                 { return nil }
+func (g *stubGenerator) Stats() generator.GeneratorStats {
+	return generator.GeneratorStats{Paused: g.paused}
+}
+func (g *stubGenerator) Wait(context.Context) error { return nil }
+func (g *stubGenerator) Pause()                     { g.paused = true }
+func (g *stubGenerator) Resume()                    { g.paused = false }
+func (g *stubGenerator) Hold()                      { g.held = true }
+func (g *stubGenerator) Release()                   { g.held = false }
+
+func TestLoadControl_PauseResume(t *testing.T) {
+	control := NewLoadControl()
+	gen := &stubGenerator{}
+
+	control.attach(gen)
+	control.Pause()
+	require.True(t, gen.paused)
+	require.True(t, control.Paused())
+
+	control.Resume()
+	require.False(t, gen.paused)
+	require.False(t, control.Paused())
+}
+
+func TestLoadControl_PausePersistsToNextGenerator(t *testing.T) {
+	control := NewLoadControl()
+	first := &stubGenerator{}
+	control.attach(first)
+	control.Pause()
+	control.detach(first)
+
+	second := &stubGenerator{}
+	control.attach(second)
+	require.True(t, second.paused)
+}
+
+func TestLoadControl_ApplyThresholds(t *testing.T) {
+	control := NewLoadControl()
+	cfg := config.DefaultConfig()
+
+	require.Equal(t, cfg, control.ApplyThresholds(cfg))
+
+	control.SetThresholds(2*time.Second, 75)
+	applied := control.ApplyThresholds(cfg)
+	require.Equal(t, 2*time.Second, applied.MaxP99Latency)
+	require.Equal(t, 75.0, applied.MinThroughput)
+}
+
+func TestLoadControl_HoldAppliesToAttachedGenerators(t *testing.T) {
+	control := NewLoadControl()
+	first := &stubGenerator{}
+	control.attach(first)
+	control.setHeld(true)
+	require.True(t, first.held)
+	control.detach(first)
+
+	second := &stubGenerator{}
+	control.attach(second)
+	require.True(t, second.held)
+
+	control.setHeld(false)
+	require.False(t, second.held)
+}
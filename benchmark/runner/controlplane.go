@@ -0,0 +1,89 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"go.temporal.io/sdk/client"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/cleanup"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// controlPlane carries the runner's control operations (clock skew canaries,
+// history size describes, history integrity reads, cluster snapshots and
+// stuck workflow probes) so they can be kept off the connection that carries
+// the measured workload.
+type controlPlane struct {
+	client    client.Client    // Dedicated rate-limited client, or the runner's own client
+	cleaner   *cleanup.Cleaner // Stuck workflow probes over client
+	namespace string           // Namespace for control workflows
+	dedicated bool
+}
+
+// newControlPlane returns the control plane for cfg. With a control RPS it
+// dials a dedicated client limited to that rate, bound to the control
+// namespace (created if missing) or the run namespace; otherwise control
+// operations share the runner's client.
+func (r *runner) newControlPlane(ctx context.Context, cfg config.BenchmarkConfig, namespace string) (*controlPlane, error) {
+	if cfg.ControlRPS <= 0 {
+		return &controlPlane{client: r.client, cleaner: r.cleaner, namespace: namespace}, nil
+	}
+
+	controlNamespace := namespace
+	if cfg.ControlNamespace != "" {
+		controlNamespace = cfg.ControlNamespace
+		if err := r.ensureNamespace(ctx, controlNamespace, nil); err != nil {
+			return nil, results.NewRunError(results.CategoryNamespace, results.PhaseSetup, fmt.Errorf("failed to create control namespace %s: %w", controlNamespace, err))
+		}
+	}
+	if r.hostPort == "" {
+		return nil, results.NewRunError(results.CategoryConfig, results.PhaseSetup, fmt.Errorf("hostPort not configured - use WithHostPort option when creating runner"))
+	}
+
+	limiter := rate.NewLimiter(rate.Limit(cfg.ControlRPS), max(1, int(cfg.ControlRPS)))
+	opts := client.Options{
+		HostPort:  r.hostPort,
+		Namespace: controlNamespace,
+		ConnectionOptions: client.ConnectionOptions{
+			DialOptions: []grpc.DialOption{grpc.WithChainUnaryInterceptor(rateLimitInterceptor(limiter))},
+		},
+	}
+	r.dialer.Apply(&opts)
+	if err := r.applyAuth(&opts); err != nil {
+		return nil, err
+	}
+	c, err := client.Dial(opts)
+	if err != nil {
+		return nil, results.NewRunError(results.CategoryConnection, results.PhaseSetup, fmt.Errorf("failed to create control client: %w", err))
+	}
+
+	slog.Info("Control operations use a dedicated client", "namespace", controlNamespace, "rps", cfg.ControlRPS)
+	return &controlPlane{
+		client:    c,
+		cleaner:   cleanup.NewCleaner(c, cleanup.WithLimits(r.cleanupLimits)),
+		namespace: controlNamespace,
+		dedicated: true,
+	}, nil
+}
+
+// close closes the dedicated client, if any.
+func (p *controlPlane) close() {
+	if p.dedicated {
+		p.client.Close()
+	}
+}
+
+// rateLimitInterceptor holds each unary call until limiter allows it.
+func rateLimitInterceptor(limiter *rate.Limiter) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
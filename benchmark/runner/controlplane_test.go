@@ -0,0 +1,34 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	interceptor := rateLimitInterceptor(rate.NewLimiter(20, 1))
+	var calls int
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		calls++
+		return nil
+	}
+
+	// The first call uses the burst; the next two wait 50ms each
+	start := time.Now()
+	for range 3 {
+		require.NoError(t, interceptor(context.Background(), "/Describe", nil, nil, nil, invoker))
+	}
+	require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	require.Equal(t, 3, calls)
+
+	// A call whose context ends while waiting is never sent
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, interceptor(ctx, "/Describe", nil, nil, nil, invoker))
+	require.Equal(t, 3, calls)
+}
@@ -0,0 +1,31 @@
+package runner
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// dashboardMargin widens the snapshot window on both sides, so the ramp-up
+// and the drain are shown against the load before and after the run.
+const dashboardMargin = time.Minute
+
+// snapshotDashboard snapshots cfg.GrafanaDashboard over the run of result,
+// returning nil if snapshots are disabled or the snapshot fails. A failed
+// snapshot is logged and never fails the run.
+func (r *runner) snapshotDashboard(ctx context.Context, cfg config.BenchmarkConfig, namespace string, result *BenchmarkResult) *results.GrafanaSnapshot {
+	if r.grafana == nil {
+		return nil
+	}
+	snapshot, err := r.grafana.Snapshot(ctx, cfg.GrafanaDashboard, "Benchmark "+namespace,
+		result.StartTime.Add(-dashboardMargin), result.EndTime.Add(dashboardMargin), cfg.GrafanaSnapshotExpiry)
+	if err != nil {
+		slog.Warn("Failed to capture Grafana snapshot", "dashboard", cfg.GrafanaDashboard, "error", err)
+		return nil
+	}
+	slog.Info("Captured Grafana snapshot", "url", snapshot.URL, "dashboard_url", snapshot.DashboardURL)
+	return &results.GrafanaSnapshot{URL: snapshot.URL, DashboardURL: snapshot.DashboardURL}
+}
@@ -0,0 +1,177 @@
+package runner
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+const (
+	// drainPollInterval is how often an adaptive drain samples the backlog.
+	drainPollInterval = 5 * time.Second
+
+	// drainInitialTimeout is an adaptive drain's deadline until workflows are
+	// seen finishing.
+	drainInitialTimeout = 60 * time.Second
+
+	// drainHeadroom scales the time the remaining backlog needs at the recent
+	// completion rate when extending an adaptive drain's deadline.
+	drainHeadroom = 2.0
+)
+
+// drainPolicy decides how long to wait for in-flight workflows once
+// generation stops.
+type drainPolicy struct {
+	fixed   time.Duration // Fixed timeout (0 = adaptive)
+	initial time.Duration // Adaptive deadline before any progress
+	max     time.Duration // Upper bound on the adaptive deadline
+	stall   time.Duration // Abort an adaptive drain after this long without progress (0 = never)
+	poll    time.Duration
+}
+
+// newDrainPolicy returns the drain policy for cfg: the completion timeout if
+// one is configured, otherwise an adaptive drain.
+func newDrainPolicy(cfg config.BenchmarkConfig) drainPolicy {
+	p := drainPolicy{
+		fixed:   cfg.CompletionTimeout,
+		initial: drainInitialTimeout,
+		max:     cfg.DrainMaxTimeout,
+		stall:   cfg.DrainStallTimeout,
+		poll:    drainPollInterval,
+	}
+	if p.max <= 0 {
+		p.max = config.DefaultDrainMaxTimeout
+	}
+	return p
+}
+
+// drain waits for the generators' in-flight workflows and reports how the
+// wait went. A fixed policy waits up to its timeout. An adaptive policy
+// starts with a short deadline and, whenever workflows finish, extends it to
+// cover the remaining backlog at the recent completion rate (up to its max);
+// it gives up early once no workflow has finished for the stall timeout.
+func drain(ctx context.Context, gens []generator.WorkflowGenerator, p drainPolicy) *results.DrainStats {
+	start := time.Now()
+	atStart := backlog(gens)
+
+	adaptive := p.fixed <= 0
+	deadline := p.fixed
+	var waitCtx context.Context
+	var cancel context.CancelFunc
+	var ticks <-chan time.Time
+	if adaptive {
+		deadline = min(p.initial, p.max)
+		waitCtx, cancel = context.WithCancel(ctx)
+		ticker := time.NewTicker(p.poll)
+		defer ticker.Stop()
+		ticks = ticker.C
+	} else {
+		waitCtx, cancel = context.WithTimeout(ctx, p.fixed)
+	}
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		for _, gen := range gens {
+			if err := gen.Wait(waitCtx); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+
+	var outcome string
+	lastProgress, prevTime, prevFinished := start, start, atStart.finished()
+	for outcome == "" {
+		select {
+		case err := <-done:
+			switch {
+			case err == nil:
+				outcome = results.DrainCompleted
+			case ctx.Err() != nil:
+				outcome = results.DrainCancelled
+			default:
+				outcome = results.DrainDeadline
+			}
+		case now := <-ticks:
+			current := backlog(gens)
+			finished := current.finished()
+			if finished > prevFinished {
+				lastProgress = now
+				rate := float64(finished-prevFinished) / now.Sub(prevTime).Seconds()
+				estimate := time.Duration(drainHeadroom * float64(current.inFlight) / rate * float64(time.Second))
+				deadline = min(max(deadline, now.Sub(start)+estimate), p.max)
+			}
+			prevTime, prevFinished = now, finished
+
+			switch {
+			case now.Sub(start) >= deadline:
+				outcome = results.DrainDeadline
+			case p.stall > 0 && now.Sub(lastProgress) >= p.stall:
+				outcome = results.DrainStalled
+			default:
+				continue
+			}
+			cancel()
+			<-done
+		}
+	}
+
+	elapsed := time.Since(start)
+	atEnd := backlog(gens)
+	stats := &results.DrainStats{
+		Outcome:         outcome,
+		Adaptive:        adaptive,
+		Duration:        elapsed.Round(time.Millisecond).String(),
+		Deadline:        deadline.String(),
+		InFlightAtStart: atStart.inFlight,
+		Completed:       atEnd.completed - atStart.completed,
+		Failed:          atEnd.failed - atStart.failed,
+		Remaining:       atEnd.inFlight,
+	}
+	if elapsed > 0 {
+		stats.CompletionRate = float64(atEnd.finished()-atStart.finished()) / elapsed.Seconds()
+	}
+
+	if outcome == results.DrainCompleted {
+		slog.Info("Drain completed", "duration", stats.Duration, "in_flight_at_start", atStart.inFlight, "completed", stats.Completed, "failed", stats.Failed)
+	} else {
+		slog.Warn("Some workflows may not have completed",
+			"outcome", outcome,
+			"duration", stats.Duration,
+			"deadline", stats.Deadline,
+			"remaining", atEnd.inFlight)
+	}
+	return stats
+}
+
+// drainCounts sums the generators' workflow counts.
+type drainCounts struct {
+	started   int64
+	completed int64
+	failed    int64
+	inFlight  int64
+}
+
+// finished counts the workflows no longer in flight, however they ended.
+func (c drainCounts) finished() int64 {
+	return c.started - c.inFlight
+}
+
+// backlog sums the workflow counts of gens.
+func backlog(gens []generator.WorkflowGenerator) drainCounts {
+	var c drainCounts
+	for _, gen := range gens {
+		stats := gen.Stats()
+		c.started += stats.WorkflowsStarted
+		c.completed += stats.WorkflowsCompleted
+		c.failed += stats.WorkflowsFailed
+		c.inFlight += stats.InFlight
+	}
+	return c
+}
@@ -0,0 +1,88 @@
+package runner
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// backlogGenerator reports a backlog that finishes one workflow per finishEvery
+// until stallAt workflows remain.
+type backlogGenerator struct {
+	stubGenerator
+	started     int64
+	inFlight    atomic.Int64
+	finishEvery time.Duration
+	stallAt     int64
+}
+
+func newBacklogGenerator(inFlight, stallAt int64, finishEvery time.Duration) *backlogGenerator {
+	g := &backlogGenerator{started: inFlight, finishEvery: finishEvery, stallAt: stallAt}
+	g.inFlight.Store(inFlight)
+	return g
+}
+
+func (g *backlogGenerator) Stats() generator.GeneratorStats {
+	inFlight := g.inFlight.Load()
+	return generator.GeneratorStats{WorkflowsStarted: g.started, WorkflowsCompleted: g.started - inFlight, InFlight: inFlight}
+}
+
+func (g *backlogGenerator) Wait(ctx context.Context) error {
+	ticker := time.NewTicker(g.finishEvery)
+	defer ticker.Stop()
+	for g.inFlight.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if g.inFlight.Load() > g.stallAt {
+				g.inFlight.Add(-1)
+			}
+		}
+	}
+	return nil
+}
+
+func TestDrain_ExtendsWhileProgressing(t *testing.T) {
+	// 20 workflows at one per 5ms take ~100ms, well past the initial deadline
+	gen := newBacklogGenerator(20, 0, 5*time.Millisecond)
+	policy := drainPolicy{initial: 20 * time.Millisecond, max: 5 * time.Second, stall: time.Second, poll: 10 * time.Millisecond}
+
+	stats := drain(context.Background(), []generator.WorkflowGenerator{gen}, policy)
+	require.Equal(t, results.DrainCompleted, stats.Outcome)
+	require.True(t, stats.Adaptive)
+	require.Equal(t, int64(20), stats.InFlightAtStart)
+	require.Equal(t, int64(20), stats.Completed)
+	require.Zero(t, stats.Remaining)
+	require.Positive(t, stats.CompletionRate)
+}
+
+func TestDrain_AbortsWhenStalled(t *testing.T) {
+	gen := newBacklogGenerator(10, 5, time.Millisecond)
+	policy := drainPolicy{initial: 5 * time.Second, max: 5 * time.Second, stall: 50 * time.Millisecond, poll: 10 * time.Millisecond}
+
+	start := time.Now()
+	stats := drain(context.Background(), []generator.WorkflowGenerator{gen}, policy)
+	require.Equal(t, results.DrainStalled, stats.Outcome)
+	require.Equal(t, int64(5), stats.Remaining)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestDrain_FixedTimeout(t *testing.T) {
+	gen := newBacklogGenerator(10, 5, time.Millisecond)
+	stats := drain(context.Background(), []generator.WorkflowGenerator{gen}, drainPolicy{fixed: 30 * time.Millisecond})
+	require.Equal(t, results.DrainDeadline, stats.Outcome)
+	require.False(t, stats.Adaptive)
+	require.Equal(t, "30ms", stats.Deadline)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stats = drain(ctx, []generator.WorkflowGenerator{newBacklogGenerator(1, 1, time.Millisecond)}, drainPolicy{fixed: time.Second})
+	require.Equal(t, results.DrainCancelled, stats.Outcome)
+}
@@ -0,0 +1,265 @@
+package runner
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// Event types of a recorded run.
+const (
+	EventRun      = "run"      // A benchmark run started
+	EventWorkflow = "workflow" // A workflow finished (or failed to start)
+	EventDrain    = "drain"    // Generation stopped and the drain began
+)
+
+// Event is one line of a recorded run's event log (newline-delimited JSON).
+type Event struct {
+	Type string    `json:"type"`
+	Time time.Time `json:"time"` // When the run started, the workflow was submitted or the drain began
+
+	// Run events
+	Namespace string `json:"namespace,omitempty"`
+
+	// Workflow events; the workflow finished at Time + Latency
+	WorkflowType   string        `json:"workflowType,omitempty"`
+	WorkflowID     string        `json:"workflowId,omitempty"`
+	RunID          string        `json:"runId,omitempty"`
+	Latency        time.Duration `json:"latencyNs,omitempty"`
+	Error          string        `json:"error,omitempty"`
+	AlreadyStarted bool          `json:"alreadyStarted,omitempty"`
+	Canceled       bool          `json:"canceled,omitempty"`
+}
+
+// EventRecorder writes a run's raw events to a file for Replay.
+type EventRecorder struct {
+	mu       sync.Mutex
+	file     *os.File
+	w        *bufio.Writer
+	enc      *json.Encoder
+	written  countingWriter
+	runStart int64 // Offset of the current run's first event
+	failed   bool
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// NewEventRecorder creates (or truncates) the event log at path.
+func NewEventRecorder(path string) (*EventRecorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event log: %w", err)
+	}
+	r := &EventRecorder{file: f, w: bufio.NewWriter(f)}
+	r.written.w = r.w
+	r.enc = json.NewEncoder(&r.written)
+	return r, nil
+}
+
+// Close flushes and closes the event log.
+func (r *EventRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return errors.Join(r.w.Flush(), r.file.Close())
+}
+
+// record appends e, logging the first write failure and dropping later events.
+// It is a no-op on a nil recorder.
+func (r *EventRecorder) record(e Event) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failed {
+		return
+	}
+	if err := r.enc.Encode(e); err != nil {
+		r.failed = true
+		slog.Warn("Failed to record event; recording stopped", "file", r.file.Name(), "error", err)
+	}
+}
+
+func (r *EventRecorder) recordRun(namespace string) {
+	if r != nil {
+		r.mu.Lock()
+		r.runStart = r.written.n
+		r.mu.Unlock()
+	}
+	r.record(Event{Type: EventRun, Time: time.Now(), Namespace: namespace})
+}
+
+// runEvents returns the current run's events recorded so far, as JSON lines.
+func (r *EventRecorder) runEvents() ([]byte, error) {
+	if r == nil {
+		return nil, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.w.Flush(); err != nil {
+		return nil, err
+	}
+	data := make([]byte, r.written.n-r.runStart)
+	if _, err := r.file.ReadAt(data, r.runStart); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *EventRecorder) recordDrain() {
+	r.record(Event{Type: EventDrain, Time: time.Now()})
+}
+
+func (r *EventRecorder) recordWorkflow(workflowType, workflowID, runID string, duration time.Duration, err error) {
+	e := Event{
+		Type:         EventWorkflow,
+		Time:         time.Now().Add(-duration),
+		WorkflowType: workflowType,
+		WorkflowID:   workflowID,
+		RunID:        runID,
+		Latency:      duration,
+	}
+	if err != nil {
+		e.Error = err.Error()
+		e.AlreadyStarted = errors.Is(err, generator.ErrAlreadyStarted)
+		e.Canceled = errors.Is(err, generator.ErrCanceled)
+	}
+	r.record(e)
+}
+
+// ReplayedRun is the result of replaying one recorded run.
+type ReplayedRun struct {
+	Namespace string
+	Result    *BenchmarkResult
+}
+
+// Replay feeds each run of a recorded event log through the metrics and
+// results pipeline in the run's own timestamps, evaluating cfg's thresholds
+// (and the baseline set with WithBaseline) as a live run would. Workflows in
+// flight when recording stopped were never recorded, and iterations and
+// scenario phases replay as a single measurement window.
+func Replay(events io.Reader, cfg config.BenchmarkConfig, opts ...RunnerOption) ([]ReplayedRun, error) {
+	var runs [][]Event
+	dec := json.NewDecoder(events)
+	for {
+		var e Event
+		if err := dec.Decode(&e); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read event log: %w", err)
+		}
+		switch {
+		case e.Type == EventRun:
+			runs = append(runs, []Event{e})
+		case len(runs) == 0:
+			return nil, fmt.Errorf("event log does not start with a %q event", EventRun)
+		default:
+			runs[len(runs)-1] = append(runs[len(runs)-1], e)
+		}
+	}
+
+	replayed := make([]ReplayedRun, 0, len(runs))
+	for _, run := range runs {
+		replayed = append(replayed, ReplayedRun{Namespace: run[0].Namespace, Result: replayRun(run, cfg, opts)})
+	}
+	return replayed, nil
+}
+
+// replayRun replays one run, whose first event is its EventRun.
+func replayRun(events []Event, cfg config.BenchmarkConfig, opts []RunnerOption) *BenchmarkResult {
+	now := events[0].Time
+	r := &runner{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	r.events = nil
+	r.stream = nil
+	r.metricsHandler = metrics.NewHandler(
+		metrics.WithTimeSource(func() time.Time { return now }),
+		metrics.WithLatencyMemoryBudget(int64(cfg.LatencyMemoryBudgetMB)<<20),
+	)
+	r.latencyBudget = metrics.SharedLatencyBudget(r.metricsHandler, int64(cfg.LatencyMemoryBudgetMB)<<20)
+	r.typeLatency = newWorkflowTypeTracker(cfg, r.scenario, r.latencyBudget)
+
+	// Workflows take effect when they finished, not when they were submitted
+	at := func(e Event) time.Time {
+		return e.Time.Add(e.Latency)
+	}
+	timeline := slices.Clone(events[1:])
+	slices.SortStableFunc(timeline, func(a, b Event) int { return at(a).Compare(at(b)) })
+
+	result := &BenchmarkResult{
+		StartTime:      events[0].Time,
+		EndTime:        events[0].Time,
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+	for _, e := range timeline {
+		now = at(e)
+		result.EndTime = now
+		if e.Type == EventDrain {
+			metrics.StartDrain(r.metricsHandler)
+			continue
+		}
+		if e.Type != EventWorkflow {
+			continue
+		}
+
+		var err error
+		switch {
+		case e.AlreadyStarted:
+			err = fmt.Errorf("%w: %s", generator.ErrAlreadyStarted, e.Error)
+			result.AlreadyStarted++
+			if cfg.AlreadyStartedAsSuccess {
+				result.WorkflowsCompleted++
+			}
+		case e.Canceled:
+			err = fmt.Errorf("%w: %s", generator.ErrCanceled, e.Error)
+		case e.Error != "":
+			err = errors.New(e.Error)
+			result.WorkflowsFailed++
+		default:
+			result.WorkflowsCompleted++
+		}
+		result.WorkflowsStarted++
+		r.recordCompletion(cfg, e.WorkflowType, e.WorkflowID, e.RunID, e.Latency, err)
+	}
+
+	result.Duration = result.EndTime.Sub(result.StartTime)
+	percentiles := r.metricsHandler.GetLatencyPercentiles()
+	result.ActualRate = r.metricsHandler.GetThroughput()
+	result.LatencyP50 = percentiles.P50
+	result.LatencyP95 = percentiles.P95
+	result.LatencyP99 = percentiles.P99
+	result.LatencyMax = percentiles.Max
+	result.LatencyApproximate = percentiles.Approximate
+	result.TypeLatency = r.typeLatency.result()
+
+	results.EvaluateThresholdsWithConfig(result, cfg)
+	results.EvaluateBaselineThresholds(result, r.baseline, cfg.BaselineMaxP99Percent, cfg.BaselineMinThroughputPercent)
+	results.EvaluateCustomThresholds(context.Background(), result, r.evaluators)
+	return result
+}
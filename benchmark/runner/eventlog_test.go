@@ -0,0 +1,111 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+)
+
+func TestEventRecorder_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	rec, err := NewEventRecorder(path)
+	require.NoError(t, err)
+
+	rec.recordRun("benchmark-1")
+	rec.recordWorkflow("simple", "wf-1", "run-1", 100*time.Millisecond, nil)
+	rec.recordWorkflow("simple", "wf-2", "", 0, errors.New("start timeout"))
+	rec.recordWorkflow("simple", "wf-1", "run-1", 0, fmt.Errorf("%w: exists", generator.ErrAlreadyStarted))
+	rec.recordDrain()
+	require.NoError(t, rec.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	cfg := config.DefaultConfig()
+	cfg.MinThroughput = 0
+	runs, err := Replay(f, cfg)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	require.Equal(t, "benchmark-1", runs[0].Namespace)
+
+	result := runs[0].Result
+	require.EqualValues(t, 3, result.WorkflowsStarted)
+	require.EqualValues(t, 1, result.WorkflowsCompleted)
+	require.EqualValues(t, 1, result.WorkflowsFailed)
+	require.EqualValues(t, 1, result.AlreadyStarted)
+}
+
+func TestEventRecorder_RunEvents(t *testing.T) {
+	rec, err := NewEventRecorder(filepath.Join(t.TempDir(), "events.jsonl"))
+	require.NoError(t, err)
+	defer rec.Close()
+
+	rec.recordRun("benchmark-1")
+	rec.recordWorkflow("simple", "wf-1", "run-1", time.Millisecond, nil)
+	rec.recordRun("benchmark-2")
+	rec.recordWorkflow("simple", "wf-2", "run-2", time.Millisecond, nil)
+	rec.recordDrain()
+
+	data, err := rec.runEvents()
+	require.NoError(t, err)
+	runs, err := Replay(strings.NewReader(string(data)), config.DefaultConfig())
+	require.NoError(t, err)
+	require.Len(t, runs, 1, "only the current run")
+	require.Equal(t, "benchmark-2", runs[0].Namespace)
+	require.EqualValues(t, 1, runs[0].Result.WorkflowsCompleted)
+
+	data, err = (*EventRecorder)(nil).runEvents()
+	require.NoError(t, err)
+	require.Nil(t, data)
+}
+
+func TestReplay(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var log strings.Builder
+	line := func(format string, args ...any) {
+		fmt.Fprintf(&log, format+"\n", args...)
+	}
+	ts := func(d time.Duration) string { return start.Add(d).Format(time.RFC3339Nano) }
+
+	// 100 workflows submitted over 10s, each taking 50ms, then a drain
+	// during which one more finishes
+	line(`{"type":"run","time":%q,"namespace":"benchmark-1"}`, ts(0))
+	for i := range 100 {
+		line(`{"type":"workflow","time":%q,"workflowId":"wf-%d","latencyNs":%d}`, ts(time.Duration(i)*100*time.Millisecond), i, 50*time.Millisecond)
+	}
+	line(`{"type":"drain","time":%q}`, ts(10*time.Second))
+	line(`{"type":"workflow","time":%q,"workflowId":"late","latencyNs":%d,"error":"boom"}`, ts(9*time.Second), 2*time.Second)
+
+	cfg := config.DefaultConfig()
+	cfg.MinThroughput = 20
+	runs, err := Replay(strings.NewReader(log.String()), cfg)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+
+	result := runs[0].Result
+	require.Equal(t, start, result.StartTime)
+	require.Equal(t, start.Add(11*time.Second), result.EndTime)
+	require.EqualValues(t, 101, result.WorkflowsStarted)
+	require.EqualValues(t, 100, result.WorkflowsCompleted)
+	require.EqualValues(t, 1, result.WorkflowsFailed)
+	require.Equal(t, 10.0, result.ActualRate) // The drain's completion does not count
+	require.InDelta(t, 50, result.LatencyP50, 1)
+	require.InDelta(t, 2000, result.LatencyMax, 1)
+
+	// Thresholds are evaluated as in a live run
+	require.False(t, result.Passed)
+	require.Len(t, result.FailureReasons, 1)
+
+	_, err = Replay(strings.NewReader(`{"type":"drain","time":"2024-01-01T00:00:00Z"}`), cfg)
+	require.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package runner_test
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/runner"
+)
+
+// Example shows how another harness embeds the benchmark engine.
+func Example() {
+	ctx := context.Background()
+
+	cfg := config.DefaultConfig()
+	cfg.TemporalAddress = "temporal-frontend:7233"
+	cfg.WorkflowType = config.WorkflowTypeSimple
+	cfg.TargetRate = 50
+	cfg.Duration = 2 * time.Minute
+
+	c, err := client.Dial(client.Options{HostPort: cfg.TemporalAddress})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer c.Close()
+
+	r := runner.NewRunner(c,
+		runner.WithHostPort(cfg.TemporalAddress),
+		runner.WithMetricsHandler(metrics.NewHandler()),
+	)
+	result, err := r.Run(ctx, cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Cleanup(ctx, r.GetNamespace())
+
+	results.NewBenchmarkResultJSON(result, cfg, r.GetNamespace()).PrintSummary(os.Stdout)
+}
@@ -0,0 +1,116 @@
+package runner
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+// Counters of the process's execution recorder, exported by work role
+// processes so the generate role can aggregate them across the worker service.
+const (
+	ActivityExecutionsMetric          = "benchmark_activity_executions_total"
+	WorkflowRunsMetric                = "benchmark_workflow_runs_total"
+	DuplicateActivityExecutionsMetric = "benchmark_duplicate_activity_executions_total"
+	DuplicateWorkflowExecutionsMetric = "benchmark_duplicate_workflow_executions_total"
+)
+
+// RegisterExecutionMetrics exports the process's execution recorder counts
+// to reg.
+func RegisterExecutionMetrics(reg prometheus.Registerer) error {
+	counters := []struct {
+		name, help string
+		value      func(workflows.ExecutionCounts) int64
+	}{
+		{ActivityExecutionsMetric, "Recorded benchmark activity executions",
+			func(c workflows.ExecutionCounts) int64 { return c.Activities }},
+		{WorkflowRunsMetric, "Workflow runs the recorded activity executions belonged to",
+			func(c workflows.ExecutionCounts) int64 { return c.Workflows }},
+		{DuplicateActivityExecutionsMetric, "Activity executions that repeated an earlier execution",
+			func(c workflows.ExecutionCounts) int64 { return c.DuplicateActivities }},
+		{DuplicateWorkflowExecutionsMetric, "Workflow IDs whose activities executed for a second run",
+			func(c workflows.ExecutionCounts) int64 { return c.DuplicateWorkflows }},
+	}
+	for _, c := range counters {
+		value := c.value
+		counter := prometheus.NewCounterFunc(prometheus.CounterOpts{Name: c.name, Help: c.help}, func() float64 {
+			return float64(value(workflows.Executions()))
+		})
+		if err := reg.Register(counter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executionWindow counts duplicate activity and workflow executions over a
+// run. The embedded workers' counts are read from the process's execution
+// recorder at its start and end; the generate role, which runs no workers,
+// instead scrapes the counters the worker service exports from
+// cfg.WorkerMetricsURLs. A nil executionWindow reports nothing, e.g. for the
+// generate role without worker metrics.
+type executionWindow struct {
+	before workflows.ExecutionCounts
+
+	// Set when counting the worker service's executions
+	scraper        *metrics.ServerScraper
+	prefix         string
+	beforeSnapshot metrics.ServerSnapshot
+}
+
+// startExecutionWindow reads the execution counts at the start of a run.
+func startExecutionWindow(ctx context.Context, cfg config.BenchmarkConfig) *executionWindow {
+	if cfg.Role != config.RoleGenerate {
+		return &executionWindow{before: workflows.Executions()}
+	}
+	if len(cfg.WorkerMetricsURLs) == 0 {
+		return nil
+	}
+	scraper := metrics.NewServerScraper(cfg.WorkerMetricsURLs)
+	before, err := scraper.Scrape(ctx)
+	if err != nil {
+		slog.Warn("Worker metrics unavailable; duplicate executions are not reported", "error", err)
+		return nil
+	}
+	return &executionWindow{scraper: scraper, prefix: cfg.MetricsPrefix, beforeSnapshot: before}
+}
+
+// result returns the duplicate executions during the run, or nil if no
+// recorded activities were executed.
+func (w *executionWindow) result(ctx context.Context) *results.DuplicateExecutions {
+	if w == nil {
+		return nil
+	}
+	if w.scraper == nil {
+		after := workflows.Executions()
+		return results.NewDuplicateExecutions(
+			after.Activities-w.before.Activities,
+			after.Workflows-w.before.Workflows,
+			after.DuplicateActivities-w.before.DuplicateActivities,
+			after.DuplicateWorkflows-w.before.DuplicateWorkflows,
+		)
+	}
+
+	// Scrape even if the run was cancelled so partial results are complete
+	after, err := w.scraper.Scrape(context.WithoutCancel(ctx))
+	if err != nil {
+		slog.Warn("Failed to read worker metrics at end of run", "error", err)
+		return nil
+	}
+	delta := func(name string) int64 {
+		d, _ := metrics.CounterDelta(w.beforeSnapshot, after, w.prefix+name)
+		return int64(d)
+	}
+	return results.NewDuplicateExecutions(
+		delta(ActivityExecutionsMetric),
+		delta(WorkflowRunsMetric),
+		delta(DuplicateActivityExecutionsMetric),
+		delta(DuplicateWorkflowExecutionsMetric),
+	)
+}
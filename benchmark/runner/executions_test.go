@@ -0,0 +1,90 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+// fakeWorker serves the execution counters of a work role process.
+type fakeWorker struct {
+	mu     sync.Mutex
+	counts workflows.ExecutionCounts
+}
+
+func (f *fakeWorker) add(activities, runs, duplicateActivities, duplicateWorkflows int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.counts.Activities += activities
+	f.counts.Workflows += runs
+	f.counts.DuplicateActivities += duplicateActivities
+	f.counts.DuplicateWorkflows += duplicateWorkflows
+}
+
+func (f *fakeWorker) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fmt.Fprintf(w, `# TYPE bench_benchmark_activity_executions_total counter
+bench_benchmark_activity_executions_total %d
+# TYPE bench_benchmark_workflow_runs_total counter
+bench_benchmark_workflow_runs_total %d
+# TYPE bench_benchmark_duplicate_activity_executions_total counter
+bench_benchmark_duplicate_activity_executions_total %d
+# TYPE bench_benchmark_duplicate_workflow_executions_total counter
+bench_benchmark_duplicate_workflow_executions_total %d
+`, f.counts.Activities, f.counts.Workflows, f.counts.DuplicateActivities, f.counts.DuplicateWorkflows)
+}
+
+func TestRegisterExecutionMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	require.NoError(t, RegisterExecutionMetrics(reg))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	var names []string
+	for _, mf := range families {
+		names = append(names, mf.GetName())
+	}
+	require.ElementsMatch(t, []string{
+		ActivityExecutionsMetric, WorkflowRunsMetric,
+		DuplicateActivityExecutionsMetric, DuplicateWorkflowExecutionsMetric,
+	}, names)
+}
+
+func TestExecutionWindow_SumsWorkerService(t *testing.T) {
+	workers := []*fakeWorker{{}, {}}
+	var urls []string
+	for _, worker := range workers {
+		worker.add(500, 100, 3, 1) // Before the run
+		server := httptest.NewServer(worker)
+		defer server.Close()
+		urls = append(urls, server.URL)
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Role = config.RoleGenerate
+	cfg.WorkerMetricsURLs = urls
+	cfg.MetricsPrefix = "bench_"
+	window := startExecutionWindow(context.Background(), cfg)
+	require.NotNil(t, window)
+
+	workers[0].add(1000, 200, 1, 0)
+	workers[1].add(1000, 200, 0, 1)
+	require.Equal(t, results.NewDuplicateExecutions(2000, 400, 1, 1), window.result(context.Background()))
+}
+
+func TestExecutionWindow_GenerateWithoutWorkerMetrics(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Role = config.RoleGenerate
+	require.Nil(t, startExecutionWindow(context.Background(), cfg))
+}
@@ -0,0 +1,75 @@
+package runner
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// latencyHeatmap buckets the latencies of finished workflows by the window of
+// the run they finished in. Its methods are no-ops on a nil latencyHeatmap.
+type latencyHeatmap struct {
+	start     time.Time
+	window    time.Duration
+	boundsSec []float64 // Bucket upper bounds in seconds, ascending
+
+	mu      sync.Mutex
+	windows []results.HeatmapWindow
+}
+
+// newLatencyHeatmap returns a heatmap of window-wide windows from start with
+// the given bucket upper bounds in seconds, or nil if window is not positive.
+func newLatencyHeatmap(start time.Time, window time.Duration, boundsSec []float64) *latencyHeatmap {
+	if window <= 0 {
+		return nil
+	}
+	return &latencyHeatmap{start: start, window: window, boundsSec: boundsSec}
+}
+
+// record adds a workflow that finished at with latency, or that failed.
+func (h *latencyHeatmap) record(at time.Time, latency time.Duration, failed bool) {
+	if h == nil {
+		return
+	}
+	i := int(max(at.Sub(h.start), 0) / h.window)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for len(h.windows) <= i {
+		h.windows = append(h.windows, results.HeatmapWindow{
+			Start:  h.start.Add(time.Duration(len(h.windows)) * h.window),
+			Counts: make([]int64, len(h.boundsSec)+1),
+		})
+	}
+	if failed {
+		h.windows[i].Failed++
+		return
+	}
+	h.windows[i].Counts[sort.SearchFloat64s(h.boundsSec, latency.Seconds())]++
+}
+
+// result returns the heatmap so far, or nil for a nil heatmap.
+func (h *latencyHeatmap) result() *results.LatencyHeatmap {
+	if h == nil {
+		return nil
+	}
+	bucketsMs := make([]float64, len(h.boundsSec))
+	for i, b := range h.boundsSec {
+		bucketsMs[i] = b * 1000
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	windows := make([]results.HeatmapWindow, len(h.windows))
+	for i, w := range h.windows {
+		w.Counts = append([]int64(nil), w.Counts...)
+		windows[i] = w
+	}
+	return &results.LatencyHeatmap{
+		WindowSeconds: h.window.Seconds(),
+		BucketsMs:     bucketsMs,
+		Windows:       windows,
+	}
+}
@@ -0,0 +1,39 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyHeatmap(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	h := newLatencyHeatmap(start, 10*time.Second, []float64{0.1, 1})
+
+	h.record(start.Add(time.Second), 50*time.Millisecond, false)
+	h.record(start.Add(2*time.Second), 100*time.Millisecond, false) // Bounds are inclusive
+	h.record(start.Add(25*time.Second), 2*time.Second, false)
+	h.record(start.Add(29*time.Second), 0, true)
+
+	result := h.result()
+	require.Equal(t, 10.0, result.WindowSeconds)
+	require.Equal(t, []float64{100, 1000}, result.BucketsMs)
+	require.Len(t, result.Windows, 3, "empty windows are kept")
+	require.Equal(t, []int64{2, 0, 0}, result.Windows[0].Counts)
+	require.Equal(t, []int64{0, 0, 0}, result.Windows[1].Counts)
+	require.Equal(t, start.Add(20*time.Second), result.Windows[2].Start)
+	require.Equal(t, []int64{0, 0, 1}, result.Windows[2].Counts)
+	require.Equal(t, int64(1), result.Windows[2].Failed)
+
+	// The result is a copy
+	h.record(start, time.Millisecond, false)
+	require.Equal(t, []int64{2, 0, 0}, result.Windows[0].Counts)
+}
+
+func TestLatencyHeatmap_Disabled(t *testing.T) {
+	h := newLatencyHeatmap(time.Now(), 0, []float64{1})
+	require.Nil(t, h)
+	h.record(time.Now(), time.Second, false)
+	require.Nil(t, h.result())
+}
@@ -0,0 +1,114 @@
+package runner
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// historyDescribeTimeout bounds the describe call for each sampled workflow.
+const historyDescribeTimeout = 10 * time.Second
+
+// historySampler keeps a uniform random sample of the workflows completed
+// during the run, per workflow type, whose history sizes are read afterwards.
+type historySampler struct {
+	limit int
+
+	mu      sync.Mutex
+	seen    map[string]int
+	samples map[string][]*commonpb.WorkflowExecution
+}
+
+func newHistorySampler(limit int) *historySampler {
+	return &historySampler{
+		limit:   limit,
+		seen:    map[string]int{},
+		samples: map[string][]*commonpb.WorkflowExecution{},
+	}
+}
+
+// offer considers a completed workflow for the sample of its type (reservoir
+// sampling, so every completion is equally likely to be kept).
+func (s *historySampler) offer(workflowType, workflowID, runID string) {
+	execution := &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seen[workflowType]++
+	if len(s.samples[workflowType]) < s.limit {
+		s.samples[workflowType] = append(s.samples[workflowType], execution)
+	} else if i := rand.IntN(s.seen[workflowType]); i < s.limit {
+		s.samples[workflowType][i] = execution
+	}
+}
+
+// measure describes each sampled workflow and summarizes history length and
+// size per workflow type. Workflows that cannot be described are skipped.
+func (s *historySampler) measure(ctx context.Context, svc workflowservice.WorkflowServiceClient, namespace string) []results.HistorySize {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	types := make([]string, 0, len(s.samples))
+	for workflowType := range s.samples {
+		types = append(types, workflowType)
+	}
+	sort.Strings(types)
+
+	var sizes []results.HistorySize
+	for _, workflowType := range types {
+		var events, bytes []float64
+		var failed int
+		for _, execution := range s.samples[workflowType] {
+			callCtx, cancel := context.WithTimeout(ctx, historyDescribeTimeout)
+			resp, err := svc.DescribeWorkflowExecution(callCtx, &workflowservice.DescribeWorkflowExecutionRequest{
+				Namespace: namespace,
+				Execution: execution,
+			})
+			cancel()
+			if err != nil {
+				failed++
+				continue
+			}
+			info := resp.GetWorkflowExecutionInfo()
+			events = append(events, float64(info.GetHistoryLength()))
+			bytes = append(bytes, float64(info.GetHistorySizeBytes()))
+		}
+		if failed > 0 {
+			slog.Warn("Failed to read history size of sampled workflows", "workflow_type", workflowType, "failed", failed)
+		}
+		if len(events) == 0 {
+			continue
+		}
+
+		e, b := metrics.CalculatePercentiles(events), metrics.CalculatePercentiles(bytes)
+		sizes = append(sizes, results.HistorySize{
+			WorkflowType: workflowType,
+			Samples:      len(events),
+			AvgEvents:    mean(events),
+			P50Events:    e.P50,
+			P95Events:    e.P95,
+			MaxEvents:    e.Max,
+			AvgBytes:     mean(bytes),
+			P50Bytes:     b.P50,
+			P95Bytes:     b.P95,
+			MaxBytes:     b.Max,
+		})
+	}
+	return sizes
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,52 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeHistoryService reports a history of n events and 100n bytes for
+// workflow "wf-<n>", and fails to describe any other workflow.
+type fakeHistoryService struct {
+	workflowservice.WorkflowServiceClient
+}
+
+func (fakeHistoryService) DescribeWorkflowExecution(_ context.Context, req *workflowservice.DescribeWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	var n int64
+	if _, err := fmt.Sscanf(req.GetExecution().GetWorkflowId(), "wf-%d", &n); err != nil {
+		return nil, errors.New("not found")
+	}
+	return &workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{HistoryLength: n, HistorySizeBytes: 100 * n},
+	}, nil
+}
+
+func TestHistorySampler(t *testing.T) {
+	s := newHistorySampler(3)
+	for i := 1; i <= 50; i++ {
+		s.offer("simple", fmt.Sprintf("wf-%d", i), "run")
+	}
+	s.offer("timer", "wf-10", "run")
+	s.offer("timer", "missing", "run")
+	require.Len(t, s.samples["simple"], 3)
+	require.Equal(t, 50, s.seen["simple"])
+
+	sizes := s.measure(context.Background(), fakeHistoryService{}, "ns")
+	require.Len(t, sizes, 2)
+	require.Equal(t, "simple", sizes[0].WorkflowType)
+	require.Equal(t, 3, sizes[0].Samples)
+	require.Equal(t, sizes[0].MaxEvents*100, sizes[0].MaxBytes)
+
+	// The undescribable sample is skipped
+	require.Equal(t, "timer", sizes[1].WorkflowType)
+	require.Equal(t, 1, sizes[1].Samples)
+	require.Equal(t, float64(10), sizes[1].AvgEvents)
+	require.Equal(t, float64(1000), sizes[1].P95Bytes)
+}
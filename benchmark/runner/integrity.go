@@ -0,0 +1,241 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// historyReadTimeout bounds reading the full history of each audited workflow.
+const historyReadTimeout = 30 * time.Second
+
+// audit reads the full history of each sampled workflow and checks it with
+// checkHistory. Histories that cannot be read are counted but not audited.
+func (s *historySampler) audit(ctx context.Context, svc workflowservice.WorkflowServiceClient, namespace string) *results.HistoryIntegrity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	types := make([]string, 0, len(s.samples))
+	for workflowType := range s.samples {
+		types = append(types, workflowType)
+	}
+	sort.Strings(types)
+
+	integrity := &results.HistoryIntegrity{}
+	for _, workflowType := range types {
+		for _, execution := range s.samples[workflowType] {
+			events, err := readHistory(ctx, svc, namespace, execution.GetWorkflowId(), execution.GetRunId())
+			if err != nil {
+				integrity.Unreadable++
+				slog.Warn("Failed to read history of audited workflow", "workflow_id", execution.GetWorkflowId(), "error", err)
+				continue
+			}
+			integrity.Checked++
+			integrity.Events += int64(len(events))
+
+			problems := checkHistory(events)
+			if len(problems) == 0 {
+				continue
+			}
+			integrity.Violated++
+			if len(integrity.Violations) < results.MaxIntegrityViolations {
+				integrity.Violations = append(integrity.Violations, results.IntegrityViolation{
+					WorkflowID: execution.GetWorkflowId(),
+					Reason:     problems[0],
+				})
+			}
+			slog.Error("Workflow history violates integrity invariants",
+				"workflow_id", execution.GetWorkflowId(),
+				"run_id", execution.GetRunId(),
+				"workflow_type", workflowType,
+				"violations", strings.Join(problems, "; "))
+		}
+	}
+	return integrity
+}
+
+// readHistory reads every page of a workflow's history.
+func readHistory(ctx context.Context, svc workflowservice.WorkflowServiceClient, namespace, workflowID, runID string) ([]*historypb.HistoryEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, historyReadTimeout)
+	defer cancel()
+
+	var events []*historypb.HistoryEvent
+	var token []byte
+	for {
+		resp, err := svc.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace:     namespace,
+			Execution:     &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, resp.GetHistory().GetEvents()...)
+		if token = resp.GetNextPageToken(); len(token) == 0 {
+			return events, nil
+		}
+	}
+}
+
+// historyAudit tracks the open scheduling events of a history being checked:
+// workflow tasks and activities scheduled, timers started and children
+// initiated, by event ID, with the event that started each.
+type historyAudit struct {
+	open     map[int64]enumspb.EventType
+	started  map[int64]int64
+	problems []string
+}
+
+func (a *historyAudit) problem(format string, args ...any) {
+	a.problems = append(a.problems, fmt.Sprintf(format, args...))
+}
+
+// start records that event e started the open event scheduledID of kind.
+func (a *historyAudit) start(e *historypb.HistoryEvent, kind enumspb.EventType, scheduledID int64) {
+	if a.open[scheduledID] != kind {
+		a.problem("event %d (%s) starts event %d, which is not an open %s", e.GetEventId(), e.GetEventType(), scheduledID, kind)
+		return
+	}
+	if _, ok := a.started[scheduledID]; ok {
+		a.problem("event %d (%s) starts event %d again", e.GetEventId(), e.GetEventType(), scheduledID)
+	}
+	a.started[scheduledID] = e.GetEventId()
+}
+
+// close records that event e closed the open event scheduledID of kind, after
+// it was started by startedID if that is not 0.
+func (a *historyAudit) close(e *historypb.HistoryEvent, kind enumspb.EventType, scheduledID, startedID int64) {
+	if a.open[scheduledID] != kind {
+		a.problem("event %d (%s) closes event %d, which is not an open %s", e.GetEventId(), e.GetEventType(), scheduledID, kind)
+		return
+	}
+	if startedID != 0 && a.started[scheduledID] != startedID {
+		a.problem("event %d (%s) refers to started event %d, but event %d was started by event %d",
+			e.GetEventId(), e.GetEventType(), startedID, scheduledID, a.started[scheduledID])
+	}
+	delete(a.open, scheduledID)
+	delete(a.started, scheduledID)
+}
+
+// checkHistory checks the structural invariants of a completed workflow's
+// history (see results.HistoryIntegrity) and returns the violations found,
+// in history order.
+func checkHistory(events []*historypb.HistoryEvent) []string {
+	if len(events) == 0 {
+		return []string{"empty history"}
+	}
+	a := &historyAudit{open: map[int64]enumspb.EventType{}, started: map[int64]int64{}}
+	if t := events[0].GetEventType(); t != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
+		a.problem("history starts with %s", t)
+	}
+
+	idsReported := false
+	for i, e := range events {
+		if want := int64(i + 1); e.GetEventId() != want && !idsReported {
+			a.problem("event %d has ID %d", want, e.GetEventId())
+			idsReported = true // Later IDs would all be reported too
+		}
+
+		switch e.GetEventType() {
+		case enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+			enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+			enumspb.EVENT_TYPE_TIMER_STARTED,
+			enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
+			a.open[e.GetEventId()] = e.GetEventType()
+
+		case enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED:
+			a.start(e, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, e.GetWorkflowTaskStartedEventAttributes().GetScheduledEventId())
+		case enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED:
+			attrs := e.GetWorkflowTaskCompletedEventAttributes()
+			a.close(e, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, attrs.GetScheduledEventId(), attrs.GetStartedEventId())
+		case enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED:
+			attrs := e.GetWorkflowTaskFailedEventAttributes()
+			a.close(e, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, attrs.GetScheduledEventId(), attrs.GetStartedEventId())
+		case enumspb.EVENT_TYPE_WORKFLOW_TASK_TIMED_OUT:
+			attrs := e.GetWorkflowTaskTimedOutEventAttributes()
+			a.close(e, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, attrs.GetScheduledEventId(), attrs.GetStartedEventId())
+
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED:
+			a.start(e, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, e.GetActivityTaskStartedEventAttributes().GetScheduledEventId())
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED:
+			attrs := e.GetActivityTaskCompletedEventAttributes()
+			a.close(e, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, attrs.GetScheduledEventId(), attrs.GetStartedEventId())
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED:
+			attrs := e.GetActivityTaskFailedEventAttributes()
+			a.close(e, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, attrs.GetScheduledEventId(), attrs.GetStartedEventId())
+			a.problem("activity scheduled by event %d failed: %s", attrs.GetScheduledEventId(), attrs.GetFailure().GetMessage())
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_TIMED_OUT:
+			attrs := e.GetActivityTaskTimedOutEventAttributes()
+			a.close(e, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, attrs.GetScheduledEventId(), attrs.GetStartedEventId())
+			a.problem("activity scheduled by event %d timed out", attrs.GetScheduledEventId())
+		case enumspb.EVENT_TYPE_ACTIVITY_TASK_CANCELED:
+			attrs := e.GetActivityTaskCanceledEventAttributes()
+			a.close(e, enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED, attrs.GetScheduledEventId(), attrs.GetStartedEventId())
+
+		case enumspb.EVENT_TYPE_TIMER_FIRED:
+			a.close(e, enumspb.EVENT_TYPE_TIMER_STARTED, e.GetTimerFiredEventAttributes().GetStartedEventId(), 0)
+		case enumspb.EVENT_TYPE_TIMER_CANCELED:
+			a.close(e, enumspb.EVENT_TYPE_TIMER_STARTED, e.GetTimerCanceledEventAttributes().GetStartedEventId(), 0)
+
+		case enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_FAILED:
+			a.close(e, enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED, e.GetStartChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId(), 0)
+			a.problem("child workflow initiated by event %d failed to start", e.GetStartChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_STARTED:
+			a.start(e, enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED, e.GetChildWorkflowExecutionStartedEventAttributes().GetInitiatedEventId())
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_COMPLETED:
+			attrs := e.GetChildWorkflowExecutionCompletedEventAttributes()
+			a.close(e, enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED, attrs.GetInitiatedEventId(), attrs.GetStartedEventId())
+		case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED,
+			enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT,
+			enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED,
+			enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED:
+			initiatedID, startedID := childCloseIDs(e)
+			a.close(e, enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED, initiatedID, startedID)
+			if e.GetEventType() != enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_CANCELED {
+				a.problem("child workflow initiated by event %d closed with %s", initiatedID, e.GetEventType())
+			}
+		}
+	}
+
+	if t := events[len(events)-1].GetEventType(); t != enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED {
+		a.problem("history ends with %s, not a completion", t)
+	}
+	ids := make([]int64, 0, len(a.open))
+	for id := range a.open {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		a.problem("event %d (%s) was never closed", id, a.open[id])
+	}
+	return a.problems
+}
+
+// childCloseIDs returns the initiated and started event IDs a failed, timed
+// out, terminated or canceled child workflow event refers to.
+func childCloseIDs(e *historypb.HistoryEvent) (initiatedID, startedID int64) {
+	switch e.GetEventType() {
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_FAILED:
+		attrs := e.GetChildWorkflowExecutionFailedEventAttributes()
+		return attrs.GetInitiatedEventId(), attrs.GetStartedEventId()
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TIMED_OUT:
+		attrs := e.GetChildWorkflowExecutionTimedOutEventAttributes()
+		return attrs.GetInitiatedEventId(), attrs.GetStartedEventId()
+	case enumspb.EVENT_TYPE_CHILD_WORKFLOW_EXECUTION_TERMINATED:
+		attrs := e.GetChildWorkflowExecutionTerminatedEventAttributes()
+		return attrs.GetInitiatedEventId(), attrs.GetStartedEventId()
+	default:
+		attrs := e.GetChildWorkflowExecutionCanceledEventAttributes()
+		return attrs.GetInitiatedEventId(), attrs.GetStartedEventId()
+	}
+}
@@ -0,0 +1,109 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// activityHistory returns the history of a workflow that ran one activity.
+func activityHistory() []*historypb.HistoryEvent {
+	return []*historypb.HistoryEvent{
+		{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+		{EventId: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{EventId: 3, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED, Attributes: &historypb.HistoryEvent_WorkflowTaskStartedEventAttributes{
+			WorkflowTaskStartedEventAttributes: &historypb.WorkflowTaskStartedEventAttributes{ScheduledEventId: 2},
+		}},
+		{EventId: 4, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED, Attributes: &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{
+			WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{ScheduledEventId: 2, StartedEventId: 3},
+		}},
+		{EventId: 5, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED},
+		{EventId: 6, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_STARTED, Attributes: &historypb.HistoryEvent_ActivityTaskStartedEventAttributes{
+			ActivityTaskStartedEventAttributes: &historypb.ActivityTaskStartedEventAttributes{ScheduledEventId: 5},
+		}},
+		{EventId: 7, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED, Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+			ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{ScheduledEventId: 5, StartedEventId: 6},
+		}},
+		{EventId: 8, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{EventId: 9, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED, Attributes: &historypb.HistoryEvent_WorkflowTaskStartedEventAttributes{
+			WorkflowTaskStartedEventAttributes: &historypb.WorkflowTaskStartedEventAttributes{ScheduledEventId: 8},
+		}},
+		{EventId: 10, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED, Attributes: &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{
+			WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{ScheduledEventId: 8, StartedEventId: 9},
+		}},
+		{EventId: 11, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED},
+	}
+}
+
+func TestCheckHistory(t *testing.T) {
+	require.Empty(t, checkHistory(activityHistory()))
+	require.Equal(t, []string{"empty history"}, checkHistory(nil))
+
+	// A skipped event ID is reported once
+	events := activityHistory()
+	for _, e := range events[6:] {
+		e.EventId++
+	}
+	require.Equal(t, []string{"event 7 has ID 8"}, checkHistory(events)[:1])
+
+	// A completion for the wrong scheduled event leaves the activity open
+	events = activityHistory()
+	events[6].GetActivityTaskCompletedEventAttributes().ScheduledEventId = 2
+	require.Equal(t, []string{
+		"event 7 (ActivityTaskCompleted) closes event 2, which is not an open ActivityTaskScheduled",
+		"event 5 (ActivityTaskScheduled) was never closed",
+	}, checkHistory(events))
+
+	// A failed activity and an unfinished workflow are unexpected
+	events = activityHistory()[:9]
+	events[6] = &historypb.HistoryEvent{EventId: 7, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_FAILED, Attributes: &historypb.HistoryEvent_ActivityTaskFailedEventAttributes{
+		ActivityTaskFailedEventAttributes: &historypb.ActivityTaskFailedEventAttributes{ScheduledEventId: 5, StartedEventId: 6},
+	}}
+	require.Equal(t, []string{
+		"activity scheduled by event 5 failed: ",
+		"history ends with WorkflowTaskStarted, not a completion",
+		"event 8 (WorkflowTaskScheduled) was never closed",
+	}, checkHistory(events))
+}
+
+// fakeHistoryReader serves activityHistory in pages of four events for
+// workflow "wf-ok" and an empty history for any other workflow.
+type fakeHistoryReader struct {
+	workflowservice.WorkflowServiceClient
+}
+
+func (fakeHistoryReader) GetWorkflowExecutionHistory(_ context.Context, req *workflowservice.GetWorkflowExecutionHistoryRequest, _ ...grpc.CallOption) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+	if req.GetExecution().GetWorkflowId() != "wf-ok" {
+		return &workflowservice.GetWorkflowExecutionHistoryResponse{History: &historypb.History{}}, nil
+	}
+	events := activityHistory()
+	start := 0
+	if len(req.GetNextPageToken()) > 0 {
+		start = int(req.GetNextPageToken()[0])
+	}
+	end := min(start+4, len(events))
+	resp := &workflowservice.GetWorkflowExecutionHistoryResponse{History: &historypb.History{Events: events[start:end]}}
+	if end < len(events) {
+		resp.NextPageToken = []byte{byte(end)}
+	}
+	return resp, nil
+}
+
+func TestHistorySampler_Audit(t *testing.T) {
+	s := newHistorySampler(5)
+	s.offer("simple", "wf-ok", "run")
+	s.offer("simple", "wf-empty", "run")
+
+	integrity := s.audit(context.Background(), fakeHistoryReader{}, "ns")
+	require.Equal(t, 2, integrity.Checked)
+	require.Equal(t, int64(11), integrity.Events)
+	require.Equal(t, 1, integrity.Violated)
+	require.Len(t, integrity.Violations, 1)
+	require.Equal(t, "wf-empty", integrity.Violations[0].WorkflowID)
+	require.Equal(t, "empty history", integrity.Violations[0].Reason)
+}
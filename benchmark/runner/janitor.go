@@ -0,0 +1,179 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+const (
+	// NamespaceJanitorWorkflowName is the registered name of NamespaceJanitorWorkflow
+	NamespaceJanitorWorkflowName = "BenchmarkNamespaceJanitor"
+
+	// deleteNamespaceActivityName is the registered name of namespaceJanitor.deleteNamespace
+	deleteNamespaceActivityName = "DeleteBenchmarkNamespace"
+
+	// janitorIDPrefix prefixes the benchmark namespace in janitor workflow IDs
+	janitorIDPrefix = "benchmark-namespace-janitor-"
+
+	// janitorTaskQueue is polled by the benchmark processes with a namespace TTL
+	janitorTaskQueue = "benchmark-namespace-janitor"
+
+	// janitorRetryInterval is how often HostNamespaceJanitor retries a
+	// janitor worker that failed to start
+	janitorRetryInterval = time.Minute
+
+	// janitorCallTimeout bounds starting a janitor
+	janitorCallTimeout = 10 * time.Second
+)
+
+// NamespaceJanitorWorkflow deletes a benchmark namespace once ttl has
+// elapsed. Its durable timer outlives the run that started it, so the
+// namespace is deleted even if the run crashes. Deletion is retried until it
+// succeeds.
+func NamespaceJanitorWorkflow(ctx workflow.Context, namespace string, ttl time.Duration) error {
+	if err := workflow.Sleep(ctx, ttl); err != nil {
+		return err
+	}
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumInterval: 10 * time.Minute},
+	})
+	return workflow.ExecuteActivity(ctx, deleteNamespaceActivityName, namespace).Get(ctx, nil)
+}
+
+// namespaceJanitor deletes expired benchmark namespaces.
+type namespaceJanitor struct {
+	operator operatorservice.OperatorServiceClient
+}
+
+// deleteNamespace deletes namespace, which must have the benchmark prefix.
+// A namespace that no longer exists is already cleaned up.
+func (j *namespaceJanitor) deleteNamespace(ctx context.Context, namespace string) error {
+	// Requirement 8.3: THE Benchmark_Runner SHALL NOT interfere with workflows in other namespaces
+	if !strings.HasPrefix(namespace, NamespacePrefix) {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("refusing to delete namespace %s without the %s prefix", namespace, NamespacePrefix),
+			"NotBenchmarkNamespace", nil)
+	}
+	_, err := j.operator.DeleteNamespace(ctx, &operatorservice.DeleteNamespaceRequest{Namespace: namespace})
+	var notFound *serviceerror.NamespaceNotFound
+	if errors.As(err, &notFound) {
+		slog.Info("Expired benchmark namespace already deleted", "namespace", namespace)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete namespace %s: %w", namespace, err)
+	}
+	slog.Info("Deleted expired benchmark namespace", "namespace", namespace)
+	return nil
+}
+
+// startJanitorWorker starts a worker processing the janitor workflows of c's
+// namespace. Janitors only make progress while some process polls the
+// janitor task queue.
+func startJanitorWorker(c client.Client) (worker.Worker, error) {
+	w := worker.New(c, janitorTaskQueue, worker.Options{})
+	w.RegisterWorkflowWithOptions(NamespaceJanitorWorkflow, workflow.RegisterOptions{Name: NamespaceJanitorWorkflowName})
+	janitor := &namespaceJanitor{operator: c.OperatorService()}
+	w.RegisterActivityWithOptions(janitor.deleteNamespace, activity.RegisterOptions{Name: deleteNamespaceActivityName})
+	if err := w.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start namespace janitor worker: %w", err)
+	}
+	return w, nil
+}
+
+// HostNamespaceJanitor processes the janitor workflows of c's namespace
+// until ctx is done, retrying every janitorRetryInterval while the worker
+// cannot start, e.g. before a run has created the janitor namespace.
+func HostNamespaceJanitor(ctx context.Context, c client.Client) {
+	for {
+		w, err := startJanitorWorker(c)
+		if err == nil {
+			slog.Info("Hosting namespace janitor")
+			<-ctx.Done()
+			w.Stop()
+			return
+		}
+		slog.Warn("Namespace janitor unavailable; retrying", "retry_in", janitorRetryInterval, "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(janitorRetryInterval):
+		}
+	}
+}
+
+// hostNamespaceJanitor processes janitor workflows, including those of
+// earlier runs that crashed, for the duration of a run if cfg sets a
+// namespace TTL. It returns a func stopping the worker.
+func (r *runner) hostNamespaceJanitor(cfg config.BenchmarkConfig) func() {
+	if cfg.NamespaceTTL <= 0 {
+		return func() {}
+	}
+	c, err := r.dialNamespaceClient(cfg.JanitorNamespace)
+	if err != nil {
+		slog.Warn("Namespace janitor not hosted", "janitor_namespace", cfg.JanitorNamespace, "error", err)
+		return func() {}
+	}
+	w, err := startJanitorWorker(c)
+	if err != nil {
+		c.Close()
+		slog.Warn("Namespace janitor not hosted", "janitor_namespace", cfg.JanitorNamespace, "error", err)
+		return func() {}
+	}
+	return func() {
+		w.Stop()
+		c.Close()
+	}
+}
+
+// startNamespaceJanitor starts the janitor deleting namespace after
+// meta.TTL. A janitor of an earlier namespace with the same name that is
+// still open is kept.
+func (r *runner) startNamespaceJanitor(ctx context.Context, namespace string, meta *namespaceMetadata) error {
+	if err := r.ensureNamespace(ctx, meta.JanitorNamespace, nil); err != nil {
+		return fmt.Errorf("failed to create janitor namespace %s: %w", meta.JanitorNamespace, err)
+	}
+	input, err := converter.GetDefaultDataConverter().ToPayloads(namespace, meta.TTL)
+	if err != nil {
+		return fmt.Errorf("failed to encode janitor input: %w", err)
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, janitorCallTimeout)
+	defer cancel()
+	_, err = r.client.WorkflowService().StartWorkflowExecution(callCtx, &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:             meta.JanitorNamespace,
+		WorkflowId:            janitorIDPrefix + namespace,
+		WorkflowType:          &commonpb.WorkflowType{Name: NamespaceJanitorWorkflowName},
+		TaskQueue:             &taskqueuepb.TaskQueue{Name: janitorTaskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		Input:                 input,
+		Identity:              runLockHolderName(namespace),
+		RequestId:             fmt.Sprintf("%s-%d", namespace, time.Now().UnixNano()),
+		WorkflowIdReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	})
+	var started *serviceerror.WorkflowExecutionAlreadyStarted
+	if err != nil && !errors.As(err, &started) {
+		return fmt.Errorf("failed to start namespace janitor: %w", err)
+	}
+	slog.Info("Namespace scheduled for deletion", "namespace", namespace, "ttl", meta.TTL, "janitor_namespace", meta.JanitorNamespace)
+	return nil
+}
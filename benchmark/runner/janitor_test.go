@@ -0,0 +1,76 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/grpc"
+)
+
+// fakeOperatorService records deleted namespaces, failing with err if set.
+type fakeOperatorService struct {
+	operatorservice.OperatorServiceClient
+	deleted []string
+	err     error
+}
+
+func (f *fakeOperatorService) DeleteNamespace(_ context.Context, req *operatorservice.DeleteNamespaceRequest, _ ...grpc.CallOption) (*operatorservice.DeleteNamespaceResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.deleted = append(f.deleted, req.GetNamespace())
+	return &operatorservice.DeleteNamespaceResponse{}, nil
+}
+
+func TestNamespaceJanitorWorkflow_DeletesAfterTTL(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(NamespaceJanitorWorkflow, workflow.RegisterOptions{Name: NamespaceJanitorWorkflowName})
+	janitor := &namespaceJanitor{}
+	env.RegisterActivityWithOptions(janitor.deleteNamespace, activity.RegisterOptions{Name: deleteNamespaceActivityName})
+
+	start := env.Now()
+	var deletedAt time.Time
+	env.OnActivity(deleteNamespaceActivityName, mock.Anything, "benchmark-1").Return(func(context.Context, string) error {
+		deletedAt = env.Now()
+		return nil
+	}).Once()
+
+	env.ExecuteWorkflow(NamespaceJanitorWorkflowName, "benchmark-1", 6*time.Hour)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.GreaterOrEqual(t, deletedAt.Sub(start), 6*time.Hour)
+	env.AssertExpectations(t)
+}
+
+func TestNamespaceJanitor_DeleteNamespace(t *testing.T) {
+	operator := &fakeOperatorService{}
+	janitor := &namespaceJanitor{operator: operator}
+
+	require.NoError(t, janitor.deleteNamespace(context.Background(), "benchmark-1"))
+	require.Equal(t, []string{"benchmark-1"}, operator.deleted)
+
+	// Namespaces outside the benchmark prefix are never deleted, and not retried
+	err := janitor.deleteNamespace(context.Background(), "production")
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &appErr)
+	require.True(t, appErr.NonRetryable())
+	require.Equal(t, []string{"benchmark-1"}, operator.deleted)
+
+	// A namespace deleted by someone else is cleaned up
+	operator.err = serviceerror.NewNamespaceNotFound("benchmark-2")
+	require.NoError(t, janitor.deleteNamespace(context.Background(), "benchmark-2"))
+
+	// Other failures are retried
+	operator.err = errors.New("unavailable")
+	require.Error(t, janitor.deleteNamespace(context.Background(), "benchmark-3"))
+}
@@ -0,0 +1,136 @@
+package runner
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+)
+
+// GitSHA is the git commit the benchmark was built from, set at build time:
+//
+//	go build -ldflags "-X github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/runner.GitSHA=$(git rev-parse --short HEAD)"
+//
+// If unset, the VCS revision stamped by go build in a git checkout is used.
+var GitSHA string
+
+// Namespace data keys identifying the run that created a benchmark namespace.
+const (
+	NamespaceDataScenario     = "benchmark.scenario"
+	NamespaceDataWorkflowType = "benchmark.workflowType"
+	NamespaceDataGitSHA       = "benchmark.gitSHA"
+	NamespaceDataStartTime    = "benchmark.startTime"
+	NamespaceDataExpiresAt    = "benchmark.expiresAt"
+)
+
+// namespaceMetadata describes the run a benchmark namespace is created for,
+// so a namespace left behind on the cluster can be traced to its origin.
+type namespaceMetadata struct {
+	Scenario     string
+	WorkflowType string
+	GitSHA       string
+	StartTime    time.Time
+
+	// TTL after which a janitor workflow in JanitorNamespace deletes the
+	// namespace (0 keeps it)
+	TTL              time.Duration
+	JanitorNamespace string
+}
+
+// newNamespaceMetadata returns the metadata of a run of cfg, or of sc's
+// phases if sc is not nil, starting now.
+func newNamespaceMetadata(cfg config.BenchmarkConfig, sc *scenario.Scenario) *namespaceMetadata {
+	m := &namespaceMetadata{
+		WorkflowType: cfg.WorkflowType,
+		GitSHA:       buildRevision(),
+		StartTime:    time.Now().UTC(),
+
+		TTL:              cfg.NamespaceTTL,
+		JanitorNamespace: cfg.JanitorNamespace,
+	}
+	if sc != nil {
+		m.Scenario = sc.Name
+	}
+	return m
+}
+
+// description returns the namespace description, which lists the run's
+// metadata for operators browsing namespaces.
+func (m *namespaceMetadata) description() string {
+	if m == nil {
+		return "Benchmark namespace for Temporal DSQL performance testing"
+	}
+	parts := []string{}
+	if m.Scenario != "" {
+		parts = append(parts, "scenario "+m.Scenario)
+	} else if m.WorkflowType != "" {
+		parts = append(parts, "workflow type "+m.WorkflowType)
+	}
+	if m.GitSHA != "" {
+		parts = append(parts, "git "+m.GitSHA)
+	}
+	parts = append(parts, "started "+m.StartTime.Format(time.RFC3339))
+	if m.TTL > 0 {
+		parts = append(parts, "expires "+m.expiresAt().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("Benchmark namespace for Temporal DSQL performance testing (%s)", strings.Join(parts, ", "))
+}
+
+// data returns the namespace data, the run's metadata as key-value pairs.
+func (m *namespaceMetadata) data() map[string]string {
+	if m == nil {
+		return nil
+	}
+	data := map[string]string{
+		NamespaceDataWorkflowType: m.WorkflowType,
+		NamespaceDataStartTime:    m.StartTime.Format(time.RFC3339),
+	}
+	if m.Scenario != "" {
+		data[NamespaceDataScenario] = m.Scenario
+	}
+	if m.GitSHA != "" {
+		data[NamespaceDataGitSHA] = m.GitSHA
+	}
+	if m.TTL > 0 {
+		data[NamespaceDataExpiresAt] = m.expiresAt().Format(time.RFC3339)
+	}
+	return data
+}
+
+// expiresAt returns when the namespace's janitor deletes it, at the earliest.
+func (m *namespaceMetadata) expiresAt() time.Time {
+	return m.StartTime.Add(m.TTL).Truncate(time.Second)
+}
+
+// buildRevision returns GitSHA, or the VCS revision go build stamped into the
+// binary, shortened and marked "-dirty" for modified checkouts. It returns ""
+// if neither is known.
+func buildRevision() string {
+	if GitSHA != "" {
+		return GitSHA
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	var revision string
+	var modified bool
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.modified":
+			modified = s.Value == "true"
+		}
+	}
+	if len(revision) > 12 {
+		revision = revision[:12]
+	}
+	if revision != "" && modified {
+		revision += "-dirty"
+	}
+	return revision
+}
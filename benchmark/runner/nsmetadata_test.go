@@ -0,0 +1,60 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+)
+
+func TestNamespaceMetadata(t *testing.T) {
+	meta := &namespaceMetadata{
+		Scenario:     "ramp",
+		WorkflowType: config.WorkflowTypeSimple,
+		GitSHA:       "abc1234",
+		StartTime:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	require.Equal(t, "Benchmark namespace for Temporal DSQL performance testing (scenario ramp, git abc1234, started 2026-01-02T03:04:05Z)", meta.description())
+	require.Equal(t, map[string]string{
+		NamespaceDataScenario:     "ramp",
+		NamespaceDataWorkflowType: config.WorkflowTypeSimple,
+		NamespaceDataGitSHA:       "abc1234",
+		NamespaceDataStartTime:    "2026-01-02T03:04:05Z",
+	}, meta.data())
+
+	// Without a scenario or a known commit
+	meta.Scenario, meta.GitSHA = "", ""
+	require.Equal(t, "Benchmark namespace for Temporal DSQL performance testing (workflow type simple, started 2026-01-02T03:04:05Z)", meta.description())
+	require.NotContains(t, meta.data(), NamespaceDataScenario)
+	require.NotContains(t, meta.data(), NamespaceDataGitSHA)
+
+	// A namespace with a TTL records when its janitor deletes it
+	meta.TTL = 90 * time.Minute
+	require.Equal(t, "Benchmark namespace for Temporal DSQL performance testing (workflow type simple, started 2026-01-02T03:04:05Z, expires 2026-01-02T04:34:05Z)", meta.description())
+	require.Equal(t, "2026-01-02T04:34:05Z", meta.data()[NamespaceDataExpiresAt])
+
+	// Shared namespaces carry no run metadata
+	var shared *namespaceMetadata
+	require.Equal(t, "Benchmark namespace for Temporal DSQL performance testing", shared.description())
+	require.Nil(t, shared.data())
+}
+
+func TestNewNamespaceMetadata(t *testing.T) {
+	defer func(sha string) { GitSHA = sha }(GitSHA)
+	GitSHA = "deadbeef"
+
+	cfg := config.DefaultConfig()
+	cfg.NamespaceTTL = 24 * time.Hour
+	meta := newNamespaceMetadata(cfg, &scenario.Scenario{Name: "soak"})
+	require.Equal(t, "soak", meta.Scenario)
+	require.Equal(t, config.DefaultConfig().WorkflowType, meta.WorkflowType)
+	require.Equal(t, "deadbeef", meta.GitSHA)
+	require.WithinDuration(t, time.Now(), meta.StartTime, time.Minute)
+	require.Equal(t, 24*time.Hour, meta.TTL)
+	require.Equal(t, config.DefaultJanitorNamespace, meta.JanitorNamespace)
+
+	require.Empty(t, newNamespaceMetadata(config.DefaultConfig(), nil).Scenario)
+}
@@ -0,0 +1,41 @@
+package runner
+
+import (
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// addOutcomes adds the outcomes a generator verified to o, starting a new
+// verification if o is nil. It returns nil if verification is disabled.
+func addOutcomes(o *results.OutcomeVerification, sampleRate float64, stats generator.GeneratorStats) *results.OutcomeVerification {
+	if sampleRate <= 0 {
+		return o
+	}
+	return mergeOutcomes(o, &results.OutcomeVerification{
+		SampleRate: sampleRate,
+		Checked:    stats.OutcomesChecked,
+		Mismatches: stats.OutcomeMismatches,
+		SampleIDs:  stats.MismatchedWorkflowIDs,
+	})
+}
+
+// mergeOutcomes combines the verified outcomes of two iterations or phases,
+// either of which may be nil.
+func mergeOutcomes(a, b *results.OutcomeVerification) *results.OutcomeVerification {
+	if a == nil || b == nil {
+		if a == nil {
+			return b
+		}
+		return a
+	}
+	merged := &results.OutcomeVerification{
+		SampleRate: max(a.SampleRate, b.SampleRate),
+		Checked:    a.Checked + b.Checked,
+		Mismatches: a.Mismatches + b.Mismatches,
+		SampleIDs:  append(append([]string(nil), a.SampleIDs...), b.SampleIDs...),
+	}
+	if len(merged.SampleIDs) > results.MaxOutcomeSamples {
+		merged.SampleIDs = merged.SampleIDs[:results.MaxOutcomeSamples]
+	}
+	return merged
+}
@@ -0,0 +1,195 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// phaseTracker collects the latencies of workflows started by one phase.
+type phaseTracker struct {
+	latencies *metrics.LatencyCollector
+}
+
+// record adds a successful workflow's latency.
+func (t *phaseTracker) record(duration time.Duration, err error) {
+	if err != nil {
+		return
+	}
+	t.latencies.AddDuration(duration)
+}
+
+// percentiles returns the phase's latency percentiles.
+func (t *phaseTracker) percentiles() metrics.LatencyPercentiles {
+	return t.latencies.Percentiles()
+}
+
+// runScenario executes the scenario's phases back to back in one namespace.
+// Generation switches directly from one phase to the next; in-flight workflows
+// from earlier phases drain concurrently and all phases are drained together at
+// the end. Each workflow is attributed to the phase that started it.
+func (r *runner) runScenario(ctx context.Context, cfg config.BenchmarkConfig, namespace string) (*BenchmarkResult, error) {
+	startTime := time.Now()
+
+	nsClient, err := r.dialNamespaceClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer nsClient.Close()
+
+	w, err := r.startEmbeddedWorker(nsClient, namespace, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if w != nil {
+		defer w.Stop()
+	}
+
+	gens := make([]generator.WorkflowGenerator, 0, len(r.scenario.Phases))
+	trackers := make([]*phaseTracker, 0, len(r.scenario.Phases))
+	phases := make([]results.PhaseResult, 0, len(r.scenario.Phases))
+
+	// A ceiling search measures the persistence stores between steps
+	search := startCeilingSearch(ctx, r.scenario.Ceiling, cfg.ServerMetricsURLs)
+
+	for i, phase := range r.scenario.Phases {
+		phaseCfg := phase.Apply(cfg)
+		tracker := &phaseTracker{latencies: metrics.NewLatencyCollector(10000)}
+		tracker.latencies.ShareMemoryBudget(r.latencyBudget)
+
+		gen := generator.NewGenerator(
+			nsClient,
+			phaseCfg,
+			DefaultTaskQueue,
+			generator.WithTypedCompletionCallback(func(workflowType, workflowID, runID string, duration time.Duration, err error) {
+				r.recordCompletion(phaseCfg, workflowType, workflowID, runID, duration, err)
+				tracker.record(duration, err)
+			}),
+			generator.WithCancelCallback(r.cancellations.record),
+			generator.WithWorkflowIDFields(generator.IDFields{Scenario: r.scenario.Name, Phase: r.scenario.PhaseName(i), Index: i + 1}),
+		)
+
+		slog.Info("Starting scenario phase",
+			"scenario", r.scenario.Name,
+			"phase", r.scenario.PhaseName(i),
+			"index", i+1,
+			"total", len(r.scenario.Phases),
+			"workflow_type", phaseCfg.WorkflowType,
+			"target_rate", phaseCfg.TargetRate,
+			"duration", phaseCfg.Duration)
+
+		r.control.attach(gen)
+		r.progress.addGenerator(gen, r.scenario.PhaseName(i))
+		phaseStart := time.Now()
+		if err := gen.Start(ctx); err != nil {
+			r.control.detach(gen)
+			return nil, results.NewRunError(results.CategoryExecution, results.PhaseRun, fmt.Errorf("failed to start generator for phase %s: %w", r.scenario.PhaseName(i), err))
+		}
+		stopTimeline := r.timeline.watch(namespace, r.scenario.PhaseName(i), phaseCfg, gen, r.metricsHandler)
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Benchmark cancelled during scenario phase", "phase", r.scenario.PhaseName(i))
+		case <-time.After(phaseCfg.Duration):
+		}
+
+		stopTimeline()
+		if err := gen.Stop(); err != nil {
+			slog.Warn("Failed to stop generator", "phase", r.scenario.PhaseName(i), "error", err)
+		}
+		r.control.detach(gen)
+
+		gens = append(gens, gen)
+		trackers = append(trackers, tracker)
+		phases = append(phases, results.PhaseResult{
+			Name:         r.scenario.PhaseName(i),
+			WorkflowType: phaseCfg.WorkflowType,
+			TargetRate:   phaseCfg.TargetRate,
+			StartTime:    phaseStart,
+			EndTime:      time.Now(),
+		})
+
+		if ctx.Err() != nil {
+			break
+		}
+		if !search.step(ctx, phaseCfg.TargetRate) {
+			slog.Info("Every persistence store exceeded its limits, ending ceiling search", "target_rate", phaseCfg.TargetRate)
+			break
+		}
+	}
+
+	// Drain all phases together. Completions from here on don't count toward
+	// the overall or per-phase throughput
+	windowCompleted := make([]int64, len(gens))
+	for i, gen := range gens {
+		windowCompleted[i] = gen.Stats().WorkflowsCompleted
+	}
+	metrics.StartDrain(r.metricsHandler)
+	r.events.recordDrain()
+	r.progress.startDrain()
+	drainStats := drain(ctx, gens, newDrainPolicy(cfg))
+
+	endTime := time.Now()
+	result := &BenchmarkResult{
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Duration:       endTime.Sub(startTime),
+		InstanceType:   "m7g.large", // Default for ECS deployment
+		ServiceCounts:  map[string]int{"frontend": 1, "history": 1, "matching": 1, "worker": 1},
+		HistoryShards:  4, // Default shard count
+		Scenario:       r.scenario.Name,
+		Drain:          drainStats,
+		Ceilings:       search.result(),
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+
+	for i, gen := range gens {
+		stats := gen.Stats()
+		percentiles := trackers[i].percentiles()
+
+		phase := &phases[i]
+		phase.WorkflowsStarted = stats.WorkflowsStarted
+		phase.WorkflowsCompleted = stats.WorkflowsCompleted
+		phase.WorkflowsFailed = stats.WorkflowsFailed
+		if window := phase.EndTime.Sub(phase.StartTime).Seconds(); window > 0 {
+			phase.ActualRate = float64(windowCompleted[i]) / window
+		}
+		phase.Latency = results.ResultLatency{
+			P50: percentiles.P50,
+			P95: percentiles.P95,
+			P99: percentiles.P99,
+			Max: percentiles.Max,
+		}
+
+		result.WorkflowsStarted += stats.WorkflowsStarted
+		result.WorkflowsCompleted += stats.WorkflowsCompleted
+		result.WorkflowsFailed += stats.WorkflowsFailed
+		result.AlreadyStarted += stats.AlreadyStarted
+		result.OCCConflicts.ClientErrors += stats.OCCConflicts
+		result.FailedAttempts += stats.FailedAttempts
+		result.HistoryEvents += stats.HistoryEvents
+		result.LatencyFallbacks += stats.LatencyFallbacks
+		result.Outcomes = addOutcomes(result.Outcomes, cfg.OutcomeSampleRate, stats)
+		result.WorkflowIDs = append(result.WorkflowIDs, results.WorkflowIDRange{Prefix: stats.WorkflowIDPrefix, Count: stats.WorkflowsSubmitted})
+	}
+	result.Phases = phases
+
+	// Overall latency and throughput cover every phase
+	percentiles := r.metricsHandler.GetLatencyPercentiles()
+	result.ActualRate = r.metricsHandler.GetThroughput()
+	result.LatencyP50 = percentiles.P50
+	result.LatencyP95 = percentiles.P95
+	result.LatencyP99 = percentiles.P99
+	result.LatencyMax = percentiles.Max
+	result.LatencyApproximate = percentiles.Approximate
+
+	return result, nil
+}
@@ -0,0 +1,149 @@
+package runner
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+)
+
+// maxProgressFailures bounds the failure feed kept for progress views.
+const maxProgressFailures = 20
+
+// maxProgressLatencies bounds the latencies buffered between snapshots.
+const maxProgressLatencies = 100_000
+
+// Progress collects a running benchmark's live state for progress views such
+// as the terminal UI. Views poll Snapshot; the runner feeds it through
+// WithProgress. Its methods are no-ops on a nil Progress.
+type Progress struct {
+	mu        sync.Mutex
+	namespace string
+	start     time.Time
+	phase     string
+	draining  bool
+	gens      []generator.WorkflowGenerator
+	latencies []time.Duration
+	failures  []WorkflowFailure
+}
+
+// WorkflowFailure is a failed workflow (or start) in the progress failure feed.
+type WorkflowFailure struct {
+	Time       time.Time
+	WorkflowID string
+	Error      string
+}
+
+// ProgressSnapshot is the state of the current run at one point in time.
+type ProgressSnapshot struct {
+	Time      time.Time
+	Namespace string
+	Start     time.Time // Zero until the run starts
+	Phase     string    // Scenario phase or iteration being generated
+	Draining  bool
+	Paused    bool
+
+	TargetRate  float64 // Of the current phase or iteration
+	CurrentRate float64 // Submission rate, lower than the target during ramp-up
+
+	// Totals over the run
+	Started   int64
+	Completed int64
+	Failed    int64
+	InFlight  int64
+
+	// Latencies of the workflows completed since the previous snapshot
+	Latencies []time.Duration
+
+	// Most recent failures, oldest first
+	Failures []WorkflowFailure
+}
+
+// NewProgress creates an empty progress collector.
+func NewProgress() *Progress {
+	return &Progress{}
+}
+
+// Snapshot returns the current state and starts a new latency interval.
+func (p *Progress) Snapshot() ProgressSnapshot {
+	if p == nil {
+		return ProgressSnapshot{Time: time.Now()}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := ProgressSnapshot{
+		Time:      time.Now(),
+		Namespace: p.namespace,
+		Start:     p.start,
+		Phase:     p.phase,
+		Draining:  p.draining,
+		Latencies: p.latencies,
+		Failures:  append([]WorkflowFailure(nil), p.failures...),
+	}
+	p.latencies = nil
+	for i, gen := range p.gens {
+		stats := gen.Stats()
+		s.Started += stats.WorkflowsStarted
+		s.Completed += stats.WorkflowsCompleted
+		s.Failed += stats.WorkflowsFailed
+		s.InFlight += stats.InFlight
+		if i == len(p.gens)-1 {
+			s.TargetRate = stats.TargetRate
+			s.CurrentRate = stats.CurrentRate
+			s.Paused = stats.Paused
+		}
+	}
+	return s
+}
+
+// startRun resets the collector for a run in namespace.
+func (p *Progress) startRun(namespace string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.namespace, p.start = namespace, time.Now()
+	p.phase, p.draining = "", false
+	p.gens, p.latencies, p.failures = nil, nil, nil
+}
+
+// addGenerator tracks the generator of a new phase or iteration.
+func (p *Progress) addGenerator(gen generator.WorkflowGenerator, phase string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.gens = append(p.gens, gen)
+	p.phase = phase
+	p.draining = false
+}
+
+func (p *Progress) startDrain() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.draining = true
+}
+
+func (p *Progress) recordWorkflow(workflowID string, duration time.Duration, err error) {
+	if p == nil || errors.Is(err, generator.ErrAlreadyStarted) || errors.Is(err, generator.ErrCanceled) {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err == nil {
+		if len(p.latencies) < maxProgressLatencies {
+			p.latencies = append(p.latencies, duration)
+		}
+		return
+	}
+	if len(p.failures) == maxProgressFailures {
+		p.failures = p.failures[1:]
+	}
+	p.failures = append(p.failures, WorkflowFailure{Time: time.Now(), WorkflowID: workflowID, Error: err.Error()})
+}
@@ -0,0 +1,58 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+)
+
+func TestProgress_Snapshot(t *testing.T) {
+	p := NewProgress()
+	p.startRun("benchmark-1")
+	gen := &stubGenerator{paused: true}
+	p.addGenerator(gen, "iteration 1")
+
+	p.recordWorkflow("wf-1", time.Second, nil)
+	p.recordWorkflow("wf-2", 2*time.Second, nil)
+	p.recordWorkflow("wf-3", 0, fmt.Errorf("start: %w", generator.ErrAlreadyStarted))
+	for i := 0; i < maxProgressFailures+5; i++ {
+		p.recordWorkflow(fmt.Sprintf("failed-%d", i), time.Second, errors.New("workflow timed out"))
+	}
+	p.startDrain()
+
+	s := p.Snapshot()
+	require.Equal(t, "benchmark-1", s.Namespace)
+	require.Equal(t, "iteration 1", s.Phase)
+	require.False(t, s.Start.IsZero())
+	require.True(t, s.Draining)
+	require.True(t, s.Paused)
+	require.Equal(t, []time.Duration{time.Second, 2 * time.Second}, s.Latencies, "only completed workflows have latencies")
+	require.Len(t, s.Failures, maxProgressFailures)
+	require.Equal(t, "failed-5", s.Failures[0].WorkflowID, "the oldest failures are dropped")
+
+	// Each snapshot starts a new latency interval
+	require.Empty(t, p.Snapshot().Latencies)
+
+	// A new generator ends the drain; a new run starts over
+	p.addGenerator(&stubGenerator{}, "iteration 2")
+	require.False(t, p.Snapshot().Draining)
+	p.startRun("benchmark-2")
+	s = p.Snapshot()
+	require.Equal(t, "benchmark-2", s.Namespace)
+	require.Empty(t, s.Phase)
+	require.Empty(t, s.Failures)
+}
+
+func TestProgress_NilIsNoop(t *testing.T) {
+	var p *Progress
+	p.startRun("benchmark-1")
+	p.addGenerator(&stubGenerator{}, "iteration 1")
+	p.startDrain()
+	p.recordWorkflow("wf-1", time.Second, nil)
+	require.Empty(t, p.Snapshot().Namespace)
+}
@@ -0,0 +1,175 @@
+package runner
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+const (
+	// readRecentExecutions is how many recently completed workflows the read
+	// workload picks its targets from
+	readRecentExecutions = 1000
+
+	// readMaxInFlight caps concurrent reads; ticks beyond it are skipped
+	readMaxInFlight = 64
+
+	// readTimeout bounds a single read call
+	readTimeout = 30 * time.Second
+)
+
+// readAPI accumulates the outcome of one read API's calls.
+type readAPI struct {
+	latencies *metrics.LatencyCollector
+	requests  atomic.Int64
+	errors    atomic.Int64
+}
+
+func (a *readAPI) record(start time.Time, err error) {
+	a.requests.Add(1)
+	if err != nil {
+		a.errors.Add(1)
+		return
+	}
+	a.latencies.Add(float64(time.Since(start).Microseconds()) / 1000)
+}
+
+func (a *readAPI) result() results.ReadAPILatency {
+	p := a.latencies.Percentiles()
+	return results.ReadAPILatency{
+		Requests: a.requests.Load(),
+		Errors:   a.errors.Load(),
+		P50:      p.P50,
+		P95:      p.P95,
+		P99:      p.P99,
+		Max:      p.Max,
+	}
+}
+
+// readWorkload reads back workflows completed during the run, alternating
+// DescribeWorkflowExecution and a first page of GetWorkflowExecutionHistory,
+// so read-path latency is measured under the benchmark's write load.
+type readWorkload struct {
+	svc       workflowservice.WorkflowServiceClient
+	namespace string
+	qps       float64
+
+	mu     sync.Mutex
+	recent []*commonpb.WorkflowExecution // Ring buffer of completed executions
+	next   int
+
+	describe   readAPI
+	getHistory readAPI
+}
+
+func newReadWorkload(svc workflowservice.WorkflowServiceClient, namespace string, qps float64) *readWorkload {
+	return &readWorkload{
+		svc:        svc,
+		namespace:  namespace,
+		qps:        qps,
+		describe:   readAPI{latencies: metrics.NewLatencyCollector(0)},
+		getHistory: readAPI{latencies: metrics.NewLatencyCollector(0)},
+	}
+}
+
+// offer adds a completed workflow to the read targets, replacing the oldest
+// once readRecentExecutions are held.
+func (w *readWorkload) offer(workflowID, runID string) {
+	execution := &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.recent) < readRecentExecutions {
+		w.recent = append(w.recent, execution)
+	} else {
+		w.recent[w.next%readRecentExecutions] = execution
+	}
+	w.next++
+}
+
+// target returns the n-th read target, cycling through the recent
+// executions, or nil before any workflow has completed.
+func (w *readWorkload) target(n int) *commonpb.WorkflowExecution {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.recent) == 0 {
+		return nil
+	}
+	return w.recent[n%len(w.recent)]
+}
+
+// run issues reads at the workload's QPS until ctx is done, then waits for
+// reads in flight.
+func (w *readWorkload) run(ctx context.Context) {
+	slog.Info("Starting background read workload", "qps", w.qps)
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / w.qps))
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	var inFlight atomic.Int64
+	for n := 0; ; {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			execution := w.target(n / 2)
+			if execution == nil || inFlight.Load() >= readMaxInFlight {
+				continue
+			}
+			describe := n%2 == 0
+			n++
+			inFlight.Add(1)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer inFlight.Add(-1)
+				w.read(ctx, execution, describe)
+			}()
+		}
+	}
+}
+
+// read calls one read API for execution. Reads cut short because ctx ended
+// with the run are not recorded.
+func (w *readWorkload) read(ctx context.Context, execution *commonpb.WorkflowExecution, describe bool) {
+	callCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	api := &w.getHistory
+	start := time.Now()
+	var err error
+	if describe {
+		api = &w.describe
+		_, err = w.svc.DescribeWorkflowExecution(callCtx, &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: w.namespace,
+			Execution: execution,
+		})
+	} else {
+		_, err = w.svc.GetWorkflowExecutionHistory(callCtx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace: w.namespace,
+			Execution: execution,
+		})
+	}
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+	api.record(start, err)
+}
+
+// result reports the latencies measured so far.
+func (w *readWorkload) result() *results.ReadLatency {
+	return &results.ReadLatency{
+		QPS:        w.qps,
+		Describe:   w.describe.result(),
+		GetHistory: w.getHistory.result(),
+	}
+}
@@ -0,0 +1,52 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeReadService answers describes and fails history reads.
+type fakeReadService struct {
+	workflowservice.WorkflowServiceClient
+}
+
+func (fakeReadService) DescribeWorkflowExecution(context.Context, *workflowservice.DescribeWorkflowExecutionRequest, ...grpc.CallOption) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	return &workflowservice.DescribeWorkflowExecutionResponse{}, nil
+}
+
+func (fakeReadService) GetWorkflowExecutionHistory(context.Context, *workflowservice.GetWorkflowExecutionHistoryRequest, ...grpc.CallOption) (*workflowservice.GetWorkflowExecutionHistoryResponse, error) {
+	return nil, errors.New("history unavailable")
+}
+
+func TestReadWorkload(t *testing.T) {
+	w := newReadWorkload(fakeReadService{}, "ns", 500)
+	require.Nil(t, w.target(0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	w.offer("wf-1", "run-1")
+	w.run(ctx)
+
+	result := w.result()
+	require.Equal(t, float64(500), result.QPS)
+	require.Positive(t, result.Describe.Requests)
+	require.Zero(t, result.Describe.Errors)
+	require.Positive(t, result.GetHistory.Requests)
+	require.Equal(t, result.GetHistory.Requests, result.GetHistory.Errors)
+}
+
+func TestReadWorkload_KeepsRecentExecutions(t *testing.T) {
+	w := newReadWorkload(fakeReadService{}, "ns", 1)
+	for i := 0; i < readRecentExecutions+1; i++ {
+		w.offer("wf", "run")
+	}
+	w.offer("latest", "run")
+	require.Len(t, w.recent, readRecentExecutions)
+	require.Equal(t, "latest", w.target(1).GetWorkflowId())
+}
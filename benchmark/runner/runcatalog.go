@@ -0,0 +1,343 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// Run catalog statuses.
+const (
+	CatalogRunning = "running" // Started; a run that crashed stays running
+	CatalogPassed  = "passed"  // Finished and passed the thresholds
+	CatalogFailed  = "failed"  // Finished and failed the thresholds
+	CatalogError   = "error"   // Ended with an error before producing a result
+)
+
+const (
+	// RunCatalogWorkflowName is the registered name of RunCatalogWorkflow
+	RunCatalogWorkflowName = "BenchmarkRunCatalog"
+
+	// runCatalogWorkflowID is the singleton catalog workflow of the catalog namespace
+	runCatalogWorkflowID = "benchmark-run-catalog"
+
+	// runCatalogTaskQueue is polled by the benchmark processes using the catalog
+	runCatalogTaskQueue = "benchmark-run-catalog"
+
+	// runCatalogSignal adds or updates a run; runCatalogQuery lists the runs
+	runCatalogSignal = "record-run"
+	runCatalogQuery  = "runs"
+
+	// maxCatalogRuns bounds the runs kept, dropping the oldest
+	maxCatalogRuns = 1000
+
+	// catalogSignalsPerRun bounds the signals handled before continuing as
+	// new, keeping the catalog's history small
+	catalogSignalsPerRun = 500
+
+	// runCatalogCallTimeout bounds each catalog call
+	runCatalogCallTimeout = 10 * time.Second
+)
+
+// CatalogRun is one benchmark run in the run catalog. A run is recorded when
+// it starts and updated when it finishes.
+type CatalogRun struct {
+	ID             string        `json:"id"`
+	Namespace      string        `json:"namespace"`
+	Host           string        `json:"host"`
+	ClientAZ       string        `json:"clientAz,omitempty"`
+	WorkflowType   string        `json:"workflowType"`
+	Scenario       string        `json:"scenario,omitempty"`
+	TargetRate     float64       `json:"targetRate"`
+	Duration       time.Duration `json:"duration"`
+	Status         string        `json:"status"`
+	Started        time.Time     `json:"started"`
+	Finished       time.Time     `json:"finished,omitempty"`
+	Throughput     float64       `json:"throughput,omitempty"`
+	LatencyP99Ms   float64       `json:"latencyP99Ms,omitempty"`
+	FailureReasons []string      `json:"failureReasons,omitempty"`
+	Error          string        `json:"error,omitempty"`
+	ResultLocation string        `json:"resultLocation,omitempty"` // Result sinks and history file the result was published to
+}
+
+// RunCatalogWorkflow keeps the run catalog: the runs recorded by signal, in
+// start order, returned by query. It runs forever, continuing as new with
+// the catalog every catalogSignalsPerRun signals.
+func RunCatalogWorkflow(ctx workflow.Context, runs []CatalogRun) error {
+	if err := workflow.SetQueryHandler(ctx, runCatalogQuery, func() ([]CatalogRun, error) {
+		return runs, nil
+	}); err != nil {
+		return err
+	}
+
+	signals := workflow.GetSignalChannel(ctx, runCatalogSignal)
+	for handled := 0; handled < catalogSignalsPerRun; handled++ {
+		var run CatalogRun
+		signals.Receive(ctx, &run)
+		runs = upsertCatalogRun(runs, run)
+	}
+
+	// Carry over signals received since the last one handled
+	for {
+		var run CatalogRun
+		if !signals.ReceiveAsync(&run) {
+			break
+		}
+		runs = upsertCatalogRun(runs, run)
+	}
+	return workflow.NewContinueAsNewError(ctx, RunCatalogWorkflowName, runs)
+}
+
+// upsertCatalogRun replaces the run with run's ID or appends run, dropping
+// the oldest runs beyond maxCatalogRuns.
+func upsertCatalogRun(runs []CatalogRun, run CatalogRun) []CatalogRun {
+	if i := slices.IndexFunc(runs, func(r CatalogRun) bool { return r.ID == run.ID }); i >= 0 {
+		runs[i] = run
+		return runs
+	}
+	runs = append(runs, run)
+	if len(runs) > maxCatalogRuns {
+		runs = runs[len(runs)-maxCatalogRuns:]
+	}
+	return runs
+}
+
+// runCatalog records one run in the catalog. Recording is best effort: a
+// run is never failed because the catalog is unavailable.
+type runCatalog struct {
+	client client.Client
+	worker worker.Worker
+	run    CatalogRun
+}
+
+// startCatalogWorker starts a worker processing the catalog workflow.
+// Signals are only applied while some process polls the catalog task queue,
+// so every process recording or listing runs polls it.
+func startCatalogWorker(c client.Client) (worker.Worker, error) {
+	w := worker.New(c, runCatalogTaskQueue, worker.Options{})
+	w.RegisterWorkflowWithOptions(RunCatalogWorkflow, workflow.RegisterOptions{Name: RunCatalogWorkflowName})
+	if err := w.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start run catalog worker: %w", err)
+	}
+	return w, nil
+}
+
+// openRunCatalog records the run in namespace as running if cfg enables the
+// catalog, returning nil if it is disabled or unavailable.
+func (r *runner) openRunCatalog(ctx context.Context, cfg config.BenchmarkConfig, namespace string) *runCatalog {
+	if !cfg.RunCatalog {
+		return nil
+	}
+	if err := r.ensureNamespace(ctx, cfg.RunCatalogNamespace, nil); err != nil {
+		slog.Warn("Run catalog unavailable; run not recorded", "catalog_namespace", cfg.RunCatalogNamespace, "error", err)
+		return nil
+	}
+	c, err := r.dialNamespaceClient(cfg.RunCatalogNamespace)
+	if err != nil {
+		slog.Warn("Run catalog unavailable; run not recorded", "catalog_namespace", cfg.RunCatalogNamespace, "error", err)
+		return nil
+	}
+	w, err := startCatalogWorker(c)
+	if err != nil {
+		c.Close()
+		slog.Warn("Run catalog unavailable; run not recorded", "catalog_namespace", cfg.RunCatalogNamespace, "error", err)
+		return nil
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown-host"
+	}
+	now := time.Now()
+	catalog := &runCatalog{client: c, worker: w, run: CatalogRun{
+		ID:             fmt.Sprintf("%s-%d", namespace, now.UnixNano()),
+		Namespace:      namespace,
+		Host:           host,
+		WorkflowType:   cfg.WorkflowType,
+		TargetRate:     cfg.TargetRate,
+		Duration:       cfg.Duration,
+		Status:         CatalogRunning,
+		Started:        now,
+		ResultLocation: resultLocation(cfg),
+	}}
+	if r.scenario != nil {
+		catalog.run.Scenario = r.scenario.Name
+	}
+	if r.topology != nil {
+		catalog.run.ClientAZ = r.topology.ClientAZ
+	}
+	if catalog.record(ctx) {
+		slog.Info("Recorded run in the run catalog", "run", catalog.run.ID, "catalog_namespace", cfg.RunCatalogNamespace)
+	}
+	return catalog
+}
+
+// finish records the run's outcome and stops the catalog worker.
+func (c *runCatalog) finish(ctx context.Context, result *BenchmarkResult, err error) {
+	if c == nil {
+		return
+	}
+	defer c.client.Close()
+	defer c.worker.Stop()
+
+	c.run.Finished = time.Now()
+	switch {
+	case result != nil:
+		c.run.Status = CatalogFailed
+		if result.Passed {
+			c.run.Status = CatalogPassed
+		}
+		c.run.Throughput = result.ActualRate
+		c.run.LatencyP99Ms = result.LatencyP99
+		c.run.FailureReasons = result.FailureReasons
+	default:
+		c.run.Status = CatalogError
+	}
+	if err != nil {
+		c.run.Error = err.Error()
+	}
+	c.record(context.WithoutCancel(ctx))
+}
+
+// record signals the run to the catalog workflow, starting the catalog if
+// needed, and reports whether it succeeded.
+func (c *runCatalog) record(ctx context.Context) bool {
+	ctx, cancel := context.WithTimeout(ctx, runCatalogCallTimeout)
+	defer cancel()
+	_, err := c.client.SignalWithStartWorkflow(ctx, runCatalogWorkflowID, runCatalogSignal, c.run,
+		client.StartWorkflowOptions{ID: runCatalogWorkflowID, TaskQueue: runCatalogTaskQueue},
+		RunCatalogWorkflowName, []CatalogRun(nil))
+	if err != nil {
+		slog.Warn("Failed to record run in the run catalog", "run", c.run.ID, "status", c.run.Status, "error", err)
+		return false
+	}
+	return true
+}
+
+// resultLocation describes where cfg publishes the run's result. HTTP sinks
+// are reduced to their host, since resolved webhook URLs may embed tokens.
+func resultLocation(cfg config.BenchmarkConfig) string {
+	var locations []string
+	for _, sink := range strings.Split(cfg.ResultSinks, ",") {
+		sink = strings.TrimSpace(sink)
+		if u, err := url.Parse(sink); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+			sink = u.Scheme + "://" + u.Host
+		}
+		if sink != "" {
+			locations = append(locations, sink)
+		}
+	}
+	if cfg.HistoryFile != "" {
+		locations = append(locations, "history:"+cfg.HistoryFile)
+	}
+	return strings.Join(locations, ",")
+}
+
+// ListRuns returns the runs in the catalog of c's namespace, newest first.
+// It polls the catalog task queue while querying, so runs recorded while no
+// benchmark process was polling are applied first. An unstarted catalog has
+// no runs.
+func ListRuns(ctx context.Context, c client.Client) ([]CatalogRun, error) {
+	w, err := startCatalogWorker(c)
+	if err != nil {
+		return nil, results.NewRunError(results.CategoryWorker, results.PhaseSetup, err)
+	}
+	defer w.Stop()
+
+	resp, err := c.QueryWorkflow(ctx, runCatalogWorkflowID, "", runCatalogQuery)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query run catalog: %w", err)
+	}
+	var runs []CatalogRun
+	if err := resp.Get(&runs); err != nil {
+		return nil, fmt.Errorf("failed to decode run catalog: %w", err)
+	}
+	slices.Reverse(runs)
+	return runs, nil
+}
+
+// AZLatency summarizes the runs of one availability zone among concurrent
+// runs in a namespace, such as several generator tasks spread over AZs.
+type AZLatency struct {
+	Namespace    string    `json:"namespace"`
+	Started      time.Time `json:"started"` // Start of the first of the concurrent runs
+	AZ           string    `json:"az"`
+	Runs         int       `json:"runs"`
+	Throughput   float64   `json:"throughput"`   // Sum over the AZ's runs
+	LatencyP99Ms float64   `json:"latencyP99Ms"` // Highest of the AZ's runs
+}
+
+// LatencyByAZ groups the finished runs into sets of runs in the same
+// namespace that overlapped in time, and breaks down each set whose runs came
+// from more than one availability zone by zone, oldest set first. Runs
+// without a recorded zone are left out.
+func LatencyByAZ(runs []CatalogRun) []AZLatency {
+	var finished []CatalogRun
+	for _, run := range runs {
+		if run.ClientAZ != "" && !run.Finished.IsZero() {
+			finished = append(finished, run)
+		}
+	}
+	slices.SortFunc(finished, func(a, b CatalogRun) int {
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return a.Started.Compare(b.Started)
+	})
+
+	var breakdown []AZLatency
+	for start := 0; start < len(finished); {
+		end, until := start+1, finished[start].Finished
+		for end < len(finished) && finished[end].Namespace == finished[start].Namespace && finished[end].Started.Before(until) {
+			until = latest(until, finished[end].Finished)
+			end++
+		}
+
+		byAZ := make(map[string]*AZLatency)
+		for _, run := range finished[start:end] {
+			az, ok := byAZ[run.ClientAZ]
+			if !ok {
+				az = &AZLatency{Namespace: run.Namespace, Started: finished[start].Started, AZ: run.ClientAZ}
+				byAZ[run.ClientAZ] = az
+			}
+			az.Runs++
+			az.Throughput += run.Throughput
+			az.LatencyP99Ms = max(az.LatencyP99Ms, run.LatencyP99Ms)
+		}
+		if len(byAZ) > 1 {
+			for _, name := range slices.Sorted(maps.Keys(byAZ)) {
+				breakdown = append(breakdown, *byAZ[name])
+			}
+		}
+		start = end
+	}
+	slices.SortStableFunc(breakdown, func(a, b AZLatency) int { return a.Started.Compare(b.Started) })
+	return breakdown
+}
+
+// latest returns the later of a and b.
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,110 @@
+package runner
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func newCatalogEnv() *testsuite.TestWorkflowEnvironment {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(RunCatalogWorkflow, workflow.RegisterOptions{Name: RunCatalogWorkflowName})
+	return env
+}
+
+func queryCatalog(t *testing.T, env *testsuite.TestWorkflowEnvironment) []CatalogRun {
+	value, err := env.QueryWorkflow(runCatalogQuery)
+	require.NoError(t, err)
+	var runs []CatalogRun
+	require.NoError(t, value.Get(&runs))
+	return runs
+}
+
+func TestRunCatalogWorkflow_RecordsAndUpdatesRuns(t *testing.T) {
+	env := newCatalogEnv()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(runCatalogSignal, CatalogRun{ID: "a", Namespace: "benchmark-a", Status: CatalogRunning})
+		env.SignalWorkflow(runCatalogSignal, CatalogRun{ID: "b", Namespace: "benchmark-b", Status: CatalogRunning})
+		env.SignalWorkflow(runCatalogSignal, CatalogRun{ID: "a", Namespace: "benchmark-a", Status: CatalogPassed, Throughput: 100})
+	}, time.Second)
+	env.RegisterDelayedCallback(func() {
+		runs := queryCatalog(t, env)
+		require.Len(t, runs, 2)
+		require.Equal(t, "a", runs[0].ID)
+		require.Equal(t, CatalogPassed, runs[0].Status)
+		require.Equal(t, 100.0, runs[0].Throughput)
+		require.Equal(t, CatalogRunning, runs[1].Status)
+		env.CancelWorkflow()
+	}, 2*time.Second)
+
+	env.ExecuteWorkflow(RunCatalogWorkflowName, []CatalogRun(nil))
+	require.True(t, env.IsWorkflowCompleted())
+}
+
+func TestRunCatalogWorkflow_ContinuesAsNewWithRuns(t *testing.T) {
+	env := newCatalogEnv()
+	env.RegisterDelayedCallback(func() {
+		for i := 0; i < catalogSignalsPerRun+2; i++ {
+			env.SignalWorkflow(runCatalogSignal, CatalogRun{ID: fmt.Sprint(i), Status: CatalogRunning})
+		}
+	}, time.Second)
+
+	env.ExecuteWorkflow(RunCatalogWorkflowName, []CatalogRun{{ID: "old", Status: CatalogPassed}})
+	require.True(t, env.IsWorkflowCompleted())
+	require.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+}
+
+func TestUpsertCatalogRun_DropsOldestBeyondLimit(t *testing.T) {
+	var runs []CatalogRun
+	for i := 0; i < maxCatalogRuns+5; i++ {
+		runs = upsertCatalogRun(runs, CatalogRun{ID: fmt.Sprint(i)})
+	}
+	require.Len(t, runs, maxCatalogRuns)
+	require.Equal(t, "5", runs[0].ID)
+	require.Equal(t, fmt.Sprint(maxCatalogRuns+4), runs[len(runs)-1].ID)
+}
+
+func TestResultLocation(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.ResultSinks = "stdout,file:/results/run.json"
+	require.Equal(t, "stdout,file:/results/run.json", resultLocation(cfg))
+
+	cfg.HistoryFile = "/results/history.jsonl"
+	require.Equal(t, "stdout,file:/results/run.json,history:/results/history.jsonl", resultLocation(cfg))
+
+	cfg.ResultSinks = "https://hooks.example.com/services/secret-token?key=x"
+	require.Equal(t, "https://hooks.example.com,history:/results/history.jsonl", resultLocation(cfg))
+}
+
+func TestLatencyByAZ(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	run := func(namespace, az string, startOffset time.Duration, throughput, p99 float64) CatalogRun {
+		return CatalogRun{
+			Namespace: namespace, ClientAZ: az, Throughput: throughput, LatencyP99Ms: p99,
+			Started: start.Add(startOffset), Finished: start.Add(startOffset + 10*time.Minute),
+		}
+	}
+	runs := []CatalogRun{
+		// Three concurrent generators over two AZs
+		run("benchmark", "us-east-1a", 0, 100, 80),
+		run("benchmark", "us-east-1b", time.Second, 90, 120),
+		run("benchmark", "us-east-1a", 2*time.Second, 110, 95),
+		// A later run of the same namespace in a single AZ
+		run("benchmark", "us-east-1a", time.Hour, 100, 80),
+		// Concurrent runs in different namespaces are not compared
+		run("benchmark-other", "us-east-1c", 0, 50, 60),
+		{Namespace: "benchmark", ClientAZ: "us-east-1c", Started: start, Status: CatalogRunning},
+	}
+
+	breakdown := LatencyByAZ(runs)
+	require.Equal(t, []AZLatency{
+		{Namespace: "benchmark", Started: start, AZ: "us-east-1a", Runs: 2, Throughput: 210, LatencyP99Ms: 95},
+		{Namespace: "benchmark", Started: start, AZ: "us-east-1b", Runs: 1, Throughput: 90, LatencyP99Ms: 120},
+	}, breakdown)
+}
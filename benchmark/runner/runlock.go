@@ -0,0 +1,160 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+const (
+	// runLockWorkflowID is the singleton workflow whose open execution is the lock
+	runLockWorkflowID = "benchmark-run-lock"
+
+	// runLockTaskQueue has no workers: the lock workflow only has to stay open
+	// until it is terminated on release or times out after the TTL
+	runLockTaskQueue = "benchmark-run-lock"
+
+	// runLockHolderMemo names the memo field identifying the holder
+	runLockHolderMemo = "holder"
+
+	// runLockPollInterval is how often a queued run retries the lock
+	runLockPollInterval = 10 * time.Second
+
+	// runLockCallTimeout bounds each lock RPC
+	runLockCallTimeout = 10 * time.Second
+)
+
+// runLock is a held concurrent-run lock.
+type runLock struct {
+	svc       workflowservice.WorkflowServiceClient
+	namespace string
+	runID     string
+}
+
+// acquireRunLock takes the cluster-wide run lock for holder by starting the
+// lock workflow. If another run holds it, it fails or, in queue mode, retries
+// until the lock is free or cfg.RunLockWait elapses. The lock is not renewed:
+// it expires after cfg.RunLockTTL, which config validation makes cover the
+// longest run (see BenchmarkConfig.MaxRunLength), unless released first.
+func acquireRunLock(ctx context.Context, svc workflowservice.WorkflowServiceClient, cfg config.BenchmarkConfig, holder string) (*runLock, error) {
+	holderPayload, err := converter.GetDefaultDataConverter().ToPayload(holder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode lock holder: %w", err)
+	}
+
+	deadline := time.Now().Add(cfg.RunLockWait)
+	for {
+		callCtx, cancel := context.WithTimeout(ctx, runLockCallTimeout)
+		resp, err := svc.StartWorkflowExecution(callCtx, &workflowservice.StartWorkflowExecutionRequest{
+			Namespace:                cfg.RunLockNamespace,
+			WorkflowId:               runLockWorkflowID,
+			WorkflowType:             &commonpb.WorkflowType{Name: "BenchmarkRunLock"},
+			TaskQueue:                &taskqueuepb.TaskQueue{Name: runLockTaskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			WorkflowExecutionTimeout: durationpb.New(cfg.RunLockTTL),
+			Identity:                 holder,
+			RequestId:                fmt.Sprintf("%s-%d", holder, time.Now().UnixNano()),
+			WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			WorkflowIdConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_FAIL,
+			Memo:                     &commonpb.Memo{Fields: map[string]*commonpb.Payload{runLockHolderMemo: holderPayload}},
+		})
+		cancel()
+		if err == nil {
+			slog.Info("Acquired run lock", "namespace", cfg.RunLockNamespace, "holder", holder, "ttl", cfg.RunLockTTL)
+			return &runLock{svc: svc, namespace: cfg.RunLockNamespace, runID: resp.GetRunId()}, nil
+		}
+
+		var held *serviceerror.WorkflowExecutionAlreadyStarted
+		if !errors.As(err, &held) {
+			return nil, fmt.Errorf("failed to acquire run lock: %w", err)
+		}
+		current, since := runLockHolder(ctx, svc, cfg.RunLockNamespace)
+		if cfg.RunLock != config.RunLockQueue {
+			return nil, fmt.Errorf("another benchmark holds the run lock (holder %q since %s)", current, since.Format(time.RFC3339))
+		}
+		if time.Now().Add(runLockPollInterval).After(deadline) {
+			return nil, fmt.Errorf("run lock still held by %q after waiting %s", current, cfg.RunLockWait)
+		}
+
+		slog.Info("Waiting for run lock", "holder", current, "held_since", since, "retry_in", runLockPollInterval)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(runLockPollInterval):
+		}
+	}
+}
+
+// runLockHolder returns the current holder of the lock and when it took it,
+// or "unknown" if the lock workflow cannot be described.
+func runLockHolder(ctx context.Context, svc workflowservice.WorkflowServiceClient, namespace string) (string, time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, runLockCallTimeout)
+	defer cancel()
+	resp, err := svc.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: runLockWorkflowID},
+	})
+	if err != nil {
+		return "unknown", time.Time{}
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	holder := "unknown"
+	if payload := info.GetMemo().GetFields()[runLockHolderMemo]; payload != nil {
+		_ = converter.GetDefaultDataConverter().FromPayload(payload, &holder)
+	}
+	return holder, info.GetStartTime().AsTime()
+}
+
+// release terminates the lock workflow this run started. A lock that cannot
+// be released expires after its TTL.
+func (l *runLock) release(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), runLockCallTimeout)
+	defer cancel()
+	_, err := l.svc.TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace:         l.namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: runLockWorkflowID, RunId: l.runID},
+		Reason:            "benchmark run finished",
+	})
+	if err != nil {
+		slog.Warn("Failed to release run lock; it expires after its TTL", "error", err)
+		return
+	}
+	slog.Info("Released run lock", "namespace", l.namespace)
+}
+
+// runLockHolderName identifies this run in the lock memo.
+func runLockHolderName(namespace string) string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown-host"
+	}
+	return fmt.Sprintf("%s/%s", host, namespace)
+}
+
+// lockRun takes the run lock if cfg enables it, returning a func releasing it.
+func (r *runner) lockRun(ctx context.Context, cfg config.BenchmarkConfig, namespace string) (func(), error) {
+	if cfg.RunLock == "" || cfg.RunLock == config.RunLockOff {
+		return func() {}, nil
+	}
+	if err := r.ensureNamespace(ctx, cfg.RunLockNamespace, nil); err != nil {
+		return nil, results.NewRunError(results.CategoryNamespace, results.PhaseSetup, fmt.Errorf("failed to create run lock namespace %s: %w", cfg.RunLockNamespace, err))
+	}
+	lock, err := acquireRunLock(ctx, r.client.WorkflowService(), cfg, runLockHolderName(namespace))
+	if err != nil {
+		return nil, results.NewRunError(results.CategoryLocked, results.PhaseSetup, err)
+	}
+	return func() { lock.release(ctx) }, nil
+}
@@ -0,0 +1,86 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// fakeLockService holds the lock workflow open from its start until it is
+// terminated.
+type fakeLockService struct {
+	workflowservice.WorkflowServiceClient
+	runs  int
+	open  *workflowservice.StartWorkflowExecutionRequest
+	runID string
+}
+
+func (f *fakeLockService) StartWorkflowExecution(_ context.Context, req *workflowservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.StartWorkflowExecutionResponse, error) {
+	if f.open != nil {
+		return nil, serviceerror.NewWorkflowExecutionAlreadyStarted("already started", req.GetRequestId(), f.runID)
+	}
+	f.runs++
+	f.open, f.runID = req, fmt.Sprintf("run-%d", f.runs)
+	return &workflowservice.StartWorkflowExecutionResponse{RunId: f.runID}, nil
+}
+
+func (f *fakeLockService) DescribeWorkflowExecution(context.Context, *workflowservice.DescribeWorkflowExecutionRequest, ...grpc.CallOption) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	return &workflowservice.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+		Memo:      f.open.GetMemo(),
+		StartTime: timestamppb.New(time.Date(2026, 1, 13, 20, 0, 0, 0, time.UTC)),
+	}}, nil
+}
+
+func (f *fakeLockService) TerminateWorkflowExecution(_ context.Context, req *workflowservice.TerminateWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.TerminateWorkflowExecutionResponse, error) {
+	if req.GetWorkflowExecution().GetRunId() == f.runID {
+		f.open = nil
+	}
+	return &workflowservice.TerminateWorkflowExecutionResponse{}, nil
+}
+
+func TestRunLock(t *testing.T) {
+	ctx := context.Background()
+	svc := &fakeLockService{}
+	cfg := config.DefaultConfig()
+	cfg.RunLock = config.RunLockFail
+
+	lock, err := acquireRunLock(ctx, svc, cfg, "host-a/benchmark-1")
+	require.NoError(t, err)
+	require.Equal(t, cfg.RunLockNamespace, svc.open.GetNamespace())
+	require.Equal(t, runLockWorkflowID, svc.open.GetWorkflowId())
+	require.Equal(t, cfg.RunLockTTL, svc.open.GetWorkflowExecutionTimeout().AsDuration())
+
+	// A second run fails, naming the holder
+	_, err = acquireRunLock(ctx, svc, cfg, "host-b/benchmark-2")
+	require.ErrorContains(t, err, `holder "host-a/benchmark-1" since 2026-01-13T20:00:00Z`)
+
+	// Queued runs give up once the wait is over
+	cfg.RunLock = config.RunLockQueue
+	cfg.RunLockWait = time.Second
+	_, err = acquireRunLock(ctx, svc, cfg, "host-b/benchmark-2")
+	require.ErrorContains(t, err, `still held by "host-a/benchmark-1"`)
+
+	// Once released, the lock can be taken again
+	lock.release(ctx)
+	require.Nil(t, svc.open)
+	_, err = acquireRunLock(ctx, svc, cfg, "host-b/benchmark-2")
+	require.NoError(t, err)
+	require.Equal(t, "run-2", svc.runID)
+}
+
+func TestRunLockReleaseKeepsOtherHolders(t *testing.T) {
+	svc := &fakeLockService{open: &workflowservice.StartWorkflowExecutionRequest{Memo: &commonpb.Memo{}}, runID: "run-other"}
+	(&runLock{svc: svc, namespace: config.DefaultRunLockNamespace, runID: "run-expired"}).release(context.Background())
+	require.NotNil(t, svc.open)
+}
@@ -0,0 +1,1242 @@
+// Package runner provides the benchmark orchestration logic.
+//
+// The runner can be embedded in other test harnesses: build a
+// config.BenchmarkConfig (config.DefaultConfig or config.LoadFromEnv), create a
+// runner with NewRunner and WithHostPort, then call Run and Cleanup. Results
+// can be published with PublishResults or inspected directly.
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/worker"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/auth"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/churn"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/cleanup"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/dialer"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/dynconfig"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/grafana"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/internal/redact"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scaling"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/stream"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+// BenchmarkResult is an alias to the results package BenchmarkResult.
+// This maintains backward compatibility while using the centralized results package.
+type BenchmarkResult = results.BenchmarkResult
+
+// BenchmarkRunner orchestrates benchmark execution.
+type BenchmarkRunner interface {
+	// Run executes the benchmark with the given configuration
+	Run(ctx context.Context, cfg config.BenchmarkConfig) (*BenchmarkResult, error)
+
+	// Smoke runs the fixed smoke test scenario with strict success criteria
+	Smoke(ctx context.Context, cfg config.BenchmarkConfig) (*SmokeResult, error)
+
+	// Verify runs one workflow of every registered type and reports per-type results
+	Verify(ctx context.Context, cfg config.BenchmarkConfig) (*VerifyResult, error)
+
+	// Cleanup terminates workflows and cleans up resources
+	Cleanup(ctx context.Context, namespace string) error
+
+	// GetNamespace returns the namespace used for the last benchmark run
+	GetNamespace() string
+}
+
+// NamespacePrefix is the prefix for benchmark namespaces.
+// Requirement 8.1: THE Benchmark_Runner SHALL use a dedicated namespace prefixed with "benchmark-"
+const NamespacePrefix = "benchmark-"
+
+// DefaultTaskQueue is the default task queue for benchmark workflows.
+const DefaultTaskQueue = "benchmark-task-queue"
+
+// MetricsPort is the default port for the Prometheus metrics endpoint.
+// Requirement 3.1.1: THE Benchmark_Runner SHALL expose Temporal SDK metrics on a Prometheus endpoint (port 9090)
+// The effective port is taken from BenchmarkConfig.MetricsPort.
+const MetricsPort = config.DefaultMetricsPort
+
+// runner implements BenchmarkRunner.
+type runner struct {
+	client         client.Client
+	hostPort       string        // Store the host:port for creating namespace-specific clients
+	authProvider   auth.Provider // Credentials for namespace-specific clients (nil connects without)
+	metricsHandler metrics.MetricsHandler
+	sdkMetrics     client.MetricsHandler // SDK metrics for namespace clients (nil disables them)
+	cleaner        *cleanup.Cleaner
+	lastNamespace  string // Track the namespace used in the last run
+	cleanupLimits  cleanup.Limits
+	control        *LoadControl
+	scenario       *scenario.Scenario           // Phased scenario (nil runs the single configured phase)
+	scaling        *scaling.Experiment          // Worker scaling experiment (nil leaves the worker fleet alone)
+	churn          *churn.Experiment            // History task restarts of a shard churn scenario (nil restarts none)
+	dynamicConfig  *dynconfig.Overrides         // Dynamic config overrides applied for each run (nil applies none)
+	topology       *results.Topology            // Availability zones of the client and frontend (nil if not detected)
+	dialer         *dialer.Dialer               // Frontend dialing of namespace-specific clients (nil leaves it to gRPC)
+	baseline       *results.BenchmarkResultJSON // Baseline for percent-of-baseline thresholds (nil skips them)
+	evaluators     []results.ThresholdEvaluator // Custom pass/fail logic, after the built-in thresholds
+	grafana        *grafana.Client              // Snapshots each run's dashboard (nil disables snapshots)
+	reads          *readWorkload                // Background read workload of the current run (nil if disabled)
+	histories      *historySampler              // Completed workflows sampled for history size (nil if disabled)
+	audits         *historySampler              // Completed workflows sampled for the history integrity audit (nil if disabled)
+	burnRate       *burnRateMonitor             // Latency SLA burn rate evaluated during the run (nil if disabled)
+	heatmap        *latencyHeatmap              // Latencies of the current run by time window (nil if disabled)
+	churnLatency   *latencyHeatmap              // Latencies around shard churn restarts (nil without shard churn)
+	latencyBudget  *metrics.LatencyBudget       // Raw latency sample budget the run's collectors share with the metrics handler
+	cancellations  *cancellationTracker         // Workflows cancelled mid-flight in the current run
+	typeLatency    *workflowTypeTracker         // Latencies per workflow type of a mixed run (nil if not mixed)
+	events         *EventRecorder               // Raw event log for Replay (nil disables recording)
+	stream         *stream.Firehose             // Per-workflow record stream (nil disables streaming)
+	progress       *Progress                    // Live state for a progress view (nil disables it)
+	timeline       *Timeline                    // Run lifecycle timeline (nil disables it)
+
+	// externalMetricsServer is set when the caller owns the metrics server lifecycle
+	externalMetricsServer bool
+}
+
+// RunnerOption configures the runner.
+type RunnerOption func(*runner)
+
+// WithMetricsHandler sets a custom metrics handler.
+func WithMetricsHandler(h metrics.MetricsHandler) RunnerOption {
+	return func(r *runner) {
+		r.metricsHandler = h
+	}
+}
+
+// WithSDKMetricsHandler sets the Temporal SDK metrics handler used by the
+// namespace clients and embedded worker. It must write to the metrics handler's
+// registry; the worker slot gauges it records drive backpressure detection.
+func WithSDKMetricsHandler(h client.MetricsHandler) RunnerOption {
+	return func(r *runner) {
+		r.sdkMetrics = h
+	}
+}
+
+// WithHostPort sets the Temporal server host:port for creating namespace-specific clients.
+func WithHostPort(hostPort string) RunnerOption {
+	return func(r *runner) {
+		r.hostPort = hostPort
+	}
+}
+
+// WithAuth sets the credentials the runner's namespace-specific clients connect
+// with; use the provider the client passed to NewRunner was dialed with.
+func WithAuth(p auth.Provider) RunnerOption {
+	return func(r *runner) {
+		r.authProvider = p
+	}
+}
+
+// WithLoadControl sets the control used to pause/resume generation and override thresholds.
+func WithLoadControl(c *LoadControl) RunnerOption {
+	return func(r *runner) {
+		r.control = c
+	}
+}
+
+// WithScenario runs the scenario's phases instead of the single load phase from config.
+func WithScenario(s *scenario.Scenario) RunnerOption {
+	return func(r *runner) {
+		r.scenario = s
+	}
+}
+
+// WithWorkerScaling runs a worker scaling experiment alongside the benchmark,
+// recording each scaling step in the results.
+func WithWorkerScaling(exp *scaling.Experiment) RunnerOption {
+	return func(r *runner) {
+		r.scaling = exp
+	}
+}
+
+// WithShardChurn restarts history tasks on the experiment's schedule during
+// the benchmark, recording each restart and its latency spike in the results.
+func WithShardChurn(exp *churn.Experiment) RunnerOption {
+	return func(r *runner) {
+		r.churn = exp
+	}
+}
+
+// WithDynamicConfig applies the dynamic config overrides before each run and
+// reverts them after it, recording them in the results. The revert outlives
+// the run's context by up to o.RevertTimeout(), so callers bounding the run
+// with a deadline should reserve that much from it.
+func WithDynamicConfig(o *dynconfig.Overrides) RunnerOption {
+	return func(r *runner) {
+		r.dynamicConfig = o
+	}
+}
+
+// WithDialer dials the frontend for namespace-specific clients through d,
+// recording the address family of its connections in the results.
+func WithDialer(d *dialer.Dialer) RunnerOption {
+	return func(r *runner) {
+		r.dialer = d
+	}
+}
+
+// WithTopology records the availability zones of the client and the
+// frontend in the results and the run catalog.
+func WithTopology(t *results.Topology) RunnerOption {
+	return func(r *runner) {
+		r.topology = t
+	}
+}
+
+// WithBaseline evaluates the percent-of-baseline thresholds in the config
+// against baseline, in addition to the absolute thresholds.
+func WithBaseline(baseline *results.BenchmarkResultJSON) RunnerOption {
+	return func(r *runner) {
+		r.baseline = baseline
+	}
+}
+
+// WithThresholdEvaluators evaluates custom pass/fail logic against each
+// result, in order, after the built-in and baseline thresholds.
+func WithThresholdEvaluators(evaluators ...results.ThresholdEvaluator) RunnerOption {
+	return func(r *runner) {
+		r.evaluators = append(r.evaluators, evaluators...)
+	}
+}
+
+// WithGrafanaSnapshots snapshots the configured Grafana dashboard over each
+// run's time window, linking the snapshot from the result.
+func WithGrafanaSnapshots(c *grafana.Client) RunnerOption {
+	return func(r *runner) {
+		r.grafana = c
+	}
+}
+
+// WithExternalMetricsServer tells the runner not to start or stop the metrics server,
+// for callers (such as daemon mode) that keep it running across benchmark runs.
+func WithExternalMetricsServer() RunnerOption {
+	return func(r *runner) {
+		r.externalMetricsServer = true
+	}
+}
+
+// WithEventRecorder records each run's raw events for Replay.
+func WithEventRecorder(rec *EventRecorder) RunnerOption {
+	return func(r *runner) {
+		r.events = rec
+	}
+}
+
+// WithWorkflowStream streams a record of every finished workflow to Firehose.
+func WithWorkflowStream(f *stream.Firehose) RunnerOption {
+	return func(r *runner) {
+		r.stream = f
+	}
+}
+
+// WithProgress feeds the run's live state to p for a progress view.
+func WithProgress(p *Progress) RunnerOption {
+	return func(r *runner) {
+		r.progress = p
+	}
+}
+
+// WithTimeline writes each run's lifecycle events to t.
+func WithTimeline(t *Timeline) RunnerOption {
+	return func(r *runner) {
+		r.timeline = t
+	}
+}
+
+// WithCleanupLimits sets the termination concurrency, rate and timeouts used during cleanup.
+func WithCleanupLimits(limits cleanup.Limits) RunnerOption {
+	return func(r *runner) {
+		r.cleanupLimits = limits
+	}
+}
+
+// NewRunner creates a new BenchmarkRunner.
+func NewRunner(c client.Client, opts ...RunnerOption) BenchmarkRunner {
+	r := &runner{
+		client: c,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	// Create default metrics handler if not provided
+	if r.metricsHandler == nil {
+		r.metricsHandler = metrics.NewHandler()
+	}
+	if r.control == nil {
+		r.control = NewLoadControl()
+	}
+
+	// Cleanup progress is exported alongside the benchmark metrics
+	r.cleaner = cleanup.NewCleaner(c,
+		cleanup.WithRegistry(r.metricsHandler.Registry()),
+		cleanup.WithLimits(r.cleanupLimits),
+	)
+
+	return r
+}
+
+// Run executes the benchmark with the given configuration.
+// Requirement 5.1: THE Benchmark_Runner SHALL be deployable as an ECS task
+// Requirement 5.5: THE Benchmark_Runner SHALL support running multiple iterations and averaging results
+func (r *runner) Run(ctx context.Context, cfg config.BenchmarkConfig) (result *BenchmarkResult, err error) {
+	// A state transition target is generated as the equivalent workflow rate
+	if cfg.TargetStateTransitions > 0 {
+		cfg.TargetRate = cfg.EffectiveTargetRate()
+		cost, source := cfg.TransitionCost()
+		slog.Info("Translated state transition target to a workflow rate",
+			"target_state_transitions", cfg.TargetStateTransitions,
+			"workflow_type", cfg.WorkflowType,
+			"transitions_per_workflow", cost,
+			"cost_source", source,
+			"target_rate", cfg.TargetRate)
+	}
+
+	// Must precede the first worker, including the run catalog's
+	if cfg.Role != config.RoleGenerate {
+		ConfigureWorkflowCache(cfg)
+	}
+
+	// Requirement 5.6: IF the Temporal cluster is unhealthy, THEN THE Benchmark_Runner SHALL fail fast
+	if err := r.checkClusterHealth(ctx); err != nil {
+		return nil, results.NewRunError(results.CategoryConnection, results.PhaseConnect, fmt.Errorf("cluster health check failed: %w", err))
+	}
+
+	// Requirement 5.3: WHEN a benchmark starts, THE Benchmark_Runner SHALL create a dedicated namespace
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = generateNamespace()
+	}
+	r.lastNamespace = namespace // Track the namespace for later use
+	r.events.recordRun(namespace)
+	r.progress.startRun(namespace)
+	if cfg.ArtifactBundle {
+		r.timeline.keepRun(namespace)
+	}
+	r.timeline.runStarted(namespace, cfg, r.scenario)
+
+	// Keep other benchmarks off the cluster for the whole run
+	unlock, err := r.lockRun(ctx, cfg, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// Override dynamic config for the whole run; the services are redeployed
+	// with the overrides before the workload starts and without them after
+	if r.dynamicConfig != nil {
+		if err := r.dynamicConfig.Apply(ctx); err != nil {
+			return nil, results.NewRunError(results.CategoryConfig, results.PhaseSetup, err)
+		}
+		defer func() {
+			// Revert even when the run was cancelled or ran out of time, within
+			// the deploy timeout of each service
+			revertCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.dynamicConfig.RevertTimeout())
+			defer cancel()
+			reverted := r.dynamicConfig.Revert(revertCtx)
+			if result != nil {
+				result.DynamicConfig = reverted
+			}
+		}()
+		if err := r.checkClusterHealth(ctx); err != nil {
+			return nil, results.NewRunError(results.CategoryConnection, results.PhaseConnect, fmt.Errorf("cluster health check after applying dynamic config failed: %w", err))
+		}
+	}
+
+	// Register the run so worker services can tell its workflows from those of abandoned runs
+	run, unregister, err := r.registerRun(ctx, cfg, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer unregister()
+	if run != "" {
+		cfg.Memo = withRunMemo(cfg.Memo, run)
+	}
+
+	// Record the run and its outcome in the run catalog
+	catalog := r.openRunCatalog(ctx, cfg, namespace)
+	defer func() { catalog.finish(ctx, result, err) }()
+
+	// Keep control operations off the measured workload's connection if configured
+	control, err := r.newControlPlane(ctx, cfg, namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer control.close()
+
+	// Record the load already on the cluster before adding any
+	var clusterBefore results.ClusterSnapshot
+	if cfg.ClusterSnapshot {
+		clusterBefore = takeClusterSnapshot(ctx, control.client.WorkflowService())
+	}
+
+	if err := r.ensureNamespace(ctx, namespace, newNamespaceMetadata(cfg, r.scenario)); err != nil {
+		return nil, results.NewRunError(results.CategoryNamespace, results.PhaseSetup, fmt.Errorf("failed to create namespace %s: %w", namespace, err))
+	}
+	if usesSearchAttributes(cfg, r.scenario) {
+		if err := ensureSearchAttributes(ctx, r.client.OperatorService(), namespace); err != nil {
+			return nil, results.NewRunError(results.CategoryNamespace, results.PhaseSetup, fmt.Errorf("namespace %s: %w", namespace, err))
+		}
+	}
+
+	// Delete namespaces of expired runs, including crashed ones, while this run lasts
+	stopJanitor := r.hostNamespaceJanitor(cfg)
+	defer stopJanitor()
+
+	// Start metrics server
+	// Requirement 3.1.1: THE Benchmark_Runner SHALL expose Temporal SDK metrics on port 9090
+	if !r.externalMetricsServer {
+		if err := r.metricsHandler.StartServer(ctx, cfg.MetricsPort); err != nil {
+			return nil, results.NewRunError(results.CategoryWorker, results.PhaseSetup, fmt.Errorf("failed to start metrics server: %w", err))
+		}
+		defer func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := r.metricsHandler.StopServer(shutdownCtx); err != nil {
+				slog.Warn("Failed to stop metrics server", "error", err)
+			}
+		}()
+	}
+
+	// Watch worker slots for saturation across all iterations
+	monitor := newBackpressureMonitor(r.metricsHandler.Registry(), namespace, cfg.BackpressureThreshold, cfg.BackpressureHold, r.control)
+	monitorCtx, cancelMonitor := context.WithCancel(ctx)
+	monitorDone := make(chan struct{})
+	go func() {
+		defer close(monitorDone)
+		monitor.run(monitorCtx)
+	}()
+	// stopMonitor closes any open interval and releases a held rate; safe to call twice
+	stopMonitor := func() []results.BackpressureInterval {
+		cancelMonitor()
+		<-monitorDone
+		return monitor.finish(time.Now())
+	}
+	defer stopMonitor()
+
+	// Evaluate the latency threshold's burn rate across all iterations
+	r.burnRate = newBurnRateMonitor(r.metricsHandler.Registry(), cfg, r.control)
+	burnRateCtx, cancelBurnRate := context.WithCancel(ctx)
+	burnRateDone := make(chan struct{})
+	go func() {
+		defer close(burnRateDone)
+		r.burnRate.run(burnRateCtx)
+	}()
+	// stopBurnRate closes any open alert; safe to call twice
+	stopBurnRate := func() []results.BurnRateAlert {
+		cancelBurnRate()
+		<-burnRateDone
+		return r.burnRate.finish(time.Now())
+	}
+	defer stopBurnRate()
+
+	// Scale the worker fleet on schedule across all iterations
+	stopScaling := func() []results.ScalingEvent { return nil }
+	if r.scaling != nil {
+		scalingCtx, cancelScaling := context.WithCancel(ctx)
+		scalingDone := make(chan []results.ScalingEvent, 1)
+		go func() {
+			scalingDone <- r.scaling.Run(scalingCtx, time.Now())
+		}()
+		// stopScaling restores the original worker count; safe to call twice
+		var events []results.ScalingEvent
+		var once sync.Once
+		stopScaling = func() []results.ScalingEvent {
+			once.Do(func() {
+				cancelScaling()
+				events = <-scalingDone
+			})
+			return events
+		}
+		defer stopScaling()
+	}
+
+	// Restart history tasks on schedule across all iterations
+	stopChurn := func() []results.ShardRestart { return nil }
+	if r.churn != nil {
+		churnStart := time.Now()
+		r.churnLatency = newLatencyHeatmap(churnStart, shardChurnWindow, metrics.WorkflowLatencyBuckets(cfg.HistogramBuckets))
+		churnCtx, cancelChurn := context.WithCancel(ctx)
+		churnDone := make(chan []results.ShardRestart, 1)
+		go func() {
+			churnDone <- r.churn.Run(churnCtx, churnStart)
+		}()
+		// stopChurn measures the latency spike of each restart; safe to call twice
+		var restarts []results.ShardRestart
+		var once sync.Once
+		stopChurn = func() []results.ShardRestart {
+			once.Do(func() {
+				cancelChurn()
+				restarts = measureShardRestarts(r.churnLatency, <-churnDone, time.Now())
+			})
+			return restarts
+		}
+		defer stopChurn()
+	}
+
+	// Latencies mixing client and server timestamps are only as good as the clocks
+	clockSkew := r.measureClockSkew(ctx, cfg, control)
+
+	// Measure server-side metrics across all iterations
+	server := startServerWindow(ctx, cfg.ServerMetricsURLs)
+	workflowCache := startWorkflowCacheWindow(r.metricsHandler.Registry(), cfg, namespace)
+	activityLatency := startActivityLatencyWindow(r.metricsHandler.Registry(), cfg, namespace)
+	executions := startExecutionWindow(ctx, cfg)
+
+	// Sample completed workflows across all iterations for history size accounting
+	if cfg.HistorySizeSamples > 0 {
+		r.histories = newHistorySampler(cfg.HistorySizeSamples)
+	}
+	if cfg.IntegritySamples > 0 {
+		r.audits = newHistorySampler(cfg.IntegritySamples)
+	}
+
+	// Every raw latency sample of the run counts against one memory budget,
+	// however many collectors keep a copy
+	r.latencyBudget = metrics.SharedLatencyBudget(r.metricsHandler, int64(cfg.LatencyMemoryBudgetMB)<<20)
+
+	// Bucket workflow latencies by time window across all iterations
+	r.heatmap = newLatencyHeatmap(time.Now(), cfg.LatencyHeatmapWindow, metrics.WorkflowLatencyBuckets(cfg.HistogramBuckets))
+
+	// Time cancelled workflows across all iterations
+	r.cancellations = newCancellationTracker(r.latencyBudget)
+
+	// Break latencies down by workflow type across all iterations of a mixed run
+	r.typeLatency = newWorkflowTypeTracker(cfg, r.scenario, r.latencyBudget)
+
+	// Read back completed workflows across all iterations
+	stopReads := func() *results.ReadLatency { return nil }
+	if cfg.ReadQPS > 0 {
+		r.reads = newReadWorkload(r.client.WorkflowService(), namespace, cfg.ReadQPS)
+		readsCtx, cancelReads := context.WithCancel(ctx)
+		readsDone := make(chan struct{})
+		go func() {
+			defer close(readsDone)
+			r.reads.run(readsCtx)
+		}()
+		// stopReads ends the read workload and reports its latencies; safe to call twice
+		stopReads = func() *results.ReadLatency {
+			cancelReads()
+			<-readsDone
+			return r.reads.result()
+		}
+		defer stopReads()
+	}
+
+	// Run iterations and aggregate results
+	var aggregatedResult *BenchmarkResult
+	for i := 0; i < cfg.Iterations; i++ {
+		if cfg.Iterations > 1 {
+			slog.Info("Starting iteration", "iteration", i+1, "total", cfg.Iterations)
+		}
+
+		var result *BenchmarkResult
+		var err error
+		if r.scenario != nil {
+			result, err = r.runScenario(ctx, cfg, namespace)
+		} else {
+			result, err = r.runSingleIteration(ctx, cfg, namespace, i+1)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("iteration %d failed: %w", i+1, err)
+		}
+
+		if aggregatedResult == nil {
+			aggregatedResult = result
+		} else {
+			aggregatedResult = aggregateResults(aggregatedResult, result)
+		}
+
+		// Check for cancellation between iterations
+		select {
+		case <-ctx.Done():
+			slog.Info("Benchmark cancelled between iterations")
+			aggregatedResult.Backpressure = stopMonitor()
+			aggregatedResult.BurnRateAlerts = stopBurnRate()
+			aggregatedResult.ScalingEvents = stopScaling()
+			aggregatedResult.ShardRestarts = stopChurn()
+			aggregatedResult.ReadLatency = stopReads()
+			aggregatedResult.LatencyHeatmap = r.heatmap.result()
+			server.finish(ctx, aggregatedResult, cfg)
+			aggregatedResult.WorkflowCache = workflowCache.result(cfg)
+			aggregatedResult.ActivityLatency = activityLatency.result()
+			aggregatedResult.Duplicates = executions.result(ctx)
+			aggregatedResult.Cancellation = r.cancellations.result()
+			aggregatedResult.TypeLatency = r.typeLatency.result()
+			aggregatedResult.Topology = r.topology
+			aggregatedResult.Connection = r.dialer.Result()
+			aggregatedResult.Artifacts = r.artifacts(cfg, namespace)
+			return aggregatedResult, ctx.Err()
+		default:
+		}
+	}
+	aggregatedResult.Backpressure = stopMonitor()
+	aggregatedResult.BurnRateAlerts = stopBurnRate()
+	aggregatedResult.ScalingEvents = stopScaling()
+	aggregatedResult.ShardRestarts = stopChurn()
+	aggregatedResult.ReadLatency = stopReads()
+	aggregatedResult.LatencyHeatmap = r.heatmap.result()
+	server.finish(ctx, aggregatedResult, cfg)
+	aggregatedResult.WorkflowCache = workflowCache.result(cfg)
+	aggregatedResult.ActivityLatency = activityLatency.result()
+	aggregatedResult.Duplicates = executions.result(ctx)
+	aggregatedResult.Cancellation = r.cancellations.result()
+	aggregatedResult.TypeLatency = r.typeLatency.result()
+
+	aggregatedResult.ClockSkew = clockSkew
+	aggregatedResult.Topology = r.topology
+	aggregatedResult.Connection = r.dialer.Result()
+
+	// Workflows still open after the drain may be stuck rather than slow
+	aggregatedResult.StuckWorkflows = detectStuckWorkflows(ctx, control.cleaner, cfg, namespace)
+
+	if r.histories != nil {
+		aggregatedResult.HistorySize = r.histories.measure(ctx, control.client.WorkflowService(), namespace)
+	}
+	if r.audits != nil {
+		aggregatedResult.Integrity = r.audits.audit(ctx, control.client.WorkflowService(), namespace)
+	}
+
+	if cfg.ClusterSnapshot {
+		aggregatedResult.ClusterState = results.NewClusterState(clusterBefore, takeClusterSnapshot(ctx, control.client.WorkflowService()))
+		if aggregatedResult.ClusterState.Preloaded() {
+			slog.Warn("Benchmark started on an already-loaded cluster",
+				"open_workflows", clusterBefore.OpenWorkflows,
+				"workflow_task_backlog", clusterBefore.WorkflowTaskBacklog,
+				"activity_task_backlog", clusterBefore.ActivityTaskBacklog)
+		}
+	}
+
+	// Evaluate pass/fail against thresholds using the results package
+	// Requirement 6.4: THE Benchmark_Runner SHALL compare results against configurable thresholds
+	// Thresholds may have been reloaded while the benchmark was running
+	thresholdCfg := r.control.ApplyThresholds(cfg)
+	results.EvaluateThresholdsWithConfig(aggregatedResult, thresholdCfg)
+	results.EvaluateBaselineThresholds(aggregatedResult, r.baseline, thresholdCfg.BaselineMaxP99Percent, thresholdCfg.BaselineMinThroughputPercent)
+	results.EvaluateCustomThresholds(ctx, aggregatedResult, r.evaluators)
+	r.timeline.thresholdEvaluated(namespace, aggregatedResult)
+
+	// Link the server-side graphs of the run for reviewers
+	aggregatedResult.GrafanaSnapshot = r.snapshotDashboard(ctx, cfg, namespace, aggregatedResult)
+
+	aggregatedResult.Artifacts = r.artifacts(cfg, namespace)
+
+	if aggregatedResult.Passed {
+		slog.Info("Benchmark PASSED all thresholds")
+	} else {
+		slog.Warn("Benchmark FAILED", "failure_reasons", aggregatedResult.FailureReasons)
+	}
+
+	return aggregatedResult, nil
+}
+
+// artifacts returns the run's raw data for its artifact bundle: the
+// timeline's events (including the interval snapshots) and, when events are
+// recorded, the run's workflow events. It returns nil unless
+// cfg.ArtifactBundle is set.
+func (r *runner) artifacts(cfg config.BenchmarkConfig, namespace string) []results.Artifact {
+	if !cfg.ArtifactBundle {
+		return nil
+	}
+	var artifacts []results.Artifact
+	if data := r.timeline.takeRun(namespace); len(data) > 0 {
+		artifacts = append(artifacts, results.Artifact{Name: results.BundleTimelineFile, Data: data})
+	}
+	data, err := r.events.runEvents()
+	if err != nil {
+		slog.Warn("Failed to read recorded events for the artifact bundle", "error", err)
+	} else if len(data) > 0 {
+		artifacts = append(artifacts, results.Artifact{Name: results.BundleEventsFile, Data: data})
+	}
+	return artifacts
+}
+
+// GetNamespace returns the namespace used for the last benchmark run.
+func (r *runner) GetNamespace() string {
+	return r.lastNamespace
+}
+
+// runSingleIteration executes a single benchmark iteration (numbered from 1).
+func (r *runner) runSingleIteration(ctx context.Context, cfg config.BenchmarkConfig, namespace string, iteration int) (*BenchmarkResult, error) {
+	startTime := time.Now()
+
+	nsClient, err := r.dialNamespaceClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer nsClient.Close()
+
+	w, err := r.startEmbeddedWorker(nsClient, namespace, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if w != nil {
+		defer w.Stop()
+	}
+
+	// Create workflow generator with completion callback using namespace client
+	gen := generator.NewGenerator(
+		nsClient,
+		cfg,
+		DefaultTaskQueue,
+		generator.WithTypedCompletionCallback(func(workflowType, workflowID, runID string, duration time.Duration, err error) {
+			r.recordCompletion(cfg, workflowType, workflowID, runID, duration, err)
+		}),
+		generator.WithCancelCallback(r.cancellations.record),
+		generator.WithWorkflowIDFields(generator.IDFields{Index: iteration}),
+	)
+
+	// Allow operators to pause and resume this iteration's load
+	r.control.attach(gen)
+	defer r.control.detach(gen)
+	r.progress.addGenerator(gen, fmt.Sprintf("iteration %d", iteration))
+
+	// Start generating workflows
+	if err := gen.Start(ctx); err != nil {
+		return nil, results.NewRunError(results.CategoryExecution, results.PhaseRun, fmt.Errorf("failed to start generator: %w", err))
+	}
+	stopTimeline := r.timeline.watch(namespace, fmt.Sprintf("iteration %d", iteration), cfg, gen, r.metricsHandler)
+
+	// Wait for test duration
+	select {
+	case <-ctx.Done():
+		slog.Info("Benchmark cancelled during execution")
+	case <-time.After(cfg.Duration):
+		slog.Info("Benchmark duration completed")
+	}
+
+	// Stop generator
+	stopTimeline()
+	if err := gen.Stop(); err != nil {
+		slog.Warn("Failed to stop generator", "error", err)
+	}
+
+	// Wait for remaining workflows to complete; they no longer count toward throughput
+	metrics.StartDrain(r.metricsHandler)
+	r.events.recordDrain()
+	r.progress.startDrain()
+	drainStats := drain(ctx, []generator.WorkflowGenerator{gen}, newDrainPolicy(cfg))
+
+	endTime := time.Now()
+	stats := gen.Stats()
+	percentiles := r.metricsHandler.GetLatencyPercentiles()
+	throughput := r.metricsHandler.GetThroughput()
+
+	return &BenchmarkResult{
+		StartTime:          startTime,
+		EndTime:            endTime,
+		Duration:           endTime.Sub(startTime),
+		WorkflowsStarted:   stats.WorkflowsStarted,
+		WorkflowsCompleted: stats.WorkflowsCompleted,
+		WorkflowsFailed:    stats.WorkflowsFailed,
+		AlreadyStarted:     stats.AlreadyStarted,
+		ActualRate:         throughput,
+		LatencyP50:         percentiles.P50,
+		LatencyP95:         percentiles.P95,
+		LatencyP99:         percentiles.P99,
+		LatencyMax:         percentiles.Max,
+		LatencyApproximate: percentiles.Approximate,
+		InstanceType:       "m7g.large", // Default for ECS deployment
+		ServiceCounts:      map[string]int{"frontend": 1, "history": 1, "matching": 1, "worker": 1},
+		HistoryShards:      4, // Default shard count
+		WorkflowIDs:        []results.WorkflowIDRange{{Prefix: stats.WorkflowIDPrefix, Count: stats.WorkflowsSubmitted}},
+		OCCConflicts:       results.OCCConflicts{ClientErrors: stats.OCCConflicts},
+		FailedAttempts:     stats.FailedAttempts,
+		HistoryEvents:      stats.HistoryEvents,
+		LatencyFallbacks:   stats.LatencyFallbacks,
+		Outcomes:           addOutcomes(nil, cfg.OutcomeSampleRate, stats),
+		Drain:              drainStats,
+		Passed:             true,
+		FailureReasons:     []string{},
+	}, nil
+}
+
+// dialNamespaceClient creates a client bound to the benchmark namespace.
+// The original client uses "default" namespace, but we need to use the benchmark namespace
+// Its HeaderInterceptor attaches the header padding of generated workflow starts.
+func (r *runner) dialNamespaceClient(namespace string) (client.Client, error) {
+	if r.hostPort == "" {
+		return nil, results.NewRunError(results.CategoryConfig, results.PhaseSetup, fmt.Errorf("hostPort not configured - use WithHostPort option when creating runner"))
+	}
+	nsClientOptions := client.Options{
+		HostPort:       r.hostPort,
+		Namespace:      namespace,
+		MetricsHandler: r.sdkMetrics,
+		Interceptors:   []interceptor.ClientInterceptor{&generator.HeaderInterceptor{}},
+	}
+	r.dialer.Apply(&nsClientOptions)
+	if err := r.applyAuth(&nsClientOptions); err != nil {
+		return nil, err
+	}
+	nsClient, err := client.Dial(nsClientOptions)
+	if err != nil {
+		return nil, results.NewRunError(results.CategoryConnection, results.PhaseSetup, fmt.Errorf("failed to create namespace client for %s: %w", namespace, err))
+	}
+	return nsClient, nil
+}
+
+// applyAuth sets the runner's credentials, if any, on opts.
+func (r *runner) applyAuth(opts *client.Options) error {
+	if r.authProvider == nil {
+		return nil
+	}
+	if err := r.authProvider.Apply(opts); err != nil {
+		return results.NewRunError(results.CategoryConfig, results.PhaseSetup, fmt.Errorf("failed to apply %s credentials: %w", r.authProvider.Name(), err))
+	}
+	return nil
+}
+
+// DisableStickyExecution makes the workers this process starts poll only the
+// normal task queue, so every workflow task is dispatched to any worker and
+// replays the workflow's history. The SDK's workflow cache is shared by a
+// process's workers and sized when the first one starts, so call it before
+// starting any worker; it cannot be re-enabled for the life of the process.
+func DisableStickyExecution() {
+	worker.SetStickyWorkflowCacheSize(0)
+	slog.Info("Sticky execution disabled: every workflow task replays its history")
+}
+
+// ConfigureWorkflowCache sizes the workflow cache of the workers this process
+// starts from cfg, disabling sticky execution if cfg does. Like
+// DisableStickyExecution, call it before starting any worker.
+func ConfigureWorkflowCache(cfg config.BenchmarkConfig) {
+	if cfg.DisableSticky {
+		DisableStickyExecution()
+		return
+	}
+	worker.SetStickyWorkflowCacheSize(cfg.WorkflowCacheSize)
+	if cfg.WorkflowCacheSize != config.DefaultWorkflowCacheSize {
+		slog.Info("Workflow cache sized", "workflow_cache_size", cfg.WorkflowCacheSize)
+	}
+}
+
+// startEmbeddedWorker starts a worker for the benchmark namespace and
+// reports it from StartedWorkers until it is stopped.
+// In the generate role it returns nil: workflows are processed by the
+// separate worker service, so the generator doesn't need its own worker.
+func (r *runner) startEmbeddedWorker(nsClient client.Client, namespace string, cfg config.BenchmarkConfig) (worker.Worker, error) {
+	if cfg.Role == config.RoleGenerate {
+		slog.Info("Generate role: no embedded worker (workflows processed by external workers)")
+		return nil, nil
+	}
+
+	// Create a worker to process workflows in the benchmark namespace
+	// Optimized for high-throughput benchmarking:
+	// - High concurrent execution sizes for parallel processing
+	// - Increased poller counts for faster task pickup
+	// - Eager execution enabled for lower latency
+	// - Sticky execution enabled for workflow caching (unless cfg.DisableSticky)
+	workerOptions := worker.Options{
+		// Concurrent execution limits - high values for benchmark throughput
+		MaxConcurrentActivityExecutionSize:      200,
+		MaxConcurrentWorkflowTaskExecutionSize:  200,
+		MaxConcurrentLocalActivityExecutionSize: 200,
+
+		// Poller counts - higher values for faster task pickup
+		// Rule: pollers should be significantly < execution size
+		MaxConcurrentWorkflowTaskPollers: 16,
+		MaxConcurrentActivityTaskPollers: 16,
+
+		// Eager activity execution - reduces latency by executing locally when possible
+		// Activities requested from same workflow can start immediately without server round-trip
+		DisableEagerActivities:                  false,
+		MaxConcurrentEagerActivityExecutionSize: 100, // Allow up to 100 eager activities
+
+		// Sticky execution timeout - how long to keep workflow state cached
+		// Default is 5s, keeping it for workflow caching benefits
+		StickyScheduleToStartTimeout: 5 * time.Second,
+
+		// Send every heartbeat of the heartbeat workflow type
+		MaxHeartbeatThrottleInterval: workflows.HeartbeatThrottleInterval,
+
+		// No rate limiting unless configured to emulate rate-limited production
+		// workers; 0 keeps the SDK defaults (100k per worker, unlimited per queue)
+		WorkerActivitiesPerSecond:    cfg.WorkerActivitiesPerSecond,
+		TaskQueueActivitiesPerSecond: cfg.TaskQueueActivitiesPerSecond,
+	}
+
+	w := worker.New(nsClient, DefaultTaskQueue, workerOptions)
+	workflows.RegisterAll(w)
+
+	// Start the worker
+	if err := w.Start(); err != nil {
+		return nil, results.NewRunError(results.CategoryWorker, results.PhaseSetup, fmt.Errorf("failed to start worker: %w", err))
+	}
+	slog.Info("Embedded worker started")
+	untrack := TrackWorker(NewWorkerInfo(WorkerKindEmbedded, namespace, DefaultTaskQueue, workerOptions, cfg))
+	return trackedWorker{Worker: w, untrack: untrack}, nil
+}
+
+// checkClusterHealth verifies the Temporal cluster is healthy before starting.
+// Requirement 5.6: IF the Temporal cluster is unhealthy, THEN THE Benchmark_Runner SHALL fail fast
+// with a clear error message.
+func (r *runner) checkClusterHealth(ctx context.Context) error {
+	slog.Info("Checking Temporal cluster health...")
+
+	// Use CheckHealth API to verify cluster is responsive
+	_, err := r.client.CheckHealth(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Temporal cluster is unhealthy: %w", err)
+	}
+
+	slog.Info("Temporal cluster health check passed")
+	return nil
+}
+
+// ensureNamespace creates the benchmark namespace if it doesn't exist,
+// recording meta (nil for namespaces shared across runs) in its description
+// and data and scheduling its deletion after meta.TTL. An existing namespace
+// is left unchanged.
+// Requirement 5.3: WHEN a benchmark starts, THE Benchmark_Runner SHALL create a dedicated namespace
+// Requirement 8.1: THE Benchmark_Runner SHALL use a dedicated namespace prefixed with "benchmark-"
+func (r *runner) ensureNamespace(ctx context.Context, namespace string, meta *namespaceMetadata) error {
+	slog.Info("Ensuring namespace exists", "namespace", namespace)
+
+	namespaceCreated := false
+
+	// Check if namespace already exists
+	_, err := r.client.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: namespace,
+	})
+	if err == nil {
+		slog.Info("Namespace already exists", "namespace", namespace)
+	} else {
+		// Create the namespace
+		slog.Info("Creating namespace", "namespace", namespace)
+		_, err = r.client.WorkflowService().RegisterNamespace(ctx, &workflowservice.RegisterNamespaceRequest{
+			Namespace:                        namespace,
+			Description:                      meta.description(),
+			Data:                             meta.data(),
+			WorkflowExecutionRetentionPeriod: durationpb.New(24 * time.Hour), // 1 day retention
+			IsGlobalNamespace:                false,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register namespace: %w", err)
+		}
+		namespaceCreated = true
+
+		// Only namespaces a run creates, and may delete, get a janitor
+		if meta != nil && meta.TTL > 0 && strings.HasPrefix(namespace, NamespacePrefix) {
+			if err := r.startNamespaceJanitor(ctx, namespace, meta); err != nil {
+				return err
+			}
+		}
+
+		// Wait for namespace to be registered
+		slog.Info("Waiting for namespace to be registered", "namespace", namespace)
+		for i := 0; i < 30; i++ {
+			_, err := r.client.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+				Namespace: namespace,
+			})
+			if err == nil {
+				slog.Info("Namespace is registered", "namespace", namespace)
+				break
+			}
+			if i == 29 {
+				return fmt.Errorf("namespace %s not registered after 30 seconds", namespace)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+			}
+		}
+	}
+
+	// If namespace was just created, wait for it to propagate to all services
+	// This is critical because namespace registration on frontend doesn't mean
+	// history and matching services are ready to handle workflows in that namespace
+	if namespaceCreated {
+		slog.Info("Waiting for namespace to propagate to all services", "namespace", namespace)
+		// Wait 10 seconds for namespace to propagate across all Temporal services
+		// This is necessary because namespace changes need to sync to history/matching
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Second):
+		}
+		slog.Info("Namespace propagation wait complete", "namespace", namespace)
+	}
+
+	return nil
+}
+
+// generateNamespace creates a unique namespace name with the benchmark prefix.
+func generateNamespace() string {
+	return fmt.Sprintf("%s%d", NamespacePrefix, time.Now().UnixNano())
+}
+
+// IsGeneratedNamespace reports whether namespace is a per-run namespace
+// generated when no namespace is configured.
+func IsGeneratedNamespace(namespace string) bool {
+	suffix, ok := strings.CutPrefix(namespace, NamespacePrefix)
+	if !ok || suffix == "" {
+		return false
+	}
+	_, err := strconv.ParseUint(suffix, 10, 64)
+	return err == nil
+}
+
+// Cleanup terminates all running workflows in the benchmark namespace.
+// Requirement 8.2: WHEN a benchmark completes, THE Benchmark_Runner SHALL terminate all running workflows
+// Requirement 8.4: IF cleanup fails, THEN THE Benchmark_Runner SHALL log the failure and provide manual cleanup instructions
+func (r *runner) Cleanup(ctx context.Context, namespace string) error {
+	slog.Info("Starting cleanup", "namespace", namespace)
+
+	// Use the dedicated cleaner for comprehensive cleanup
+	result, err := r.cleaner.CleanupNamespace(ctx, namespace)
+	r.timeline.cleanupFinished(namespace, result, err)
+	if err != nil {
+		return err
+	}
+
+	// Verify cleanup was successful
+	if result.Partial {
+		return fmt.Errorf("cleanup stopped before completion after terminating %d of %d workflows (%d skipped)",
+			result.WorkflowsTerminated, result.WorkflowsFound, result.WorkflowsSkipped)
+	}
+	if !result.Success {
+		return fmt.Errorf("cleanup completed with %d errors out of %d workflows and %d resource errors",
+			len(result.TerminationErrors), result.WorkflowsFound, len(result.ResourceErrors))
+	}
+
+	// Verify no workflows remain
+	if err := r.cleaner.VerifyCleanup(ctx, namespace); err != nil {
+		slog.Warn("Cleanup verification failed", "error", err)
+		// Don't return error here as workflows may have been terminated but verification timing issue
+	}
+
+	return nil
+}
+
+// CleanupWithResult terminates all running workflows and returns detailed results.
+// This is useful for testing and detailed reporting.
+func (r *runner) CleanupWithResult(ctx context.Context, namespace string) (*cleanup.CleanupResult, error) {
+	return r.cleaner.CleanupNamespace(ctx, namespace)
+}
+
+// GetCleaner returns the underlying cleaner for direct access if needed.
+func (r *runner) GetCleaner() *cleanup.Cleaner {
+	return r.cleaner
+}
+
+// recordCompletion records a generated workflow's outcome in the metrics
+// handler. Starts rejected as already started have no latency and count as
+// successes only if cfg.AlreadyStartedAsSuccess. workflowType is the type the
+// workflow was started as, one of the mix of a mixed cfg.
+func (r *runner) recordCompletion(cfg config.BenchmarkConfig, workflowType, workflowID, runID string, duration time.Duration, err error) {
+	r.events.recordWorkflow(workflowType, workflowID, runID, duration, err)
+	r.streamWorkflow(workflowType, workflowID, runID, duration, err)
+	r.progress.recordWorkflow(workflowID, duration, err)
+	if errors.Is(err, generator.ErrCanceled) {
+		// Timed from the cancel request by the cancellation tracker instead
+		return
+	}
+	if errors.Is(err, generator.ErrAlreadyStarted) {
+		if cfg.AlreadyStartedAsSuccess {
+			r.metricsHandler.RecordWorkflowResult(true)
+		}
+		return
+	}
+	metrics.RecordWorkflowLatency(r.metricsHandler, duration, workflowID, runID)
+	r.metricsHandler.RecordWorkflowResult(err == nil)
+	r.heatmap.record(time.Now(), duration, err != nil)
+	r.churnLatency.record(time.Now(), duration, err != nil)
+	r.typeLatency.record(workflowType, duration, err)
+	if err != nil {
+		return
+	}
+	r.burnRate.record(time.Now(), duration)
+	if r.reads != nil {
+		r.reads.offer(workflowID, runID)
+	}
+	if r.histories != nil {
+		r.histories.offer(workflowType, workflowID, runID)
+	}
+	if r.audits != nil {
+		r.audits.offer(workflowType, workflowID, runID)
+	}
+}
+
+// streamWorkflow sends a finished workflow's record to the stream, if any.
+func (r *runner) streamWorkflow(workflowType, workflowID, runID string, duration time.Duration, err error) {
+	if r.stream == nil {
+		return
+	}
+	now := time.Now()
+	rec := stream.WorkflowRecord{
+		Namespace:    r.lastNamespace,
+		WorkflowType: workflowType,
+		WorkflowID:   workflowID,
+		RunID:        runID,
+		Outcome:      stream.OutcomeCompleted,
+		SubmitTime:   now.Add(-duration),
+		CloseTime:    now,
+		LatencyMs:    float64(duration) / float64(time.Millisecond),
+	}
+	switch {
+	case errors.Is(err, generator.ErrAlreadyStarted):
+		rec.Outcome = stream.OutcomeAlreadyStarted
+	case errors.Is(err, generator.ErrCanceled):
+		rec.Outcome = stream.OutcomeCanceled
+	case err != nil:
+		rec.Outcome = stream.OutcomeFailed
+		rec.Error = err.Error()
+	}
+	r.stream.Send(rec)
+}
+
+// aggregateResults combines results from multiple iterations.
+func aggregateResults(a, b *BenchmarkResult) *BenchmarkResult {
+	return &BenchmarkResult{
+		StartTime:          a.StartTime,
+		EndTime:            b.EndTime,
+		Duration:           a.Duration + b.Duration,
+		WorkflowsStarted:   a.WorkflowsStarted + b.WorkflowsStarted,
+		WorkflowsCompleted: a.WorkflowsCompleted + b.WorkflowsCompleted,
+		WorkflowsFailed:    a.WorkflowsFailed + b.WorkflowsFailed,
+		AlreadyStarted:     a.AlreadyStarted + b.AlreadyStarted,
+		ActualRate:         (a.ActualRate + b.ActualRate) / 2, // Average rate
+		LatencyP50:         (a.LatencyP50 + b.LatencyP50) / 2,
+		LatencyP95:         (a.LatencyP95 + b.LatencyP95) / 2,
+		LatencyP99:         (a.LatencyP99 + b.LatencyP99) / 2,
+		LatencyMax:         max(a.LatencyMax, b.LatencyMax),
+		LatencyApproximate: a.LatencyApproximate || b.LatencyApproximate,
+		InstanceType:       a.InstanceType,
+		ServiceCounts:      a.ServiceCounts,
+		HistoryShards:      a.HistoryShards,
+		WorkflowIDs:        append(a.WorkflowIDs, b.WorkflowIDs...),
+		Scenario:           a.Scenario,
+		Phases:             append(a.Phases, b.Phases...),
+		Ceilings:           append(a.Ceilings, b.Ceilings...),
+		Backpressure:       append(a.Backpressure, b.Backpressure...),
+		ScalingEvents:      append(a.ScalingEvents, b.ScalingEvents...),
+		ShardRestarts:      append(a.ShardRestarts, b.ShardRestarts...),
+		Drain:              b.Drain,
+		OCCConflicts:       results.OCCConflicts{ClientErrors: a.OCCConflicts.ClientErrors + b.OCCConflicts.ClientErrors},
+		FailedAttempts:     a.FailedAttempts + b.FailedAttempts,
+		HistoryEvents:      a.HistoryEvents + b.HistoryEvents,
+		LatencyFallbacks:   a.LatencyFallbacks + b.LatencyFallbacks,
+		Outcomes:           mergeOutcomes(a.Outcomes, b.Outcomes),
+		Passed:             a.Passed && b.Passed,
+		FailureReasons:     append(a.FailureReasons, b.FailureReasons...),
+	}
+}
+
+// OutputResults outputs the benchmark results in both JSON and human-readable formats.
+// Requirement 6.1: THE Benchmark_Runner SHALL output results in JSON format for programmatic consumption.
+// Requirement 6.2: THE Benchmark_Runner SHALL output a human-readable summary to stdout.
+func OutputResults(result *BenchmarkResult, cfg config.BenchmarkConfig, namespace string) error {
+	return PublishResults(context.Background(), []results.Sink{results.NewStdoutSink(os.Stdout)}, result, cfg, namespace)
+}
+
+// PublishResults publishes the benchmark results to every configured sink.
+// If cfg.ArtifactBundle is set, an artifact bundle of the run's outputs,
+// including the result's artifacts, is also published to the sinks that
+// support bundles. With cfg.Redact set,
+// deployment internals are scrubbed from everything published.
+func PublishResults(ctx context.Context, sinks []results.Sink, result *BenchmarkResult, cfg config.BenchmarkConfig, namespace string) error {
+	jsonResult := results.NewBenchmarkResultJSON(result, cfg, namespace)
+	if cfg.Redact {
+		redact.New(cfg.SensitiveValues()...).Value(jsonResult)
+	}
+	err := results.PublishAll(ctx, sinks, jsonResult)
+	if !cfg.ArtifactBundle {
+		return err
+	}
+
+	artifacts := result.Artifacts
+	if cfg.Redact {
+		redactor := redact.New(cfg.SensitiveValues()...)
+		artifacts = make([]results.Artifact, len(result.Artifacts))
+		for i, a := range result.Artifacts {
+			artifacts[i] = results.Artifact{Name: a.Name, Data: []byte(redactor.String(string(a.Data)))}
+		}
+	}
+	bundle, bundleErr := results.NewResultBundle(jsonResult, artifacts...)
+	if bundleErr == nil {
+		bundleErr = results.PublishBundleAll(ctx, sinks, bundle)
+	}
+	if bundleErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to publish artifact bundle: %w", bundleErr))
+	}
+	return err
+}
+
+// ListOpenWorkflow is a helper to list open workflows using the workflow service.
+func (r *runner) ListOpenWorkflow(ctx context.Context, req *workflowservice.ListOpenWorkflowExecutionsRequest) (*workflowservice.ListOpenWorkflowExecutionsResponse, error) {
+	return r.client.WorkflowService().ListOpenWorkflowExecutions(ctx, req)
+}
+
+// VerifyNamespaceIsolation checks that workflows only exist in the benchmark namespace.
+// Requirement 8.3: THE Benchmark_Runner SHALL NOT interfere with workflows in other namespaces
+func (r *runner) VerifyNamespaceIsolation(ctx context.Context, namespace string) error {
+	// Verify the namespace has the correct prefix
+	if len(namespace) < len(NamespacePrefix) || namespace[:len(NamespacePrefix)] != NamespacePrefix {
+		return fmt.Errorf("namespace %s does not have required prefix %s", namespace, NamespacePrefix)
+	}
+	return nil
+}
+
+// GetWorkflowCount returns the count of workflows in a namespace by status.
+func (r *runner) GetWorkflowCount(ctx context.Context, namespace string, status enums.WorkflowExecutionStatus) (int64, error) {
+	var count int64
+	var nextPageToken []byte
+
+	for {
+		var resp *workflowservice.ListWorkflowExecutionsResponse
+		var err error
+
+		if status == enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			openResp, openErr := r.client.WorkflowService().ListOpenWorkflowExecutions(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+				Namespace:       namespace,
+				MaximumPageSize: 100,
+				NextPageToken:   nextPageToken,
+			})
+			if openErr != nil {
+				return 0, openErr
+			}
+			count += int64(len(openResp.Executions))
+			nextPageToken = openResp.NextPageToken
+		} else {
+			resp, err = r.client.WorkflowService().ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+				Namespace:     namespace,
+				PageSize:      100,
+				NextPageToken: nextPageToken,
+				Query:         fmt.Sprintf("ExecutionStatus = %d", status),
+			})
+			if err != nil {
+				return 0, err
+			}
+			count += int64(len(resp.Executions))
+			nextPageToken = resp.NextPageToken
+		}
+
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	return count, nil
+}
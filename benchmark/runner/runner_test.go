@@ -0,0 +1,16 @@
+package runner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsGeneratedNamespace(t *testing.T) {
+	require.True(t, IsGeneratedNamespace(generateNamespace()))
+	require.True(t, IsGeneratedNamespace("benchmark-1768334400000000000"))
+	require.False(t, IsGeneratedNamespace("benchmark"))
+	require.False(t, IsGeneratedNamespace("benchmark-"))
+	require.False(t, IsGeneratedNamespace("benchmark-steady"))
+	require.False(t, IsGeneratedNamespace("temporal-benchmark-lock"))
+}
@@ -0,0 +1,187 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	filterpb "go.temporal.io/api/filter/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/cleanup"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+const (
+	// RunMemoKey names the memo field of generated workflows identifying
+	// their registered run
+	RunMemoKey = "benchmarkRun"
+
+	// activeRunWorkflowType and activeRunIDPrefix identify the registry
+	// workflows, one open workflow per active run in the run lock namespace
+	activeRunWorkflowType = "BenchmarkActiveRun"
+	activeRunIDPrefix     = "benchmark-active-run-"
+
+	// activeRunNamespaceMemo names the registry memo field holding the run's namespace
+	activeRunNamespaceMemo = "namespace"
+)
+
+// registerRun registers the run in namespace as active if cfg enables the
+// registry, returning the run's ID (empty if not registered) and a func
+// unregistering it. The registration expires after cfg.RunLockTTL if the
+// run never unregisters; it is not renewed, so config validation makes the
+// TTL cover the longest run (see BenchmarkConfig.MaxRunLength).
+func (r *runner) registerRun(ctx context.Context, cfg config.BenchmarkConfig, namespace string) (string, func(), error) {
+	if !cfg.RunRegistry {
+		return "", func() {}, nil
+	}
+	if err := r.ensureNamespace(ctx, cfg.RunLockNamespace, nil); err != nil {
+		return "", nil, results.NewRunError(results.CategoryNamespace, results.PhaseSetup, fmt.Errorf("failed to create run registry namespace %s: %w", cfg.RunLockNamespace, err))
+	}
+
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown-host"
+	}
+	run := fmt.Sprintf("%s-%d", host, time.Now().UnixNano())
+	namespacePayload, err := converter.GetDefaultDataConverter().ToPayload(namespace)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode run namespace: %w", err)
+	}
+
+	svc := r.client.WorkflowService()
+	callCtx, cancel := context.WithTimeout(ctx, runLockCallTimeout)
+	resp, err := svc.StartWorkflowExecution(callCtx, &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                cfg.RunLockNamespace,
+		WorkflowId:               activeRunIDPrefix + run,
+		WorkflowType:             &commonpb.WorkflowType{Name: activeRunWorkflowType},
+		TaskQueue:                &taskqueuepb.TaskQueue{Name: runLockTaskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+		WorkflowExecutionTimeout: durationpb.New(cfg.RunLockTTL),
+		Identity:                 runLockHolderName(namespace),
+		RequestId:                run,
+		Memo:                     &commonpb.Memo{Fields: map[string]*commonpb.Payload{activeRunNamespaceMemo: namespacePayload}},
+	})
+	cancel()
+	if err != nil {
+		return "", nil, results.NewRunError(results.CategoryNamespace, results.PhaseSetup, fmt.Errorf("failed to register run: %w", err))
+	}
+	slog.Info("Registered active run", "run", run, "registry_namespace", cfg.RunLockNamespace, "ttl", cfg.RunLockTTL)
+
+	unregister := func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), runLockCallTimeout)
+		defer cancel()
+		_, err := svc.TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+			Namespace:         cfg.RunLockNamespace,
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: activeRunIDPrefix + run, RunId: resp.GetRunId()},
+			Reason:            "benchmark run finished",
+		})
+		if err != nil {
+			slog.Warn("Failed to unregister run; it expires after the run lock TTL", "run", run, "error", err)
+		}
+	}
+	return run, unregister, nil
+}
+
+// withRunMemo returns a copy of memo that also identifies run.
+func withRunMemo(memo map[string]string, run string) map[string]string {
+	tagged := maps.Clone(memo)
+	if tagged == nil {
+		tagged = make(map[string]string, 1)
+	}
+	tagged[RunMemoKey] = run
+	return tagged
+}
+
+// activeRuns lists the runs registered in the registry namespace.
+func activeRuns(ctx context.Context, svc workflowservice.WorkflowServiceClient, registryNamespace string) (map[string]bool, error) {
+	active := make(map[string]bool)
+	var nextPageToken []byte
+	for {
+		callCtx, cancel := context.WithTimeout(ctx, runLockCallTimeout)
+		resp, err := svc.ListOpenWorkflowExecutions(callCtx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+			Namespace:       registryNamespace,
+			MaximumPageSize: 100,
+			NextPageToken:   nextPageToken,
+			Filters: &workflowservice.ListOpenWorkflowExecutionsRequest_TypeFilter{
+				TypeFilter: &filterpb.WorkflowTypeFilter{Name: activeRunWorkflowType},
+			},
+		})
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list active runs: %w", err)
+		}
+		for _, execution := range resp.Executions {
+			if run, ok := strings.CutPrefix(execution.GetExecution().GetWorkflowId(), activeRunIDPrefix); ok {
+				active[run] = true
+			}
+		}
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return active, nil
+		}
+	}
+}
+
+// WatchStaleRuns checks namespace every cfg.StaleRunInterval for open
+// workflows of runs that are no longer registered (finished, crashed or
+// abandoned runs, see BenchmarkConfig.RunRegistry), reporting them and, if
+// cfg.StaleRuns is terminate, terminating them. It returns when ctx is done.
+// A check is skipped if the active runs cannot be listed, so workflows are
+// never terminated on an incomplete registry.
+func WatchStaleRuns(ctx context.Context, c client.Client, cfg config.BenchmarkConfig, namespace string) {
+	cleaner := cleanup.NewCleaner(c)
+	ticker := time.NewTicker(cfg.StaleRunInterval)
+	defer ticker.Stop()
+
+	slog.Info("Watching for workflows of stale runs",
+		"namespace", namespace,
+		"action", cfg.StaleRuns,
+		"interval", cfg.StaleRunInterval,
+		"grace", cfg.StaleRunGrace)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		checkStaleRuns(ctx, cleaner, c.WorkflowService(), cfg, namespace)
+	}
+}
+
+// checkStaleRuns runs one stale-run check and returns its result, or nil if
+// the check was skipped.
+func checkStaleRuns(ctx context.Context, cleaner *cleanup.Cleaner, svc workflowservice.WorkflowServiceClient, cfg config.BenchmarkConfig, namespace string) *cleanup.StaleResult {
+	active, err := activeRuns(ctx, svc, cfg.RunLockNamespace)
+	if err != nil {
+		slog.Warn("Skipping stale run check", "namespace", namespace, "error", err)
+		return nil
+	}
+	found, err := cleaner.FindStaleWorkflows(ctx, namespace, RunMemoKey, active, time.Now().Add(-cfg.StaleRunGrace))
+	if err != nil {
+		slog.Warn("Stale run check failed", "namespace", namespace, "error", err)
+		return nil
+	}
+
+	stale := make(map[string]int)
+	for _, wf := range found.Stale {
+		stale[wf.Run]++
+	}
+	for run, count := range stale {
+		slog.Warn("Stale benchmark run still has open workflows", "namespace", namespace, "run", run, "workflows", count)
+	}
+	if cfg.StaleRuns == config.StaleRunsTerminate {
+		cleaner.TerminateStaleWorkflows(ctx, namespace, found)
+	}
+	return found
+}
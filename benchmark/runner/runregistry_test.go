@@ -0,0 +1,125 @@
+package runner
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/grpc"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/cleanup"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// fakeRegistryService serves the registry namespace's active runs and the
+// workload namespace's open workflows, tagged with the run in their memo.
+type fakeRegistryService struct {
+	workflowservice.WorkflowServiceClient
+	registryNamespace string
+	active            []string
+	workflows         map[string]string // Workflow ID to run ("" for no memo)
+	failRegistry      bool
+
+	mu         sync.Mutex
+	terminated []string
+}
+
+func (f *fakeRegistryService) ListOpenWorkflowExecutions(_ context.Context, req *workflowservice.ListOpenWorkflowExecutionsRequest, _ ...grpc.CallOption) (*workflowservice.ListOpenWorkflowExecutionsResponse, error) {
+	resp := &workflowservice.ListOpenWorkflowExecutionsResponse{}
+	if req.GetNamespace() == f.registryNamespace {
+		if f.failRegistry {
+			return nil, context.DeadlineExceeded
+		}
+		for _, run := range f.active {
+			resp.Executions = append(resp.Executions, &workflowpb.WorkflowExecutionInfo{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: activeRunIDPrefix + run},
+			})
+		}
+		return resp, nil
+	}
+	for id, run := range f.workflows {
+		info := &workflowpb.WorkflowExecutionInfo{Execution: &commonpb.WorkflowExecution{WorkflowId: id, RunId: id + "-run"}}
+		if run != "" {
+			payload, _ := converter.GetDefaultDataConverter().ToPayload(run)
+			info.Memo = &commonpb.Memo{Fields: map[string]*commonpb.Payload{RunMemoKey: payload}}
+		}
+		resp.Executions = append(resp.Executions, info)
+	}
+	return resp, nil
+}
+
+func (f *fakeRegistryService) TerminateWorkflowExecution(_ context.Context, req *workflowservice.TerminateWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.TerminateWorkflowExecutionResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.terminated = append(f.terminated, req.GetWorkflowExecution().GetWorkflowId())
+	return &workflowservice.TerminateWorkflowExecutionResponse{}, nil
+}
+
+type fakeRegistryClient struct {
+	client.Client
+	service *fakeRegistryService
+}
+
+func (c *fakeRegistryClient) WorkflowService() workflowservice.WorkflowServiceClient {
+	return c.service
+}
+
+func TestCheckStaleRuns(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Role = config.RoleWork
+	cfg.StaleRuns = config.StaleRunsReport
+	svc := &fakeRegistryService{
+		registryNamespace: cfg.RunLockNamespace,
+		active:            []string{"host-a-1"},
+		workflows: map[string]string{
+			"active-1":  "host-a-1",
+			"stale-1":   "host-b-2",
+			"stale-2":   "host-b-2",
+			"untracked": "",
+		},
+	}
+	cleaner := cleanup.NewCleaner(&fakeRegistryClient{service: svc})
+
+	found := checkStaleRuns(context.Background(), cleaner, svc, cfg, "benchmark")
+	require.NotNil(t, found)
+	require.Equal(t, 4, found.Checked)
+	require.Equal(t, 1, found.Untracked)
+	require.Len(t, found.Stale, 2)
+	require.Equal(t, "host-b-2", found.Stale[0].Run)
+	require.Empty(t, svc.terminated, "report mode only logs")
+
+	cfg.StaleRuns = config.StaleRunsTerminate
+	found = checkStaleRuns(context.Background(), cleaner, svc, cfg, "benchmark")
+	require.Equal(t, 2, found.Terminated)
+	sort.Strings(svc.terminated)
+	require.Equal(t, []string{"stale-1", "stale-2"}, svc.terminated)
+}
+
+func TestCheckStaleRuns_SkipsWithoutRegistry(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.StaleRuns = config.StaleRunsTerminate
+	svc := &fakeRegistryService{
+		registryNamespace: cfg.RunLockNamespace,
+		failRegistry:      true,
+		workflows:         map[string]string{"wf": "host-b-2"},
+	}
+	cleaner := cleanup.NewCleaner(&fakeRegistryClient{service: svc})
+
+	require.Nil(t, checkStaleRuns(context.Background(), cleaner, svc, cfg, "benchmark"))
+	require.Empty(t, svc.terminated)
+}
+
+func TestWithRunMemo(t *testing.T) {
+	memo := map[string]string{"team": "persistence"}
+	tagged := withRunMemo(memo, "host-a-1")
+	require.Equal(t, map[string]string{"team": "persistence", RunMemoKey: "host-a-1"}, tagged)
+	require.Equal(t, map[string]string{"team": "persistence"}, memo, "the configured memo is not modified")
+	require.Equal(t, map[string]string{RunMemoKey: "host-a-1"}, withRunMemo(nil, "host-a-1"))
+}
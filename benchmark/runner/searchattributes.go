@@ -0,0 +1,60 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+// usesSearchAttributes reports whether the run starts search attribute
+// workflows, directly, in a scenario phase or as part of a mix.
+func usesSearchAttributes(cfg config.BenchmarkConfig, s *scenario.Scenario) bool {
+	if s == nil {
+		return slices.Contains(cfg.WorkflowTypes(), config.WorkflowTypeSearchAttributes)
+	}
+	for _, phase := range s.Phases {
+		if slices.Contains(phase.Apply(cfg).WorkflowTypes(), config.WorkflowTypeSearchAttributes) {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureSearchAttributes registers the custom search attributes the search
+// attribute workflow upserts on namespace. Attributes that already exist are
+// left unchanged, so shared namespaces are registered once. Without them, the
+// workflow's upserts fail its workflow tasks and it never completes.
+func ensureSearchAttributes(ctx context.Context, operator operatorservice.OperatorServiceClient, namespace string) error {
+	existing, err := operator.ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{Namespace: namespace})
+	if err != nil {
+		return fmt.Errorf("failed to list search attributes: %w", err)
+	}
+
+	missing := workflows.SearchAttributes()
+	maps.DeleteFunc(missing, func(name string, _ enumspb.IndexedValueType) bool {
+		_, ok := existing.GetCustomAttributes()[name]
+		return ok
+	})
+	if len(missing) == 0 {
+		return nil
+	}
+
+	slog.Info("Registering benchmark search attributes", "namespace", namespace, "count", len(missing))
+	_, err = operator.AddSearchAttributes(ctx, &operatorservice.AddSearchAttributesRequest{
+		Namespace:        namespace,
+		SearchAttributes: missing,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add search attributes: %w", err)
+	}
+	return nil
+}
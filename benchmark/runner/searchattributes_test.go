@@ -0,0 +1,65 @@
+package runner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"google.golang.org/grpc"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+// fakeSearchAttributeService holds a namespace's custom search attributes.
+type fakeSearchAttributeService struct {
+	operatorservice.OperatorServiceClient
+	attributes map[string]enumspb.IndexedValueType
+	added      []map[string]enumspb.IndexedValueType
+}
+
+func (f *fakeSearchAttributeService) ListSearchAttributes(context.Context, *operatorservice.ListSearchAttributesRequest, ...grpc.CallOption) (*operatorservice.ListSearchAttributesResponse, error) {
+	return &operatorservice.ListSearchAttributesResponse{CustomAttributes: f.attributes}, nil
+}
+
+func (f *fakeSearchAttributeService) AddSearchAttributes(_ context.Context, req *operatorservice.AddSearchAttributesRequest, _ ...grpc.CallOption) (*operatorservice.AddSearchAttributesResponse, error) {
+	f.added = append(f.added, req.GetSearchAttributes())
+	for name, valueType := range req.GetSearchAttributes() {
+		f.attributes[name] = valueType
+	}
+	return &operatorservice.AddSearchAttributesResponse{}, nil
+}
+
+func TestEnsureSearchAttributes_AddsMissingOnly(t *testing.T) {
+	keyword := workflows.BenchmarkKeywordAttribute.GetName()
+	operator := &fakeSearchAttributeService{attributes: map[string]enumspb.IndexedValueType{
+		keyword: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	}}
+
+	require.NoError(t, ensureSearchAttributes(context.Background(), operator, "benchmark-1"))
+	require.Len(t, operator.added, 1)
+	require.Len(t, operator.added[0], len(workflows.SearchAttributes())-1)
+	require.NotContains(t, operator.added[0], keyword)
+	require.Equal(t, enumspb.INDEXED_VALUE_TYPE_DATETIME, operator.added[0][workflows.BenchmarkDatetimeAttribute.GetName()])
+
+	// Registered attributes aren't added again
+	require.NoError(t, ensureSearchAttributes(context.Background(), operator, "benchmark-1"))
+	require.Len(t, operator.added, 1)
+}
+
+func TestUsesSearchAttributes(t *testing.T) {
+	cfg := config.DefaultConfig()
+	require.False(t, usesSearchAttributes(cfg, nil))
+
+	cfg.WorkflowType = config.WorkflowTypeSearchAttributes
+	require.True(t, usesSearchAttributes(cfg, nil))
+
+	// A scenario's phases decide, not the base workflow type
+	s := &scenario.Scenario{Phases: []scenario.Phase{{WorkflowType: config.WorkflowTypeSimple}}}
+	require.False(t, usesSearchAttributes(cfg, s))
+	s.Phases = append(s.Phases, scenario.Phase{WorkflowType: config.WorkflowTypeSearchAttributes})
+	require.True(t, usesSearchAttributes(config.DefaultConfig(), s))
+}
@@ -0,0 +1,112 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// serverWindow measures server-side metrics over a run from scrapes of the
+// Temporal server's Prometheus endpoints taken at its start and end. A nil
+// serverWindow (no server metrics configured, or the initial scrape failed)
+// reports client-observed metrics only.
+type serverWindow struct {
+	scraper *metrics.ServerScraper
+	before  metrics.ServerSnapshot
+}
+
+// startServerWindow takes the initial server metrics snapshot.
+func startServerWindow(ctx context.Context, urls []string) *serverWindow {
+	if len(urls) == 0 {
+		return nil
+	}
+	scraper := metrics.NewServerScraper(urls)
+	before, err := scraper.Scrape(ctx)
+	if err != nil {
+		slog.Warn("Server metrics unavailable; reporting client-side metrics only", "error", err)
+		return nil
+	}
+	return &serverWindow{scraper: scraper, before: before}
+}
+
+// finish sets the result's OCC conflict count and rate, history reads,
+// visibility writes and the persistence latency percentiles named by cfg's
+// thresholds, using the server's metrics if they can be scraped.
+func (w *serverWindow) finish(ctx context.Context, result *BenchmarkResult, cfg config.BenchmarkConfig) {
+	if w != nil {
+		// Scrape even if the run was cancelled so partial results are complete
+		after, err := w.scraper.Scrape(context.WithoutCancel(ctx))
+		if err != nil {
+			slog.Warn("Failed to read server metrics at end of run", "error", err)
+		} else {
+			if delta, ok := metrics.CounterDelta(w.before, after, metrics.ServerOCCConflictMetric); ok {
+				conflicts := int64(delta)
+				result.OCCConflicts.ServerConflicts = &conflicts
+			}
+			result.Persistence = persistenceLatencies(w.before, after, cfg.PersistenceThresholds)
+			if requests, ok := metrics.PersistenceRequests(w.before, after, ""); ok {
+				var reads int64
+				for _, operation := range metrics.HistoryReadOperations {
+					reads += int64(requests[operation])
+				}
+				result.HistoryReads = &reads
+			}
+			if requests, ok := metrics.CounterDeltaByLabel(w.before, after, metrics.VisibilityRequestsMetric, "operation"); ok {
+				// The errors counter has no series until a request fails
+				failures, _ := metrics.CounterDeltaByLabel(w.before, after, metrics.VisibilityErrorsMetric, "operation")
+				var writes, errors int64
+				for _, operation := range metrics.VisibilityWriteOperations {
+					writes += int64(requests[operation])
+					errors += int64(failures[operation])
+				}
+				result.VisibilityWrites = &writes
+				result.VisibilityWriteErrors = &errors
+			}
+		}
+	}
+
+	result.OCCConflicts.Count = result.OCCConflicts.Total()
+	if seconds := result.Duration.Seconds(); seconds > 0 {
+		result.OCCConflicts.Rate = float64(result.OCCConflicts.Count) / seconds
+	}
+}
+
+// persistenceLatencies computes each operation's latency at every service and
+// percentile named by thresholds. The result is non-nil so that evaluation can
+// tell scraped-but-idle apart from not scraped.
+func persistenceLatencies(before, after metrics.ServerSnapshot, thresholds []config.PersistenceThreshold) []results.PersistenceLatency {
+	type key struct {
+		service    string
+		percentile float64
+	}
+	latencies := []results.PersistenceLatency{}
+	seen := make(map[key]bool)
+	for _, t := range thresholds {
+		k := key{t.Service, t.Percentile}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+
+		byOperation := metrics.PersistenceLatencyQuantiles(before, after, t.Service, t.Percentile/100)
+		operations := make([]string, 0, len(byOperation))
+		for operation := range byOperation {
+			operations = append(operations, operation)
+		}
+		sort.Strings(operations)
+		for _, operation := range operations {
+			latencies = append(latencies, results.PersistenceLatency{
+				Service:    t.Service,
+				Operation:  operation,
+				Percentile: t.Percentile,
+				LatencyMs:  byOperation[operation],
+			})
+		}
+	}
+	return latencies
+}
@@ -0,0 +1,111 @@
+package runner
+
+import (
+	"math"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// Shard churn spike measurement. Workflow latencies are bucketed into short
+// windows; a restart's baseline is the p99 latency of the minute before it,
+// and its spike lasts until several consecutive windows complete workflows
+// with no failures and a p99 within shardChurnSpikeFactor of the baseline.
+const (
+	shardChurnWindow          = 5 * time.Second
+	shardChurnBaseline        = time.Minute
+	shardChurnSpikeFactor     = 1.5
+	shardChurnRecoveryWindows = 3
+)
+
+// measureShardRestarts fills in the latency spike of each restart that
+// stopped tasks from the latencies h recorded until end. A spike is bounded
+// by the next restart. Without a baseline (a restart at the start of the
+// run), only failures and windows without completions count as the spike.
+func measureShardRestarts(h *latencyHeatmap, restarts []results.ShardRestart, end time.Time) []results.ShardRestart {
+	if h == nil {
+		return restarts
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	index := func(t time.Time) int {
+		return min(int(max(t.Sub(h.start), 0)/h.window), len(h.windows))
+	}
+	p99 := func(windows []results.HeatmapWindow) (float64, bool) {
+		counts := make([]int64, len(h.boundsSec)+1)
+		for _, w := range windows {
+			for i, n := range w.Counts {
+				counts[i] += n
+			}
+		}
+		return bucketQuantileMs(counts, h.boundsSec, 0.99)
+	}
+
+	for i := range restarts {
+		r := &restarts[i]
+		if len(r.Tasks) == 0 {
+			continue
+		}
+		until := end
+		if i+1 < len(restarts) {
+			until = restarts[i+1].Time
+		}
+		first, last := index(r.Time), index(until)
+
+		baseline, hasBaseline := p99(h.windows[index(r.Time.Add(-shardChurnBaseline)):first])
+		r.BaselineP99Ms = baseline
+		healthy := func(j int) bool {
+			w := h.windows[j]
+			latency, completed := p99(h.windows[j : j+1])
+			return completed && w.Failed == 0 && (!hasBaseline || latency <= baseline*shardChurnSpikeFactor)
+		}
+
+		spikeEnd := last
+		for j := first; j+shardChurnRecoveryWindows <= last; j++ {
+			recovered := true
+			for k := j; k < j+shardChurnRecoveryWindows; k++ {
+				recovered = recovered && healthy(k)
+			}
+			if recovered {
+				spikeEnd, r.Recovered = j, true
+				break
+			}
+		}
+
+		spikeEndTime := until
+		if r.Recovered {
+			spikeEndTime = h.start.Add(time.Duration(spikeEnd) * h.window)
+		}
+		r.SpikeSeconds = max(spikeEndTime.Sub(r.Time), 0).Seconds()
+		for j := first; j < spikeEnd; j++ {
+			r.FailedRequests += h.windows[j].Failed
+			if latency, ok := p99(h.windows[j : j+1]); ok {
+				r.PeakP99Ms = max(r.PeakP99Ms, latency)
+			}
+		}
+	}
+	return restarts
+}
+
+// bucketQuantileMs returns the upper bound in milliseconds of the bucket
+// holding quantile q of counts, bucketed by boundsSec with a final overflow
+// bucket reported at the last bound. It reports false if counts are all zero.
+func bucketQuantileMs(counts []int64, boundsSec []float64, q float64) (float64, bool) {
+	var total int64
+	for _, n := range counts {
+		total += n
+	}
+	if total == 0 || len(boundsSec) == 0 {
+		return 0, false
+	}
+	rank := int64(math.Ceil(q * float64(total)))
+	var seen int64
+	for i, n := range counts {
+		seen += n
+		if seen >= rank || i == len(counts)-1 {
+			return boundsSec[min(i, len(boundsSec)-1)] * 1000, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,67 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+func TestMeasureShardRestarts(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := newLatencyHeatmap(start, shardChurnWindow, []float64{0.1, 0.5, 1, 5})
+
+	// load records 10 workflows per second between two offsets, each taking
+	// latency or failing
+	load := func(from, to time.Duration, latency time.Duration, failed bool) {
+		for at := from; at < to; at += time.Second {
+			for range 10 {
+				h.record(start.Add(at), latency, failed)
+			}
+		}
+	}
+	load(0, 2*time.Minute, 50*time.Millisecond, false)
+	// The first restart fails workflows for 10s, then slows them for 20s
+	load(2*time.Minute, 2*time.Minute+10*time.Second, time.Second, true)
+	load(2*time.Minute+10*time.Second, 2*time.Minute+30*time.Second, 800*time.Millisecond, false)
+	load(2*time.Minute+30*time.Second, 4*time.Minute, 50*time.Millisecond, false)
+	// The second never recovers
+	load(4*time.Minute, 5*time.Minute, 3*time.Second, false)
+
+	restarts := measureShardRestarts(h, []results.ShardRestart{
+		{Time: start.Add(2 * time.Minute), Offset: "2m0s", Tasks: []string{"a"}},
+		{Time: start.Add(4 * time.Minute), Offset: "4m0s", Tasks: []string{"b"}},
+		{Time: start.Add(4*time.Minute + 30*time.Second), Offset: "4m30s", Error: "failed to list tasks"},
+	}, start.Add(5*time.Minute))
+
+	first := restarts[0]
+	require.True(t, first.Recovered)
+	require.Equal(t, 100.0, first.BaselineP99Ms)
+	require.Equal(t, 1000.0, first.PeakP99Ms)
+	require.Equal(t, 30.0, first.SpikeSeconds)
+	require.Equal(t, int64(100), first.FailedRequests)
+
+	second := restarts[1]
+	require.False(t, second.Recovered)
+	require.Equal(t, 5000.0, second.PeakP99Ms)
+	require.Equal(t, 30.0, second.SpikeSeconds, "bounded by the next restart")
+	require.Zero(t, second.FailedRequests)
+
+	require.Zero(t, restarts[2].SpikeSeconds, "nothing was stopped")
+	require.Nil(t, measureShardRestarts(nil, nil, time.Now()))
+}
+
+func TestBucketQuantileMs(t *testing.T) {
+	bounds := []float64{0.1, 1}
+	_, ok := bucketQuantileMs([]int64{0, 0, 0}, bounds, 0.99)
+	require.False(t, ok)
+
+	p99, ok := bucketQuantileMs([]int64{99, 1, 0}, bounds, 0.99)
+	require.True(t, ok)
+	require.Equal(t, 100.0, p99)
+
+	p99, _ = bucketQuantileMs([]int64{98, 1, 1}, bounds, 0.99)
+	require.Equal(t, 1000.0, p99, "overflow is reported at the last bound")
+}
@@ -0,0 +1,229 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// Smoke test parameters. The smoke test is deliberately fixed so results are
+// comparable across deployments.
+const (
+	SmokeWorkflowsPerType = 50
+	SmokeBudget           = 60 * time.Second
+
+	// smokeMaxErrorSamples limits the errors reported per workflow type
+	smokeMaxErrorSamples = 3
+)
+
+// SmokeTypeResult reports the smoke outcome for one workflow type.
+type SmokeTypeResult struct {
+	WorkflowType string   `json:"workflowType"`
+	Started      int      `json:"started"`
+	Completed    int      `json:"completed"`
+	Failed       int      `json:"failed"`
+	LatencyP99Ms float64  `json:"latencyP99Ms"`
+	Errors       []string `json:"errors,omitempty"`
+}
+
+// SmokeResult is the outcome of a smoke test.
+type SmokeResult struct {
+	Namespace      string            `json:"namespace"`
+	Budget         string            `json:"budget"`
+	Duration       string            `json:"duration"`
+	Types          []SmokeTypeResult `json:"types"`
+	Passed         bool              `json:"passed"`
+	FailureReasons []string          `json:"failureReasons"`
+}
+
+// Smoke runs SmokeWorkflowsPerType workflows of every type concurrently and
+// requires every one of them to complete successfully within SmokeBudget.
+func (r *runner) Smoke(ctx context.Context, cfg config.BenchmarkConfig) (*SmokeResult, error) {
+	startTime := time.Now()
+
+	// The budget covers namespace setup, execution and waiting for completion
+	ctx, cancel := context.WithTimeout(ctx, SmokeBudget)
+	defer cancel()
+
+	namespace, nsClient, stop, err := r.prepareNamespace(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
+
+	slog.Info("Starting smoke test",
+		"namespace", namespace,
+		"workflows_per_type", SmokeWorkflowsPerType,
+		"budget", SmokeBudget)
+
+	types := config.ValidWorkflowTypes()
+	typeResults := make([]SmokeTypeResult, len(types))
+	var wg sync.WaitGroup
+	for i, workflowType := range types {
+		wg.Add(1)
+		go func(i int, workflowType string) {
+			defer wg.Done()
+			typeCfg := cfg
+			typeCfg.WorkflowType = workflowType
+			typeResults[i] = runSmokeType(ctx, nsClient, typeCfg, startTime)
+		}(i, workflowType)
+	}
+	wg.Wait()
+
+	result := &SmokeResult{
+		Namespace:      namespace,
+		Budget:         SmokeBudget.String(),
+		Duration:       time.Since(startTime).Round(time.Millisecond).String(),
+		Types:          typeResults,
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+	for _, tr := range typeResults {
+		if tr.Completed != SmokeWorkflowsPerType {
+			result.Passed = false
+			result.FailureReasons = append(result.FailureReasons,
+				fmt.Sprintf("%s: %d of %d workflows completed (%d failed)",
+					tr.WorkflowType, tr.Completed, SmokeWorkflowsPerType, tr.Failed))
+		}
+	}
+	if ctx.Err() != nil {
+		result.Passed = false
+		result.FailureReasons = append(result.FailureReasons,
+			fmt.Sprintf("smoke test exceeded its %s budget", SmokeBudget))
+	}
+
+	return result, nil
+}
+
+// prepareNamespace checks cluster health, creates the namespace with the
+// benchmark search attributes and starts the embedded worker for a short
+// fixed run. The returned stop function stops the worker and closes the
+// namespace client.
+func (r *runner) prepareNamespace(ctx context.Context, cfg config.BenchmarkConfig) (string, client.Client, func(), error) {
+	if err := r.checkClusterHealth(ctx); err != nil {
+		return "", nil, nil, results.NewRunError(results.CategoryConnection, results.PhaseConnect, fmt.Errorf("cluster health check failed: %w", err))
+	}
+
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = generateNamespace()
+	}
+	r.lastNamespace = namespace
+
+	if err := r.ensureNamespace(ctx, namespace, newNamespaceMetadata(cfg, nil)); err != nil {
+		return "", nil, nil, results.NewRunError(results.CategoryNamespace, results.PhaseSetup, fmt.Errorf("failed to create namespace %s: %w", namespace, err))
+	}
+	// Every type runs, including the search attribute workflow
+	if err := ensureSearchAttributes(ctx, r.client.OperatorService(), namespace); err != nil {
+		return "", nil, nil, results.NewRunError(results.CategoryNamespace, results.PhaseSetup, fmt.Errorf("namespace %s: %w", namespace, err))
+	}
+
+	nsClient, err := r.dialNamespaceClient(namespace)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	w, err := r.startEmbeddedWorker(nsClient, namespace, cfg)
+	if err != nil {
+		nsClient.Close()
+		return "", nil, nil, err
+	}
+
+	stop := func() {
+		if w != nil {
+			w.Stop()
+		}
+		nsClient.Close()
+	}
+	return namespace, nsClient, stop, nil
+}
+
+// runSmokeType starts the smoke workflows for one type and waits for all of them.
+// Unlike the load generator, any error (including a deadline) counts as a failure.
+func runSmokeType(ctx context.Context, c client.Client, cfg config.BenchmarkConfig, smokeStart time.Time) SmokeTypeResult {
+	result := SmokeTypeResult{WorkflowType: cfg.WorkflowType}
+	latencies := metrics.NewLatencyCollector(SmokeWorkflowsPerType)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for n := 1; n <= SmokeWorkflowsPerType; n++ {
+		wg.Add(1)
+		go func(workflowID string) {
+			defer wg.Done()
+
+			start := time.Now()
+			run, err := generator.ExecuteWorkflow(ctx, c, client.StartWorkflowOptions{
+				ID:        workflowID,
+				TaskQueue: DefaultTaskQueue,
+			}, cfg)
+			started := err == nil
+			if started {
+				err = run.Get(ctx, nil)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if started {
+				result.Started++
+			}
+			if err != nil {
+				result.Failed++
+				if len(result.Errors) < smokeMaxErrorSamples {
+					result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", workflowID, err))
+				}
+				return
+			}
+			result.Completed++
+			latencies.AddDuration(time.Since(start))
+		}(fmt.Sprintf("smoke-%s-%d-%d", cfg.WorkflowType, smokeStart.Unix(), n))
+	}
+	wg.Wait()
+
+	result.LatencyP99Ms = latencies.Percentiles().P99
+	return result
+}
+
+// PrintSummary prints a human-readable smoke test summary.
+func (s *SmokeResult) PrintSummary(w io.Writer) {
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintln(w, "                      SMOKE TEST RESULTS")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintf(w, "  Namespace:  %s\n", s.Namespace)
+	fmt.Fprintf(w, "  Duration:   %s (budget %s)\n", s.Duration, s.Budget)
+	fmt.Fprintln(w, "")
+	for _, t := range s.Types {
+		fmt.Fprintf(w, "  %-18s %3d/%d completed  %3d failed  p99 %8.2f ms\n",
+			t.WorkflowType, t.Completed, SmokeWorkflowsPerType, t.Failed, t.LatencyP99Ms)
+		for _, e := range t.Errors {
+			fmt.Fprintf(w, "    • %s\n", e)
+		}
+	}
+	fmt.Fprintln(w, "")
+	if s.Passed {
+		fmt.Fprintln(w, "                         ✓ PASSED")
+	} else {
+		fmt.Fprintln(w, "                         ✗ FAILED")
+		for _, reason := range s.FailureReasons {
+			fmt.Fprintf(w, "    • %s\n", reason)
+		}
+	}
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+}
+
+// ToJSON serializes the smoke result to JSON bytes.
+func (s *SmokeResult) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
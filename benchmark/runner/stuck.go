@@ -0,0 +1,48 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/cleanup"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// detectStuckWorkflows looks for workflows left open after the drain that have
+// made no history progress within the stuck threshold, optionally terminating
+// them before normal cleanup. It returns nil if detection is disabled or fails.
+func detectStuckWorkflows(ctx context.Context, cleaner *cleanup.Cleaner, cfg config.BenchmarkConfig, namespace string) *results.StuckWorkflows {
+	if cfg.StuckWorkflowThreshold <= 0 {
+		return nil
+	}
+
+	found, err := cleaner.FindStuckWorkflows(ctx, namespace, cfg.StuckWorkflowThreshold)
+	if err != nil {
+		slog.Warn("Stuck workflow detection failed", "namespace", namespace, "error", err)
+		return nil
+	}
+	if cfg.StuckWorkflowTerminate {
+		cleaner.TerminateStuckWorkflows(ctx, namespace, found)
+	}
+
+	stuck := &results.StuckWorkflows{
+		Threshold:  cfg.StuckWorkflowThreshold.String(),
+		Checked:    found.Checked,
+		Count:      len(found.Stuck),
+		Terminated: found.Terminated,
+	}
+	for i, wf := range found.Stuck {
+		if i == results.MaxStuckSamples {
+			break
+		}
+		stuck.SampleIDs = append(stuck.SampleIDs, wf.WorkflowID)
+		slog.Warn("Stuck workflow",
+			"workflow_id", wf.WorkflowID,
+			"run_id", wf.RunID,
+			"last_event", wf.LastEventType,
+			"last_event_time", wf.LastEventTime)
+	}
+	return stuck
+}
@@ -0,0 +1,272 @@
+package runner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/cleanup"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+)
+
+// Timeline event types.
+const (
+	TimelineRunStarted         = "run_started"         // A benchmark run started
+	TimelineRampCompleted      = "ramp_completed"      // An iteration or phase reached its target rate
+	TimelineIntervalSnapshot   = "interval_snapshot"   // Periodic progress while workflows are generated
+	TimelineThresholdEvaluated = "threshold_evaluated" // The run's result was checked against the thresholds
+	TimelineCleanupFinished    = "cleanup_finished"    // The run's namespace was cleaned up
+)
+
+// TimelineEvent is one line of the run lifecycle timeline (newline-delimited
+// JSON). Exactly one of the payloads is set, matching Event.
+type TimelineEvent struct {
+	Event     string    `json:"event"`
+	Time      time.Time `json:"time"`
+	Namespace string    `json:"namespace"`
+
+	Run       *TimelineRun       `json:"run,omitempty"`
+	Ramp      *TimelineRamp      `json:"ramp,omitempty"`
+	Snapshot  *TimelineSnapshot  `json:"snapshot,omitempty"`
+	Threshold *TimelineThreshold `json:"threshold,omitempty"`
+	Cleanup   *TimelineCleanup   `json:"cleanup,omitempty"`
+}
+
+// TimelineRun is the payload of run_started events.
+type TimelineRun struct {
+	WorkflowType    string  `json:"workflowType"`
+	TargetRate      float64 `json:"targetRate"`
+	DurationSeconds float64 `json:"durationSeconds"`
+	RampUpSeconds   float64 `json:"rampUpSeconds"`
+	Iterations      int     `json:"iterations"`
+	Scenario        string  `json:"scenario,omitempty"`
+	Phases          int     `json:"phases,omitempty"`
+}
+
+// TimelineRamp is the payload of ramp_completed events.
+type TimelineRamp struct {
+	Phase      string  `json:"phase"` // "iteration <n>" or the scenario phase name
+	TargetRate float64 `json:"targetRate"`
+}
+
+// TimelineSnapshot is the payload of interval_snapshot events. Counts and the
+// current rate cover the iteration or phase; throughput and latency cover the
+// run so far.
+type TimelineSnapshot struct {
+	Phase          string  `json:"phase"`
+	ElapsedSeconds float64 `json:"elapsedSeconds"`
+	Started        int64   `json:"started"`
+	Completed      int64   `json:"completed"`
+	Failed         int64   `json:"failed"`
+	InFlight       int64   `json:"inFlight"`
+	CurrentRate    float64 `json:"currentRate"`
+	Throughput     float64 `json:"throughput"`
+	LatencyP99Ms   float64 `json:"latencyP99Ms"`
+}
+
+// TimelineThreshold is the payload of threshold_evaluated events.
+type TimelineThreshold struct {
+	Passed         bool     `json:"passed"`
+	FailureReasons []string `json:"failureReasons,omitempty"`
+	Throughput     float64  `json:"throughput"`
+	LatencyP99Ms   float64  `json:"latencyP99Ms"`
+}
+
+// TimelineCleanup is the payload of cleanup_finished events.
+type TimelineCleanup struct {
+	Success             bool    `json:"success"`
+	Partial             bool    `json:"partial,omitempty"`
+	WorkflowsFound      int     `json:"workflowsFound"`
+	WorkflowsTerminated int     `json:"workflowsTerminated"`
+	DurationSeconds     float64 `json:"durationSeconds"`
+	Error               string  `json:"error,omitempty"`
+}
+
+// Timeline writes a machine-parsable timeline of each run's lifecycle, for
+// log pipelines that would otherwise scrape the free-text logs. Its methods
+// are no-ops on a nil Timeline.
+type Timeline struct {
+	mu       sync.Mutex
+	w        io.Writer
+	file     *os.File // Nil when writing to stdout
+	enc      *json.Encoder
+	interval time.Duration
+	failed   bool
+	runs     map[string]*bytes.Buffer // Events of the runs kept for their artifact bundle, by namespace
+}
+
+// OpenTimeline opens the timeline destination spec, "stdout" or
+// "file:<path>" (appended to), with interval_snapshot events every interval.
+func OpenTimeline(spec string, interval time.Duration) (*Timeline, error) {
+	switch {
+	case spec == "stdout":
+		return NewTimeline(os.Stdout, interval), nil
+	case strings.HasPrefix(spec, "file:") && spec != "file:":
+		f, err := os.OpenFile(strings.TrimPrefix(spec, "file:"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open timeline: %w", err)
+		}
+		t := NewTimeline(f, interval)
+		t.file = f
+		return t, nil
+	default:
+		return nil, fmt.Errorf("invalid timeline %q: must be stdout or file:<path>", spec)
+	}
+}
+
+// NewTimeline writes the timeline to w, with interval_snapshot events every interval.
+func NewTimeline(w io.Writer, interval time.Duration) *Timeline {
+	return &Timeline{w: w, enc: json.NewEncoder(w), interval: interval}
+}
+
+// Close closes the timeline file, if any.
+func (t *Timeline) Close() error {
+	if t == nil || t.file == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.file.Close()
+}
+
+// keepRun keeps a copy of the events of the run in namespace until takeRun.
+func (t *Timeline) keepRun(namespace string) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.runs == nil {
+		t.runs = make(map[string]*bytes.Buffer)
+	}
+	t.runs[namespace] = &bytes.Buffer{}
+}
+
+// takeRun returns the events kept for the run in namespace so far, as JSON
+// lines, and stops keeping them.
+func (t *Timeline) takeRun(namespace string) []byte {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	buf := t.runs[namespace]
+	delete(t.runs, namespace)
+	if buf == nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// record writes e, logging the first write failure and dropping later events.
+func (t *Timeline) record(e TimelineEvent) {
+	if t == nil {
+		return
+	}
+	e.Time = time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if buf := t.runs[e.Namespace]; buf != nil {
+		json.NewEncoder(buf).Encode(e)
+	}
+	if t.failed {
+		return
+	}
+	if err := t.enc.Encode(e); err != nil {
+		t.failed = true
+		slog.Warn("Failed to write timeline event; timeline stopped", "error", err)
+	}
+}
+
+func (t *Timeline) runStarted(namespace string, cfg config.BenchmarkConfig, sc *scenario.Scenario) {
+	run := &TimelineRun{
+		WorkflowType:    cfg.WorkflowType,
+		TargetRate:      cfg.TargetRate,
+		DurationSeconds: cfg.Duration.Seconds(),
+		RampUpSeconds:   cfg.RampUpDuration.Seconds(),
+		Iterations:      cfg.Iterations,
+	}
+	if sc != nil {
+		run.Scenario = sc.Name
+		run.Phases = len(sc.Phases)
+	}
+	t.record(TimelineEvent{Event: TimelineRunStarted, Namespace: namespace, Run: run})
+}
+
+// watch records ramp_completed once gen has ramped up to cfg's target rate and
+// interval_snapshot events until the returned stop function is called.
+func (t *Timeline) watch(namespace, phase string, cfg config.BenchmarkConfig, gen generator.WorkflowGenerator, handler metrics.MetricsHandler) (stop func()) {
+	if t == nil {
+		return func() {}
+	}
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	start := time.Now()
+	go func() {
+		defer close(finished)
+		ramp := time.NewTimer(cfg.RampUpDuration)
+		defer ramp.Stop()
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ramp.C:
+				t.record(TimelineEvent{Event: TimelineRampCompleted, Namespace: namespace,
+					Ramp: &TimelineRamp{Phase: phase, TargetRate: cfg.TargetRate}})
+			case <-ticker.C:
+				stats := gen.Stats()
+				t.record(TimelineEvent{Event: TimelineIntervalSnapshot, Namespace: namespace, Snapshot: &TimelineSnapshot{
+					Phase:          phase,
+					ElapsedSeconds: time.Since(start).Seconds(),
+					Started:        stats.WorkflowsStarted,
+					Completed:      stats.WorkflowsCompleted,
+					Failed:         stats.WorkflowsFailed,
+					InFlight:       stats.InFlight,
+					CurrentRate:    stats.CurrentRate,
+					Throughput:     handler.GetThroughput(),
+					LatencyP99Ms:   handler.GetLatencyPercentiles().P99,
+				}})
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-finished
+	}
+}
+
+func (t *Timeline) thresholdEvaluated(namespace string, result *BenchmarkResult) {
+	t.record(TimelineEvent{Event: TimelineThresholdEvaluated, Namespace: namespace, Threshold: &TimelineThreshold{
+		Passed:         result.Passed,
+		FailureReasons: result.FailureReasons,
+		Throughput:     result.ActualRate,
+		LatencyP99Ms:   result.LatencyP99,
+	}})
+}
+
+func (t *Timeline) cleanupFinished(namespace string, result *cleanup.CleanupResult, err error) {
+	c := &TimelineCleanup{}
+	if result != nil {
+		c.Success = result.Success
+		c.Partial = result.Partial
+		c.WorkflowsFound = result.WorkflowsFound
+		c.WorkflowsTerminated = result.WorkflowsTerminated
+		c.DurationSeconds = result.Duration.Seconds()
+	}
+	if err != nil {
+		c.Success = false
+		c.Error = err.Error()
+	}
+	t.record(TimelineEvent{Event: TimelineCleanupFinished, Namespace: namespace, Cleanup: c})
+}
@@ -0,0 +1,130 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/cleanup"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+)
+
+func decodeTimeline(t *testing.T, data []byte) []TimelineEvent {
+	var events []TimelineEvent
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var e TimelineEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		events = append(events, e)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+func TestTimeline_Lifecycle(t *testing.T) {
+	var buf bytes.Buffer
+	tl := NewTimeline(&buf, 10*time.Millisecond)
+
+	cfg := config.DefaultConfig()
+	cfg.RampUpDuration = 0
+	tl.runStarted("benchmark-1", cfg, nil)
+	stop := tl.watch("benchmark-1", "iteration 1", cfg, &stubGenerator{}, metrics.NewHandler())
+	time.Sleep(50 * time.Millisecond)
+	stop()
+	tl.thresholdEvaluated("benchmark-1", &BenchmarkResult{Passed: false, FailureReasons: []string{"p99 too high"}, ActualRate: 98, LatencyP99: 6000})
+	tl.cleanupFinished("benchmark-1", &cleanup.CleanupResult{WorkflowsFound: 3, WorkflowsTerminated: 3, Success: true, Duration: time.Second}, nil)
+	tl.cleanupFinished("benchmark-1", nil, errors.New("namespace not found"))
+
+	events := decodeTimeline(t, buf.Bytes())
+	require.GreaterOrEqual(t, len(events), 6)
+	for _, e := range events {
+		require.Equal(t, "benchmark-1", e.Namespace)
+		require.False(t, e.Time.IsZero())
+	}
+
+	require.Equal(t, TimelineRunStarted, events[0].Event)
+	require.Equal(t, cfg.WorkflowType, events[0].Run.WorkflowType)
+	require.Equal(t, cfg.TargetRate, events[0].Run.TargetRate)
+	ramps, snapshots := 0, 0
+	for _, e := range events[1 : len(events)-3] {
+		switch e.Event {
+		case TimelineRampCompleted:
+			ramps++
+			require.Equal(t, "iteration 1", e.Ramp.Phase)
+		case TimelineIntervalSnapshot:
+			snapshots++
+			require.Equal(t, "iteration 1", e.Snapshot.Phase)
+		default:
+			t.Fatalf("unexpected %s event while generating", e.Event)
+		}
+	}
+	require.Equal(t, 1, ramps)
+	require.NotZero(t, snapshots)
+
+	threshold := events[len(events)-3]
+	require.Equal(t, TimelineThresholdEvaluated, threshold.Event)
+	require.False(t, threshold.Threshold.Passed)
+	require.Equal(t, []string{"p99 too high"}, threshold.Threshold.FailureReasons)
+	require.Equal(t, 6000.0, threshold.Threshold.LatencyP99Ms)
+
+	require.Equal(t, TimelineCleanupFinished, events[len(events)-2].Event)
+	require.True(t, events[len(events)-2].Cleanup.Success)
+	require.Equal(t, 3, events[len(events)-2].Cleanup.WorkflowsTerminated)
+	require.False(t, events[len(events)-1].Cleanup.Success)
+	require.Equal(t, "namespace not found", events[len(events)-1].Cleanup.Error)
+}
+
+func TestOpenTimeline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "timeline.jsonl")
+	for i := 0; i < 2; i++ {
+		tl, err := OpenTimeline("file:"+path, time.Second)
+		require.NoError(t, err)
+		tl.runStarted("benchmark-1", config.DefaultConfig(), nil)
+		require.NoError(t, tl.Close())
+	}
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Len(t, decodeTimeline(t, data), 2, "the file is appended to")
+
+	_, err = OpenTimeline("file:", time.Second)
+	require.Error(t, err)
+	_, err = OpenTimeline("kafka://broker", time.Second)
+	require.Error(t, err)
+}
+
+func TestTimeline_KeepRun(t *testing.T) {
+	var buf bytes.Buffer
+	tl := NewTimeline(&buf, time.Second)
+	tl.keepRun("benchmark-1")
+	tl.runStarted("benchmark-1", config.DefaultConfig(), nil)
+	tl.runStarted("benchmark-2", config.DefaultConfig(), nil)
+	tl.thresholdEvaluated("benchmark-1", &BenchmarkResult{Passed: true})
+
+	kept := decodeTimeline(t, tl.takeRun("benchmark-1"))
+	require.Len(t, kept, 2, "only the kept run's events")
+	require.Equal(t, TimelineRunStarted, kept[0].Event)
+	require.Equal(t, TimelineThresholdEvaluated, kept[1].Event)
+	require.Len(t, decodeTimeline(t, buf.Bytes()), 3, "all events are still written")
+
+	tl.cleanupFinished("benchmark-1", nil, nil)
+	require.Nil(t, tl.takeRun("benchmark-1"), "no longer kept")
+}
+
+func TestTimeline_NilIsNoop(t *testing.T) {
+	var tl *Timeline
+	tl.runStarted("benchmark-1", config.DefaultConfig(), nil)
+	tl.watch("benchmark-1", "iteration 1", config.DefaultConfig(), &stubGenerator{}, nil)()
+	tl.thresholdEvaluated("benchmark-1", &BenchmarkResult{})
+	tl.cleanupFinished("benchmark-1", nil, nil)
+	tl.keepRun("benchmark-1")
+	require.Nil(t, tl.takeRun("benchmark-1"))
+	require.NoError(t, tl.Close())
+}
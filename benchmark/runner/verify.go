@@ -0,0 +1,168 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+)
+
+// DefaultVerifyTimeout bounds a verification run when no completion timeout is configured.
+const DefaultVerifyTimeout = 2 * time.Minute
+
+// Verification statuses for a single workflow type.
+const (
+	VerifyStatusPassed     = "passed"
+	VerifyStatusStartError = "start-failed" // The workflow could not be started (e.g. server rejected it)
+	VerifyStatusFailed     = "failed"       // The workflow started but did not complete successfully
+)
+
+// VerifyTypeResult reports the verification outcome for one workflow type.
+type VerifyTypeResult struct {
+	WorkflowType string  `json:"workflowType"`
+	WorkflowID   string  `json:"workflowId"`
+	RunID        string  `json:"runId,omitempty"`
+	Status       string  `json:"status"`
+	DurationMs   float64 `json:"durationMs"`
+	Error        string  `json:"error,omitempty"`
+}
+
+// VerifyResult is the outcome of a post-deploy verification run.
+type VerifyResult struct {
+	Namespace string             `json:"namespace"`
+	Duration  string             `json:"duration"`
+	Types     []VerifyTypeResult `json:"types"`
+	Passed    bool               `json:"passed"`
+}
+
+// Verify starts exactly one workflow of every registered type and waits for
+// each to complete, catching registration or server-compatibility breakage
+// before an expensive load run.
+func (r *runner) Verify(ctx context.Context, cfg config.BenchmarkConfig) (*VerifyResult, error) {
+	startTime := time.Now()
+
+	timeout := cfg.CompletionTimeout
+	if timeout <= 0 {
+		timeout = DefaultVerifyTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	namespace, nsClient, stop, err := r.prepareNamespace(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
+
+	slog.Info("Starting workflow verification", "namespace", namespace, "timeout", timeout)
+
+	types := config.ValidWorkflowTypes()
+	typeResults := make([]VerifyTypeResult, len(types))
+	var wg sync.WaitGroup
+	for i, workflowType := range types {
+		wg.Add(1)
+		go func(i int, workflowType string) {
+			defer wg.Done()
+			typeCfg := cfg
+			typeCfg.WorkflowType = workflowType
+			typeResults[i] = verifyType(ctx, nsClient, typeCfg,
+				fmt.Sprintf("verify-%s-%d", workflowType, startTime.Unix()))
+		}(i, workflowType)
+	}
+	wg.Wait()
+
+	result := newVerifyResult(namespace, typeResults)
+	result.Duration = time.Since(startTime).Round(time.Millisecond).String()
+	return result, nil
+}
+
+// verifyType runs a single workflow of cfg.WorkflowType to completion.
+func verifyType(ctx context.Context, c client.Client, cfg config.BenchmarkConfig, workflowID string) VerifyTypeResult {
+	result := VerifyTypeResult{WorkflowType: cfg.WorkflowType, WorkflowID: workflowID}
+
+	start := time.Now()
+	run, err := generator.ExecuteWorkflow(ctx, c, client.StartWorkflowOptions{
+		ID:        workflowID,
+		TaskQueue: DefaultTaskQueue,
+	}, cfg)
+	if err != nil {
+		result.Status = VerifyStatusStartError
+		result.Error = err.Error()
+		return result
+	}
+	result.RunID = run.GetRunID()
+
+	err = run.Get(ctx, nil)
+	result.DurationMs = float64(time.Since(start).Microseconds()) / 1000
+	if err != nil {
+		result.Status = VerifyStatusFailed
+		result.Error = err.Error()
+		return result
+	}
+	result.Status = VerifyStatusPassed
+	return result
+}
+
+// newVerifyResult builds the overall result; it passes only if every type passed.
+func newVerifyResult(namespace string, types []VerifyTypeResult) *VerifyResult {
+	result := &VerifyResult{
+		Namespace: namespace,
+		Types:     types,
+		Passed:    true,
+	}
+	for _, t := range types {
+		if t.Status != VerifyStatusPassed {
+			result.Passed = false
+		}
+	}
+	return result
+}
+
+// FailedTypes returns the workflow types that did not pass verification.
+func (v *VerifyResult) FailedTypes() []string {
+	var failed []string
+	for _, t := range v.Types {
+		if t.Status != VerifyStatusPassed {
+			failed = append(failed, t.WorkflowType)
+		}
+	}
+	return failed
+}
+
+// PrintSummary prints a human-readable verification summary.
+func (v *VerifyResult) PrintSummary(w io.Writer) {
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintln(w, "                  WORKFLOW VERIFICATION RESULTS")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintf(w, "  Namespace:  %s\n", v.Namespace)
+	fmt.Fprintf(w, "  Duration:   %s\n", v.Duration)
+	fmt.Fprintln(w, "")
+	for _, t := range v.Types {
+		fmt.Fprintf(w, "  %-18s %-13s %10.2f ms\n", t.WorkflowType, t.Status, t.DurationMs)
+		if t.Error != "" {
+			fmt.Fprintf(w, "    • %s\n", t.Error)
+		}
+	}
+	fmt.Fprintln(w, "")
+	if v.Passed {
+		fmt.Fprintln(w, "                         ✓ PASSED")
+	} else {
+		fmt.Fprintln(w, "                         ✗ FAILED")
+	}
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+}
+
+// ToJSON serializes the verification result to JSON bytes.
+func (v *VerifyResult) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(v, "", "  ")
+}
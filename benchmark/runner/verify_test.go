@@ -0,0 +1,24 @@
+package runner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVerifyResult(t *testing.T) {
+	passed := newVerifyResult("ns", []VerifyTypeResult{
+		{WorkflowType: "simple", Status: VerifyStatusPassed},
+		{WorkflowType: "timer", Status: VerifyStatusPassed},
+	})
+	require.True(t, passed.Passed)
+	require.Empty(t, passed.FailedTypes())
+
+	failed := newVerifyResult("ns", []VerifyTypeResult{
+		{WorkflowType: "simple", Status: VerifyStatusPassed},
+		{WorkflowType: "timer", Status: VerifyStatusFailed},
+		{WorkflowType: "child-workflow", Status: VerifyStatusStartError},
+	})
+	require.False(t, failed.Passed)
+	require.Equal(t, []string{"timer", "child-workflow"}, failed.FailedTypes())
+}
@@ -0,0 +1,358 @@
+// Package runner provides the benchmark runner orchestration.
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+const (
+	// visibilityMaxInFlight caps concurrent visibility queries; ticks beyond
+	// it are skipped rather than queued so a slow server cannot build a backlog
+	visibilityMaxInFlight = 256
+
+	// visibilityQueryTimeout bounds a single visibility query
+	visibilityQueryTimeout = 30 * time.Second
+
+	// visibilityMaxErrorSamples limits the errors reported per query class
+	visibilityMaxErrorSamples = 3
+)
+
+// workflowNames maps config workflow types to their registered workflow names.
+var workflowNames = map[string]string{
+	config.WorkflowTypeSimple:           workflows.SimpleWorkflowName,
+	config.WorkflowTypeMultiActivity:    workflows.MultiActivityWorkflowName,
+	config.WorkflowTypeTimer:            workflows.TimerWorkflowName,
+	config.WorkflowTypeChildWorkflow:    workflows.ChildWorkflowName,
+	config.WorkflowTypeStateTransitions: workflows.StateTransitionWorkflowName,
+	config.WorkflowTypeContention:       workflows.ContentionWorkflowName,
+	config.WorkflowTypeHeartbeat:        workflows.HeartbeatWorkflowName,
+	config.WorkflowTypeFlaky:            workflows.FlakyWorkflowName,
+	config.WorkflowTypeSearchAttributes: workflows.SearchAttributeWorkflowName,
+	config.WorkflowTypeSideEffects:      workflows.SideEffectWorkflowName,
+	config.WorkflowTypeLongHistory:      workflows.LongHistoryWorkflowName,
+	config.WorkflowTypeFanout:           workflows.FanoutWorkflowName,
+}
+
+// Visibility query kinds.
+const (
+	VisibilityList  = "list"  // ListWorkflowExecutions, one page
+	VisibilityCount = "count" // CountWorkflowExecutions, optionally grouped
+)
+
+// VisibilityQuery is one class of query issued by the visibility benchmark.
+type VisibilityQuery struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind,omitempty"` // VisibilityList (default) or VisibilityCount
+	Query string `json:"query"`
+}
+
+// DefaultVisibilityQueries returns list query classes from least to most
+// selective (every execution, completed executions, executions of the
+// configured workflow type, running executions and a single workflow ID),
+// then count query classes: plain counts, which the server answers without
+// fetching rows, and counts grouped by execution status.
+func DefaultVisibilityQueries(cfg config.BenchmarkConfig) []VisibilityQuery {
+	workflowName := workflowNames[cfg.WorkflowType]
+	if workflowName == "" {
+		workflowName = workflows.SimpleWorkflowName
+	}
+	byType := fmt.Sprintf("WorkflowType = '%s'", workflowName)
+	return []VisibilityQuery{
+		{Name: "all", Query: ""},
+		{Name: "completed", Query: "ExecutionStatus = 'Completed'"},
+		{Name: "by-type", Query: byType},
+		{Name: "running", Query: "ExecutionStatus = 'Running'"},
+		{Name: "by-id", Query: "WorkflowId = 'visibility-benchmark-probe'"},
+		{Name: "count-all", Kind: VisibilityCount, Query: ""},
+		{Name: "count-completed", Kind: VisibilityCount, Query: "ExecutionStatus = 'Completed'"},
+		{Name: "count-by-type", Kind: VisibilityCount, Query: byType},
+		{Name: "count-by-status", Kind: VisibilityCount, Query: "GROUP BY ExecutionStatus"},
+		{Name: "count-type-by-status", Kind: VisibilityCount, Query: byType + " GROUP BY ExecutionStatus"},
+	}
+}
+
+// VisibilityQueryResult reports the outcome of one query class.
+type VisibilityQueryResult struct {
+	Name         string   `json:"name"`
+	Kind         string   `json:"kind"`
+	Query        string   `json:"query"`
+	Queries      int64    `json:"queries"`
+	Errors       int64    `json:"errors"`
+	AvgRows      float64  `json:"avgRows"`            // Executions (list, at most one page) or groups (count) returned per successful query
+	AvgCount     float64  `json:"avgCount,omitempty"` // Count returned per successful count query
+	LatencyP50Ms float64  `json:"latencyP50Ms"`
+	LatencyP95Ms float64  `json:"latencyP95Ms"`
+	LatencyP99Ms float64  `json:"latencyP99Ms"`
+	LatencyMaxMs float64  `json:"latencyMaxMs"`
+	ErrorSamples []string `json:"errorSamples,omitempty"`
+}
+
+// VisibilityResult is the outcome of a visibility query benchmark.
+type VisibilityResult struct {
+	Namespace      string                  `json:"namespace"`
+	Duration       string                  `json:"duration"`
+	TargetQPS      float64                 `json:"targetQps"`
+	TargetCountQPS float64                 `json:"targetCountQps,omitempty"`
+	ActualQPS      float64                 `json:"actualQps"`
+	PageSize       int                     `json:"pageSize"`
+	Skipped        int64                   `json:"skipped,omitempty"` // Ticks skipped because visibilityMaxInFlight queries were outstanding
+	Queries        []VisibilityQueryResult `json:"queries"`
+	Passed         bool                    `json:"passed"`
+	FailureReasons []string                `json:"failureReasons"`
+}
+
+// visibilityClass accumulates the results of one query class.
+type visibilityClass struct {
+	query     VisibilityQuery
+	latencies *metrics.LatencyCollector
+	queries   atomic.Int64
+	errors    atomic.Int64
+	rows      atomic.Int64
+	count     atomic.Int64
+
+	mu           sync.Mutex
+	errorSamples []string
+}
+
+// RunVisibility issues queries against cfg.Namespace for cfg.Duration,
+// cycling through the list queries at cfg.VisibilityQPS and, independently,
+// the count queries at cfg.VisibilityCountQPS, and reports latency
+// percentiles per query class. The namespace is expected to be pre-populated
+// (e.g. by an earlier benchmark run); nothing is created or cleaned up. The
+// run fails if any query returns an error.
+func RunVisibility(ctx context.Context, svc workflowservice.WorkflowServiceClient, cfg config.BenchmarkConfig, queries []VisibilityQuery) (*VisibilityResult, error) {
+	classes := make([]*visibilityClass, len(queries))
+	byKind := map[string][]*visibilityClass{}
+	for i, q := range queries {
+		if q.Kind == "" {
+			q.Kind = VisibilityList
+		}
+		if q.Kind != VisibilityList && q.Kind != VisibilityCount {
+			return nil, fmt.Errorf("visibility query %s: unknown kind %q", q.Name, q.Kind)
+		}
+		classes[i] = &visibilityClass{query: q, latencies: metrics.NewLatencyCollector(0)}
+		byKind[q.Kind] = append(byKind[q.Kind], classes[i])
+	}
+	rates := map[string]float64{VisibilityList: cfg.VisibilityQPS, VisibilityCount: cfg.VisibilityCountQPS}
+	var streams int
+	for kind, rate := range rates {
+		if rate > 0 && len(byKind[kind]) > 0 {
+			streams++
+		}
+	}
+	if streams == 0 {
+		return nil, fmt.Errorf("no visibility queries to run: need list or count queries with a positive QPS")
+	}
+
+	slog.Info("Starting visibility benchmark",
+		"namespace", cfg.Namespace,
+		"target_qps", cfg.VisibilityQPS,
+		"target_count_qps", cfg.VisibilityCountQPS,
+		"page_size", cfg.VisibilityPageSize,
+		"duration", cfg.Duration,
+		"query_classes", len(queries))
+
+	startTime := time.Now()
+	runCtx, cancel := context.WithTimeout(ctx, cfg.Duration)
+	defer cancel()
+
+	// Queries run on ctx rather than runCtx so those in flight at the end of
+	// the run complete instead of being counted as errors
+	var wg sync.WaitGroup
+	var inFlight, skipped atomic.Int64
+	launch := func(class *visibilityClass) {
+		if inFlight.Load() >= visibilityMaxInFlight {
+			skipped.Add(1)
+			return
+		}
+		inFlight.Add(1)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer inFlight.Add(-1)
+			class.run(ctx, svc, cfg.Namespace, int32(cfg.VisibilityPageSize))
+		}()
+	}
+
+	var dispatchers sync.WaitGroup
+	for kind, rate := range rates {
+		if rate <= 0 || len(byKind[kind]) == 0 {
+			continue
+		}
+		dispatchers.Add(1)
+		go func(classes []*visibilityClass, rate float64) {
+			defer dispatchers.Done()
+			dispatchVisibility(runCtx, rate, classes, launch)
+		}(byKind[kind], rate)
+	}
+	dispatchers.Wait()
+	wg.Wait()
+
+	elapsed := time.Since(startTime)
+	result := newVisibilityResult(cfg, classes, skipped.Load(), elapsed)
+	if ctx.Err() != nil {
+		result.Passed = false
+		result.FailureReasons = append(result.FailureReasons, "visibility benchmark was cancelled")
+	}
+	return result, nil
+}
+
+// dispatchVisibility launches one query per tick at rate, cycling through
+// classes, until ctx is done.
+func dispatchVisibility(ctx context.Context, rate float64, classes []*visibilityClass, launch func(*visibilityClass)) {
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+	defer ticker.Stop()
+
+	for next := 0; ; next++ {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			launch(classes[next%len(classes)])
+		}
+	}
+}
+
+// run issues one query of the class and records its outcome.
+func (c *visibilityClass) run(ctx context.Context, svc workflowservice.WorkflowServiceClient, namespace string, pageSize int32) {
+	ctx, cancel := context.WithTimeout(ctx, visibilityQueryTimeout)
+	defer cancel()
+
+	start := time.Now()
+	var rows, count int64
+	var err error
+	if c.query.Kind == VisibilityCount {
+		var resp *workflowservice.CountWorkflowExecutionsResponse
+		resp, err = svc.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: namespace,
+			Query:     c.query.Query,
+		})
+		rows, count = int64(len(resp.GetGroups())), resp.GetCount()
+	} else {
+		var resp *workflowservice.ListWorkflowExecutionsResponse
+		resp, err = svc.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace: namespace,
+			PageSize:  pageSize,
+			Query:     c.query.Query,
+		})
+		rows = int64(len(resp.GetExecutions()))
+	}
+	c.queries.Add(1)
+	if err != nil {
+		c.errors.Add(1)
+		c.mu.Lock()
+		if len(c.errorSamples) < visibilityMaxErrorSamples {
+			c.errorSamples = append(c.errorSamples, err.Error())
+		}
+		c.mu.Unlock()
+		return
+	}
+	c.latencies.Add(float64(time.Since(start).Microseconds()) / 1000)
+	c.rows.Add(rows)
+	c.count.Add(count)
+}
+
+// newVisibilityResult summarizes the query classes; it passes only if no
+// query failed.
+func newVisibilityResult(cfg config.BenchmarkConfig, classes []*visibilityClass, skipped int64, elapsed time.Duration) *VisibilityResult {
+	result := &VisibilityResult{
+		Namespace:      cfg.Namespace,
+		Duration:       elapsed.Round(time.Millisecond).String(),
+		TargetQPS:      cfg.VisibilityQPS,
+		TargetCountQPS: cfg.VisibilityCountQPS,
+		PageSize:       cfg.VisibilityPageSize,
+		Skipped:        skipped,
+		Passed:         true,
+		FailureReasons: []string{},
+	}
+
+	var total int64
+	for _, c := range classes {
+		p := c.latencies.Percentiles()
+		qr := VisibilityQueryResult{
+			Name:         c.query.Name,
+			Kind:         c.query.Kind,
+			Query:        c.query.Query,
+			Queries:      c.queries.Load(),
+			Errors:       c.errors.Load(),
+			LatencyP50Ms: p.P50,
+			LatencyP95Ms: p.P95,
+			LatencyP99Ms: p.P99,
+			LatencyMaxMs: p.Max,
+			ErrorSamples: c.errorSamples,
+		}
+		if succeeded := qr.Queries - qr.Errors; succeeded > 0 {
+			qr.AvgRows = float64(c.rows.Load()) / float64(succeeded)
+			if qr.Kind == VisibilityCount {
+				qr.AvgCount = float64(c.count.Load()) / float64(succeeded)
+			}
+		}
+		if qr.Errors > 0 {
+			result.Passed = false
+			result.FailureReasons = append(result.FailureReasons,
+				fmt.Sprintf("%s: %d of %d queries failed", qr.Name, qr.Errors, qr.Queries))
+		}
+		total += qr.Queries
+		result.Queries = append(result.Queries, qr)
+	}
+	if elapsed > 0 {
+		result.ActualQPS = float64(total) / elapsed.Seconds()
+	}
+	return result
+}
+
+// PrintSummary prints a human-readable visibility benchmark summary.
+func (v *VisibilityResult) PrintSummary(w io.Writer) {
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintln(w, "                VISIBILITY QUERY BENCHMARK RESULTS")
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+	fmt.Fprintf(w, "  Namespace:  %s\n", v.Namespace)
+	fmt.Fprintf(w, "  Duration:   %s\n", v.Duration)
+	fmt.Fprintf(w, "  QPS:        %.2f (target %.2f list + %.2f count, page size %d)\n", v.ActualQPS, v.TargetQPS, v.TargetCountQPS, v.PageSize)
+	if v.Skipped > 0 {
+		fmt.Fprintf(w, "  Skipped:    %d (too many queries in flight)\n", v.Skipped)
+	}
+	fmt.Fprintln(w, "")
+	// RESULT is the mean executions returned by list queries, or the mean count
+	fmt.Fprintf(w, "  %-20s %7s %6s %10s %9s %9s %9s\n", "QUERY", "QUERIES", "ERRORS", "RESULT", "P50 ms", "P95 ms", "P99 ms")
+	for _, q := range v.Queries {
+		avg := q.AvgRows
+		if q.Kind == VisibilityCount {
+			avg = q.AvgCount
+		}
+		fmt.Fprintf(w, "  %-20s %7d %6d %10.1f %9.2f %9.2f %9.2f\n",
+			q.Name, q.Queries, q.Errors, avg, q.LatencyP50Ms, q.LatencyP95Ms, q.LatencyP99Ms)
+		for _, e := range q.ErrorSamples {
+			fmt.Fprintf(w, "    • %s\n", e)
+		}
+	}
+	fmt.Fprintln(w, "")
+	if v.Passed {
+		fmt.Fprintln(w, "                         ✓ PASSED")
+	} else {
+		fmt.Fprintln(w, "                         ✗ FAILED")
+		for _, reason := range v.FailureReasons {
+			fmt.Fprintf(w, "    • %s\n", reason)
+		}
+	}
+	fmt.Fprintln(w, "═══════════════════════════════════════════════════════════════")
+}
+
+// ToJSON serializes the visibility result to JSON bytes.
+func (v *VisibilityResult) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(v, "", "  ")
+}
@@ -0,0 +1,102 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// fakeVisibilityService answers list queries with a fixed page of executions
+// and count queries with a fixed count in two groups, failing queries listed
+// in failing.
+type fakeVisibilityService struct {
+	workflowservice.WorkflowServiceClient
+	rows    map[string]int
+	failing map[string]bool
+}
+
+func (f *fakeVisibilityService) ListWorkflowExecutions(_ context.Context, req *workflowservice.ListWorkflowExecutionsRequest, _ ...grpc.CallOption) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+	if f.failing[req.GetQuery()] {
+		return nil, errors.New("invalid query")
+	}
+	return &workflowservice.ListWorkflowExecutionsResponse{
+		Executions: make([]*workflow.WorkflowExecutionInfo, f.rows[req.GetQuery()]),
+	}, nil
+}
+
+func (f *fakeVisibilityService) CountWorkflowExecutions(_ context.Context, req *workflowservice.CountWorkflowExecutionsRequest, _ ...grpc.CallOption) (*workflowservice.CountWorkflowExecutionsResponse, error) {
+	if f.failing[req.GetQuery()] {
+		return nil, errors.New("invalid query")
+	}
+	return &workflowservice.CountWorkflowExecutionsResponse{
+		Count:  int64(f.rows[req.GetQuery()]),
+		Groups: make([]*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup, 2),
+	}, nil
+}
+
+func TestRunVisibility(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Namespace = "benchmark-populated"
+	cfg.VisibilityQPS = 200
+	cfg.VisibilityCountQPS = 0
+	cfg.Duration = 200 * time.Millisecond
+	queries := []VisibilityQuery{{Name: "all", Query: ""}, {Name: "by-id", Query: "WorkflowId = 'x'"}}
+
+	svc := &fakeVisibilityService{rows: map[string]int{"": 100, "WorkflowId = 'x'": 1}}
+	result, err := RunVisibility(context.Background(), svc, cfg, queries)
+	require.NoError(t, err)
+	require.True(t, result.Passed)
+	require.Len(t, result.Queries, 2)
+	for _, q := range result.Queries {
+		require.Positive(t, q.Queries)
+		require.Zero(t, q.Errors)
+	}
+	require.Equal(t, float64(100), result.Queries[0].AvgRows)
+	require.Equal(t, float64(1), result.Queries[1].AvgRows)
+	require.Positive(t, result.ActualQPS)
+
+	svc.failing = map[string]bool{"WorkflowId = 'x'": true}
+	result, err = RunVisibility(context.Background(), svc, cfg, queries)
+	require.NoError(t, err)
+	require.False(t, result.Passed)
+	require.Len(t, result.FailureReasons, 1)
+	require.Contains(t, result.FailureReasons[0], "by-id")
+	require.NotEmpty(t, result.Queries[1].ErrorSamples)
+}
+
+func TestRunVisibility_CountQueries(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Namespace = "benchmark-populated"
+	cfg.VisibilityQPS = 0
+	cfg.VisibilityCountQPS = 100
+	cfg.Duration = 200 * time.Millisecond
+	queries := []VisibilityQuery{
+		{Name: "all", Query: ""},
+		{Name: "count-by-status", Kind: VisibilityCount, Query: "GROUP BY ExecutionStatus"},
+	}
+
+	svc := &fakeVisibilityService{rows: map[string]int{"": 100, "GROUP BY ExecutionStatus": 5000}}
+	result, err := RunVisibility(context.Background(), svc, cfg, queries)
+	require.NoError(t, err)
+	require.True(t, result.Passed)
+
+	// List queries are disabled with a zero QPS
+	require.Zero(t, result.Queries[0].Queries)
+	count := result.Queries[1]
+	require.Equal(t, VisibilityCount, count.Kind)
+	require.Positive(t, count.Queries)
+	require.Equal(t, float64(5000), count.AvgCount)
+	require.Equal(t, float64(2), count.AvgRows)
+
+	cfg.VisibilityCountQPS = 0
+	_, err = RunVisibility(context.Background(), svc, cfg, queries)
+	require.Error(t, err)
+}
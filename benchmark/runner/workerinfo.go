@@ -0,0 +1,138 @@
+package runner
+
+import (
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/worker"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+// Kinds of benchmark worker reported by WorkerInfo.
+const (
+	WorkerKindEmbedded   = "embedded"    // Started by the benchmark runner for its namespace
+	WorkerKindWorkerOnly = "worker-only" // The worker role's long-running worker
+)
+
+// WorkerInfo summarizes a started benchmark worker: where it polls, its
+// effective tuning and what it has registered. It is logged once when the
+// worker starts and served by the admin /config endpoint, so mismatched
+// deployments (e.g. a worker image missing a workflow type, or a worker
+// polling another namespace) can be spotted without reading task definitions.
+type WorkerInfo struct {
+	Kind       string       `json:"kind"`
+	Tasks      string       `json:"tasks"`
+	Namespace  string       `json:"namespace"`
+	TaskQueue  string       `json:"taskQueue"`
+	StartedAt  time.Time    `json:"startedAt"`
+	Options    WorkerTuning `json:"options"`
+	Workflows  []string     `json:"workflows"`
+	Activities []string     `json:"activities"`
+}
+
+// WorkerTuning holds the worker.Options fields the benchmark sets, plus the
+// process-wide workflow cache settings. worker.Options itself holds
+// functions and interfaces, which don't serialize.
+type WorkerTuning struct {
+	MaxConcurrentActivityExecutionSize      int     `json:"maxConcurrentActivityExecutionSize"`
+	MaxConcurrentWorkflowTaskExecutionSize  int     `json:"maxConcurrentWorkflowTaskExecutionSize"`
+	MaxConcurrentLocalActivityExecutionSize int     `json:"maxConcurrentLocalActivityExecutionSize"`
+	MaxConcurrentWorkflowTaskPollers        int     `json:"maxConcurrentWorkflowTaskPollers"`
+	MaxConcurrentActivityTaskPollers        int     `json:"maxConcurrentActivityTaskPollers"`
+	DisableEagerActivities                  bool    `json:"disableEagerActivities"`
+	MaxConcurrentEagerActivityExecutionSize int     `json:"maxConcurrentEagerActivityExecutionSize"`
+	StickyScheduleToStartTimeout            string  `json:"stickyScheduleToStartTimeout"`
+	MaxHeartbeatThrottleInterval            string  `json:"maxHeartbeatThrottleInterval"`
+	WorkerActivitiesPerSecond               float64 `json:"workerActivitiesPerSecond,omitempty"`
+	TaskQueueActivitiesPerSecond            float64 `json:"taskQueueActivitiesPerSecond,omitempty"`
+	WorkflowCacheSize                       int     `json:"workflowCacheSize"`
+	StickyDisabled                          bool    `json:"stickyDisabled"`
+}
+
+// NewWorkerInfo describes a worker of the given kind polling taskQueue in
+// namespace with opts and the benchmark workflows and activities registered
+// for cfg.WorkerTasks.
+func NewWorkerInfo(kind, namespace, taskQueue string, opts worker.Options, cfg config.BenchmarkConfig) WorkerInfo {
+	info := WorkerInfo{
+		Kind:      kind,
+		Tasks:     cfg.WorkerTasks,
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+		StartedAt: time.Now(),
+		Options: WorkerTuning{
+			MaxConcurrentActivityExecutionSize:      opts.MaxConcurrentActivityExecutionSize,
+			MaxConcurrentWorkflowTaskExecutionSize:  opts.MaxConcurrentWorkflowTaskExecutionSize,
+			MaxConcurrentLocalActivityExecutionSize: opts.MaxConcurrentLocalActivityExecutionSize,
+			MaxConcurrentWorkflowTaskPollers:        opts.MaxConcurrentWorkflowTaskPollers,
+			MaxConcurrentActivityTaskPollers:        opts.MaxConcurrentActivityTaskPollers,
+			DisableEagerActivities:                  opts.DisableEagerActivities,
+			MaxConcurrentEagerActivityExecutionSize: opts.MaxConcurrentEagerActivityExecutionSize,
+			StickyScheduleToStartTimeout:            opts.StickyScheduleToStartTimeout.String(),
+			MaxHeartbeatThrottleInterval:            opts.MaxHeartbeatThrottleInterval.String(),
+			WorkerActivitiesPerSecond:               opts.WorkerActivitiesPerSecond,
+			TaskQueueActivitiesPerSecond:            opts.TaskQueueActivitiesPerSecond,
+			WorkflowCacheSize:                       cfg.EffectiveWorkflowCacheSize(),
+			StickyDisabled:                          cfg.DisableSticky,
+		},
+	}
+	if cfg.WorkerTasks != config.WorkerTasksActivity {
+		info.Workflows = workflows.WorkflowNames()
+	}
+	if cfg.WorkerTasks != config.WorkerTasksWorkflow {
+		info.Activities = workflows.ActivityNames()
+	}
+	return info
+}
+
+// Log writes the summary as a single structured log line.
+func (i WorkerInfo) Log() {
+	slog.Info("Worker configuration", "worker", i)
+}
+
+// startedWorkers holds the workers running in this process, in start order.
+var startedWorkers struct {
+	mu      sync.Mutex
+	workers []*WorkerInfo
+}
+
+// TrackWorker logs info and reports it from StartedWorkers until the
+// returned function is called when the worker stops.
+func TrackWorker(info WorkerInfo) (untrack func()) {
+	info.Log()
+	p := &info
+	startedWorkers.mu.Lock()
+	startedWorkers.workers = append(startedWorkers.workers, p)
+	startedWorkers.mu.Unlock()
+	return func() {
+		startedWorkers.mu.Lock()
+		defer startedWorkers.mu.Unlock()
+		startedWorkers.workers = slices.DeleteFunc(startedWorkers.workers, func(w *WorkerInfo) bool { return w == p })
+	}
+}
+
+// StartedWorkers returns the benchmark workers currently running in this
+// process.
+func StartedWorkers() []WorkerInfo {
+	startedWorkers.mu.Lock()
+	defer startedWorkers.mu.Unlock()
+	infos := make([]WorkerInfo, len(startedWorkers.workers))
+	for i, w := range startedWorkers.workers {
+		infos[i] = *w
+	}
+	return infos
+}
+
+// trackedWorker stops reporting a worker from StartedWorkers once it stops.
+type trackedWorker struct {
+	worker.Worker
+	untrack func()
+}
+
+func (w trackedWorker) Stop() {
+	w.Worker.Stop()
+	w.untrack()
+}
@@ -0,0 +1,50 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/worker"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+func TestNewWorkerInfo(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.DisableSticky = true
+	opts := worker.Options{
+		MaxConcurrentWorkflowTaskPollers: 32,
+		StickyScheduleToStartTimeout:     5 * time.Second,
+	}
+
+	info := NewWorkerInfo(WorkerKindWorkerOnly, "benchmark", DefaultTaskQueue, opts, cfg)
+	require.Equal(t, "benchmark", info.Namespace)
+	require.Equal(t, DefaultTaskQueue, info.TaskQueue)
+	require.Equal(t, 32, info.Options.MaxConcurrentWorkflowTaskPollers)
+	require.Equal(t, "5s", info.Options.StickyScheduleToStartTimeout)
+	require.True(t, info.Options.StickyDisabled)
+	require.Zero(t, info.Options.WorkflowCacheSize)
+	require.Contains(t, info.Workflows, workflows.FlakyWorkflowName)
+	require.Contains(t, info.Activities, workflows.FlakyActivityName)
+}
+
+func TestTrackWorker(t *testing.T) {
+	cfg := config.DefaultConfig()
+	untrackA := TrackWorker(NewWorkerInfo(WorkerKindEmbedded, "benchmark-a", DefaultTaskQueue, worker.Options{}, cfg))
+	untrackB := TrackWorker(NewWorkerInfo(WorkerKindEmbedded, "benchmark-b", DefaultTaskQueue, worker.Options{}, cfg))
+
+	started := StartedWorkers()
+	require.Len(t, started, 2)
+	require.Equal(t, "benchmark-a", started[0].Namespace)
+	require.Equal(t, "benchmark-b", started[1].Namespace)
+
+	untrackA()
+	started = StartedWorkers()
+	require.Len(t, started, 1)
+	require.Equal(t, "benchmark-b", started[0].Namespace)
+
+	untrackB()
+	require.Empty(t, StartedWorkers())
+}
@@ -0,0 +1,32 @@
+package runner
+
+import (
+	"go.temporal.io/sdk/worker"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/workflows"
+)
+
+// ConfigureWorkerTasks restricts opts to the task kinds tasks selects (see
+// config.WorkerTasksAll and friends). A workflow worker still runs local
+// activities; an activity worker polls no workflow tasks, so it keeps no
+// workflow cache.
+func ConfigureWorkerTasks(opts *worker.Options, tasks string) {
+	switch tasks {
+	case config.WorkerTasksWorkflow:
+		opts.LocalActivityWorkerOnly = true
+	case config.WorkerTasksActivity:
+		opts.DisableWorkflowWorker = true
+	}
+}
+
+// RegisterWorkerTasks registers the benchmark workflows, activities or both
+// with w, as tasks selects.
+func RegisterWorkerTasks(w worker.Worker, tasks string) {
+	if tasks != config.WorkerTasksActivity {
+		workflows.RegisterWorkflows(w)
+	}
+	if tasks != config.WorkerTasksWorkflow {
+		workflows.RegisterActivities(w)
+	}
+}
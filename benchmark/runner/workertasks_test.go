@@ -0,0 +1,43 @@
+package runner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/worker"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func TestConfigureWorkerTasks(t *testing.T) {
+	var opts worker.Options
+	ConfigureWorkerTasks(&opts, config.WorkerTasksAll)
+	require.False(t, opts.LocalActivityWorkerOnly)
+	require.False(t, opts.DisableWorkflowWorker)
+
+	opts = worker.Options{}
+	ConfigureWorkerTasks(&opts, config.WorkerTasksWorkflow)
+	require.True(t, opts.LocalActivityWorkerOnly)
+	require.False(t, opts.DisableWorkflowWorker)
+
+	opts = worker.Options{}
+	ConfigureWorkerTasks(&opts, config.WorkerTasksActivity)
+	require.False(t, opts.LocalActivityWorkerOnly)
+	require.True(t, opts.DisableWorkflowWorker)
+}
+
+func TestNewWorkerInfo_SplitTasks(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Role = config.RoleWork
+
+	cfg.WorkerTasks = config.WorkerTasksWorkflow
+	info := NewWorkerInfo(WorkerKindWorkerOnly, "benchmark", DefaultTaskQueue, worker.Options{}, cfg)
+	require.Equal(t, config.WorkerTasksWorkflow, info.Tasks)
+	require.NotEmpty(t, info.Workflows)
+	require.Empty(t, info.Activities)
+
+	cfg.WorkerTasks = config.WorkerTasksActivity
+	info = NewWorkerInfo(WorkerKindWorkerOnly, "benchmark", DefaultTaskQueue, worker.Options{}, cfg)
+	require.Empty(t, info.Workflows)
+	require.NotEmpty(t, info.Activities)
+}
@@ -0,0 +1,57 @@
+package runner
+
+import (
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// workflowCacheWindow measures the embedded workers' workflow cache activity
+// over a run from the SDK's cache counters at its start and end. A nil
+// workflowCacheWindow reports the cache configuration only, or nothing for
+// the generate role, which runs no workers.
+type workflowCacheWindow struct {
+	gatherer  prometheus.Gatherer
+	namespace string
+	before    metrics.WorkflowCacheCounts
+}
+
+// startWorkflowCacheWindow reads the cache counters of namespace's workers
+// at the start of a run.
+func startWorkflowCacheWindow(gatherer prometheus.Gatherer, cfg config.BenchmarkConfig, namespace string) *workflowCacheWindow {
+	if cfg.Role == config.RoleGenerate {
+		return nil
+	}
+	before, err := metrics.WorkflowCacheCounters(gatherer, namespace)
+	if err != nil {
+		slog.Warn("Workflow cache metrics unavailable", "error", err)
+		return nil
+	}
+	return &workflowCacheWindow{gatherer: gatherer, namespace: namespace, before: before}
+}
+
+// result returns the workflow cache size from cfg and, if measured, the
+// cache's hits, misses and forced evictions during the run.
+func (w *workflowCacheWindow) result(cfg config.BenchmarkConfig) *results.WorkflowCache {
+	if cfg.Role == config.RoleGenerate {
+		return nil
+	}
+	cache := &results.WorkflowCache{Size: cfg.EffectiveWorkflowCacheSize()}
+	if w == nil {
+		return cache
+	}
+	after, err := metrics.WorkflowCacheCounters(w.gatherer, w.namespace)
+	if err != nil {
+		slog.Warn("Failed to read workflow cache metrics at end of run", "error", err)
+		return cache
+	}
+	counts := after.Sub(w.before)
+	cache.Hits = &counts.Hits
+	cache.Misses = &counts.Misses
+	cache.ForcedEvictions = &counts.ForcedEvictions
+	return cache
+}
@@ -0,0 +1,98 @@
+package runner
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/metrics"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+)
+
+// workflowTypeTracker collects the latencies of each workflow type of a mixed
+// run, whose overall percentiles blend types of very different cost. A nil
+// workflowTypeTracker records nothing.
+type workflowTypeTracker struct {
+	budget *metrics.LatencyBudget // Shared by the latencies of every type
+
+	mu    sync.Mutex
+	types map[string]*workflowTypeStats
+}
+
+type workflowTypeStats struct {
+	completed int64
+	failed    int64
+	latencies *metrics.LatencyCollector
+}
+
+// newWorkflowTypeTracker creates a tracker if the run starts mixed workflows,
+// directly or in a scenario phase, and returns nil otherwise. The latencies
+// of every type draw on budget.
+func newWorkflowTypeTracker(cfg config.BenchmarkConfig, s *scenario.Scenario, budget *metrics.LatencyBudget) *workflowTypeTracker {
+	mixed := cfg.WorkflowType == config.WorkflowTypeMixed
+	if s != nil {
+		mixed = slices.ContainsFunc(s.Phases, func(p scenario.Phase) bool {
+			return p.WorkflowType == config.WorkflowTypeMixed
+		})
+	}
+	if !mixed {
+		return nil
+	}
+	return &workflowTypeTracker{
+		budget: budget,
+		types:  make(map[string]*workflowTypeStats),
+	}
+}
+
+// record adds a finished workflow of workflowType, with its latency if it
+// completed.
+func (t *workflowTypeTracker) record(workflowType string, duration time.Duration, err error) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats, ok := t.types[workflowType]
+	if !ok {
+		stats = &workflowTypeStats{latencies: metrics.NewLatencyCollector(1000)}
+		stats.latencies.ShareMemoryBudget(t.budget)
+		t.types[workflowType] = stats
+	}
+	if err != nil {
+		stats.failed++
+		return
+	}
+	stats.completed++
+	stats.latencies.AddDuration(duration)
+}
+
+// result returns the workflows and latencies of each type in name order, or
+// nil if none finished.
+func (t *workflowTypeTracker) result() []results.WorkflowTypeLatency {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var latencies []results.WorkflowTypeLatency
+	for _, workflowType := range slices.Sorted(maps.Keys(t.types)) {
+		stats := t.types[workflowType]
+		percentiles := stats.latencies.Percentiles()
+		latencies = append(latencies, results.WorkflowTypeLatency{
+			WorkflowType: workflowType,
+			Completed:    stats.completed,
+			Failed:       stats.failed,
+			Latency: results.ResultLatency{
+				P50:         percentiles.P50,
+				P95:         percentiles.P95,
+				P99:         percentiles.P99,
+				Max:         percentiles.Max,
+				Approximate: percentiles.Approximate,
+			},
+		})
+	}
+	return latencies
+}
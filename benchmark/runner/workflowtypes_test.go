@@ -0,0 +1,43 @@
+package runner
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+)
+
+func TestWorkflowTypeTracker(t *testing.T) {
+	cfg := config.DefaultConfig()
+	require.Nil(t, newWorkflowTypeTracker(cfg, nil, nil), "not mixed")
+	require.NotNil(t, newWorkflowTypeTracker(cfg, &scenario.Scenario{Phases: []scenario.Phase{
+		{WorkflowType: config.WorkflowTypeSimple},
+		{WorkflowType: config.WorkflowTypeMixed},
+	}}, nil), "mixed phase")
+
+	cfg.WorkflowType = config.WorkflowTypeMixed
+	tracker := newWorkflowTypeTracker(cfg, nil, nil)
+	require.Nil(t, tracker.result(), "nothing finished")
+
+	tracker.record(config.WorkflowTypeTimer, 2*time.Second, nil)
+	tracker.record(config.WorkflowTypeSimple, 20*time.Millisecond, nil)
+	tracker.record(config.WorkflowTypeSimple, 40*time.Millisecond, nil)
+	tracker.record(config.WorkflowTypeSimple, time.Minute, errors.New("timed out"))
+
+	result := tracker.result()
+	require.Len(t, result, 2)
+	require.Equal(t, config.WorkflowTypeSimple, result[0].WorkflowType)
+	require.Equal(t, int64(2), result[0].Completed)
+	require.Equal(t, int64(1), result[0].Failed)
+	require.InDelta(t, 40, result[0].Latency.Max, 0.01, "failed workflows have no latency")
+	require.Equal(t, config.WorkflowTypeTimer, result[1].WorkflowType)
+	require.InDelta(t, 2000, result[1].Latency.P50, 0.01)
+
+	var disabled *workflowTypeTracker
+	disabled.record(config.WorkflowTypeSimple, time.Millisecond, nil)
+	require.Nil(t, disabled.result())
+}
@@ -0,0 +1,60 @@
+// Package scaling adjusts the external worker fleet on a schedule during a
+// benchmark run, to measure how quickly added workers absorb load.
+package scaling
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+)
+
+// ecsScaler scales an ECS service by updating its desired count.
+type ecsScaler struct {
+	client  *ecs.Client
+	cluster string
+	service string
+}
+
+// NewECSScaler creates a Scaler for an ECS service using the default AWS
+// credential chain (the task role when running on ECS).
+func NewECSScaler(ctx context.Context, cluster, service string) (Scaler, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	return &ecsScaler{
+		client:  ecs.NewFromConfig(cfg),
+		cluster: cluster,
+		service: service,
+	}, nil
+}
+
+func (s *ecsScaler) Counts(ctx context.Context) (int, int, error) {
+	out, err := s.client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(s.cluster),
+		Services: []string{s.service},
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to describe service %s: %w", s.service, err)
+	}
+	if len(out.Services) == 0 {
+		return 0, 0, fmt.Errorf("service %s not found in cluster %s", s.service, s.cluster)
+	}
+	svc := out.Services[0]
+	return int(svc.DesiredCount), int(svc.RunningCount), nil
+}
+
+func (s *ecsScaler) SetDesiredCount(ctx context.Context, count int) error {
+	_, err := s.client.UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:      aws.String(s.cluster),
+		Service:      aws.String(s.service),
+		DesiredCount: aws.Int32(int32(count)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update service %s: %w", s.service, err)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Package scaling adjusts the external worker fleet on a schedule during a
+// benchmark run, to measure how quickly added workers absorb load.
+package scaling
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/generator"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+const (
+	// DefaultPollInterval is how often running worker counts are checked after a step
+	DefaultPollInterval = 5 * time.Second
+
+	// restoreTimeout bounds restoring the original worker count after the run
+	restoreTimeout = 30 * time.Second
+)
+
+// Step sets the worker fleet to Count workers at Offset from the start of the run.
+type Step struct {
+	Offset time.Duration
+	Count  int
+}
+
+// ParseSchedule parses a comma-separated schedule of "<offset>=<count>" steps,
+// e.g. "0s=2,5m=4,10m=8". Offsets must be strictly increasing.
+func ParseSchedule(spec string) ([]Step, error) {
+	var steps []Step
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		offsetStr, countStr, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid scaling step %q: must be <offset>=<count>", entry)
+		}
+		offset, err := time.ParseDuration(strings.TrimSpace(offsetStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid scaling step %q: %w", entry, err)
+		}
+		count, err := strconv.Atoi(strings.TrimSpace(countStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid scaling step %q: %w", entry, err)
+		}
+		if offset < 0 || count < 0 {
+			return nil, fmt.Errorf("invalid scaling step %q: offset and count must not be negative", entry)
+		}
+		if len(steps) > 0 && offset <= steps[len(steps)-1].Offset {
+			return nil, fmt.Errorf("invalid scaling step %q: offsets must be strictly increasing", entry)
+		}
+		steps = append(steps, Step{Offset: offset, Count: count})
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("scaling schedule must define at least one step")
+	}
+	return steps, nil
+}
+
+// Scaler reads and changes the size of a worker fleet.
+type Scaler interface {
+	// Counts returns the desired and running worker counts
+	Counts(ctx context.Context) (desired, running int, err error)
+
+	// SetDesiredCount requests count workers
+	SetDesiredCount(ctx context.Context, count int) error
+}
+
+// Experiment applies a scaling schedule to a worker fleet during a run.
+type Experiment struct {
+	Steps        []Step
+	Scaler       Scaler
+	PollInterval time.Duration // 0 uses DefaultPollInterval
+}
+
+// Run applies each step at its offset from start until the schedule is done
+// or ctx is cancelled, then restores the fleet's original desired count. After
+// each step it polls until the running count reaches the new desired count,
+// recording how long that took. It returns one event per applied step.
+func (e *Experiment) Run(ctx context.Context, start time.Time) []results.ScalingEvent {
+	pollInterval := e.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	original, _, err := e.Scaler.Counts(ctx)
+	if err != nil {
+		slog.Warn("Failed to read worker count; skipping scaling experiment", "error", err)
+		return nil
+	}
+	defer e.restore(ctx, original)
+
+	var events []results.ScalingEvent
+	current := original
+	for i, step := range e.Steps {
+		if !generator.SleepUntil(ctx, start.Add(step.Offset)) {
+			break
+		}
+
+		event := results.ScalingEvent{
+			Time:      time.Now(),
+			Offset:    step.Offset.String(),
+			FromCount: current,
+			ToCount:   step.Count,
+		}
+		slog.Info("Scaling workers", "offset", step.Offset, "from", current, "to", step.Count)
+		if err := e.Scaler.SetDesiredCount(ctx, step.Count); err != nil {
+			slog.Warn("Failed to scale workers", "to", step.Count, "error", err)
+			event.Error = err.Error()
+			events = append(events, event)
+			continue
+		}
+		current = step.Count
+
+		// Wait for the fleet to converge, but no longer than the next step
+		deadline := time.Time{}
+		if i+1 < len(e.Steps) {
+			deadline = start.Add(e.Steps[i+1].Offset)
+		}
+		if readyAfter, ok := e.waitForRunning(ctx, step.Count, deadline, pollInterval); ok {
+			event.ReadyAfter = readyAfter.Round(time.Second).String()
+			slog.Info("Workers scaled", "count", step.Count, "ready_after", readyAfter)
+		}
+		events = append(events, event)
+	}
+
+	// Hold the final step until the run ends
+	<-ctx.Done()
+	return events
+}
+
+// waitForRunning polls until count workers are running, deadline passes (zero
+// means no deadline) or ctx is done. It reports the time taken if count was reached.
+func (e *Experiment) waitForRunning(ctx context.Context, count int, deadline time.Time, pollInterval time.Duration) (time.Duration, bool) {
+	begin := time.Now()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, running, err := e.Scaler.Counts(ctx); err == nil && running == count {
+			return time.Since(begin), true
+		}
+		select {
+		case <-ctx.Done():
+			return 0, false
+		case now := <-ticker.C:
+			if !deadline.IsZero() && !now.Before(deadline) {
+				return 0, false
+			}
+		}
+	}
+}
+
+// restore returns the fleet to its original desired count.
+func (e *Experiment) restore(ctx context.Context, count int) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), restoreTimeout)
+	defer cancel()
+	if err := e.Scaler.SetDesiredCount(ctx, count); err != nil {
+		slog.Warn("Failed to restore worker count", "count", count, "error", err)
+		return
+	}
+	slog.Info("Restored worker count", "count", count)
+}
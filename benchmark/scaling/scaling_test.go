@@ -0,0 +1,96 @@
+package scaling
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	steps, err := ParseSchedule("0s=2, 5m=4,10m=8")
+	require.NoError(t, err)
+	require.Equal(t, []Step{
+		{Offset: 0, Count: 2},
+		{Offset: 5 * time.Minute, Count: 4},
+		{Offset: 10 * time.Minute, Count: 8},
+	}, steps)
+
+	for _, spec := range []string{
+		"",
+		"5m",
+		"soon=4",
+		"5m=many",
+		"5m=-1",
+		"5m=4,5m=8",
+		"10m=4,5m=8",
+	} {
+		_, err := ParseSchedule(spec)
+		require.Error(t, err, "spec %q", spec)
+	}
+}
+
+// fakeScaler converges to the desired count on the next Counts call.
+type fakeScaler struct {
+	mu      sync.Mutex
+	desired int
+	running int
+	history []int
+}
+
+func (s *fakeScaler) Counts(context.Context) (int, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	running := s.running
+	s.running = s.desired
+	return s.desired, running, nil
+}
+
+func (s *fakeScaler) SetDesiredCount(_ context.Context, count int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.desired = count
+	s.history = append(s.history, count)
+	return nil
+}
+
+func TestExperiment_RunAppliesStepsAndRestores(t *testing.T) {
+	scaler := &fakeScaler{desired: 2, running: 2}
+	exp := &Experiment{
+		Steps:        []Step{{Offset: 0, Count: 4}, {Offset: 50 * time.Millisecond, Count: 8}},
+		Scaler:       scaler,
+		PollInterval: 5 * time.Millisecond,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	events := exp.Run(ctx, time.Now())
+
+	require.Len(t, events, 2)
+	require.Equal(t, 2, events[0].FromCount)
+	require.Equal(t, 4, events[0].ToCount)
+	require.NotEmpty(t, events[0].ReadyAfter)
+	require.Equal(t, 4, events[1].FromCount)
+	require.Equal(t, 8, events[1].ToCount)
+	require.NotEmpty(t, events[1].ReadyAfter)
+
+	// The original count is restored once the run ends
+	require.Equal(t, []int{4, 8, 2}, scaler.history)
+}
+
+func TestExperiment_RunStopsOnCancel(t *testing.T) {
+	scaler := &fakeScaler{desired: 2, running: 2}
+	exp := &Experiment{
+		Steps:  []Step{{Offset: time.Hour, Count: 4}},
+		Scaler: scaler,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	events := exp.Run(ctx, time.Now())
+
+	require.Empty(t, events)
+	require.Equal(t, []int{2}, scaler.history)
+}
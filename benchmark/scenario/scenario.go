@@ -0,0 +1,487 @@
+// Package scenario provides multi-phase benchmark scenarios loaded from a file.
+package scenario
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/dynconfig"
+)
+
+// Duration is a time.Duration that unmarshals from a Go duration string such as "5m".
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"5m\": %w", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalJSON formats the duration as a string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Scenario is a sequence of load phases executed back to back in one namespace.
+// Thresholds holds named threshold profiles (e.g. dev, staging, prod) selected
+// with config.ThresholdProfile, so one scenario can gate differently per environment.
+// A scenario with a Ceiling search has one phase per step of the search
+// instead of phases of its own. ShardChurn restarts history tasks while the
+// phases run.
+type Scenario struct {
+	Name       string                      `json:"name"`
+	Phases     []Phase                     `json:"phases"`
+	Thresholds map[string]ThresholdProfile `json:"thresholds,omitempty"`
+	Ceiling    *Ceiling                    `json:"ceiling,omitempty"`
+	ShardChurn *ShardChurn                 `json:"shardChurn,omitempty"`
+
+	// DynamicConfig overrides Temporal dynamic config keys for the run, each
+	// with a list of constrained values in the dynamic config file's format,
+	// e.g. {"matching.rps": [{"value": 5000}]}. The servers read dynamic
+	// config only from a file in their image, so the overrides are applied
+	// by redeploying them (see package dynconfig)
+	DynamicConfig map[string]json.RawMessage `json:"dynamicConfig,omitempty"`
+}
+
+// MarshalJSON omits the phases a ceiling search expands into, so the
+// scenario parses back to the same search.
+func (s Scenario) MarshalJSON() ([]byte, error) {
+	type plain Scenario
+	p := plain(s)
+	if p.Ceiling != nil {
+		p.Phases = nil
+	}
+	return json.Marshal(p)
+}
+
+// Ceiling search defaults and limits.
+const (
+	DefaultCeilingMaxErrorRate = 0.01
+	DefaultCeilingMaxLatency   = 100 * time.Millisecond
+	MaxCeilingSteps            = 100
+)
+
+// Ceiling searches for the persistence QPS ceiling of each store (history,
+// matching and visibility): it raises the rate of WorkflowType from
+// StartRate by StepRate up to MaxRate, holding each step for StepDuration,
+// and a store's ceiling is the highest QPS it served in a step before one in
+// which its failed requests exceeded MaxErrorRate or its p99 latency
+// exceeded MaxLatency. The search stops once every store has exceeded its
+// limits. Workflow parameters are inherited from the base config.
+type Ceiling struct {
+	WorkflowType string   `json:"workflowType"`
+	StartRate    float64  `json:"startRate"`
+	StepRate     float64  `json:"stepRate"`
+	MaxRate      float64  `json:"maxRate"`
+	StepDuration Duration `json:"stepDuration"`
+	MaxErrorRate float64  `json:"maxErrorRate,omitempty"` // Fraction of a store's requests that may fail (default: 0.01)
+	MaxLatency   Duration `json:"maxLatency,omitempty"`   // p99 persistence latency of a store (default: 100ms)
+}
+
+// ErrorRateLimit returns the failed request fraction a store may reach.
+func (c *Ceiling) ErrorRateLimit() float64 {
+	if c.MaxErrorRate > 0 {
+		return c.MaxErrorRate
+	}
+	return DefaultCeilingMaxErrorRate
+}
+
+// LatencyLimit returns the p99 persistence latency a store may reach.
+func (c *Ceiling) LatencyLimit() time.Duration {
+	if c.MaxLatency > 0 {
+		return time.Duration(c.MaxLatency)
+	}
+	return DefaultCeilingMaxLatency
+}
+
+// validate checks the search's rates and limits; the steps' configs are
+// validated as phases.
+func (c *Ceiling) validate() error {
+	if c.StartRate <= 0 || c.StepRate <= 0 || c.MaxRate < c.StartRate {
+		return fmt.Errorf("ceiling: startRate and stepRate must be positive and maxRate at least startRate")
+	}
+	if steps := c.steps(); steps > MaxCeilingSteps {
+		return fmt.Errorf("ceiling: %d steps exceed the maximum of %d", steps, MaxCeilingSteps)
+	}
+	if c.MaxErrorRate < 0 || c.MaxErrorRate >= 1 {
+		return fmt.Errorf("ceiling: maxErrorRate %.3f out of range [0, 1)", c.MaxErrorRate)
+	}
+	if c.MaxLatency < 0 {
+		return fmt.Errorf("ceiling: maxLatency must not be negative")
+	}
+	return nil
+}
+
+// steps returns the number of steps from StartRate to MaxRate, tolerating
+// rounding in fractional step rates.
+func (c *Ceiling) steps() int {
+	return int((c.MaxRate-c.StartRate)/c.StepRate+1e-9) + 1
+}
+
+// Phases returns one phase per step of the search.
+func (c *Ceiling) Phases() []Phase {
+	phases := make([]Phase, 0, c.steps())
+	for i := range c.steps() {
+		rate := c.StartRate + float64(i)*c.StepRate
+		phases = append(phases, Phase{
+			Name:         fmt.Sprintf("ceiling-%g", rate),
+			WorkflowType: c.WorkflowType,
+			TargetRate:   rate,
+			Duration:     c.StepDuration,
+		})
+	}
+	return phases
+}
+
+// MaxShardChurnRestarts bounds the restarts of a shard churn scenario.
+const MaxShardChurnRestarts = 20
+
+// ShardChurn restarts Tasks history service tasks (default: 1) at each offset
+// in RestartAt from the start of the run, forcing the remaining history hosts
+// to re-acquire the shards they owned, and measures the latency spike and
+// failed requests that follow each restart.
+type ShardChurn struct {
+	RestartAt []Duration `json:"restartAt"`
+	Tasks     int        `json:"tasks,omitempty"`
+}
+
+// TaskCount returns the history tasks stopped per restart.
+func (c *ShardChurn) TaskCount() int {
+	if c.Tasks > 0 {
+		return c.Tasks
+	}
+	return 1
+}
+
+// Offsets returns the restart offsets from the start of the run.
+func (c *ShardChurn) Offsets() []time.Duration {
+	offsets := make([]time.Duration, len(c.RestartAt))
+	for i, d := range c.RestartAt {
+		offsets[i] = time.Duration(d)
+	}
+	return offsets
+}
+
+// validate checks that the restarts fall within the scenario's total duration
+// in increasing order.
+func (c *ShardChurn) validate(total time.Duration) error {
+	if len(c.RestartAt) == 0 || len(c.RestartAt) > MaxShardChurnRestarts {
+		return fmt.Errorf("shardChurn: restartAt must list 1 to %d offsets", MaxShardChurnRestarts)
+	}
+	if c.Tasks < 0 {
+		return fmt.Errorf("shardChurn: tasks must not be negative")
+	}
+	for i, at := range c.RestartAt {
+		if at < 0 || time.Duration(at) >= total {
+			return fmt.Errorf("shardChurn: restart at %s is outside the scenario's %s", time.Duration(at), total)
+		}
+		if i > 0 && at <= c.RestartAt[i-1] {
+			return fmt.Errorf("shardChurn: restartAt offsets must be strictly increasing")
+		}
+	}
+	return nil
+}
+
+// validateDynamicConfig checks that each override names a key and holds a
+// non-empty list of constrained values, each with a value.
+func validateDynamicConfig(overrides map[string]json.RawMessage) error {
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == "" || strings.ContainsAny(key, ": \t\n#") {
+			return fmt.Errorf("dynamicConfig: invalid key %q", key)
+		}
+		var values []map[string]json.RawMessage
+		if err := json.Unmarshal(overrides[key], &values); err != nil || len(values) == 0 {
+			return fmt.Errorf("dynamicConfig: %s must be a list of constrained values, e.g. [{\"value\": 5000}]", key)
+		}
+		for _, v := range values {
+			if _, ok := v["value"]; !ok {
+				return fmt.Errorf("dynamicConfig: every value of %s must set value", key)
+			}
+		}
+	}
+	return nil
+}
+
+// ThresholdProfile overrides the pass/fail thresholds. Unset fields keep the
+// thresholds from the base config.
+type ThresholdProfile struct {
+	MaxP99Latency Duration `json:"maxP99Latency,omitempty"`
+	MinThroughput float64  `json:"minThroughput,omitempty"`
+	Persistence   string   `json:"persistence,omitempty"` // Same syntax as BENCHMARK_PERSISTENCE_THRESHOLDS
+
+	// Percent-of-baseline limits, evaluated when a baseline file is supplied
+	MaxP99Percent        float64 `json:"maxP99Percent,omitempty"`
+	MinThroughputPercent float64 `json:"minThroughputPercent,omitempty"`
+}
+
+// Phase is one step of a scenario. Workflow parameters that are not set
+// (activity count, timer duration, child count and depth, fan-in, heartbeat
+// duration and interval, failure rate, retry policy, search attribute
+// upserts, side effects, long history events, fanout shape and cancellation)
+// are inherited from the base config, as is the workflow mix of a mixed phase.
+type Phase struct {
+	Name          string   `json:"name,omitempty"`
+	WorkflowType  string   `json:"workflowType"`
+	TargetRate    float64  `json:"targetRate"`
+	Duration      Duration `json:"duration"`
+	RampUp        Duration `json:"rampUp,omitempty"`
+	ActivityCount int      `json:"activityCount,omitempty"`
+	TimerDuration Duration `json:"timerDuration,omitempty"`
+	ChildCount    int      `json:"childCount,omitempty"`
+	ChildDepth    int      `json:"childDepth,omitempty"`
+	FanIn         int      `json:"fanIn,omitempty"`
+
+	WorkflowMix map[string]int `json:"workflowMix,omitempty"`
+
+	HeartbeatDuration Duration `json:"heartbeatDuration,omitempty"`
+	HeartbeatInterval Duration `json:"heartbeatInterval,omitempty"`
+
+	FailureRate             float64  `json:"failureRate,omitempty"`
+	RetryMaxAttempts        int      `json:"retryMaxAttempts,omitempty"`
+	RetryInitialInterval    Duration `json:"retryInitialInterval,omitempty"`
+	RetryBackoffCoefficient float64  `json:"retryBackoffCoefficient,omitempty"`
+
+	SearchAttributeUpserts int `json:"searchAttributeUpserts,omitempty"`
+	SideEffects            int `json:"sideEffects,omitempty"`
+	LongHistoryEvents      int `json:"longHistoryEvents,omitempty"`
+
+	FanoutWidth int    `json:"fanoutWidth,omitempty"`
+	FanoutDepth int    `json:"fanoutDepth,omitempty"`
+	FanoutJoin  string `json:"fanoutJoin,omitempty"`
+
+	CancelRate  float64  `json:"cancelRate,omitempty"`
+	CancelAfter Duration `json:"cancelAfter,omitempty"`
+}
+
+// Load reads and validates a scenario file against the base config.
+func Load(path string, base config.BenchmarkConfig) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario file: %w", err)
+	}
+	s, err := Parse(data, base)
+	if err != nil {
+		return nil, fmt.Errorf("scenario file %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Parse decodes and validates a JSON scenario against the base config.
+func Parse(data []byte, base config.BenchmarkConfig) (*Scenario, error) {
+	var s Scenario
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+	if s.Ceiling != nil {
+		if len(s.Phases) > 0 {
+			return nil, fmt.Errorf("invalid scenario: a ceiling search defines its own phases")
+		}
+		if err := s.Ceiling.validate(); err != nil {
+			return nil, fmt.Errorf("invalid scenario: %w", err)
+		}
+		s.Phases = s.Ceiling.Phases()
+	}
+	if err := s.Validate(base); err != nil {
+		return nil, fmt.Errorf("invalid scenario: %w", err)
+	}
+	return &s, nil
+}
+
+// Validate checks that the scenario has phases and that each phase produces a valid config.
+func (s *Scenario) Validate(base config.BenchmarkConfig) error {
+	if len(s.Phases) == 0 {
+		return fmt.Errorf("scenario must define at least one phase")
+	}
+	if s.Ceiling != nil {
+		if err := s.Ceiling.validate(); err != nil {
+			return err
+		}
+	}
+	if s.ShardChurn != nil {
+		if err := s.ShardChurn.validate(s.TotalDuration()); err != nil {
+			return err
+		}
+	}
+	if err := validateDynamicConfig(s.DynamicConfig); err != nil {
+		return err
+	}
+	for name, profile := range s.Thresholds {
+		if profile.MaxP99Latency < 0 || profile.MinThroughput < 0 || profile.MaxP99Percent < 0 || profile.MinThroughputPercent < 0 {
+			return fmt.Errorf("threshold profile %s: thresholds must not be negative", name)
+		}
+		if _, err := config.ParsePersistenceThresholds(profile.Persistence); err != nil {
+			return fmt.Errorf("threshold profile %s: %w", name, err)
+		}
+	}
+	cfg, err := s.ApplyThresholdProfile(base)
+	if err != nil {
+		return err
+	}
+	// Redeploying the services with dynamic config overrides and back takes
+	// up to their deploy timeouts on top of the phases
+	var setup time.Duration
+	if len(s.DynamicConfig) > 0 {
+		setup = 2 * time.Duration(len(base.DynamicConfigServices)) * dynconfig.DeployTimeout
+	}
+	if setup > 0 && cfg.MaxTotalRuntime > 0 && cfg.MaxTotalRuntime <= setup {
+		return fmt.Errorf("BENCHMARK_MAX_TOTAL_RUNTIME (%v) must exceed the dynamic config rollouts (%v: two per service), since the revert is reserved from it", cfg.MaxTotalRuntime, setup)
+	}
+	if err := cfg.ValidateRunLength(cfg.MaxRunLength(s.TotalDuration(), setup)); err != nil {
+		return err
+	}
+	for i, p := range s.Phases {
+		cfg := p.Apply(cfg)
+		if err := cfg.Validate(); err != nil {
+			return fmt.Errorf("phase %d (%s): %w", i+1, s.PhaseName(i), err)
+		}
+	}
+	return nil
+}
+
+// ApplyThresholdProfile returns base with the thresholds of the profile named
+// by base.ThresholdProfile applied. base is returned unchanged if no profile
+// is selected; a selected profile missing from the scenario is an error.
+func (s *Scenario) ApplyThresholdProfile(base config.BenchmarkConfig) (config.BenchmarkConfig, error) {
+	if base.ThresholdProfile == "" {
+		return base, nil
+	}
+	profile, ok := s.Thresholds[base.ThresholdProfile]
+	if !ok {
+		names := make([]string, 0, len(s.Thresholds))
+		for name := range s.Thresholds {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return base, fmt.Errorf("threshold profile %q not defined in scenario (available: %s)", base.ThresholdProfile, strings.Join(names, ", "))
+	}
+
+	cfg := base
+	if profile.MaxP99Latency > 0 {
+		cfg.MaxP99Latency = time.Duration(profile.MaxP99Latency)
+	}
+	if profile.MinThroughput > 0 {
+		cfg.MinThroughput = profile.MinThroughput
+	}
+	if profile.MaxP99Percent > 0 {
+		cfg.BaselineMaxP99Percent = profile.MaxP99Percent
+	}
+	if profile.MinThroughputPercent > 0 {
+		cfg.BaselineMinThroughputPercent = profile.MinThroughputPercent
+	}
+	if profile.Persistence != "" {
+		thresholds, err := config.ParsePersistenceThresholds(profile.Persistence)
+		if err != nil {
+			return base, fmt.Errorf("threshold profile %s: %w", base.ThresholdProfile, err)
+		}
+		cfg.PersistenceThresholds = thresholds
+	}
+	return cfg, nil
+}
+
+// PhaseName returns the phase's name, or a generated one if unset.
+func (s *Scenario) PhaseName(i int) string {
+	if s.Phases[i].Name != "" {
+		return s.Phases[i].Name
+	}
+	return fmt.Sprintf("phase-%d", i+1)
+}
+
+// TotalDuration returns the combined duration of all phases.
+func (s *Scenario) TotalDuration() time.Duration {
+	var total time.Duration
+	for _, p := range s.Phases {
+		total += time.Duration(p.Duration)
+	}
+	return total
+}
+
+// Apply returns the base config with the phase's load and workflow settings applied.
+func (p Phase) Apply(base config.BenchmarkConfig) config.BenchmarkConfig {
+	cfg := base
+	cfg.WorkflowType = p.WorkflowType
+	if p.WorkflowType != config.WorkflowTypeMixed {
+		cfg.WorkflowMix = nil
+	} else if len(p.WorkflowMix) > 0 {
+		cfg.WorkflowMix = p.WorkflowMix
+	}
+	cfg.TargetRate = p.TargetRate
+	cfg.Duration = time.Duration(p.Duration)
+	cfg.RampUpDuration = time.Duration(p.RampUp) // Phases step between rates unless they ramp explicitly
+
+	if p.ActivityCount > 0 {
+		cfg.ActivityCount = p.ActivityCount
+	}
+	if p.TimerDuration > 0 {
+		cfg.TimerDuration = time.Duration(p.TimerDuration)
+	}
+	if p.ChildCount > 0 {
+		cfg.ChildCount = p.ChildCount
+	}
+	if p.ChildDepth > 0 {
+		cfg.ChildDepth = p.ChildDepth
+	}
+	if p.FanIn > 0 {
+		cfg.FanIn = p.FanIn
+	}
+	if p.HeartbeatDuration > 0 {
+		cfg.HeartbeatDuration = time.Duration(p.HeartbeatDuration)
+	}
+	if p.HeartbeatInterval > 0 {
+		cfg.HeartbeatInterval = time.Duration(p.HeartbeatInterval)
+	}
+	if p.FailureRate > 0 {
+		cfg.FailureRate = p.FailureRate
+	}
+	if p.RetryMaxAttempts > 0 {
+		cfg.RetryMaxAttempts = p.RetryMaxAttempts
+	}
+	if p.RetryInitialInterval > 0 {
+		cfg.RetryInitialInterval = time.Duration(p.RetryInitialInterval)
+	}
+	if p.RetryBackoffCoefficient > 0 {
+		cfg.RetryBackoffCoefficient = p.RetryBackoffCoefficient
+	}
+	if p.SearchAttributeUpserts > 0 {
+		cfg.SearchAttributeUpserts = p.SearchAttributeUpserts
+	}
+	if p.SideEffects > 0 {
+		cfg.SideEffects = p.SideEffects
+	}
+	if p.LongHistoryEvents > 0 {
+		cfg.LongHistoryEvents = p.LongHistoryEvents
+	}
+	if p.FanoutWidth > 0 {
+		cfg.FanoutWidth = p.FanoutWidth
+	}
+	if p.FanoutDepth > 0 {
+		cfg.FanoutDepth = p.FanoutDepth
+	}
+	if p.FanoutJoin != "" {
+		cfg.FanoutJoin = p.FanoutJoin
+	}
+	if p.CancelRate > 0 {
+		cfg.CancelRate = p.CancelRate
+	}
+	if p.CancelAfter > 0 {
+		cfg.CancelAfter = time.Duration(p.CancelAfter)
+	}
+	return cfg
+}
@@ -0,0 +1,229 @@
+package scenario
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+func writeScenario(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "scenario.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoad_Phases(t *testing.T) {
+	path := writeScenario(t, `{
+		"name": "rate-steps",
+		"phases": [
+			{"name": "warm", "workflowType": "simple", "targetRate": 100, "duration": "5m"},
+			{"workflowType": "multi-activity", "targetRate": 50, "duration": "10m", "activityCount": 8}
+		]
+	}`)
+
+	s, err := Load(path, config.DefaultConfig())
+	require.NoError(t, err)
+	require.Equal(t, "rate-steps", s.Name)
+	require.Len(t, s.Phases, 2)
+	require.Equal(t, "warm", s.PhaseName(0))
+	require.Equal(t, "phase-2", s.PhaseName(1))
+	require.Equal(t, 15*time.Minute, s.TotalDuration())
+}
+
+func TestLoad_InvalidPhase(t *testing.T) {
+	path := writeScenario(t, `{"phases": [{"workflowType": "simple", "targetRate": 5000, "duration": "5m"}]}`)
+	_, err := Load(path, config.DefaultConfig())
+	require.ErrorContains(t, err, "phase 1")
+
+	path = writeScenario(t, `{"phases": []}`)
+	_, err = Load(path, config.DefaultConfig())
+	require.Error(t, err)
+
+	path = writeScenario(t, `{"phases": [{"workflowType": "simple", "targetRate": 10, "duration": 300}]}`)
+	_, err = Load(path, config.DefaultConfig())
+	require.Error(t, err)
+}
+
+func TestLoad_DynamicConfig(t *testing.T) {
+	const phases = `"phases": [{"workflowType": "simple", "targetRate": 10, "duration": "5m"}]`
+	path := writeScenario(t, `{`+phases+`, "dynamicConfig": {"matching.rps": [{"value": 5000}], "history.persistenceMaxQPS": [{"value": 20000, "constraints": {}}]}}`)
+	sc, err := Load(path, config.DefaultConfig())
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"value": 5000}]`, string(sc.DynamicConfig["matching.rps"]))
+
+	for overrides, want := range map[string]string{
+		`{"matching.rps": 5000}`:                  "matching.rps must be a list of constrained values",
+		`{"matching.rps": []}`:                    "matching.rps must be a list of constrained values",
+		`{"matching.rps": [{"constraints": {}}]}`: "every value of matching.rps must set value",
+		`{"matching rps": [{"value": 1}]}`:        `invalid key "matching rps"`,
+	} {
+		_, err := Load(writeScenario(t, `{`+phases+`, "dynamicConfig": `+overrides+`}`), config.DefaultConfig())
+		require.ErrorContains(t, err, want, overrides)
+	}
+}
+
+func TestLoad_RunLength(t *testing.T) {
+	base := config.DefaultConfig()
+	base.RunRegistry = true
+	base.RunLockTTL = 2 * time.Hour
+
+	// 2 × 30m phases + 30m drain + 15m cleanup
+	path := writeScenario(t, `{"phases": [{"workflowType": "simple", "targetRate": 10, "duration": "30m"}, {"workflowType": "simple", "targetRate": 20, "duration": "30m"}]}`)
+	_, err := Load(path, base)
+	require.NoError(t, err)
+
+	// Redeploying four services with dynamic config and back adds up to 2h
+	base.DynamicConfigCluster = "benchmark"
+	base.DynamicConfigServices = []string{"frontend", "history", "matching", "worker"}
+	path = writeScenario(t, `{"phases": [{"workflowType": "simple", "targetRate": 10, "duration": "30m"}], "dynamicConfig": {"matching.rps": [{"value": 5000}]}}`)
+	_, err = Load(path, base)
+	require.ErrorContains(t, err, "must cover the longest run (3h15m0s")
+
+	// The revert is reserved from the total runtime, which must leave time for the run
+	base.RunLockTTL = 3 * time.Hour
+	base.MaxTotalRuntime = 2 * time.Hour
+	_, err = Load(path, base)
+	require.ErrorContains(t, err, "BENCHMARK_MAX_TOTAL_RUNTIME (2h0m0s) must exceed the dynamic config rollouts (2h0m0s")
+	base.MaxTotalRuntime = 3 * time.Hour
+	_, err = Load(path, base)
+	require.NoError(t, err)
+}
+
+func TestPhase_ApplyInheritsWorkflowParameters(t *testing.T) {
+	base := config.DefaultConfig()
+	base.ActivityCount = 7
+
+	cfg := Phase{
+		WorkflowType: config.WorkflowTypeMultiActivity,
+		TargetRate:   25,
+		Duration:     Duration(2 * time.Minute),
+	}.Apply(base)
+
+	require.Equal(t, config.WorkflowTypeMultiActivity, cfg.WorkflowType)
+	require.Equal(t, 25.0, cfg.TargetRate)
+	require.Equal(t, 2*time.Minute, cfg.Duration)
+	require.Equal(t, time.Duration(0), cfg.RampUpDuration)
+	require.Equal(t, 7, cfg.ActivityCount)
+}
+
+func TestPhase_ApplyWorkflowMix(t *testing.T) {
+	base := config.DefaultConfig()
+	base.WorkflowType = config.WorkflowTypeMixed
+	base.WorkflowMix = map[string]int{config.WorkflowTypeSimple: 3, config.WorkflowTypeTimer: 1}
+
+	cfg := Phase{WorkflowType: config.WorkflowTypeMixed, TargetRate: 10, Duration: Duration(time.Minute)}.Apply(base)
+	require.Equal(t, base.WorkflowMix, cfg.WorkflowMix, "inherited")
+	require.NoError(t, cfg.Validate())
+
+	mix := map[string]int{config.WorkflowTypeFanout: 1}
+	cfg = Phase{WorkflowType: config.WorkflowTypeMixed, WorkflowMix: mix, TargetRate: 10, Duration: Duration(time.Minute)}.Apply(base)
+	require.Equal(t, mix, cfg.WorkflowMix)
+
+	cfg = Phase{WorkflowType: config.WorkflowTypeSimple, TargetRate: 10, Duration: Duration(time.Minute)}.Apply(base)
+	require.Nil(t, cfg.WorkflowMix, "only mixed phases start a mix")
+	require.NoError(t, cfg.Validate())
+}
+
+func TestThresholdProfiles(t *testing.T) {
+	path := writeScenario(t, `{
+		"phases": [{"workflowType": "simple", "targetRate": 100, "duration": "5m"}],
+		"thresholds": {
+			"dev": {"minThroughput": 10},
+			"prod": {"maxP99Latency": "2s", "minThroughput": 90}
+		}
+	}`)
+
+	base := config.DefaultConfig()
+	base.ScenarioFile = path
+	s, err := Load(path, base)
+	require.NoError(t, err)
+
+	// No profile selected keeps the base thresholds
+	cfg, err := s.ApplyThresholdProfile(base)
+	require.NoError(t, err)
+	require.Equal(t, base.MaxP99Latency, cfg.MaxP99Latency)
+
+	base.ThresholdProfile = "prod"
+	cfg, err = s.ApplyThresholdProfile(base)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Second, cfg.MaxP99Latency)
+	require.Equal(t, 90.0, cfg.MinThroughput)
+
+	base.ThresholdProfile = "dev"
+	cfg, err = s.ApplyThresholdProfile(base)
+	require.NoError(t, err)
+	require.Equal(t, base.MaxP99Latency, cfg.MaxP99Latency)
+	require.Equal(t, 10.0, cfg.MinThroughput)
+
+	// An unknown profile fails at load time
+	base.ThresholdProfile = "staging"
+	_, err = Load(path, base)
+	require.ErrorContains(t, err, "available: dev, prod")
+}
+
+func TestLoad_Ceiling(t *testing.T) {
+	path := writeScenario(t, `{
+		"name": "dsql-ceiling",
+		"ceiling": {"workflowType": "simple", "startRate": 50, "stepRate": 25, "maxRate": 110, "stepDuration": "2m", "maxLatency": "50ms"}
+	}`)
+
+	s, err := Load(path, config.DefaultConfig())
+	require.NoError(t, err)
+	require.Len(t, s.Phases, 3)
+	require.Equal(t, "ceiling-50", s.PhaseName(0))
+	require.Equal(t, 100.0, s.Phases[2].TargetRate)
+	require.Equal(t, 6*time.Minute, s.TotalDuration())
+	require.Equal(t, DefaultCeilingMaxErrorRate, s.Ceiling.ErrorRateLimit())
+	require.Equal(t, 50*time.Millisecond, s.Ceiling.LatencyLimit())
+
+	// The expanded phases are not serialized, so the scenario parses back
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	parsed, err := Parse(data, config.DefaultConfig())
+	require.NoError(t, err)
+	require.Equal(t, s.Phases, parsed.Phases)
+
+	path = writeScenario(t, `{"ceiling": {"workflowType": "simple", "startRate": 50, "stepRate": 25, "maxRate": 100, "stepDuration": "2m"},
+		"phases": [{"workflowType": "simple", "targetRate": 10, "duration": "5m"}]}`)
+	_, err = Load(path, config.DefaultConfig())
+	require.ErrorContains(t, err, "defines its own phases")
+
+	path = writeScenario(t, `{"ceiling": {"workflowType": "simple", "startRate": 1, "stepRate": 1, "maxRate": 1000, "stepDuration": "2m"}}`)
+	_, err = Load(path, config.DefaultConfig())
+	require.ErrorContains(t, err, "1000 steps exceed the maximum of 100")
+
+	// Steps are validated as phases
+	path = writeScenario(t, `{"ceiling": {"workflowType": "simple", "startRate": 500, "stepRate": 500, "maxRate": 1500, "stepDuration": "2m"}}`)
+	_, err = Load(path, config.DefaultConfig())
+	require.ErrorContains(t, err, "phase 3 (ceiling-1500)")
+}
+
+func TestLoad_ShardChurn(t *testing.T) {
+	path := writeScenario(t, `{
+		"name": "shard-churn",
+		"phases": [{"workflowType": "simple", "targetRate": 50, "duration": "10m"}],
+		"shardChurn": {"restartAt": ["3m", "7m"]}
+	}`)
+	s, err := Load(path, config.DefaultConfig())
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{3 * time.Minute, 7 * time.Minute}, s.ShardChurn.Offsets())
+	require.Equal(t, 1, s.ShardChurn.TaskCount())
+
+	for churn, want := range map[string]string{
+		`{"restartAt": []}`:                  "1 to 20 offsets",
+		`{"restartAt": ["10m"]}`:             "outside the scenario's 10m0s",
+		`{"restartAt": ["5m", "3m"]}`:        "strictly increasing",
+		`{"restartAt": ["5m"], "tasks": -1}`: "must not be negative",
+	} {
+		path := writeScenario(t, `{"phases": [{"workflowType": "simple", "targetRate": 50, "duration": "10m"}], "shardChurn": `+churn+`}`)
+		_, err := Load(path, config.DefaultConfig())
+		require.ErrorContains(t, err, want, churn)
+	}
+}
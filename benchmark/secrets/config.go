@@ -0,0 +1,78 @@
+package secrets
+
+import (
+	"context"
+	"strings"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+// ResolveConfig replaces every secret reference in cfg with the value it
+// references: the API key, OAuth client secret, admin token, Grafana token and
+// result sink URLs directly, and the TLS certificate, key and CA file settings
+// by the PEM they reference, which is kept in memory (TLSCertPEM, TLSKeyPEM
+// and TLSCAPEM) and never written to disk. It does nothing
+// (and needs no AWS credentials) when cfg holds no references.
+func ResolveConfig(ctx context.Context, cfg *config.BenchmarkConfig) error {
+	if !hasReferences(cfg) {
+		return nil
+	}
+	r, err := NewResolver(ctx)
+	if err != nil {
+		return err
+	}
+	return r.resolveConfig(ctx, cfg)
+}
+
+func hasReferences(cfg *config.BenchmarkConfig) bool {
+	for _, v := range []string{cfg.APIKey, cfg.OAuthClientSecret, cfg.AdminToken, cfg.GrafanaToken, cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSCAFile} {
+		if IsReference(v) {
+			return true
+		}
+	}
+	for _, entry := range strings.Split(cfg.ResultSinks, ",") {
+		if IsReference(strings.TrimSpace(entry)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Resolver) resolveConfig(ctx context.Context, cfg *config.BenchmarkConfig) error {
+	for _, v := range []*string{&cfg.APIKey, &cfg.OAuthClientSecret, &cfg.AdminToken, &cfg.GrafanaToken} {
+		value, err := r.Resolve(ctx, *v)
+		if err != nil {
+			return err
+		}
+		*v = value
+	}
+
+	entries := strings.Split(cfg.ResultSinks, ",")
+	for i, entry := range entries {
+		value, err := r.Resolve(ctx, strings.TrimSpace(entry))
+		if err != nil {
+			return err
+		}
+		entries[i] = value
+	}
+	cfg.ResultSinks = strings.Join(entries, ",")
+
+	for _, tls := range []struct {
+		path *string
+		pem  *[]byte
+	}{
+		{&cfg.TLSCertFile, &cfg.TLSCertPEM},
+		{&cfg.TLSKeyFile, &cfg.TLSKeyPEM},
+		{&cfg.TLSCAFile, &cfg.TLSCAPEM},
+	} {
+		if !IsReference(*tls.path) {
+			continue
+		}
+		pem, err := r.Resolve(ctx, *tls.path)
+		if err != nil {
+			return err
+		}
+		*tls.path, *tls.pem = "", []byte(pem)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Package secrets resolves configuration values that reference AWS Secrets
+// Manager secrets or SSM parameters by ARN, so ECS task definitions carry
+// references instead of raw secrets.
+//
+// A reference is a secret or parameter ARN, e.g.
+//
+//	arn:aws:secretsmanager:us-east-1:123456789012:secret:benchmark-api-key-AbCdEf
+//	arn:aws:ssm:us-east-1:123456789012:parameter/benchmark/api-key
+//
+// A secret ARN may end in #<key> to select one field of a JSON secret.
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+// requestTimeout bounds a single lookup.
+const requestTimeout = 10 * time.Second
+
+// Services a reference can point at.
+const (
+	serviceSecretsManager = "secretsmanager"
+	serviceSSM            = "ssm"
+)
+
+// reference is a parsed secret or parameter ARN.
+type reference struct {
+	arn       string
+	partition string
+	service   string
+	region    string
+	jsonKey   string // Field of a JSON secret to return (empty = the whole value)
+}
+
+// IsReference reports whether v is a Secrets Manager or SSM parameter ARN.
+func IsReference(v string) bool {
+	_, ok := parseReference(v)
+	return ok
+}
+
+func parseReference(v string) (reference, bool) {
+	parts := strings.SplitN(v, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[3] == "" {
+		return reference{}, false
+	}
+	ref := reference{arn: v, partition: parts[1], service: parts[2], region: parts[3]}
+	switch {
+	case ref.service == serviceSecretsManager && strings.HasPrefix(parts[5], "secret:"):
+		if arn, key, ok := strings.Cut(v, "#"); ok {
+			ref.arn, ref.jsonKey = arn, key
+		}
+	case ref.service == serviceSSM && strings.HasPrefix(parts[5], "parameter/"):
+	default:
+		return reference{}, false
+	}
+	return ref, true
+}
+
+// Resolver fetches referenced values, caching each secret or parameter so
+// settings sharing one (e.g. several keys of a JSON secret) fetch it once.
+type Resolver struct {
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	httpClient  *http.Client
+	endpoint    func(ref reference) string
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// NewResolver creates a resolver using the default AWS credential chain (the
+// task role when running on ECS).
+func NewResolver(ctx context.Context) (*Resolver, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	return newResolver(cfg.Credentials, endpoint), nil
+}
+
+func newResolver(credentials aws.CredentialsProvider, endpoint func(reference) string) *Resolver {
+	return &Resolver{
+		credentials: credentials,
+		signer:      v4.NewSigner(),
+		httpClient:  &http.Client{Timeout: requestTimeout},
+		endpoint:    endpoint,
+		cache:       map[string]string{},
+	}
+}
+
+// endpoint returns the regional endpoint of the reference's service.
+func endpoint(ref reference) string {
+	domain := "amazonaws.com"
+	if ref.partition == "aws-cn" {
+		domain = "amazonaws.com.cn"
+	}
+	return fmt.Sprintf("https://%s.%s.%s/", ref.service, ref.region, domain)
+}
+
+// Resolve returns the value v references, or v itself if it is not a reference.
+func (r *Resolver) Resolve(ctx context.Context, v string) (string, error) {
+	ref, ok := parseReference(v)
+	if !ok {
+		return v, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	value, ok := r.cache[ref.arn]
+	if !ok {
+		var err error
+		if ref.service == serviceSecretsManager {
+			value, err = r.secretValue(ctx, ref)
+		} else {
+			value, err = r.parameterValue(ctx, ref)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve %s: %w", ref.arn, err)
+		}
+		r.cache[ref.arn] = value
+	}
+	if ref.jsonKey == "" {
+		return value, nil
+	}
+	field, err := jsonField(value, ref.jsonKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", v, err)
+	}
+	return field, nil
+}
+
+// secretValue reads a secret with Secrets Manager GetSecretValue.
+func (r *Resolver) secretValue(ctx context.Context, ref reference) (string, error) {
+	var resp struct {
+		SecretString string `json:"SecretString"`
+		SecretBinary []byte `json:"SecretBinary"`
+	}
+	if err := r.call(ctx, ref, "secretsmanager.GetSecretValue", map[string]any{"SecretId": ref.arn}, &resp); err != nil {
+		return "", err
+	}
+	if resp.SecretString == "" {
+		return string(resp.SecretBinary), nil
+	}
+	return resp.SecretString, nil
+}
+
+// jsonField returns one field of a JSON object secret.
+func jsonField(secret, key string) (string, error) {
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(secret), &fields); err != nil {
+		return "", fmt.Errorf("secret is not a JSON object: %w", err)
+	}
+	field, ok := fields[key]
+	if !ok {
+		return "", fmt.Errorf("secret has no key %q", key)
+	}
+	if s, ok := field.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(field), nil
+}
+
+// parameterValue reads a parameter, decrypting SecureStrings, with SSM GetParameter.
+func (r *Resolver) parameterValue(ctx context.Context, ref reference) (string, error) {
+	var resp struct {
+		Parameter struct {
+			Value string `json:"Value"`
+		} `json:"Parameter"`
+	}
+	if err := r.call(ctx, ref, "AmazonSSM.GetParameter", map[string]any{"Name": ref.arn, "WithDecryption": true}, &resp); err != nil {
+		return "", err
+	}
+	return resp.Parameter.Value, nil
+}
+
+// call makes a signed AWS JSON 1.1 request for target and decodes the response into out.
+func (r *Resolver) call(ctx context.Context, ref reference, target string, in, out any) error {
+	body, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to serialize request: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint(ref), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", target)
+
+	creds, err := r.credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+	hash := sha256.Sum256(body)
+	if err := r.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), ref.service, ref.region, time.Now()); err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &apiErr)
+		return fmt.Errorf("%s returned %s: %s %s", target, resp.Status, apiErr.Type, apiErr.Message)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+)
+
+const (
+	testSecretARN    = "arn:aws:secretsmanager:us-east-1:123456789012:secret:benchmark-AbCdEf"
+	testParameterARN = "arn:aws:ssm:us-east-1:123456789012:parameter/benchmark/webhook"
+)
+
+// fakeAWS serves GetSecretValue and GetParameter from fixed values, counting calls.
+func fakeAWS(t *testing.T, values map[string]string, calls *int) *Resolver {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		*calls++
+		if !strings.HasPrefix(req.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var in struct{ SecretId, Name string }
+		_ = json.NewDecoder(req.Body).Decode(&in)
+		switch req.Header.Get("X-Amz-Target") {
+		case "secretsmanager.GetSecretValue":
+			value, ok := values[in.SecretId]
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"__type":"ResourceNotFoundException","message":"Secrets Manager can't find the specified secret."}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"SecretString": value})
+		case "AmazonSSM.GetParameter":
+			_ = json.NewEncoder(w).Encode(map[string]any{"Parameter": map[string]any{"Value": values[in.Name]}})
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	creds := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "secret"}, nil
+	})
+	return newResolver(creds, func(reference) string { return srv.URL })
+}
+
+func TestIsReference(t *testing.T) {
+	require.True(t, IsReference(testSecretARN))
+	require.True(t, IsReference(testSecretARN+"#apiKey"))
+	require.True(t, IsReference(testParameterARN))
+	require.False(t, IsReference("plain-api-key"))
+	require.False(t, IsReference("https://hooks.example.com/benchmark"))
+	require.False(t, IsReference("arn:aws:s3:::bucket/key"))
+}
+
+func TestResolve(t *testing.T) {
+	calls := 0
+	r := fakeAWS(t, map[string]string{
+		testSecretARN:    `{"apiKey":"key-123","port":7233}`,
+		testParameterARN: "https://hooks.example.com/benchmark",
+	}, &calls)
+	ctx := context.Background()
+
+	v, err := r.Resolve(ctx, testSecretARN+"#apiKey")
+	require.NoError(t, err)
+	require.Equal(t, "key-123", v)
+
+	v, err = r.Resolve(ctx, testSecretARN+"#port")
+	require.NoError(t, err)
+	require.Equal(t, "7233", v)
+
+	v, err = r.Resolve(ctx, testParameterARN)
+	require.NoError(t, err)
+	require.Equal(t, "https://hooks.example.com/benchmark", v)
+
+	v, err = r.Resolve(ctx, "plain")
+	require.NoError(t, err)
+	require.Equal(t, "plain", v)
+
+	// Each secret is fetched once, however many of its keys are used
+	_, err = r.Resolve(ctx, testParameterARN)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	_, err = r.Resolve(ctx, testSecretARN+"#missing")
+	require.ErrorContains(t, err, `no key "missing"`)
+
+	_, err = r.Resolve(ctx, "arn:aws:secretsmanager:us-east-1:123456789012:secret:other-AbCdEf")
+	require.ErrorContains(t, err, "ResourceNotFoundException")
+}
+
+func TestResolveConfig(t *testing.T) {
+	calls := 0
+	r := fakeAWS(t, map[string]string{
+		testSecretARN:    `{"apiKey":"key-123","ca":"-----BEGIN CERTIFICATE-----"}`,
+		testParameterARN: "https://hooks.example.com/benchmark",
+	}, &calls)
+
+	cfg := config.DefaultConfig()
+	cfg.APIKey = testSecretARN + "#apiKey"
+	cfg.TLSCAFile = testSecretARN + "#ca"
+	cfg.ResultSinks = "stdout, " + testParameterARN
+	require.True(t, hasReferences(&cfg))
+	require.NoError(t, r.resolveConfig(context.Background(), &cfg))
+
+	require.Equal(t, "key-123", cfg.APIKey)
+	require.Equal(t, "stdout,https://hooks.example.com/benchmark", cfg.ResultSinks)
+	require.Empty(t, cfg.TLSCAFile)
+	require.Equal(t, "-----BEGIN CERTIFICATE-----", string(cfg.TLSCAPEM))
+
+	require.False(t, hasReferences(&cfg))
+}
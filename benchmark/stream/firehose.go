@@ -0,0 +1,297 @@
+// Package stream delivers one record per finished workflow to Amazon Data
+// Firehose while a benchmark runs, so long runs can be analyzed in Athena or
+// Redshift before they end.
+//
+// Records are newline-terminated JSON objects, sent in PutRecordBatch calls
+// from a background goroutine. Sending never blocks workflow generation: when
+// the buffer is full, records are dropped and counted.
+package stream
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+// Workflow outcomes of a record.
+const (
+	OutcomeCompleted      = "completed"
+	OutcomeFailed         = "failed"
+	OutcomeAlreadyStarted = "already_started"
+	OutcomeCanceled       = "canceled"
+)
+
+// PutRecordBatch limits and client tuning.
+const (
+	maxBatchRecords = 500
+	maxBatchBytes   = 4 << 20
+	bufferSize      = 50_000
+	flushInterval   = time.Second
+	requestTimeout  = 10 * time.Second
+	maxAttempts     = 3
+)
+
+// WorkflowRecord is the streamed record of one finished workflow (or failed start).
+type WorkflowRecord struct {
+	Namespace    string    `json:"namespace"`
+	WorkflowType string    `json:"workflowType"`
+	WorkflowID   string    `json:"workflowId"`
+	RunID        string    `json:"runId,omitempty"`
+	Outcome      string    `json:"outcome"`
+	Error        string    `json:"error,omitempty"`
+	SubmitTime   time.Time `json:"submitTime"`
+	CloseTime    time.Time `json:"closeTime"`
+	LatencyMs    float64   `json:"latencyMs"`
+}
+
+// Firehose streams workflow records to a Firehose delivery stream.
+type Firehose struct {
+	stream      string
+	region      string
+	endpoint    string
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	httpClient  *http.Client
+
+	records chan []byte
+	stop    chan struct{}
+	done    chan struct{}
+	once    sync.Once
+
+	sent    atomic.Int64
+	failed  atomic.Int64
+	dropped atomic.Int64
+}
+
+// NewFirehose streams to the delivery stream named by stream, either a name
+// (in the region of the default AWS configuration) or a delivery stream ARN.
+// Credentials come from the default AWS credential chain (the task role when
+// running on ECS).
+func NewFirehose(ctx context.Context, stream string) (*Firehose, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	name, region := stream, cfg.Region
+	partition := "aws"
+	if parts := strings.SplitN(stream, ":", 6); len(parts) == 6 && parts[0] == "arn" {
+		partition, region = parts[1], parts[3]
+		name = strings.TrimPrefix(parts[5], "deliverystream/")
+	}
+	if region == "" {
+		return nil, fmt.Errorf("no AWS region for Firehose stream %q: use a delivery stream ARN or set AWS_REGION", stream)
+	}
+	domain := "amazonaws.com"
+	if partition == "aws-cn" {
+		domain = "amazonaws.com.cn"
+	}
+	return newFirehose(cfg.Credentials, name, region, fmt.Sprintf("https://firehose.%s.%s/", region, domain)), nil
+}
+
+func newFirehose(credentials aws.CredentialsProvider, stream, region, endpoint string) *Firehose {
+	f := &Firehose{
+		stream:      stream,
+		region:      region,
+		endpoint:    endpoint,
+		credentials: credentials,
+		signer:      v4.NewSigner(),
+		httpClient:  &http.Client{Timeout: requestTimeout},
+		records:     make(chan []byte, bufferSize),
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+	go f.run()
+	return f
+}
+
+// Send queues rec for delivery without blocking. It is a no-op on a nil
+// Firehose and after Close.
+func (f *Firehose) Send(rec WorkflowRecord) {
+	if f == nil {
+		return
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		f.dropped.Add(1)
+		return
+	}
+	select {
+	case <-f.stop:
+		f.dropped.Add(1)
+		return
+	default:
+	}
+	select {
+	case f.records <- append(data, '\n'):
+	default:
+		f.dropped.Add(1)
+	}
+}
+
+// Close delivers the queued records, waiting until ctx is done at the latest,
+// and logs how many records were sent, failed and dropped.
+func (f *Firehose) Close(ctx context.Context) error {
+	f.once.Do(func() { close(f.stop) })
+	select {
+	case <-f.done:
+	case <-ctx.Done():
+		return fmt.Errorf("firehose stream %s: %w", f.stream, ctx.Err())
+	}
+	slog.Info("Firehose stream closed", "stream", f.stream,
+		"sent", f.sent.Load(), "failed", f.failed.Load(), "dropped", f.dropped.Load())
+	return nil
+}
+
+// run batches queued records, flushing every flushInterval or when a batch
+// is full, until Close drains the queue.
+func (f *Firehose) run() {
+	defer close(f.done)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	var batch [][]byte
+	size := 0
+	add := func(data []byte) {
+		if len(batch) == maxBatchRecords || size+len(data) > maxBatchBytes {
+			f.flush(batch)
+			batch, size = nil, 0
+		}
+		batch = append(batch, data)
+		size += len(data)
+	}
+	for {
+		select {
+		case data := <-f.records:
+			add(data)
+		case <-ticker.C:
+			f.flush(batch)
+			batch, size = nil, 0
+		case <-f.stop:
+			for {
+				select {
+				case data := <-f.records:
+					add(data)
+				default:
+					f.flush(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+// flush sends batch, retrying the records Firehose rejects. Records still
+// rejected after maxAttempts are counted as failed.
+func (f *Firehose) flush(batch [][]byte) {
+	for attempt := 1; len(batch) > 0; attempt++ {
+		rejected, err := f.putRecordBatch(batch)
+		if err != nil && rejected == nil {
+			rejected = batch
+		}
+		f.sent.Add(int64(len(batch) - len(rejected)))
+		if len(rejected) == 0 {
+			return
+		}
+		if attempt == maxAttempts {
+			f.failed.Add(int64(len(rejected)))
+			slog.Warn("Failed to stream workflow records", "stream", f.stream, "records", len(rejected), "error", err)
+			return
+		}
+		batch = rejected
+		time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+	}
+}
+
+// putRecordBatch makes a signed PutRecordBatch call and returns the records
+// Firehose rejected with the last rejection's error. A failed call returns no
+// records and its error.
+func (f *Firehose) putRecordBatch(batch [][]byte) ([][]byte, error) {
+	type record struct {
+		Data []byte `json:"Data"`
+	}
+	in := struct {
+		DeliveryStreamName string   `json:"DeliveryStreamName"`
+		Records            []record `json:"Records"`
+	}{DeliveryStreamName: f.stream}
+	for _, data := range batch {
+		in.Records = append(in.Records, record{Data: data})
+	}
+	body, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize request: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "Firehose_20150804.PutRecordBatch")
+
+	creds, err := f.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+	hash := sha256.Sum256(body)
+	if err := f.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "firehose", f.region, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &apiErr)
+		return nil, fmt.Errorf("PutRecordBatch returned %s: %s %s", resp.Status, apiErr.Type, apiErr.Message)
+	}
+
+	var out struct {
+		FailedPutCount   int `json:"FailedPutCount"`
+		RequestResponses []struct {
+			ErrorCode    string `json:"ErrorCode"`
+			ErrorMessage string `json:"ErrorMessage"`
+		} `json:"RequestResponses"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if out.FailedPutCount == 0 {
+		return nil, nil
+	}
+	var rejected [][]byte
+	var lastErr error
+	for i, r := range out.RequestResponses {
+		if r.ErrorCode != "" && i < len(batch) {
+			rejected = append(rejected, batch[i])
+			lastErr = fmt.Errorf("%s: %s", r.ErrorCode, r.ErrorMessage)
+		}
+	}
+	return rejected, lastErr
+}
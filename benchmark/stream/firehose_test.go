@@ -0,0 +1,100 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFirehose serves PutRecordBatch, rejecting the first record of the first
+// batch once, and collects the delivered records.
+func fakeFirehose(t *testing.T) (*Firehose, func() []WorkflowRecord) {
+	var mu sync.Mutex
+	var delivered []WorkflowRecord
+	rejected := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !strings.HasPrefix(req.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") ||
+			req.Header.Get("X-Amz-Target") != "Firehose_20150804.PutRecordBatch" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var in struct {
+			DeliveryStreamName string
+			Records            []struct{ Data []byte }
+		}
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&in))
+		require.Equal(t, "benchmark-workflows", in.DeliveryStreamName)
+
+		mu.Lock()
+		defer mu.Unlock()
+		var responses []map[string]string
+		failed := 0
+		for i, r := range in.Records {
+			if i == 0 && !rejected {
+				rejected = true
+				failed++
+				responses = append(responses, map[string]string{"ErrorCode": "ServiceUnavailableException", "ErrorMessage": "Slow down."})
+				continue
+			}
+			require.True(t, strings.HasSuffix(string(r.Data), "\n"))
+			var rec WorkflowRecord
+			require.NoError(t, json.Unmarshal(r.Data, &rec))
+			delivered = append(delivered, rec)
+			responses = append(responses, map[string]string{"RecordId": "id"})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"FailedPutCount": failed, "RequestResponses": responses})
+	}))
+	t.Cleanup(srv.Close)
+
+	creds := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "secret"}, nil
+	})
+	f := newFirehose(creds, "benchmark-workflows", "us-east-1", srv.URL)
+	return f, func() []WorkflowRecord {
+		mu.Lock()
+		defer mu.Unlock()
+		return delivered
+	}
+}
+
+func TestFirehose_DeliversRecords(t *testing.T) {
+	f, delivered := fakeFirehose(t)
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		f.Send(WorkflowRecord{
+			Namespace:    "benchmark-1",
+			WorkflowType: "simple",
+			WorkflowID:   "wf-" + string(rune('a'+i)),
+			Outcome:      OutcomeCompleted,
+			SubmitTime:   now.Add(-time.Second),
+			CloseTime:    now,
+			LatencyMs:    1000,
+		})
+	}
+	require.NoError(t, f.Close(context.Background()))
+
+	records := delivered()
+	require.Len(t, records, 3, "the rejected record is retried")
+	ids := []string{records[0].WorkflowID, records[1].WorkflowID, records[2].WorkflowID}
+	require.ElementsMatch(t, []string{"wf-a", "wf-b", "wf-c"}, ids)
+	require.Equal(t, int64(3), f.sent.Load())
+	require.Zero(t, f.failed.Load())
+
+	// Records sent after Close are dropped, not delivered
+	f.Send(WorkflowRecord{WorkflowID: "late"})
+	require.Equal(t, int64(1), f.dropped.Load())
+}
+
+func TestFirehose_NilIsNoop(t *testing.T) {
+	var f *Firehose
+	f.Send(WorkflowRecord{WorkflowID: "wf"})
+}
@@ -0,0 +1,190 @@
+// Package taskdef renders ECS task definitions that run a benchmark scenario,
+// sized for the scenario's peak load, so a new scenario can be launched
+// without hand-editing the infrastructure templates.
+package taskdef
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+)
+
+// Sizing heuristics for the benchmark container, which generates load and
+// runs the embedded worker.
+const (
+	// TransitionsPerVCPU is the state transitions per second one vCPU
+	// sustains across the generator and embedded worker
+	TransitionsPerVCPU = 1500
+
+	// MemoryPerVCPUMB is the memory given per vCPU
+	MemoryPerVCPUMB = 2048
+
+	// MemoryHeadroomMB is kept free on top of the latency sample budget
+	MemoryHeadroomMB = 512
+)
+
+// cpuSizes are the task CPU units the benchmark task accepts.
+var cpuSizes = []int{256, 512, 1024, 2048, 4096}
+
+// Options are the deployment settings a scenario does not carry.
+type Options struct {
+	Family           string            // Task definition family (default "benchmark-<scenario name>")
+	Image            string            // Benchmark image
+	ExecutionRoleARN string            // Role ECS pulls the image and writes logs with
+	TaskRoleARN      string            // Role the benchmark calls AWS with
+	TemporalAddress  string            // Temporal frontend address (default config.DefaultConfig's)
+	ThresholdProfile string            // Scenario threshold profile to gate with
+	Environment      map[string]string // Extra environment, overriding the rendered values
+}
+
+// TaskDefinition is the RegisterTaskDefinition input, in the JSON accepted by
+// aws ecs register-task-definition --cli-input-json.
+type TaskDefinition struct {
+	Family                  string                `json:"family"`
+	RequiresCompatibilities []string              `json:"requiresCompatibilities"`
+	NetworkMode             string                `json:"networkMode"`
+	CPU                     string                `json:"cpu"`
+	Memory                  string                `json:"memory"`
+	ExecutionRoleARN        string                `json:"executionRoleArn,omitempty"`
+	TaskRoleARN             string                `json:"taskRoleArn,omitempty"`
+	RuntimePlatform         RuntimePlatform       `json:"runtimePlatform"`
+	ContainerDefinitions    []ContainerDefinition `json:"containerDefinitions"`
+}
+
+// RuntimePlatform is the task's OS and CPU architecture.
+type RuntimePlatform struct {
+	OperatingSystemFamily string `json:"operatingSystemFamily"`
+	CPUArchitecture       string `json:"cpuArchitecture"`
+}
+
+// ContainerDefinition is the benchmark container.
+type ContainerDefinition struct {
+	Name            string          `json:"name"`
+	Image           string          `json:"image"`
+	Essential       bool            `json:"essential"`
+	CPU             int             `json:"cpu"`
+	Memory          int             `json:"memory"`
+	PortMappings    []PortMapping   `json:"portMappings"`
+	Environment     []KeyValuePair  `json:"environment"`
+	LinuxParameters LinuxParameters `json:"linuxParameters"`
+}
+
+// PortMapping exposes a container port.
+type PortMapping struct {
+	ContainerPort int    `json:"containerPort"`
+	Protocol      string `json:"protocol"`
+	Name          string `json:"name"`
+}
+
+// KeyValuePair is one environment variable.
+type KeyValuePair struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// LinuxParameters enables the init process ECS Exec relies on.
+type LinuxParameters struct {
+	InitProcessEnabled bool `json:"initProcessEnabled"`
+}
+
+// Render builds a task definition running sc. The scenario is passed inline
+// in BENCHMARK_SCENARIO, so the image needs no scenario file.
+func Render(sc *scenario.Scenario, opts Options) (*TaskDefinition, error) {
+	if opts.Image == "" {
+		return nil, fmt.Errorf("an image is required")
+	}
+	base := config.DefaultConfig()
+	if opts.TemporalAddress != "" {
+		base.TemporalAddress = opts.TemporalAddress
+	}
+	inline, err := json.Marshal(sc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize scenario: %w", err)
+	}
+	base.Scenario = string(inline)
+	base.ThresholdProfile = opts.ThresholdProfile
+	if err := sc.Validate(base); err != nil {
+		return nil, fmt.Errorf("invalid scenario: %w", err)
+	}
+
+	cpu, memory := Size(PeakStateTransitions(sc, base), base.LatencyMemoryBudgetMB)
+
+	env := map[string]string{
+		"TEMPORAL_ADDRESS":   base.TemporalAddress,
+		"BENCHMARK_SCENARIO": string(inline),
+	}
+	if opts.ThresholdProfile != "" {
+		env["BENCHMARK_THRESHOLD_PROFILE"] = opts.ThresholdProfile
+	}
+	for name, value := range opts.Environment {
+		env[name] = value
+	}
+
+	family := opts.Family
+	if family == "" {
+		family = "benchmark-" + sc.Name
+	}
+	return &TaskDefinition{
+		Family:                  family,
+		RequiresCompatibilities: []string{"EC2"},
+		NetworkMode:             "awsvpc",
+		CPU:                     strconv.Itoa(cpu),
+		Memory:                  strconv.Itoa(memory),
+		ExecutionRoleARN:        opts.ExecutionRoleARN,
+		TaskRoleARN:             opts.TaskRoleARN,
+		RuntimePlatform:         RuntimePlatform{OperatingSystemFamily: "LINUX", CPUArchitecture: "ARM64"},
+		ContainerDefinitions: []ContainerDefinition{{
+			Name:            "benchmark",
+			Image:           opts.Image,
+			Essential:       true,
+			CPU:             cpu,
+			Memory:          memory,
+			PortMappings:    []PortMapping{{ContainerPort: config.DefaultMetricsPort, Protocol: "tcp", Name: "metrics"}},
+			Environment:     sortedEnvironment(env),
+			LinuxParameters: LinuxParameters{InitProcessEnabled: true},
+		}},
+	}, nil
+}
+
+// PeakStateTransitions returns the highest state transition rate of any phase.
+func PeakStateTransitions(sc *scenario.Scenario, base config.BenchmarkConfig) float64 {
+	var peak float64
+	for _, p := range sc.Phases {
+		cfg := p.Apply(base)
+		cost, _ := cfg.TransitionCost()
+		peak = max(peak, cfg.TargetRate*cost)
+	}
+	return peak
+}
+
+// Size returns the task CPU units and memory (MB) for a peak state transition
+// rate: enough vCPUs at TransitionsPerVCPU, rounded up to a supported size
+// (at most 4 vCPUs), with MemoryPerVCPUMB per vCPU and at least the latency
+// sample budget plus headroom.
+func Size(peakTransitions float64, latencyBudgetMB int) (cpu, memory int) {
+	needed := int(math.Ceil(peakTransitions / TransitionsPerVCPU * 1024))
+	cpu = cpuSizes[len(cpuSizes)-1]
+	for _, size := range cpuSizes {
+		if size >= needed {
+			cpu = size
+			break
+		}
+	}
+	memory = max(cpu*MemoryPerVCPUMB/1024, latencyBudgetMB+MemoryHeadroomMB)
+	memory = (memory + 511) / 512 * 512
+	return cpu, memory
+}
+
+func sortedEnvironment(env map[string]string) []KeyValuePair {
+	pairs := make([]KeyValuePair, 0, len(env))
+	for name, value := range env {
+		pairs = append(pairs, KeyValuePair{Name: name, Value: value})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Name < pairs[j].Name })
+	return pairs
+}
@@ -0,0 +1,75 @@
+package taskdef
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/config"
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/scenario"
+)
+
+func TestSize(t *testing.T) {
+	cases := []struct {
+		peak        float64
+		budgetMB    int
+		cpu, memory int
+	}{
+		{peak: 100, budgetMB: 256, cpu: 256, memory: 1024},
+		{peak: 1000, budgetMB: 256, cpu: 1024, memory: 2048},
+		{peak: 1000, budgetMB: 4000, cpu: 1024, memory: 4608},
+		{peak: 5000, budgetMB: 256, cpu: 4096, memory: 8192},
+		{peak: 100000, budgetMB: 256, cpu: 4096, memory: 8192},
+	}
+	for _, c := range cases {
+		cpu, memory := Size(c.peak, c.budgetMB)
+		require.Equal(t, c.cpu, cpu, "peak %.0f", c.peak)
+		require.Equal(t, c.memory, memory, "peak %.0f", c.peak)
+	}
+}
+
+func TestRender(t *testing.T) {
+	sc := &scenario.Scenario{
+		Name: "ramp",
+		Phases: []scenario.Phase{
+			{WorkflowType: config.WorkflowTypeSimple, TargetRate: 100, Duration: scenario.Duration(2 * time.Minute)},
+			{WorkflowType: config.WorkflowTypeSimple, TargetRate: 200, Duration: scenario.Duration(5 * time.Minute)},
+		},
+		Thresholds: map[string]scenario.ThresholdProfile{"prod": {MaxP99Latency: scenario.Duration(3 * time.Second)}},
+	}
+	require.Equal(t, 1000.0, PeakStateTransitions(sc, config.DefaultConfig()))
+
+	_, err := Render(sc, Options{})
+	require.ErrorContains(t, err, "image is required")
+
+	def, err := Render(sc, Options{
+		Image:            "benchmark:latest",
+		ThresholdProfile: "prod",
+		Environment:      map[string]string{"BENCHMARK_NAMESPACE": "benchmark", "TEMPORAL_ADDRESS": "frontend:7233"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "benchmark-ramp", def.Family)
+	require.Equal(t, "1024", def.CPU)
+	require.Equal(t, "2048", def.Memory)
+
+	env := map[string]string{}
+	var names []string
+	for _, kv := range def.ContainerDefinitions[0].Environment {
+		env[kv.Name] = kv.Value
+		names = append(names, kv.Name)
+	}
+	require.Equal(t, []string{"BENCHMARK_NAMESPACE", "BENCHMARK_SCENARIO", "BENCHMARK_THRESHOLD_PROFILE", "TEMPORAL_ADDRESS"}, names)
+	require.Equal(t, "frontend:7233", env["TEMPORAL_ADDRESS"])
+
+	// The inline scenario round-trips through the benchmark's config
+	cfg := config.DefaultConfig()
+	cfg.Scenario = env["BENCHMARK_SCENARIO"]
+	cfg.ThresholdProfile = env["BENCHMARK_THRESHOLD_PROFILE"]
+	parsed, err := scenario.Parse([]byte(cfg.Scenario), cfg)
+	require.NoError(t, err)
+	require.Equal(t, sc, parsed)
+
+	_, err = Render(sc, Options{Image: "benchmark:latest", ThresholdProfile: "staging"})
+	require.ErrorContains(t, err, `threshold profile "staging" not defined`)
+}
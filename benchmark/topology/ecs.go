@@ -0,0 +1,83 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+// describeTasksLimit is the most tasks one DescribeTasks call accepts.
+const describeTasksLimit = 100
+
+// ecsTasks lists the tasks of an ECS service.
+type ecsTasks struct {
+	client  *ecs.Client
+	cluster string
+	service string
+}
+
+// NewECSTaskSource creates a TaskSource for an ECS service using the default
+// AWS credential chain (the task role when running on ECS).
+func NewECSTaskSource(ctx context.Context, cluster, service string) (TaskSource, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	return &ecsTasks{client: ecs.NewFromConfig(cfg), cluster: cluster, service: service}, nil
+}
+
+// TaskAZs returns the availability zone of each running task of the service
+// by the private IPv4 address of its network interface (awsvpc networking).
+func (s *ecsTasks) TaskAZs(ctx context.Context) (map[string]string, error) {
+	var arns []string
+	paginator := ecs.NewListTasksPaginator(s.client, &ecs.ListTasksInput{
+		Cluster:       aws.String(s.cluster),
+		ServiceName:   aws.String(s.service),
+		DesiredStatus: types.DesiredStatusRunning,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tasks of service %s: %w", s.service, err)
+		}
+		arns = append(arns, page.TaskArns...)
+	}
+	if len(arns) == 0 {
+		return nil, fmt.Errorf("service %s has no running tasks", s.service)
+	}
+
+	azs := make(map[string]string, len(arns))
+	for start := 0; start < len(arns); start += describeTasksLimit {
+		out, err := s.client.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+			Cluster: aws.String(s.cluster),
+			Tasks:   arns[start:min(start+describeTasksLimit, len(arns))],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe tasks of service %s: %w", s.service, err)
+		}
+		for _, task := range out.Tasks {
+			for _, ip := range privateIPs(task) {
+				azs[ip] = aws.ToString(task.AvailabilityZone)
+			}
+		}
+	}
+	return azs, nil
+}
+
+// privateIPs returns the private IPv4 addresses of a task's network
+// interfaces.
+func privateIPs(task types.Task) []string {
+	var ips []string
+	for _, attachment := range task.Attachments {
+		for _, detail := range attachment.Details {
+			if aws.ToString(detail.Name) == "privateIPv4Address" {
+				ips = append(ips, aws.ToString(detail.Value))
+			}
+		}
+	}
+	return ips
+}
@@ -0,0 +1,141 @@
+// Package topology detects the availability zones of the benchmark client
+// and of the Temporal frontend tasks it calls, so results and metrics show
+// whether latency includes a cross-AZ hop.
+package topology
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// metadataVariable holds the ECS task metadata endpoint (version 4), set by
+// the ECS agent in every container of a task.
+const metadataVariable = "ECS_CONTAINER_METADATA_URI_V4"
+
+// callTimeout bounds each lookup.
+const callTimeout = 10 * time.Second
+
+// TaskSource lists the running tasks of the frontend service.
+type TaskSource interface {
+	// TaskAZs returns the availability zone of each running task by its
+	// private IP address
+	TaskAZs(ctx context.Context) (map[string]string, error)
+}
+
+// Detect returns the availability zones of the ECS task this process runs
+// in and of the frontend tasks address (host:port) reaches, looked up in
+// frontend if it is not nil. It returns nil off ECS without a frontend
+// source. Lookups that fail are logged and leave their side empty.
+func Detect(ctx context.Context, address string, frontend TaskSource) *results.Topology {
+	endpoint := os.Getenv(metadataVariable)
+	if endpoint == "" && frontend == nil {
+		return nil
+	}
+
+	t := &results.Topology{}
+	if endpoint != "" {
+		az, err := ClientAZ(ctx, endpoint)
+		if err != nil {
+			slog.Warn("Failed to detect the task's availability zone", "error", err)
+		}
+		t.ClientAZ = az
+	}
+	if frontend != nil {
+		azs, err := FrontendAZs(ctx, address, frontend)
+		if err != nil {
+			slog.Warn("Failed to detect the frontend's availability zones", "address", address, "error", err)
+		}
+		t.FrontendAZs = azs
+	}
+	t.Placement = Placement(t.ClientAZ, t.FrontendAZs)
+	return t
+}
+
+// ClientAZ reads the availability zone of the task from its ECS task
+// metadata endpoint.
+func ClientAZ(ctx context.Context, endpoint string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/task", nil)
+	if err != nil {
+		return "", fmt.Errorf("invalid task metadata endpoint %q: %w", endpoint, err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to read task metadata: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("task metadata endpoint returned %s", resp.Status)
+	}
+
+	var task struct {
+		AvailabilityZone string `json:"AvailabilityZone"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
+		return "", fmt.Errorf("failed to decode task metadata: %w", err)
+	}
+	if task.AvailabilityZone == "" {
+		return "", fmt.Errorf("task metadata has no availability zone")
+	}
+	return task.AvailabilityZone, nil
+}
+
+// FrontendAZs returns the sorted availability zones of the frontend tasks
+// address resolves to. An address that resolves to no task, such as a
+// Service Connect proxy or a load balancer spreading calls over every task,
+// is attributed to the zones of all running tasks.
+func FrontendAZs(ctx context.Context, address string, frontend TaskSource) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	tasks, err := frontend.TaskAZs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	ips, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		slog.Debug("Frontend address not resolvable; using every frontend task", "host", host, "error", err)
+	}
+	var azs []string
+	for _, ip := range ips {
+		if az, ok := tasks[ip]; ok {
+			azs = append(azs, az)
+		}
+	}
+	if len(azs) == 0 {
+		for _, az := range tasks {
+			azs = append(azs, az)
+		}
+	}
+	slices.Sort(azs)
+	return slices.Compact(azs), nil
+}
+
+// Placement classifies the client's AZ against the frontend's, or returns ""
+// if either is unknown.
+func Placement(clientAZ string, frontendAZs []string) string {
+	switch {
+	case clientAZ == "" || len(frontendAZs) == 0:
+		return ""
+	case !slices.Contains(frontendAZs, clientAZ):
+		return results.PlacementCrossAZ
+	case len(frontendAZs) == 1:
+		return results.PlacementSameAZ
+	default:
+		return results.PlacementMixed
+	}
+}
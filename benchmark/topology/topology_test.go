@@ -0,0 +1,92 @@
+package topology
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/temporalio/temporal-dsql-deploy-ecs/benchmark/results"
+)
+
+// fakeTasks returns fixed task availability zones, or err.
+type fakeTasks struct {
+	azs map[string]string
+	err error
+}
+
+func (f fakeTasks) TaskAZs(context.Context) (map[string]string, error) {
+	return f.azs, f.err
+}
+
+func metadataServer(t *testing.T, body string) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/task" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestClientAZ(t *testing.T) {
+	endpoint := metadataServer(t, `{"Cluster":"benchmark","AvailabilityZone":"us-east-1b"}`)
+	az, err := ClientAZ(context.Background(), endpoint)
+	require.NoError(t, err)
+	require.Equal(t, "us-east-1b", az)
+
+	_, err = ClientAZ(context.Background(), metadataServer(t, `{"Cluster":"benchmark"}`))
+	require.Error(t, err, "metadata without an availability zone")
+
+	_, err = ClientAZ(context.Background(), endpoint+"/missing")
+	require.Error(t, err)
+}
+
+func TestFrontendAZs(t *testing.T) {
+	tasks := fakeTasks{azs: map[string]string{
+		"10.0.1.5": "us-east-1a",
+		"10.0.2.7": "us-east-1b",
+		"10.0.3.9": "us-east-1b",
+	}}
+
+	azs, err := FrontendAZs(context.Background(), "10.0.2.7:7233", tasks)
+	require.NoError(t, err)
+	require.Equal(t, []string{"us-east-1b"}, azs, "address of one task")
+
+	azs, err = FrontendAZs(context.Background(), "10.9.9.9:7233", tasks)
+	require.NoError(t, err)
+	require.Equal(t, []string{"us-east-1a", "us-east-1b"}, azs, "proxy address spreads calls over every task")
+
+	_, err = FrontendAZs(context.Background(), "10.0.2.7:7233", fakeTasks{err: errors.New("access denied")})
+	require.Error(t, err)
+}
+
+func TestDetect(t *testing.T) {
+	t.Setenv(metadataVariable, "")
+	require.Nil(t, Detect(context.Background(), "10.0.1.5:7233", nil), "off ECS without a frontend source")
+
+	t.Setenv(metadataVariable, metadataServer(t, `{"AvailabilityZone":"us-east-1a"}`))
+	topology := Detect(context.Background(), "10.0.2.7:7233", fakeTasks{azs: map[string]string{"10.0.2.7": "us-east-1b"}})
+	require.Equal(t, &results.Topology{
+		ClientAZ:    "us-east-1a",
+		FrontendAZs: []string{"us-east-1b"},
+		Placement:   results.PlacementCrossAZ,
+	}, topology)
+
+	topology = Detect(context.Background(), "10.0.2.7:7233", nil)
+	require.Equal(t, &results.Topology{ClientAZ: "us-east-1a"}, topology, "client only")
+}
+
+func TestPlacement(t *testing.T) {
+	require.Equal(t, "", Placement("", []string{"us-east-1a"}))
+	require.Equal(t, "", Placement("us-east-1a", nil))
+	require.Equal(t, results.PlacementSameAZ, Placement("us-east-1a", []string{"us-east-1a"}))
+	require.Equal(t, results.PlacementCrossAZ, Placement("us-east-1a", []string{"us-east-1b", "us-east-1c"}))
+	require.Equal(t, results.PlacementMixed, Placement("us-east-1a", []string{"us-east-1a", "us-east-1b"}))
+}
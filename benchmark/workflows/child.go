@@ -16,31 +16,54 @@ const MinChildCount = 1
 // MaxChildCount is the maximum allowed child workflow count.
 const MaxChildCount = 100
 
+// MaxChildDepth is the maximum allowed depth of a child workflow tree.
+const MaxChildDepth = 10
+
 // ChildWorkflow spawns N child workflows.
 // Used to measure child workflow scheduling and execution overhead.
 // All child workflows are started concurrently and then awaited.
 //
+// With depth above 1 the children are ChildWorkflows themselves, one level
+// shallower, so the workflow is the root of a tree of childCount children
+// per parent and depth levels; the leaves run SimpleWorkflow. Each parent
+// closes only after its subtree, so deep trees chain parent-close
+// processing and child completion writes across levels.
+//
 // Parameters:
 //   - childCount: Number of child workflows to spawn (1-100)
+//   - depth: Levels of children below this workflow (1-10; 0, as passed by
+//     starters predating it, is 1)
 //
 // Requirements: 1.4 - THE Workflow_Generator SHALL support a workflow
 // with child workflow spawning.
-func ChildWorkflow(ctx workflow.Context, childCount int) error {
+func ChildWorkflow(ctx workflow.Context, childCount int, depth int) (Outcome, error) {
+	var outcome Outcome
 	// Validate child count
 	if childCount < MinChildCount || childCount > MaxChildCount {
-		return fmt.Errorf("childCount must be between %d and %d, got %d",
+		return outcome, fmt.Errorf("childCount must be between %d and %d, got %d",
 			MinChildCount, MaxChildCount, childCount)
 	}
+	if depth > MaxChildDepth {
+		return outcome, fmt.Errorf("depth must be at most %d, got %d", MaxChildDepth, depth)
+	}
 
 	var futures []workflow.ChildWorkflowFuture
 	for range childCount {
-		future := workflow.ExecuteChildWorkflow(ctx, SimpleWorkflow)
+		var future workflow.ChildWorkflowFuture
+		if depth > 1 {
+			future = workflow.ExecuteChildWorkflow(ctx, ChildWorkflow, childCount, depth-1)
+		} else {
+			future = workflow.ExecuteChildWorkflow(ctx, SimpleWorkflow)
+		}
 		futures = append(futures, future)
 	}
-	for _, f := range futures {
-		if err := f.Get(ctx, nil); err != nil {
-			return err
+	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	for i, f := range futures {
+		var child Outcome
+		if err := f.Get(ctx, &child); err != nil {
+			return outcome, err
 		}
+		outcome.add(runID, i)
 	}
-	return nil
+	return outcome, nil
 }
@@ -0,0 +1,30 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestChildWorkflow_Nested(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(ChildWorkflow, workflow.RegisterOptions{Name: ChildWorkflowName})
+	env.RegisterWorkflowWithOptions(SimpleWorkflow, workflow.RegisterOptions{Name: SimpleWorkflowName})
+
+	started := map[string]int{}
+	env.SetOnChildWorkflowStartedListener(func(info *workflow.Info, _ workflow.Context, _ converter.EncodedValues) {
+		started[info.WorkflowType.Name]++
+	})
+
+	env.ExecuteWorkflow(ChildWorkflowName, 2, 3)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var outcome Outcome
+	require.NoError(t, env.GetWorkflowResult(&outcome))
+	require.Equal(t, 2, outcome.Steps, "the root folds its direct children")
+	require.Equal(t, map[string]int{ChildWorkflowName: 2 + 4, SimpleWorkflowName: 8}, started)
+}
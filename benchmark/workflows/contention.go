@@ -0,0 +1,117 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"errors"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// ContentionWorkflowName is the registered name for ContentionWorkflow.
+const ContentionWorkflowName = "ContentionWorkflow"
+
+// AggregatorWorkflowName is the registered name for AggregatorWorkflow.
+const AggregatorWorkflowName = "AggregatorWorkflow"
+
+// AggregatorSignal is the signal ContentionWorkflow sends its aggregator.
+const AggregatorSignal = "contribute"
+
+const (
+	// aggregatorSignalsPerRun bounds the signals an aggregator run handles
+	// before continuing as new, keeping its history small
+	aggregatorSignalsPerRun = 1000
+
+	// aggregatorIdleTimeout completes an aggregator no workflow has signalled
+	// for this long; the next signal starts it again
+	aggregatorIdleTimeout = time.Minute
+)
+
+// ContentionWorkflow signals the aggregator workflow aggregatorID once,
+// starting the aggregator if it is not running.
+// Used to characterize DSQL optimistic concurrency control under contention:
+// every workflow sharing an aggregator updates the aggregator's mutable state
+// row, so the fan-in (workflows per aggregator) sets how hot that row is.
+//
+// State transitions per workflow (~14):
+// - 1 workflow started, 1 workflow completed
+// - 2 workflow tasks (scheduled/started/completed)
+// - 2 signal external events (initiated, signaled)
+// - 1 signal and ~1 workflow task on the aggregator (batched under contention)
+func ContentionWorkflow(ctx workflow.Context, aggregatorID string) (Outcome, error) {
+	var outcome Outcome
+	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	err := workflow.SignalExternalWorkflow(ctx, aggregatorID, "", AggregatorSignal, 1).Get(ctx, nil)
+	if err == nil {
+		outcome.add(runID, 0)
+		return outcome, nil
+	}
+	var unknown *temporal.UnknownExternalWorkflowExecutionError
+	if !errors.As(err, &unknown) {
+		return outcome, err
+	}
+
+	// Start the aggregator, abandoned so it outlives this workflow. Another
+	// workflow may start it first, which is as good.
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		WorkflowID:            aggregatorID,
+		ParentClosePolicy:     enumspb.PARENT_CLOSE_POLICY_ABANDON,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	})
+	child := workflow.ExecuteChildWorkflow(ctx, AggregatorWorkflowName, int64(0))
+	if err := child.GetChildWorkflowExecution().Get(ctx, nil); err != nil &&
+		!temporal.IsWorkflowExecutionAlreadyStartedError(err) {
+		return outcome, err
+	}
+	if err := workflow.SignalExternalWorkflow(ctx, aggregatorID, "", AggregatorSignal, 1).Get(ctx, nil); err != nil {
+		return outcome, err
+	}
+	outcome.add(runID, 0)
+	return outcome, nil
+}
+
+// AggregatorWorkflow sums the contributions signalled by ContentionWorkflows,
+// starting from total. It continues as new with the total every
+// aggregatorSignalsPerRun signals and completes once idle for
+// aggregatorIdleTimeout.
+func AggregatorWorkflow(ctx workflow.Context, total int64) (int64, error) {
+	signals := workflow.GetSignalChannel(ctx, AggregatorSignal)
+	for handled := 0; handled < aggregatorSignalsPerRun; {
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		idle := false
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(signals, func(c workflow.ReceiveChannel, _ bool) {
+			var n int64
+			c.Receive(ctx, &n)
+			total += n
+			handled++
+		})
+		selector.AddFuture(workflow.NewTimer(timerCtx, aggregatorIdleTimeout), func(workflow.Future) {
+			idle = true
+		})
+		selector.Select(ctx)
+		cancelTimer()
+
+		if idle {
+			// Complete only if no contribution arrived with the timer
+			var n int64
+			if !signals.ReceiveAsync(&n) {
+				return total, nil
+			}
+			total += n
+			handled++
+		}
+	}
+
+	// Carry over contributions received since the last one handled
+	for {
+		var n int64
+		if !signals.ReceiveAsync(&n) {
+			break
+		}
+		total += n
+	}
+	return 0, workflow.NewContinueAsNewError(ctx, AggregatorWorkflowName, total)
+}
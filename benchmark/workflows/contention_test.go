@@ -0,0 +1,69 @@
+package workflows
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestAggregatorWorkflow_SumsUntilIdle(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(AggregatorWorkflow, workflow.RegisterOptions{Name: AggregatorWorkflowName})
+	env.RegisterDelayedCallback(func() {
+		for range 3 {
+			env.SignalWorkflow(AggregatorSignal, int64(1))
+		}
+	}, time.Second)
+
+	env.ExecuteWorkflow(AggregatorWorkflowName, int64(10))
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var total int64
+	require.NoError(t, env.GetWorkflowResult(&total))
+	require.Equal(t, int64(13), total)
+}
+
+func TestAggregatorWorkflow_ContinuesAsNew(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(AggregatorWorkflow, workflow.RegisterOptions{Name: AggregatorWorkflowName})
+	// Signal in batches, since the test environment queues at most 1000 callbacks
+	for batch := range 2 {
+		env.RegisterDelayedCallback(func() {
+			for range aggregatorSignalsPerRun/2 + 1 {
+				env.SignalWorkflow(AggregatorSignal, int64(1))
+			}
+		}, time.Duration(batch+1)*time.Second)
+	}
+
+	env.ExecuteWorkflow(AggregatorWorkflowName, int64(0))
+	require.True(t, env.IsWorkflowCompleted())
+	require.True(t, workflow.IsContinueAsNewError(env.GetWorkflowError()))
+}
+
+func TestContentionWorkflow_SignalsAggregator(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(ContentionWorkflow, workflow.RegisterOptions{Name: ContentionWorkflowName})
+	env.OnSignalExternalWorkflow(mock.Anything, "contention-aggregator-0", "", AggregatorSignal, 1).Return(nil).Once()
+
+	env.ExecuteWorkflow(ContentionWorkflowName, "contention-aggregator-0")
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestContentionWorkflow_StartsMissingAggregator(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(ContentionWorkflow, workflow.RegisterOptions{Name: ContentionWorkflowName})
+	env.RegisterWorkflowWithOptions(AggregatorWorkflow, workflow.RegisterOptions{Name: AggregatorWorkflowName})
+	env.OnSignalExternalWorkflow(mock.Anything, "contention-aggregator-0", "", AggregatorSignal, 1).
+		Return(&temporal.UnknownExternalWorkflowExecutionError{}).Once()
+	// The retried signal reaches the started aggregator rather than the mock
+
+	env.ExecuteWorkflow(ContentionWorkflowName, "contention-aggregator-0")
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
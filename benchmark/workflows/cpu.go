@@ -0,0 +1,55 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"go.temporal.io/sdk/activity"
+)
+
+// CPUActivityName is the registered name for CPUActivity.
+const CPUActivityName = "CPUActivity"
+
+// cpuCheckInterval is how many iterations CPUActivity hashes between checks
+// of its context.
+const cpuCheckInterval = 10000
+
+// CPUActivity is NoOpActivity's CPU-bound variant: instead of sleeping it
+// hashes input.CPUIterations times with SHA-256, each round hashing the last,
+// so it occupies a core for the whole execution and worker CPU saturation
+// shows up in schedule-to-start and activity latencies. It stops early only
+// when its context ends (a timeout or worker shutdown); it does not
+// heartbeat, so cancellation requests are not delivered.
+func CPUActivity(ctx context.Context, input ActivityInput) (ActivityOutput, error) {
+	info := activity.GetInfo(ctx)
+	recordExecution(ctx, input)
+
+	var sum [sha256.Size]byte
+	binary.BigEndian.PutUint64(sum[:], uint64(input.ActivityIndex))
+	for i := 0; i < input.CPUIterations; i++ {
+		if i%cpuCheckInterval == 0 && ctx.Err() != nil {
+			return ActivityOutput{}, ctx.Err()
+		}
+		sum = sha256.Sum256(sum[:])
+	}
+
+	return ActivityOutput{
+		TaskQueue:     info.TaskQueue,
+		WorkerID:      info.WorkflowExecution.ID,
+		ActivityID:    info.ActivityID,
+		Attempt:       info.Attempt,
+		WorkflowRunID: input.WorkflowRunID,
+		ActivityIndex: input.ActivityIndex,
+	}, nil
+}
+
+// workActivity returns the activity a workflow executes for its generic
+// steps: CPUActivity for inputs with CPU iterations, otherwise NoOpActivity.
+func workActivity(cpuIterations int) interface{} {
+	if cpuIterations > 0 {
+		return CPUActivity
+	}
+	return NoOpActivity
+}
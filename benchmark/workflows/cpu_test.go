@@ -0,0 +1,54 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestCPUActivity(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(CPUActivity, activity.RegisterOptions{Name: CPUActivityName})
+
+	result, err := env.ExecuteActivity(CPUActivityName, ActivityInput{WorkflowRunID: "run-1", ActivityIndex: 3, CPUIterations: 25000})
+	require.NoError(t, err)
+
+	var output ActivityOutput
+	require.NoError(t, result.Get(&output))
+	require.Equal(t, "run-1", output.WorkflowRunID)
+	require.Equal(t, 3, output.ActivityIndex)
+}
+
+func TestMultiActivityWorkflow_ActivityWork(t *testing.T) {
+	tests := []struct {
+		name          string
+		cpuIterations int
+		activity      string
+	}{
+		{"sleep", 0, NoOpActivityName},
+		{"cpu", 1000, CPUActivityName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+			env.RegisterWorkflowWithOptions(MultiActivityWorkflow, workflow.RegisterOptions{Name: MultiActivityWorkflowName})
+			env.RegisterActivityWithOptions(NoOpActivity, activity.RegisterOptions{Name: NoOpActivityName})
+			env.RegisterActivityWithOptions(CPUActivity, activity.RegisterOptions{Name: CPUActivityName})
+
+			executed := make(map[string]int)
+			env.SetOnActivityStartedListener(func(info *activity.Info, _ context.Context, _ converter.EncodedValues) {
+				executed[info.ActivityType.Name]++
+			})
+
+			env.ExecuteWorkflow(MultiActivityWorkflowName, MultiActivityInput{CPUIterations: tt.cpuIterations})
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			require.Equal(t, map[string]int{tt.activity: 10}, executed)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"sync"
+
+	"go.temporal.io/sdk/activity"
+)
+
+// executionGeneration is how many keys each generation of the execution
+// recorder holds. Two generations are kept, so duplicates are detected among
+// at least the last executionGeneration activity executions and workflows
+// with bounded memory.
+const executionGeneration = 1 << 20
+
+// ExecutionCounts counts the NoOpActivity, CPUActivity and FastActivity
+// executions of this process and the at-least-once anomalies among them.
+type ExecutionCounts struct {
+	Activities int64 // Activity executions recorded
+	Workflows  int64 // Workflow runs the recorded activities belonged to
+
+	// DuplicateActivities counts executions of an activity (workflow run and
+	// activity index) that had already executed here, or that are a retry
+	// (attempt > 1) wherever the earlier attempt ran. These activities never
+	// fail, so every repeat is a redelivery or a retry after a lost or late
+	// completion rather than a retry the workflow asked for
+	DuplicateActivities int64
+
+	// DuplicateWorkflows counts workflow IDs whose activities were executed
+	// for a second run. Every generated workflow ID is started once, so a
+	// second run is a duplicate workflow execution
+	DuplicateWorkflows int64
+}
+
+// executionRecorder remembers executed activities and the run of each
+// workflow ID by hash, in two generations.
+type executionRecorder struct {
+	mu             sync.Mutex
+	activities     map[uint64]struct{}
+	prevActivities map[uint64]struct{}
+	runs           map[uint64]uint64
+	prevRuns       map[uint64]uint64
+	counts         ExecutionCounts
+}
+
+// executions records the activity executions of this process's workers.
+var executions = newExecutionRecorder()
+
+func newExecutionRecorder() *executionRecorder {
+	return &executionRecorder{
+		activities: make(map[uint64]struct{}),
+		runs:       make(map[uint64]uint64),
+	}
+}
+
+// Executions returns the activity executions and duplicates recorded so far
+// in this process.
+func Executions() ExecutionCounts {
+	executions.mu.Lock()
+	defer executions.mu.Unlock()
+	return executions.counts
+}
+
+// record records attempt of activity index of runID of workflowID, reporting
+// whether the activity or the workflow was a duplicate.
+func (r *executionRecorder) record(workflowID, runID string, index int, attempt int32) (duplicateActivity, duplicateWorkflow bool) {
+	workflowKey, runKey := hashKey(workflowID), hashKey(runID)
+	activityKey := hashKey(runID + "/" + strconv.Itoa(index))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.counts.Activities++
+
+	_, seen := r.activities[activityKey]
+	if !seen {
+		_, seen = r.prevActivities[activityKey]
+	}
+	if seen || attempt > 1 {
+		r.counts.DuplicateActivities++
+		duplicateActivity = true
+	}
+	if !seen {
+		if len(r.activities) >= executionGeneration {
+			r.prevActivities, r.activities = r.activities, make(map[uint64]struct{})
+		}
+		r.activities[activityKey] = struct{}{}
+	}
+
+	run, seen := r.runs[workflowKey]
+	if !seen {
+		run, seen = r.prevRuns[workflowKey]
+	}
+	if seen && run != runKey {
+		r.counts.DuplicateWorkflows++
+		duplicateWorkflow = true
+	}
+	if !seen || run != runKey {
+		r.counts.Workflows++
+		if len(r.runs) >= executionGeneration {
+			r.prevRuns, r.runs = r.runs, make(map[uint64]uint64)
+		}
+		r.runs[workflowKey] = runKey
+	}
+	return duplicateActivity, duplicateWorkflow
+}
+
+func hashKey(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// recordExecution records an execution of a NoOpActivity, CPUActivity or
+// FastActivity, logging it if it is a duplicate.
+func recordExecution(ctx context.Context, input ActivityInput) {
+	info := activity.GetInfo(ctx)
+	duplicateActivity, duplicateWorkflow := executions.record(info.WorkflowExecution.ID, info.WorkflowExecution.RunID, input.ActivityIndex, info.Attempt)
+	if duplicateActivity {
+		activity.GetLogger(ctx).Warn("Duplicate activity execution",
+			"workflow_id", info.WorkflowExecution.ID, "run_id", info.WorkflowExecution.RunID,
+			"activity_index", input.ActivityIndex, "attempt", info.Attempt)
+	}
+	if duplicateWorkflow {
+		activity.GetLogger(ctx).Warn("Duplicate workflow execution",
+			"workflow_id", info.WorkflowExecution.ID, "run_id", info.WorkflowExecution.RunID)
+	}
+}
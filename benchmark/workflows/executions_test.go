@@ -0,0 +1,32 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionRecorder(t *testing.T) {
+	r := newExecutionRecorder()
+
+	for i := range 3 {
+		duplicateActivity, duplicateWorkflow := r.record("wf-1", "run-1", i, 1)
+		require.False(t, duplicateActivity)
+		require.False(t, duplicateWorkflow)
+	}
+
+	duplicateActivity, duplicateWorkflow := r.record("wf-1", "run-1", 1, 1)
+	require.True(t, duplicateActivity, "same run and index")
+	require.False(t, duplicateWorkflow)
+
+	// A retry is a duplicate even if the earlier attempt ran in another process
+	duplicateActivity, duplicateWorkflow = r.record("wf-1", "run-1", 3, 2)
+	require.True(t, duplicateActivity, "retry of an activity that never fails")
+	require.False(t, duplicateWorkflow)
+
+	duplicateActivity, duplicateWorkflow = r.record("wf-1", "run-2", 0, 1)
+	require.False(t, duplicateActivity)
+	require.True(t, duplicateWorkflow, "second run of the workflow ID")
+
+	require.Equal(t, ExecutionCounts{Activities: 6, Workflows: 2, DuplicateActivities: 2, DuplicateWorkflows: 1}, r.counts)
+}
@@ -0,0 +1,89 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+// FanoutWorkflowName is the registered name for FanoutWorkflow.
+const FanoutWorkflowName = "FanoutWorkflow"
+
+// FanoutInput contains the input for FanoutWorkflow.
+type FanoutInput struct {
+	Width int // Activities started in parallel per stage
+	Depth int // Stages run one after another
+	Await int // Activities each stage waits for before the next starts, at most Width
+
+	CPUIterations int // Run CPUActivity with this many iterations in place of NoOpActivity if positive
+}
+
+// FanoutWorkflow runs input.Depth stages one after another, each fanning out
+// input.Width NoOpActivity executions in parallel and fanning in once
+// input.Await of them have completed. The activities a stage did not wait for
+// are cancelled, and the workflow waits for them to close before completing
+// so none are left open in its history. Width 4 with Await 4 and Depth 1
+// followed by Width 1 and Depth 6 is MultiActivityWorkflow's shape; wide
+// stages stress the transfer queue and matching, deep ones the workflow task
+// round trips. The outcome's steps are the awaited activities, in the order
+// they completed.
+func FanoutWorkflow(ctx workflow.Context, input FanoutInput) (Outcome, error) {
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		WaitForCancellation: true,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	activityIndex := 0
+	var outcome Outcome
+	var abandoned []workflow.Future
+	for stage := 0; stage < input.Depth; stage++ {
+		stageCtx, cancel := workflow.WithCancel(ctx)
+		selector := workflow.NewSelector(ctx)
+		futures := make([]workflow.Future, input.Width)
+		joined := make([]bool, input.Width)
+		var stageErr error
+		for i := range futures {
+			activityInput := ActivityInput{
+				WorkflowRunID: runID,
+				ActivityIndex: activityIndex,
+				CPUIterations: input.CPUIterations,
+			}
+			activityIndex++
+			futures[i] = workflow.ExecuteActivity(stageCtx, workActivity(input.CPUIterations), activityInput)
+			selector.AddFuture(futures[i], func(f workflow.Future) {
+				joined[i] = true
+				var output ActivityOutput
+				if err := f.Get(ctx, &output); err != nil {
+					stageErr = err
+					return
+				}
+				// Folded by position rather than index, since any of the
+				// stage's activities may be among the first to complete
+				outcome.add(output.WorkflowRunID, outcome.Steps)
+			})
+		}
+
+		for i := 0; i < input.Await && stageErr == nil; i++ {
+			selector.Select(ctx)
+		}
+		cancel()
+		if stageErr != nil {
+			return outcome, stageErr
+		}
+		for i, f := range futures {
+			if !joined[i] {
+				abandoned = append(abandoned, f)
+			}
+		}
+	}
+
+	// Cancelled activities close once their cancellation is recorded or they
+	// complete; the errors are expected
+	for _, f := range abandoned {
+		_ = f.Get(ctx, nil)
+	}
+	return outcome, nil
+}
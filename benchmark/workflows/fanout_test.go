@@ -0,0 +1,43 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func runFanout(t *testing.T, input FanoutInput) (Outcome, int) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(FanoutWorkflow, workflow.RegisterOptions{Name: FanoutWorkflowName})
+	env.RegisterActivityWithOptions(NoOpActivity, activity.RegisterOptions{Name: NoOpActivityName})
+
+	var activities int
+	env.SetOnActivityStartedListener(func(*activity.Info, context.Context, converter.EncodedValues) {
+		activities++
+	})
+
+	env.ExecuteWorkflow(FanoutWorkflowName, input)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var outcome Outcome
+	require.NoError(t, env.GetWorkflowResult(&outcome))
+	return outcome, activities
+}
+
+func TestFanoutWorkflow_JoinAll(t *testing.T) {
+	outcome, activities := runFanout(t, FanoutInput{Width: 3, Depth: 2, Await: 3})
+	require.Equal(t, 6, outcome.Steps)
+	require.Equal(t, 6, activities)
+}
+
+func TestFanoutWorkflow_JoinFirst(t *testing.T) {
+	outcome, activities := runFanout(t, FanoutInput{Width: 3, Depth: 2, Await: 1})
+	require.Equal(t, 2, outcome.Steps, "one awaited activity per stage")
+	require.LessOrEqual(t, activities, 6)
+}
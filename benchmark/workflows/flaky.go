@@ -0,0 +1,70 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// FlakyWorkflowName is the registered name for FlakyWorkflow.
+const FlakyWorkflowName = "FlakyWorkflow"
+
+// FlakyActivityName is the registered name for FlakyActivity.
+const FlakyActivityName = "FlakyActivity"
+
+// FlakyFailureType is the application error type of injected failures.
+const FlakyFailureType = "InjectedFailure"
+
+// FlakyInput contains the input for FlakyWorkflow and FlakyActivity.
+type FlakyInput struct {
+	Activities         int           // Activities run one after another
+	FailureRate        float64       // Probability that an attempt fails, in [0, 1)
+	MaxAttempts        int32         // Attempts per activity; the last one always succeeds
+	InitialInterval    time.Duration // Delay before the first retry
+	BackoffCoefficient float64       // Growth of the delay between retries
+}
+
+// FlakyWorkflow runs input.Activities activities in sequence, each failing
+// with probability input.FailureRate and retried by the server under the
+// input's retry policy. Every failed attempt makes the server persist the
+// failure and create a retry timer, load the other workflow types don't
+// produce. The outcome counts the failed attempts.
+func FlakyWorkflow(ctx workflow.Context, input FlakyInput) (Outcome, error) {
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    input.InitialInterval,
+			BackoffCoefficient: input.BackoffCoefficient,
+			MaximumAttempts:    input.MaxAttempts,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	var outcome Outcome
+	for i := 0; i < input.Activities; i++ {
+		var attempt int32
+		if err := workflow.ExecuteActivity(ctx, FlakyActivityName, input).Get(ctx, &attempt); err != nil {
+			return outcome, err
+		}
+		outcome.FailedAttempts += int(attempt) - 1
+		outcome.add(runID, i)
+	}
+	return outcome, nil
+}
+
+// FlakyActivity fails with probability input.FailureRate and returns the
+// attempt that succeeded. The final attempt always succeeds, so injected
+// failures exercise retries without failing the workflow.
+func FlakyActivity(ctx context.Context, input FlakyInput) (int32, error) {
+	attempt := activity.GetInfo(ctx).Attempt
+	if attempt < input.MaxAttempts && rand.Float64() < input.FailureRate {
+		return 0, temporal.NewApplicationError("injected failure", FlakyFailureType)
+	}
+	return attempt, nil
+}
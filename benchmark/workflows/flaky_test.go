@@ -0,0 +1,53 @@
+package workflows
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestFlakyWorkflow_CountsFailedAttempts(t *testing.T) {
+	tests := []struct {
+		name        string
+		failureRate float64
+		want        int
+	}{
+		// Every attempt but the last fails: 2 failed attempts per activity
+		{"always failing", 0.999999, 6},
+		{"never failing", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+			env.RegisterWorkflowWithOptions(FlakyWorkflow, workflow.RegisterOptions{Name: FlakyWorkflowName})
+			env.RegisterActivityWithOptions(FlakyActivity, activity.RegisterOptions{Name: FlakyActivityName})
+
+			env.ExecuteWorkflow(FlakyWorkflowName, FlakyInput{
+				Activities:         3,
+				FailureRate:        tt.failureRate,
+				MaxAttempts:        3,
+				InitialInterval:    time.Second,
+				BackoffCoefficient: 2,
+			})
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+
+			var outcome Outcome
+			require.NoError(t, env.GetWorkflowResult(&outcome))
+			require.Equal(t, tt.want, outcome.FailedAttempts)
+			require.Equal(t, 3, outcome.Steps)
+		})
+	}
+}
+
+func TestFlakyActivity_FailsWithInjectedFailure(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(FlakyActivity, activity.RegisterOptions{Name: FlakyActivityName})
+
+	_, err := env.ExecuteActivity(FlakyActivityName, FlakyInput{FailureRate: 0.999999, MaxAttempts: 3})
+	require.ErrorContains(t, err, FlakyFailureType)
+}
@@ -0,0 +1,74 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+)
+
+// HeartbeatWorkflowName is the registered name for HeartbeatWorkflow.
+const HeartbeatWorkflowName = "HeartbeatWorkflow"
+
+// HeartbeatActivityName is the registered name for HeartbeatActivity.
+const HeartbeatActivityName = "HeartbeatActivity"
+
+// HeartbeatThrottleInterval caps the SDK's heartbeat throttling on the
+// benchmark workers (MaxHeartbeatThrottleInterval). The SDK otherwise only
+// sends a heartbeat every 80% of the heartbeat timeout, so workers must use
+// it for HeartbeatActivity to reach the server at every interval.
+const HeartbeatThrottleInterval = time.Second
+
+// heartbeatMissesTolerated is how many intervals a heartbeat may be late
+// before the server times out the activity.
+const heartbeatMissesTolerated = 3
+
+// HeartbeatInput contains the input for HeartbeatWorkflow and HeartbeatActivity.
+type HeartbeatInput struct {
+	Duration time.Duration // How long the activity runs
+	Interval time.Duration // Time between heartbeats
+}
+
+// HeartbeatWorkflow runs one long activity that heartbeats every interval.
+// Each heartbeat updates the activity's progress in the workflow's mutable
+// state, a persistence write without a history event that the short no-op
+// activities of the other workflow types never produce.
+func HeartbeatWorkflow(ctx workflow.Context, input HeartbeatInput) (Outcome, error) {
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: input.Duration + time.Minute,
+		HeartbeatTimeout:    heartbeatMissesTolerated * input.Interval,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	var outcome Outcome
+	if err := workflow.ExecuteActivity(ctx, HeartbeatActivityName, input).Get(ctx, nil); err != nil {
+		return outcome, err
+	}
+	outcome.add(workflow.GetInfo(ctx).WorkflowExecution.RunID, 0)
+	return outcome, nil
+}
+
+// HeartbeatActivity runs for input.Duration, recording a heartbeat with the
+// number of beats so far every input.Interval, and returns the beat count.
+// It stops early if the activity is cancelled or times out.
+func HeartbeatActivity(ctx context.Context, input HeartbeatInput) (int, error) {
+	ticker := time.NewTicker(input.Interval)
+	defer ticker.Stop()
+	done := time.NewTimer(input.Duration)
+	defer done.Stop()
+
+	beats := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return beats, ctx.Err()
+		case <-done.C:
+			return beats, nil
+		case <-ticker.C:
+			beats++
+			activity.RecordHeartbeat(ctx, beats)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestHeartbeatWorkflow(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(HeartbeatWorkflow, workflow.RegisterOptions{Name: HeartbeatWorkflowName})
+	env.RegisterActivityWithOptions(HeartbeatActivity, activity.RegisterOptions{Name: HeartbeatActivityName})
+
+	var heartbeatTimeout time.Duration
+	env.SetOnActivityStartedListener(func(info *activity.Info, _ context.Context, _ converter.EncodedValues) {
+		heartbeatTimeout = info.HeartbeatTimeout
+	})
+
+	env.ExecuteWorkflow(HeartbeatWorkflowName, HeartbeatInput{Duration: 100 * time.Millisecond, Interval: 20 * time.Millisecond})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.Equal(t, 60*time.Millisecond, heartbeatTimeout, "three intervals")
+}
+
+func TestHeartbeatActivity_BeatsEveryInterval(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestActivityEnvironment()
+	env.RegisterActivityWithOptions(HeartbeatActivity, activity.RegisterOptions{Name: HeartbeatActivityName})
+
+	start := time.Now()
+	result, err := env.ExecuteActivity(HeartbeatActivityName, HeartbeatInput{Duration: 110 * time.Millisecond, Interval: 20 * time.Millisecond})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 110*time.Millisecond)
+
+	var beats int
+	require.NoError(t, result.Get(&beats))
+	require.InDelta(t, 5, beats, 1)
+}
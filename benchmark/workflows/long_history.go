@@ -0,0 +1,59 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+// LongHistoryWorkflowName is the registered name for LongHistoryWorkflow.
+const LongHistoryWorkflowName = "LongHistoryWorkflow"
+
+// longHistoryTimer is the timer of each timer step, short enough that the
+// workflow's latency is dominated by its history rather than its sleeps.
+const longHistoryTimer = time.Millisecond
+
+// LongHistoryInput contains the input for LongHistoryWorkflow.
+type LongHistoryInput struct {
+	Steps int // Activities and timers, alternately, that bring the history to the configured length
+}
+
+// LongHistoryWorkflow alternates input.Steps FastActivity executions and
+// short timers, growing its history to the length the generator configured
+// (see config.LongHistorySteps). Every workflow task past the sticky cache
+// replays the whole history, so long histories stress the history branch
+// reads of replay and make sticky cache misses expensive. The steps are fixed
+// up front rather than read from the growing history, so extra events such
+// as workflow task retries don't change the outcome; its HistoryLength is the
+// actual length.
+func LongHistoryWorkflow(ctx workflow.Context, input LongHistoryInput) (Outcome, error) {
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	var outcome Outcome
+	for i := 0; i < input.Steps; i++ {
+		if i%2 == 0 {
+			input := ActivityInput{
+				WorkflowRunID: runID,
+				ActivityIndex: i,
+			}
+			var output ActivityOutput
+			if err := workflow.ExecuteActivity(ctx, FastActivity, input).Get(ctx, &output); err != nil {
+				return outcome, err
+			}
+			outcome.addActivity(output)
+			continue
+		}
+		if err := workflow.Sleep(ctx, longHistoryTimer); err != nil {
+			return outcome, err
+		}
+		outcome.add(runID, i)
+	}
+	// The completion event follows
+	outcome.HistoryLength = workflow.GetInfo(ctx).GetCurrentHistoryLength() + 1
+	return outcome, nil
+}
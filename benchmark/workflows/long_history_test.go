@@ -0,0 +1,39 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestLongHistoryWorkflow_AlternatesActivitiesAndTimers(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(LongHistoryWorkflow, workflow.RegisterOptions{Name: LongHistoryWorkflowName})
+	env.RegisterActivityWithOptions(FastActivity, activity.RegisterOptions{Name: FastActivityName})
+
+	var activities int
+	env.SetOnActivityStartedListener(func(*activity.Info, context.Context, converter.EncodedValues) {
+		activities++
+	})
+	var timers int
+	env.SetOnTimerScheduledListener(func(string, time.Duration) {
+		timers++
+	})
+
+	env.ExecuteWorkflow(LongHistoryWorkflowName, LongHistoryInput{Steps: 5})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var outcome Outcome
+	require.NoError(t, env.GetWorkflowResult(&outcome))
+	require.Equal(t, 5, outcome.Steps)
+	require.Equal(t, 3, activities)
+	require.Equal(t, 2, timers)
+	require.Positive(t, outcome.HistoryLength)
+}
@@ -16,10 +16,18 @@ const MultiActivityWorkflowName = "MultiActivityWorkflow"
 // NoOpActivityName is the registered name for NoOpActivity.
 const NoOpActivityName = "NoOpActivity"
 
-// ActivityInput contains the input for NoOpActivity.
+// ActivityInput contains the input for NoOpActivity and CPUActivity.
 type ActivityInput struct {
 	WorkflowRunID string
 	ActivityIndex int
+
+	CPUIterations int // SHA-256 iterations of CPUActivity; NoOpActivity ignores it
+}
+
+// MultiActivityInput contains the input for MultiActivityWorkflow. Starts
+// without input run sleeping activities.
+type MultiActivityInput struct {
+	CPUIterations int // Run CPUActivity with this many iterations in place of NoOpActivity if positive
 }
 
 // ActivityOutput contains the output from NoOpActivity.
@@ -28,14 +36,19 @@ type ActivityOutput struct {
 	WorkerID   string
 	ActivityID string
 	Attempt    int32
+
+	// The input's run ID and index, echoed back for the workflow's Outcome
+	WorkflowRunID string
+	ActivityIndex int
 }
 
 // MultiActivityWorkflow executes 10 activities total:
 // - 4 concurrent activities that run in parallel and join
 // - 6 sequential activities that run one after another
 //
-// This pattern tests both parallel execution and sequential scheduling overhead.
-func MultiActivityWorkflow(ctx workflow.Context) error {
+// The activities sleep, or burn CPU if input.CPUIterations is positive. This
+// pattern tests both parallel execution and sequential scheduling overhead.
+func MultiActivityWorkflow(ctx workflow.Context, input MultiActivityInput) (Outcome, error) {
 	ao := workflow.ActivityOptions{
 		StartToCloseTimeout: time.Minute,
 	}
@@ -43,16 +56,18 @@ func MultiActivityWorkflow(ctx workflow.Context) error {
 
 	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
 	activityIndex := 0
+	var outcome Outcome
 
 	// Phase 1: Execute 4 activities concurrently
 	var futures []workflow.Future
 	for i := 0; i < 4; i++ {
-		input := ActivityInput{
+		activityInput := ActivityInput{
 			WorkflowRunID: runID,
 			ActivityIndex: activityIndex,
+			CPUIterations: input.CPUIterations,
 		}
 		activityIndex++
-		future := workflow.ExecuteActivity(ctx, NoOpActivity, input)
+		future := workflow.ExecuteActivity(ctx, workActivity(input.CPUIterations), activityInput)
 		futures = append(futures, future)
 	}
 
@@ -60,24 +75,27 @@ func MultiActivityWorkflow(ctx workflow.Context) error {
 	for _, future := range futures {
 		var output ActivityOutput
 		if err := future.Get(ctx, &output); err != nil {
-			return err
+			return outcome, err
 		}
+		outcome.addActivity(output)
 	}
 
 	// Phase 2: Execute 6 activities sequentially
 	for i := 0; i < 6; i++ {
-		input := ActivityInput{
+		activityInput := ActivityInput{
 			WorkflowRunID: runID,
 			ActivityIndex: activityIndex,
+			CPUIterations: input.CPUIterations,
 		}
 		activityIndex++
 		var output ActivityOutput
-		if err := workflow.ExecuteActivity(ctx, NoOpActivity, input).Get(ctx, &output); err != nil {
-			return err
+		if err := workflow.ExecuteActivity(ctx, workActivity(input.CPUIterations), activityInput).Get(ctx, &output); err != nil {
+			return outcome, err
 		}
+		outcome.addActivity(output)
 	}
 
-	return nil
+	return outcome, nil
 }
 
 // NoOpActivity is a minimal activity for testing.
@@ -85,15 +103,18 @@ func MultiActivityWorkflow(ctx workflow.Context) error {
 // Returns metadata about the activity execution.
 func NoOpActivity(ctx context.Context, input ActivityInput) (ActivityOutput, error) {
 	info := activity.GetInfo(ctx)
+	recordExecution(ctx, input)
 
 	// Random sleep between 100ms and 600ms (min 0.1s as per tuning guidance)
 	sleepDuration := time.Duration(100+rand.Intn(500)) * time.Millisecond
 	time.Sleep(sleepDuration)
 
 	return ActivityOutput{
-		TaskQueue:  info.TaskQueue,
-		WorkerID:   info.WorkflowExecution.ID,
-		ActivityID: info.ActivityID,
-		Attempt:    info.Attempt,
+		TaskQueue:     info.TaskQueue,
+		WorkerID:      info.WorkflowExecution.ID,
+		ActivityID:    info.ActivityID,
+		Attempt:       info.Attempt,
+		WorkflowRunID: input.WorkflowRunID,
+		ActivityIndex: input.ActivityIndex,
 	}, nil
 }
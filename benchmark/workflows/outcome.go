@@ -0,0 +1,62 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+)
+
+// Outcome is what every generated benchmark workflow returns: the steps it
+// completed (activities, timers, children, signals or upserts) and a
+// checksum over them in order. A step is folded in only once the workflow
+// has seen it complete, and activity steps are folded from the IDs the
+// activity echoed back, so a lost signal, a skipped or repeated activity or
+// another run's result changes the outcome. ExpectedOutcome computes the
+// outcome a correct run produces.
+type Outcome struct {
+	Steps    int    `json:"steps"`
+	Checksum uint64 `json:"checksum"`
+
+	// FailedAttempts counts the injected activity failures a flaky workflow
+	// retried; failures don't change the steps or checksum
+	FailedAttempts int `json:"failedAttempts,omitempty"`
+
+	// HistoryLength is the history events of a long-history workflow,
+	// including its completion; like FailedAttempts it is not checked
+	HistoryLength int `json:"historyLength,omitempty"`
+}
+
+// ExpectedOutcome returns the outcome of a correct run of runID that
+// completes steps steps.
+func ExpectedOutcome(runID string, steps int) Outcome {
+	var o Outcome
+	for i := 0; i < steps; i++ {
+		o.add(runID, i)
+	}
+	return o
+}
+
+// Matches reports whether o has the steps and checksum of expected,
+// ignoring counters such as FailedAttempts and HistoryLength that vary
+// between correct runs.
+func (o Outcome) Matches(expected Outcome) bool {
+	return o.Steps == expected.Steps && o.Checksum == expected.Checksum
+}
+
+// add folds step of runID into the checksum with FNV-1a.
+func (o *Outcome) add(runID string, step int) {
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:8], o.Checksum)
+	binary.BigEndian.PutUint64(b[8:], uint64(step))
+	h := fnv.New64a()
+	_, _ = h.Write(b[:])
+	_, _ = h.Write([]byte(runID))
+	o.Checksum = h.Sum64()
+	o.Steps++
+}
+
+// addActivity folds the step of an activity's output, as echoed back by
+// NoOpActivity, CPUActivity or FastActivity.
+func (o *Outcome) addActivity(output ActivityOutput) {
+	o.add(output.WorkflowRunID, output.ActivityIndex)
+}
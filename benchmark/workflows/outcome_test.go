@@ -0,0 +1,62 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestExpectedOutcome(t *testing.T) {
+	expected := ExpectedOutcome("run-1", 3)
+	require.Equal(t, 3, expected.Steps)
+	require.Equal(t, expected, ExpectedOutcome("run-1", 3), "deterministic")
+	require.NotEqual(t, expected.Checksum, ExpectedOutcome("run-2", 3).Checksum, "run ID")
+	require.NotEqual(t, expected.Checksum, ExpectedOutcome("run-1", 2).Checksum, "steps")
+	require.Zero(t, ExpectedOutcome("run-1", 0).Checksum)
+}
+
+func TestOutcome_MatchesStepOrder(t *testing.T) {
+	var inOrder, swapped, repeated Outcome
+	for _, step := range []int{0, 1, 2} {
+		inOrder.add("run-1", step)
+	}
+	for _, step := range []int{1, 0, 2} {
+		swapped.add("run-1", step)
+	}
+	for _, step := range []int{0, 1, 1} {
+		repeated.add("run-1", step)
+	}
+
+	expected := ExpectedOutcome("run-1", 3)
+	require.True(t, inOrder.Matches(expected))
+	require.False(t, swapped.Matches(expected))
+	require.False(t, repeated.Matches(expected))
+
+	inOrder.FailedAttempts = 4
+	require.True(t, inOrder.Matches(expected), "failed attempts are ignored")
+}
+
+func TestMultiActivityWorkflow_Outcome(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(MultiActivityWorkflow, workflow.RegisterOptions{Name: MultiActivityWorkflowName})
+	env.RegisterActivityWithOptions(NoOpActivity, activity.RegisterOptions{Name: NoOpActivityName})
+
+	var runID string
+	env.SetOnActivityStartedListener(func(info *activity.Info, _ context.Context, _ converter.EncodedValues) {
+		runID = info.WorkflowExecution.RunID
+	})
+
+	env.ExecuteWorkflow(MultiActivityWorkflowName)
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var outcome Outcome
+	require.NoError(t, env.GetWorkflowResult(&outcome))
+	require.Equal(t, 10, outcome.Steps)
+	require.True(t, outcome.Matches(ExpectedOutcome(runID, 10)))
+}
@@ -25,6 +25,30 @@ func RegisterWorkflows(w worker.Worker) {
 	w.RegisterWorkflowWithOptions(StateTransitionWorkflow, workflow.RegisterOptions{
 		Name: StateTransitionWorkflowName,
 	})
+	w.RegisterWorkflowWithOptions(ContentionWorkflow, workflow.RegisterOptions{
+		Name: ContentionWorkflowName,
+	})
+	w.RegisterWorkflowWithOptions(AggregatorWorkflow, workflow.RegisterOptions{
+		Name: AggregatorWorkflowName,
+	})
+	w.RegisterWorkflowWithOptions(HeartbeatWorkflow, workflow.RegisterOptions{
+		Name: HeartbeatWorkflowName,
+	})
+	w.RegisterWorkflowWithOptions(FlakyWorkflow, workflow.RegisterOptions{
+		Name: FlakyWorkflowName,
+	})
+	w.RegisterWorkflowWithOptions(SearchAttributeWorkflow, workflow.RegisterOptions{
+		Name: SearchAttributeWorkflowName,
+	})
+	w.RegisterWorkflowWithOptions(SideEffectWorkflow, workflow.RegisterOptions{
+		Name: SideEffectWorkflowName,
+	})
+	w.RegisterWorkflowWithOptions(LongHistoryWorkflow, workflow.RegisterOptions{
+		Name: LongHistoryWorkflowName,
+	})
+	w.RegisterWorkflowWithOptions(FanoutWorkflow, workflow.RegisterOptions{
+		Name: FanoutWorkflowName,
+	})
 }
 
 // RegisterActivities registers all benchmark activities with the given worker.
@@ -36,6 +60,45 @@ func RegisterActivities(w worker.Worker) {
 	w.RegisterActivityWithOptions(FastActivity, activity.RegisterOptions{
 		Name: FastActivityName,
 	})
+	w.RegisterActivityWithOptions(HeartbeatActivity, activity.RegisterOptions{
+		Name: HeartbeatActivityName,
+	})
+	w.RegisterActivityWithOptions(FlakyActivity, activity.RegisterOptions{
+		Name: FlakyActivityName,
+	})
+	w.RegisterActivityWithOptions(CPUActivity, activity.RegisterOptions{
+		Name: CPUActivityName,
+	})
+}
+
+// WorkflowNames returns the names of the workflows RegisterWorkflows registers.
+func WorkflowNames() []string {
+	return []string{
+		SimpleWorkflowName,
+		MultiActivityWorkflowName,
+		TimerWorkflowName,
+		ChildWorkflowName,
+		StateTransitionWorkflowName,
+		ContentionWorkflowName,
+		AggregatorWorkflowName,
+		HeartbeatWorkflowName,
+		FlakyWorkflowName,
+		SearchAttributeWorkflowName,
+		SideEffectWorkflowName,
+		LongHistoryWorkflowName,
+		FanoutWorkflowName,
+	}
+}
+
+// ActivityNames returns the names of the activities RegisterActivities registers.
+func ActivityNames() []string {
+	return []string{
+		NoOpActivityName,
+		FastActivityName,
+		HeartbeatActivityName,
+		FlakyActivityName,
+		CPUActivityName,
+	}
 }
 
 // RegisterAll registers all workflows and activities with the given worker.
@@ -0,0 +1,69 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// SearchAttributeWorkflowName is the registered name for SearchAttributeWorkflow.
+const SearchAttributeWorkflowName = "SearchAttributeWorkflow"
+
+// Custom search attributes upserted by SearchAttributeWorkflow, one of each
+// scalar type the visibility store indexes. They must be registered on the
+// namespace before the workflow runs; see SearchAttributes.
+var (
+	BenchmarkKeywordAttribute  = temporal.NewSearchAttributeKeyKeyword("BenchmarkKeyword")
+	BenchmarkIntAttribute      = temporal.NewSearchAttributeKeyInt64("BenchmarkInt")
+	BenchmarkDoubleAttribute   = temporal.NewSearchAttributeKeyFloat64("BenchmarkDouble")
+	BenchmarkBoolAttribute     = temporal.NewSearchAttributeKeyBool("BenchmarkBool")
+	BenchmarkDatetimeAttribute = temporal.NewSearchAttributeKeyTime("BenchmarkDatetime")
+)
+
+// SearchAttributes returns the types of the custom search attributes
+// SearchAttributeWorkflow upserts, by name.
+func SearchAttributes() map[string]enumspb.IndexedValueType {
+	attributes := make(map[string]enumspb.IndexedValueType)
+	for _, key := range []temporal.SearchAttributeKey{
+		BenchmarkKeywordAttribute,
+		BenchmarkIntAttribute,
+		BenchmarkDoubleAttribute,
+		BenchmarkBoolAttribute,
+		BenchmarkDatetimeAttribute,
+	} {
+		attributes[key.GetName()] = key.GetValueType()
+	}
+	return attributes
+}
+
+// SearchAttributeInput contains the input for SearchAttributeWorkflow.
+type SearchAttributeInput struct {
+	Upserts int // Search attribute upserts per workflow
+}
+
+// SearchAttributeWorkflow upserts every benchmark search attribute
+// input.Upserts times with new values, then completes. Each upsert is its own
+// history event and visibility task, so the workflow exercises the visibility
+// store's write path far more than start and close alone. Its outcome has a
+// step per upsert.
+func SearchAttributeWorkflow(ctx workflow.Context, input SearchAttributeInput) (Outcome, error) {
+	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	var outcome Outcome
+	for i := 0; i < input.Upserts; i++ {
+		err := workflow.UpsertTypedSearchAttributes(ctx,
+			BenchmarkKeywordAttribute.ValueSet(fmt.Sprintf("upsert-%d", i)),
+			BenchmarkIntAttribute.ValueSet(int64(i)),
+			BenchmarkDoubleAttribute.ValueSet(float64(i)/2),
+			BenchmarkBoolAttribute.ValueSet(i%2 == 0),
+			BenchmarkDatetimeAttribute.ValueSet(workflow.Now(ctx)),
+		)
+		if err != nil {
+			return outcome, err
+		}
+		outcome.add(runID, i)
+	}
+	return outcome, nil
+}
@@ -0,0 +1,32 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestSearchAttributeWorkflow_UpsertsAttributes(t *testing.T) {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(SearchAttributeWorkflow, workflow.RegisterOptions{Name: SearchAttributeWorkflowName})
+	env.OnUpsertTypedSearchAttributes(mock.Anything).Return(nil).Times(3)
+
+	env.ExecuteWorkflow(SearchAttributeWorkflowName, SearchAttributeInput{Upserts: 3})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var outcome Outcome
+	require.NoError(t, env.GetWorkflowResult(&outcome))
+	require.Equal(t, 3, outcome.Steps)
+	env.AssertExpectations(t)
+}
+
+func TestSearchAttributes(t *testing.T) {
+	attributes := SearchAttributes()
+	require.Len(t, attributes, 5)
+	require.Contains(t, attributes, BenchmarkKeywordAttribute.GetName())
+	require.Contains(t, attributes, BenchmarkDatetimeAttribute.GetName())
+}
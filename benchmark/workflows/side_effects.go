@@ -0,0 +1,64 @@
+// Package workflows provides benchmark workflow definitions.
+package workflows
+
+import (
+	"math/rand"
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+// SideEffectWorkflowName is the registered name for SideEffectWorkflow.
+const SideEffectWorkflowName = "SideEffectWorkflow"
+
+// SideEffectInput contains the input for SideEffectWorkflow.
+type SideEffectInput struct {
+	SideEffects int // workflow.SideEffect calls per workflow, each paired with a workflow.Now call
+	Activities  int // Activities interleaved evenly among the side effects
+
+	CPUIterations int // Run CPUActivity with this many iterations in place of NoOpActivity if positive
+}
+
+// SideEffectWorkflow makes input.SideEffects workflow.SideEffect calls, each
+// recording a random value, and reads workflow.Now after each, with
+// input.Activities NoOpActivity executions spread evenly among them. Each side
+// effect is recorded as a marker event in history, so comparing this
+// workflow's history size and latency with the same activities alone shows
+// the marker overhead; workflow.Now adds no events and measures only the
+// deterministic time reads on replay. The outcome's steps are the
+// activities; the markers are not counted.
+func SideEffectWorkflow(ctx workflow.Context, input SideEffectInput) (Outcome, error) {
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	activities := 0
+	var outcome Outcome
+	for i := 0; i < input.SideEffects; i++ {
+		var value int64
+		if err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+			return rand.Int63()
+		}).Get(&value); err != nil {
+			return outcome, err
+		}
+		_ = workflow.Now(ctx)
+
+		// Run the activities due by this point of the sequence
+		for activities < input.Activities && (activities+1)*input.SideEffects <= (i+1)*input.Activities {
+			activityInput := ActivityInput{
+				WorkflowRunID: runID,
+				ActivityIndex: activities,
+				CPUIterations: input.CPUIterations,
+			}
+			activities++
+			var output ActivityOutput
+			if err := workflow.ExecuteActivity(ctx, workActivity(input.CPUIterations), activityInput).Get(ctx, &output); err != nil {
+				return outcome, err
+			}
+			outcome.addActivity(output)
+		}
+	}
+	return outcome, nil
+}
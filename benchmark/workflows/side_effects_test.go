@@ -0,0 +1,45 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestSideEffectWorkflow_InterleavesActivities(t *testing.T) {
+	tests := []struct {
+		name        string
+		sideEffects int
+		activities  int
+	}{
+		{"fewer activities", 10, 3},
+		{"more activities", 2, 5},
+		{"no activities", 4, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+			env.RegisterWorkflowWithOptions(SideEffectWorkflow, workflow.RegisterOptions{Name: SideEffectWorkflowName})
+			env.RegisterActivityWithOptions(NoOpActivity, activity.RegisterOptions{Name: NoOpActivityName})
+
+			var executed int
+			env.SetOnActivityStartedListener(func(*activity.Info, context.Context, converter.EncodedValues) {
+				executed++
+			})
+
+			env.ExecuteWorkflow(SideEffectWorkflowName, SideEffectInput{SideEffects: tt.sideEffects, Activities: tt.activities})
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+
+			var outcome Outcome
+			require.NoError(t, env.GetWorkflowResult(&outcome))
+			require.Equal(t, tt.activities, outcome.Steps)
+			require.Equal(t, tt.activities, executed)
+		})
+	}
+}
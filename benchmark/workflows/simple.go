@@ -15,6 +15,6 @@ const SimpleWorkflowName = "SimpleWorkflow"
 //
 // Requirements: 1.1 - THE Workflow_Generator SHALL support a simple
 // "hello world" workflow that completes immediately.
-func SimpleWorkflow(ctx workflow.Context) error {
-	return nil
+func SimpleWorkflow(ctx workflow.Context) (Outcome, error) {
+	return Outcome{}, nil
 }
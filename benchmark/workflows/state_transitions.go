@@ -32,13 +32,14 @@ const FastActivityName = "FastActivity"
 //
 // At 50 WPS, this generates ~3,000 state transitions/second.
 // At 100 WPS, this generates ~6,000 state transitions/second.
-func StateTransitionWorkflow(ctx workflow.Context) error {
+func StateTransitionWorkflow(ctx workflow.Context) (Outcome, error) {
 	ao := workflow.ActivityOptions{
 		StartToCloseTimeout: time.Minute,
 	}
 	ctx = workflow.WithActivityOptions(ctx, ao)
 
 	runID := workflow.GetInfo(ctx).WorkflowExecution.RunID
+	var outcome Outcome
 
 	// Execute 10 activities serially to avoid OCC conflicts
 	for i := 0; i < 10; i++ {
@@ -48,11 +49,12 @@ func StateTransitionWorkflow(ctx workflow.Context) error {
 		}
 		var output ActivityOutput
 		if err := workflow.ExecuteActivity(ctx, FastActivity, input).Get(ctx, &output); err != nil {
-			return err
+			return outcome, err
 		}
+		outcome.addActivity(output)
 	}
 
-	return nil
+	return outcome, nil
 }
 
 // FastActivity completes instantly with minimal overhead.
@@ -60,11 +62,14 @@ func StateTransitionWorkflow(ctx workflow.Context) error {
 // Uses the same input/output types as NoOpActivity for consistency.
 func FastActivity(ctx context.Context, input ActivityInput) (ActivityOutput, error) {
 	info := activity.GetInfo(ctx)
+	recordExecution(ctx, input)
 
 	return ActivityOutput{
-		TaskQueue:  info.TaskQueue,
-		WorkerID:   info.WorkflowExecution.ID,
-		ActivityID: info.ActivityID,
-		Attempt:    info.Attempt,
+		TaskQueue:     info.TaskQueue,
+		WorkerID:      info.WorkflowExecution.ID,
+		ActivityID:    info.ActivityID,
+		Attempt:       info.Attempt,
+		WorkflowRunID: input.WorkflowRunID,
+		ActivityIndex: input.ActivityIndex,
 	}, nil
 }
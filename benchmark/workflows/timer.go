@@ -19,10 +19,15 @@ const TimerWorkflowName = "TimerWorkflow"
 //
 // Requirements: 1.3 - THE Workflow_Generator SHALL support a workflow
 // with configurable sleep/timer duration.
-func TimerWorkflow(ctx workflow.Context, duration time.Duration) error {
+func TimerWorkflow(ctx workflow.Context, duration time.Duration) (Outcome, error) {
+	var outcome Outcome
 	// Validate duration is positive
 	if duration < 0 {
-		return fmt.Errorf("duration must be non-negative, got %v", duration)
+		return outcome, fmt.Errorf("duration must be non-negative, got %v", duration)
 	}
-	return workflow.Sleep(ctx, duration)
+	if err := workflow.Sleep(ctx, duration); err != nil {
+		return outcome, err
+	}
+	outcome.add(workflow.GetInfo(ctx).WorkflowExecution.RunID, 0)
+	return outcome, nil
 }
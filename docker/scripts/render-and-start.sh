@@ -3,7 +3,8 @@ set -eu
 
 # Render Temporal config template and start the server
 # This script:
-# 1. Selects environment-specific dynamic config (dev, bench, prod)
+# 1. Selects environment-specific dynamic config (dev, bench, prod), merging
+#    TEMPORAL_DYNAMIC_CONFIG_OVERRIDES into it if set
 # 2. Resolves network binding addresses (with ECS metadata support)
 # 3. Renders the persistence config template using environment variables
 # 4. Starts temporal-server with the rendered config
@@ -30,6 +31,23 @@ else
     ln -sf "/etc/temporal/config/dynamicconfig/prod.yaml" "$DYNAMIC_CONFIG_LINK"
 fi
 
+# Merge run-scoped overrides (set by benchmark scenarios) into the dynamic config
+# Each override line is "key: <value list>"; the base file's entries for the
+# overridden keys are dropped and the overrides appended
+if [ -n "${TEMPORAL_DYNAMIC_CONFIG_OVERRIDES:-}" ]; then
+    BASE_CONFIG=$(readlink -f "$DYNAMIC_CONFIG_LINK")
+    MERGED_CONFIG="/etc/temporal/config/dynamicconfig/overridden.yaml"
+    OVERRIDE_KEYS=$(printf '%s\n' "$TEMPORAL_DYNAMIC_CONFIG_OVERRIDES" | sed -n 's/^\([^ #][^:]*\):.*/\1/p' | tr '\n' ' ')
+    echo "Applying dynamic config overrides: $OVERRIDE_KEYS"
+    awk -v keys="$OVERRIDE_KEYS" '
+        BEGIN { n = split(keys, k, " "); for (i = 1; i <= n; i++) skip[tolower(k[i])] = 1 }
+        /^[^ #]/ { key = $0; sub(/:.*/, "", key); dropping = (tolower(key) in skip) }
+        !dropping
+    ' "$BASE_CONFIG" > "$MERGED_CONFIG"
+    printf '\n# Run-scoped overrides (TEMPORAL_DYNAMIC_CONFIG_OVERRIDES)\n%s\n' "$TEMPORAL_DYNAMIC_CONFIG_OVERRIDES" >> "$MERGED_CONFIG"
+    ln -sf "$MERGED_CONFIG" "$DYNAMIC_CONFIG_LINK"
+fi
+
 # Determine the broadcast address for cluster membership
 # In ECS with awsvpc mode, we need to get the task's private IP from the metadata endpoint
 if [ -z "${TEMPORAL_BROADCAST_ADDRESS:-}" ]; then
@@ -144,6 +144,7 @@ docker buildx build \
     --platform "linux/${TARGET_ARCH}" \
     --build-arg "TARGETARCH=${TARGET_ARCH}" \
     --build-arg "ALPINE_TAG=3.23" \
+    --build-arg "GIT_SHA=${GIT_SHA}" \
     --file Dockerfile \
     --tag "${ECR_REPO_URL}:latest" \
     --tag "${ECR_REPO_URL}:${VERSION_TAG}" \
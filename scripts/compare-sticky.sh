@@ -0,0 +1,132 @@
+#!/bin/bash
+# -----------------------------------------------------------------------------
+# Temporal Benchmark Sticky Execution Comparison
+# -----------------------------------------------------------------------------
+# This script runs the same benchmark twice with the embedded worker: once
+# with sticky execution (the default) and once with BENCHMARK_DISABLE_STICKY,
+# so every workflow task replays its history, and compares the two runs.
+# Server-side history reads are only compared when server metrics are scraped
+# (BENCHMARK_SERVER_METRICS_URLS).
+#
+# The runs use separate processes because the SDK's sticky cache is sized
+# once per process. All other BENCHMARK_* and TEMPORAL_* variables in the
+# environment configure both runs.
+#
+# Usage:
+#   ./scripts/compare-sticky.sh [OPTIONS]
+#
+# Options:
+#   --binary PATH           Benchmark binary (default: built from benchmark/)
+#   --output DIR            Directory for the results of both runs (default: a temporary directory)
+#   -h, --help              Show this help message
+#
+# Examples:
+#   TEMPORAL_ADDRESS=localhost:7233 BENCHMARK_TARGET_RATE=50 ./scripts/compare-sticky.sh
+#   BENCHMARK_WORKFLOW_TYPE=state-transitions ./scripts/compare-sticky.sh --output ./sticky-comparison
+#
+# -----------------------------------------------------------------------------
+
+set -euo pipefail
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+PROJECT_ROOT="$(cd "$SCRIPT_DIR/.." && pwd)"
+
+# Colors for output
+RED='\033[0;31m'
+GREEN='\033[0;32m'
+NC='\033[0m' # No Color
+
+BINARY=""
+OUTPUT_DIR=""
+
+log_info() {
+    echo -e "${GREEN}[INFO]${NC} $1" >&2
+}
+
+log_error() {
+    echo -e "${RED}[ERROR]${NC} $1" >&2
+}
+
+show_help() {
+    head -27 "$0" | tail -25
+    exit 0
+}
+
+while [[ $# -gt 0 ]]; do
+    case $1 in
+        --binary)
+            BINARY="$2"
+            shift 2
+            ;;
+        --output)
+            OUTPUT_DIR="$2"
+            shift 2
+            ;;
+        -h|--help)
+            show_help
+            ;;
+        *)
+            log_error "Unknown option: $1"
+            exit 1
+            ;;
+    esac
+done
+
+if ! command -v jq &> /dev/null; then
+    log_error "jq is required"
+    exit 1
+fi
+
+if [ -z "$OUTPUT_DIR" ]; then
+    OUTPUT_DIR="$(mktemp -d)"
+fi
+mkdir -p "$OUTPUT_DIR"
+
+if [ -z "$BINARY" ]; then
+    BINARY="$OUTPUT_DIR/benchmark"
+    log_info "Building benchmark binary..."
+    (cd "$PROJECT_ROOT/benchmark" && go build -o "$BINARY" ./cmd/benchmark)
+fi
+
+# run_benchmark NAME DISABLE_STICKY writes the run's result to $OUTPUT_DIR/NAME.jsonl
+run_benchmark() {
+    local name="$1"
+    local disable_sticky="$2"
+    local result_file="$OUTPUT_DIR/$name.jsonl"
+
+    rm -f "$result_file"
+    log_info "Running $name benchmark (BENCHMARK_DISABLE_STICKY=$disable_sticky)..."
+    # A run failing its thresholds still produces a result worth comparing
+    BENCHMARK_ROLE=all \
+    BENCHMARK_DISABLE_STICKY="$disable_sticky" \
+    BENCHMARK_RESULT_SINKS="file:$result_file" \
+        "$BINARY" || log_info "$name benchmark exited with status $?"
+
+    if [ ! -s "$result_file" ]; then
+        log_error "$name benchmark produced no result"
+        exit 1
+    fi
+}
+
+run_benchmark sticky false
+run_benchmark non-sticky true
+
+echo ""
+echo "STICKY EXECUTION COMPARISON"
+echo "─────────────────────────────────────────────────────────────────"
+jq -rs '
+  def num(f): if f == null then "n/a" else (f * 100 | round / 100 | tostring) end;
+  def ratio(a; b): if a == null or b == null or a == 0 then "n/a" else ((b / a) * 100 | round / 100 | tostring) + "x" end;
+  (.[0] | .results) as $s | (.[1] | .results) as $n |
+  def pad(n): if n > length then . + (" " * (n - length)) else . end;
+  ["Metric", "Sticky", "Non-sticky", "Ratio"],
+  ["Throughput (wf/s)", num($s.actualRate), num($n.actualRate), ratio($s.actualRate; $n.actualRate)],
+  ["Latency P50 (ms)", num($s.latency.p50), num($n.latency.p50), ratio($s.latency.p50; $n.latency.p50)],
+  ["Latency P99 (ms)", num($s.latency.p99), num($n.latency.p99), ratio($s.latency.p99; $n.latency.p99)],
+  ["History reads", num($s.historyReads), num($n.historyReads), ratio($s.historyReads; $n.historyReads)],
+  ["History reads/workflow", num($s.historyReadsPerWorkflow), num($n.historyReadsPerWorkflow), ratio($s.historyReadsPerWorkflow; $n.historyReadsPerWorkflow)],
+  ["OCC conflicts", num($s.occConflicts.count), num($n.occConflicts.count), ratio($s.occConflicts.count; $n.occConflicts.count)]
+  | "  \(.[0] | pad(24))\(.[1] | pad(12))\(.[2] | pad(12))\(.[3])"
+' "$OUTPUT_DIR/sticky.jsonl" "$OUTPUT_DIR/non-sticky.jsonl"
+echo ""
+log_info "Results written to $OUTPUT_DIR"
@@ -15,14 +15,29 @@
 #   environment             Environment to run benchmark in (dev, bench, prod)
 #
 # Options:
-#   --workflow-type TYPE    Workflow type: simple, multi-activity, timer, child-workflow, state-transitions (default: simple)
+#   --workflow-type TYPE    Workflow type: simple, multi-activity, timer, child-workflow, state-transitions, contention, heartbeat, flaky, search-attributes, side-effects, long-history, fanout, mixed (default: simple)
+#   --workflow-mix MIX      Weights of the workflow types of a mixed workload, e.g. "simple=50,timer=30,multi-activity=20"
 #   --rate RATE             Target workflows per second (default: 10)
 #   --duration DURATION     Test duration (default: 1m)
 #   --ramp-up DURATION      Ramp-up period (default: 10s)
 #   --workers COUNT         Number of parallel workers (default: 4)
 #   --namespace NAME        Namespace for benchmark workflows (default: benchmark)
-#   --activity-count COUNT  Activities for multi-activity workflow (default: 5)
+#   --activity-count COUNT  Activities for multi-activity, flaky and side-effects workflows (default: 5)
+#   --fan-in COUNT          Workflows signalling each aggregator for contention workflow (default: 100)
+#   --heartbeat-duration D  Activity run time for heartbeat workflow (default: 30s)
+#   --heartbeat-interval D  Time between activity heartbeats for heartbeat workflow (default: 5s)
+#   --failure-rate RATE     Probability an activity attempt fails for flaky workflow (default: 0.2)
+#   --retry-attempts COUNT  Attempts per activity for flaky workflow (default: 3)
+#   --upserts COUNT         Search attribute upserts per workflow for search-attributes workflow (default: 5)
+#   --side-effects COUNT    SideEffect calls per workflow for side-effects workflow (default: 20)
+#   --history-events COUNT  Approximate history events per workflow for long-history workflow (default: 1000)
+#   --fanout-width COUNT    Parallel activities per stage for fanout workflow (default: 4)
+#   --fanout-depth COUNT    Sequential stages for fanout workflow (default: 3)
+#   --fanout-join JOIN      Activities each fanout stage waits for: all, quorum or any (default: all)
+#   --activity-work WORK    Work of multi-activity, side-effects and fanout activities: sleep or cpu (default: sleep)
+#   --cpu-iterations COUNT  SHA-256 iterations per activity with --activity-work cpu (default: 100000)
 #   --generator-only        Run in generator-only mode (use separate benchmark workers)
+#   --worker-scaling SCHED  Scale benchmark workers on schedule, e.g. "0s=2,5m=4,10m=8" (requires --generator-only)
 #   --wait                  Wait for task to complete and show results
 #   -h, --help              Show this help message
 #
@@ -30,6 +45,7 @@
 #   ./scripts/run-benchmark.sh dev
 #   ./scripts/run-benchmark.sh dev --rate 20 --duration 5m --wait
 #   ./scripts/run-benchmark.sh bench --workflow-type multi-activity --rate 100 --generator-only
+#   ./scripts/run-benchmark.sh bench --generator-only --duration 15m --worker-scaling "0s=2,5m=4,10m=8"
 #
 # -----------------------------------------------------------------------------
 
@@ -51,17 +67,32 @@ AVAILABLE_ENVS=("dev" "bench" "prod")
 # Defaults
 ENVIRONMENT=""
 WORKFLOW_TYPE="simple"
+WORKFLOW_MIX=""
 TARGET_RATE="10"
 DURATION="1m"
 RAMP_UP="10s"
 WORKER_COUNT="4"
 ACTIVITY_COUNT="5"
+FAN_IN="100"
+HEARTBEAT_DURATION="30s"
+HEARTBEAT_INTERVAL="5s"
+FAILURE_RATE="0.2"
+RETRY_ATTEMPTS="3"
+UPSERTS="5"
+SIDE_EFFECTS="20"
+HISTORY_EVENTS="1000"
+FANOUT_WIDTH="4"
+FANOUT_DEPTH="3"
+FANOUT_JOIN="all"
+ACTIVITY_WORK="sleep"
+CPU_ITERATIONS="100000"
 NAMESPACE="benchmark"
 GENERATOR_ONLY=false
+WORKER_SCALING_SCHEDULE=""
 WAIT_FOR_COMPLETION=false
 
 show_usage() {
-    head -34 "$0" | tail -32
+    head -47 "$0" | tail -45
     exit 0
 }
 
@@ -93,6 +124,10 @@ while [[ $# -gt 0 ]]; do
             WORKFLOW_TYPE="$2"
             shift 2
             ;;
+        --workflow-mix)
+            WORKFLOW_MIX="$2"
+            shift 2
+            ;;
         --rate)
             TARGET_RATE="$2"
             shift 2
@@ -113,6 +148,58 @@ while [[ $# -gt 0 ]]; do
             ACTIVITY_COUNT="$2"
             shift 2
             ;;
+        --fan-in)
+            FAN_IN="$2"
+            shift 2
+            ;;
+        --heartbeat-duration)
+            HEARTBEAT_DURATION="$2"
+            shift 2
+            ;;
+        --heartbeat-interval)
+            HEARTBEAT_INTERVAL="$2"
+            shift 2
+            ;;
+        --failure-rate)
+            FAILURE_RATE="$2"
+            shift 2
+            ;;
+        --retry-attempts)
+            RETRY_ATTEMPTS="$2"
+            shift 2
+            ;;
+        --upserts)
+            UPSERTS="$2"
+            shift 2
+            ;;
+        --side-effects)
+            SIDE_EFFECTS="$2"
+            shift 2
+            ;;
+        --history-events)
+            HISTORY_EVENTS="$2"
+            shift 2
+            ;;
+        --fanout-width)
+            FANOUT_WIDTH="$2"
+            shift 2
+            ;;
+        --fanout-depth)
+            FANOUT_DEPTH="$2"
+            shift 2
+            ;;
+        --fanout-join)
+            FANOUT_JOIN="$2"
+            shift 2
+            ;;
+        --activity-work)
+            ACTIVITY_WORK="$2"
+            shift 2
+            ;;
+        --cpu-iterations)
+            CPU_ITERATIONS="$2"
+            shift 2
+            ;;
         --namespace)
             NAMESPACE="$2"
             shift 2
@@ -121,6 +208,10 @@ while [[ $# -gt 0 ]]; do
             GENERATOR_ONLY=true
             shift
             ;;
+        --worker-scaling)
+            WORKER_SCALING_SCHEDULE="$2"
+            shift 2
+            ;;
         --wait)
             WAIT_FOR_COMPLETION=true
             shift
@@ -146,6 +237,17 @@ fi
 
 validate_environment "$ENVIRONMENT"
 
+if [ -n "$WORKER_SCALING_SCHEDULE" ] && [ "$GENERATOR_ONLY" != true ]; then
+    echo -e "${RED}Error: --worker-scaling requires --generator-only${NC}"
+    exit 1
+fi
+
+# Generator-only runs leave workflow processing to the worker service
+ROLE="all"
+if [ "$GENERATOR_ONLY" = true ]; then
+    ROLE="generate"
+fi
+
 # Get terraform values
 ENV_DIR="$PROJECT_ROOT/terraform/envs/$ENVIRONMENT"
 if [ ! -d "$ENV_DIR" ]; then
@@ -162,10 +264,11 @@ CLUSTER_NAME=$(echo "$TERRAFORM_OUTPUT" | jq -r '.ecs_cluster_name.value')
 REGION=$(echo "$TERRAFORM_OUTPUT" | jq -r '.region.value // "eu-west-1"')
 GENERATOR_SERVICE=$(echo "$TERRAFORM_OUTPUT" | jq -r '.benchmark_generator_service_name.value // empty')
 
+PROJECT_NAME="${CLUSTER_NAME%-cluster}"
 if [ -z "$GENERATOR_SERVICE" ]; then
-    PROJECT_NAME="${CLUSTER_NAME%-cluster}"
     GENERATOR_SERVICE="${PROJECT_NAME}-benchmark-generator"
 fi
+WORKER_SERVICE="${PROJECT_NAME}-benchmark-worker"
 
 echo ""
 echo "Configuration:"
@@ -174,10 +277,16 @@ echo "  Cluster:        $CLUSTER_NAME"
 echo "  Region:         $REGION"
 echo "  Service:        $GENERATOR_SERVICE"
 echo "  Workflow Type:  $WORKFLOW_TYPE"
+if [ -n "$WORKFLOW_MIX" ]; then
+    echo "  Workflow Mix:   $WORKFLOW_MIX"
+fi
 echo "  Target Rate:    $TARGET_RATE WPS"
 echo "  Duration:       $DURATION"
 echo "  Namespace:      $NAMESPACE"
 echo "  Generator Only: $GENERATOR_ONLY"
+if [ -n "$WORKER_SCALING_SCHEDULE" ]; then
+    echo "  Worker Scaling: $WORKER_SCALING_SCHEDULE ($WORKER_SERVICE)"
+fi
 echo ""
 
 # Get current task definition
@@ -206,26 +315,45 @@ ENV_OVERRIDES=$(cat <<EOF
 [
   {"name": "BENCHMARK_NAMESPACE", "value": "$NAMESPACE"},
   {"name": "BENCHMARK_WORKFLOW_TYPE", "value": "$WORKFLOW_TYPE"},
+  {"name": "BENCHMARK_WORKFLOW_MIX", "value": "$WORKFLOW_MIX"},
   {"name": "BENCHMARK_TARGET_RATE", "value": "$TARGET_RATE"},
   {"name": "BENCHMARK_DURATION", "value": "$DURATION"},
   {"name": "BENCHMARK_RAMP_UP", "value": "$RAMP_UP"},
   {"name": "BENCHMARK_WORKER_COUNT", "value": "$WORKER_COUNT"},
   {"name": "BENCHMARK_ACTIVITY_COUNT", "value": "$ACTIVITY_COUNT"},
-  {"name": "BENCHMARK_GENERATOR_ONLY", "value": "$GENERATOR_ONLY"},
+  {"name": "BENCHMARK_CONTENTION_FAN_IN", "value": "$FAN_IN"},
+  {"name": "BENCHMARK_HEARTBEAT_DURATION", "value": "$HEARTBEAT_DURATION"},
+  {"name": "BENCHMARK_HEARTBEAT_INTERVAL", "value": "$HEARTBEAT_INTERVAL"},
+  {"name": "BENCHMARK_FAILURE_RATE", "value": "$FAILURE_RATE"},
+  {"name": "BENCHMARK_RETRY_MAX_ATTEMPTS", "value": "$RETRY_ATTEMPTS"},
+  {"name": "BENCHMARK_SEARCH_ATTRIBUTE_UPSERTS", "value": "$UPSERTS"},
+  {"name": "BENCHMARK_SIDE_EFFECTS", "value": "$SIDE_EFFECTS"},
+  {"name": "BENCHMARK_LONG_HISTORY_EVENTS", "value": "$HISTORY_EVENTS"},
+  {"name": "BENCHMARK_FANOUT_WIDTH", "value": "$FANOUT_WIDTH"},
+  {"name": "BENCHMARK_FANOUT_DEPTH", "value": "$FANOUT_DEPTH"},
+  {"name": "BENCHMARK_FANOUT_JOIN", "value": "$FANOUT_JOIN"},
+  {"name": "BENCHMARK_ACTIVITY_WORK", "value": "$ACTIVITY_WORK"},
+  {"name": "BENCHMARK_ACTIVITY_CPU_ITERATIONS", "value": "$CPU_ITERATIONS"},
+  {"name": "BENCHMARK_ROLE", "value": "$ROLE"},
   {"name": "TEMPORAL_ADDRESS", "value": "temporal-frontend:7233"},
   {"name": "BENCHMARK_ITERATIONS", "value": "1"},
   {"name": "BENCHMARK_MAX_P99_LATENCY", "value": "5s"},
-  {"name": "BENCHMARK_MIN_THROUGHPUT", "value": "50"}
+  {"name": "BENCHMARK_MIN_THROUGHPUT", "value": "50"},
+  {"name": "BENCHMARK_WORKER_SCALING_SCHEDULE", "value": "$WORKER_SCALING_SCHEDULE"},
+  {"name": "BENCHMARK_WORKER_SCALING_CLUSTER", "value": "$CLUSTER_NAME"},
+  {"name": "BENCHMARK_WORKER_SCALING_SERVICE", "value": "$WORKER_SERVICE"}
 ]
 EOF
 )
 
 # Update the container definitions with new environment variables
-# Find the benchmark container and replace its environment
+# Find the benchmark container and replace its environment, keeping the
+# cost-allocation memo, run registration, run catalog, namespace TTL and
+# sticky execution set by Terraform
 UPDATED_CONTAINER_DEFS=$(echo "$TASK_DEF" | jq --argjson env "$ENV_OVERRIDES" '
   .containerDefinitions | map(
     if .name == "benchmark" then
-      .environment = $env
+      .environment = $env + [(.environment // [])[] | select(.name == "BENCHMARK_MEMO" or .name == "BENCHMARK_RUN_REGISTRY" or .name == "BENCHMARK_RUN_CATALOG" or .name == "BENCHMARK_NAMESPACE_TTL" or .name == "BENCHMARK_DISABLE_STICKY")]
     else
       .
     end
@@ -552,6 +552,7 @@ module "benchmark" {
   subnet_ids                     = module.vpc.private_subnet_ids
   service_connect_namespace_arn  = module.ecs_cluster.service_connect_namespace_arn
   execution_role_arn             = module.iam.execution_role_arn
+  temporal_task_role_arn         = module.iam.temporal_task_role_arn
   prometheus_workspace_arn       = module.observability.prometheus_workspace_arn
   frontend_security_group_id     = module.temporal_frontend.security_group_id
   instance_security_group_id     = module.ec2_capacity.instance_security_group_id
@@ -501,6 +501,7 @@ output "run_benchmark_command" {
     aws ecs run-task \
       --cluster ${module.ecs_cluster.cluster_name} \
       --task-definition ${module.benchmark[0].task_definition_arn} \
+      --propagate-tags TASK_DEFINITION \
       --capacity-provider-strategy capacityProvider=${module.benchmark[0].capacity_provider_name},weight=1 \
       --network-configuration "awsvpcConfiguration={subnets=[${module.vpc.private_subnet_ids[0]}],securityGroups=[${module.benchmark[0].security_group_id}],assignPublicIp=DISABLED}" \
       --overrides '{"containerOverrides":[{"name":"benchmark","environment":[{"name":"BENCHMARK_RATE","value":"100"},{"name":"BENCHMARK_DURATION","value":"5m"}]}]}' \
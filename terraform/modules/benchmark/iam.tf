@@ -99,3 +99,126 @@ resource "aws_iam_role_policy" "benchmark_ssm" {
   })
 }
 
+
+# Worker service scaling for worker scaling experiments
+resource "aws_iam_role_policy" "benchmark_worker_scaling" {
+  name = "worker-service-scaling"
+  role = aws_iam_role.benchmark_task.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "ecs:DescribeServices",
+        "ecs:UpdateService"
+      ]
+      Resource = aws_ecs_service.benchmark_worker.id
+    }]
+  })
+}
+
+# History task restarts for shard churn scenarios
+resource "aws_iam_role_policy" "benchmark_shard_churn" {
+  name = "history-task-restarts"
+  role = aws_iam_role.benchmark_task.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["ecs:ListTasks"]
+        Resource = "*"
+        Condition = {
+          ArnEquals = { "ecs:cluster" = var.cluster_id }
+        }
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["ecs:StopTask"]
+        Resource = "arn:aws:ecs:${var.region}:*:task/${var.cluster_name}/*"
+        Condition = {
+          ArnEquals = { "ecs:cluster" = var.cluster_id }
+        }
+      }
+    ]
+  })
+}
+
+# Temporal service redeploys for scenario dynamic config overrides
+resource "aws_iam_role_policy" "benchmark_dynamic_config" {
+  name = "temporal-dynamic-config-overrides"
+  role = aws_iam_role.benchmark_task.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["ecs:DescribeServices", "ecs:UpdateService"]
+        Resource = [for s in local.temporal_services : "arn:aws:ecs:${var.region}:*:service/${var.cluster_name}/${s}"]
+      },
+      {
+        Effect = "Allow"
+        Action = [
+          "ecs:DescribeTaskDefinition",
+          "ecs:RegisterTaskDefinition",
+          "ecs:DeregisterTaskDefinition",
+          "ecs:TagResource"
+        ]
+        Resource = "*"
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["iam:PassRole"]
+        Resource = [var.execution_role_arn, var.temporal_task_role_arn]
+      }
+    ]
+  })
+}
+
+# Frontend task availability zones for topology tagging
+resource "aws_iam_role_policy" "benchmark_topology" {
+  name = "frontend-task-topology"
+  role = aws_iam_role.benchmark_task.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = ["ecs:ListTasks"]
+        Resource = "*"
+        Condition = {
+          ArnEquals = { "ecs:cluster" = var.cluster_id }
+        }
+      },
+      {
+        Effect   = "Allow"
+        Action   = ["ecs:DescribeTasks"]
+        Resource = "arn:aws:ecs:${var.region}:*:task/${var.cluster_name}/*"
+        Condition = {
+          ArnEquals = { "ecs:cluster" = var.cluster_id }
+        }
+      }
+    ]
+  })
+}
+
+# Per-workflow record streaming to Firehose
+resource "aws_iam_role_policy" "benchmark_firehose" {
+  count = var.firehose_stream_arn != "" ? 1 : 0
+
+  name = "firehose-put-records"
+  role = aws_iam_role.benchmark_task.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["firehose:PutRecordBatch"]
+      Resource = var.firehose_stream_arn
+    }]
+  })
+}
@@ -17,6 +17,11 @@
 # Requirements: 10.1
 # -----------------------------------------------------------------------------
 
+# Temporal services that scenario dynamic config overrides redeploy
+locals {
+  temporal_services = [for s in ["frontend", "history", "matching", "worker"] : "${var.project_name}-temporal-${s}"]
+}
+
 # -----------------------------------------------------------------------------
 # Benchmark Task Definition
 # -----------------------------------------------------------------------------
@@ -70,6 +75,7 @@ resource "aws_ecs_task_definition" "benchmark" {
         # Default environment variables - can be overridden at runtime
         environment = [
           { name = "TEMPORAL_ADDRESS", value = "temporal-frontend:7233" },
+          { name = "BENCHMARK_ADDRESS_FAMILY", value = var.address_family },
           { name = "BENCHMARK_NAMESPACE", value = "benchmark" },
           { name = "BENCHMARK_WORKFLOW_TYPE", value = "multi-activity" },
           { name = "BENCHMARK_TARGET_RATE", value = "100" },
@@ -78,7 +84,24 @@ resource "aws_ecs_task_definition" "benchmark" {
           { name = "BENCHMARK_WORKER_COUNT", value = "4" },
           { name = "BENCHMARK_ITERATIONS", value = "1" },
           { name = "BENCHMARK_MAX_P99_LATENCY", value = "5s" },
-          { name = "BENCHMARK_MIN_THROUGHPUT", value = "50" }
+          { name = "BENCHMARK_MIN_THROUGHPUT", value = "50" },
+          { name = "BENCHMARK_WORKER_SCALING_CLUSTER", value = var.cluster_name },
+          { name = "BENCHMARK_WORKER_SCALING_SERVICE", value = "${var.project_name}-benchmark-worker" },
+          { name = "BENCHMARK_HISTORY_CLUSTER", value = var.cluster_name },
+          { name = "BENCHMARK_HISTORY_SERVICE", value = "${var.project_name}-temporal-history" },
+          { name = "BENCHMARK_FRONTEND_CLUSTER", value = var.cluster_name },
+          { name = "BENCHMARK_FRONTEND_SERVICE", value = "${var.project_name}-temporal-frontend" },
+          { name = "BENCHMARK_DYNAMIC_CONFIG_CLUSTER", value = var.cluster_name },
+          { name = "BENCHMARK_DYNAMIC_CONFIG_SERVICES", value = join(",", local.temporal_services) },
+          { name = "BENCHMARK_FIREHOSE_STREAM", value = var.firehose_stream_arn },
+          { name = "BENCHMARK_MEMO", value = join(",", [for k, v in var.cost_allocation_tags : "${k}=${v}"]) },
+          { name = "BENCHMARK_RUN_REGISTRY", value = tostring(var.stale_run_action != "off") },
+          { name = "BENCHMARK_RUN_CATALOG", value = tostring(var.run_catalog) },
+          { name = "BENCHMARK_NAMESPACE_TTL", value = var.namespace_ttl },
+          { name = "BENCHMARK_DISABLE_STICKY", value = tostring(var.disable_sticky_execution) },
+          { name = "BENCHMARK_WORKER_ACTIVITIES_PER_SECOND", value = tostring(var.worker_activities_per_second) },
+          { name = "BENCHMARK_TASK_QUEUE_ACTIVITIES_PER_SECOND", value = tostring(var.task_queue_activities_per_second) },
+          { name = "BENCHMARK_REDACT", value = tostring(var.redact) }
         ]
 
         # No logConfiguration - logs collected by Alloy sidecar
@@ -93,10 +116,10 @@ resource "aws_ecs_task_definition" "benchmark" {
     var.alloy_sidecar_container != null ? [var.alloy_sidecar_container] : []
   ))
 
-  tags = {
+  tags = merge(var.cost_allocation_tags, {
     Name    = "${var.project_name}-benchmark"
     Service = "benchmark"
-  }
+  })
 }
 
 
@@ -146,10 +169,13 @@ resource "aws_ecs_service" "benchmark_generator" {
   deployment_maximum_percent         = 200
   deployment_minimum_healthy_percent = 0 # Allow scaling to 0
 
-  tags = {
+  # Tasks carry the service's tags, including the cost-allocation tags
+  propagate_tags = "SERVICE"
+
+  tags = merge(var.cost_allocation_tags, {
     Name    = "${var.project_name}-benchmark-generator"
     Service = "benchmark-generator"
-  }
+  })
 
   lifecycle {
     ignore_changes = [desired_count]
@@ -48,6 +48,11 @@ output "generator_service_name" {
   value       = aws_ecs_service.benchmark_generator.name
 }
 
+output "activity_worker_service_name" {
+  description = "Benchmark activity worker service name (null unless activity_worker_count > 0)"
+  value       = local.split_workers ? aws_ecs_service.benchmark_activity_worker[0].name : null
+}
+
 output "worker_service_name" {
   description = "Name of the Benchmark Worker ECS service"
   value       = aws_ecs_service.benchmark_worker.name
@@ -50,6 +50,11 @@ variable "execution_role_arn" {
   type        = string
 }
 
+variable "temporal_task_role_arn" {
+  description = "Task role ARN of the Temporal services, passed to the task definitions that dynamic config overrides register"
+  type        = string
+}
+
 variable "prometheus_workspace_arn" {
   description = "Amazon Managed Prometheus workspace ARN"
   type        = string
@@ -148,6 +153,17 @@ variable "worker_count" {
   }
 }
 
+variable "activity_worker_count" {
+  description = "Number of activity-only benchmark worker tasks. Above 0, the worker service handles workflow tasks only and activities run in a separate service. Workers of both services count towards the 51-task limit."
+  type        = number
+  default     = 0
+
+  validation {
+    condition     = var.activity_worker_count >= 0 && var.activity_worker_count <= 51
+    error_message = "Activity worker count must be between 0 and 51."
+  }
+}
+
 # -----------------------------------------------------------------------------
 # EC2 Capacity Configuration
 # -----------------------------------------------------------------------------
@@ -173,6 +189,97 @@ variable "max_instances" {
 # Observability Configuration
 # -----------------------------------------------------------------------------
 
+variable "firehose_stream_arn" {
+  description = "Firehose delivery stream ARN receiving per-workflow records (empty disables streaming)"
+  type        = string
+  default     = ""
+}
+
+variable "cost_allocation_tags" {
+  description = "Cost-allocation tags (e.g. team, cost-center, experiment) set on benchmark tasks and as the memo of every generated workflow"
+  type        = map(string)
+  default     = {}
+}
+
+variable "stale_run_action" {
+  description = "What worker services do with open workflows of runs that are no longer active: off, report or terminate (also makes the generator register its runs)"
+  type        = string
+  default     = "off"
+
+  validation {
+    condition     = contains(["off", "report", "terminate"], var.stale_run_action)
+    error_message = "stale_run_action must be off, report or terminate."
+  }
+}
+
+variable "run_catalog" {
+  description = "Record every benchmark run in the run catalog workflow, listed with `benchmark runs list`"
+  type        = bool
+  default     = false
+}
+
+variable "namespace_ttl" {
+  description = "Delete each namespace a benchmark run creates after this Go duration (e.g. \"24h\"), even if the run crashes; worker services host the janitor workflows. \"0s\" keeps namespaces"
+  type        = string
+  default     = "0s"
+}
+
+variable "disable_sticky_execution" {
+  description = "Disable sticky execution on benchmark workers so every workflow task replays its history (also recorded in generator results)"
+  type        = bool
+  default     = false
+}
+
+variable "workflow_cache_size" {
+  description = "SDK workflow cache size of each benchmark worker; cache misses replay history from DSQL"
+  type        = number
+  default     = 10000
+
+  validation {
+    condition     = var.workflow_cache_size >= 1
+    error_message = "workflow_cache_size must be at least 1; use disable_sticky_execution to disable the cache."
+  }
+}
+
+variable "worker_activities_per_second" {
+  description = "Activities per second each benchmark worker may start, emulating rate-limited production workers (also recorded in generator results). 0 keeps the SDK default"
+  type        = number
+  default     = 0
+
+  validation {
+    condition     = var.worker_activities_per_second >= 0
+    error_message = "worker_activities_per_second must not be negative."
+  }
+}
+
+variable "task_queue_activities_per_second" {
+  description = "Activities per second the server dispatches from the benchmark task queue across all workers (also recorded in generator results). 0 leaves it unlimited"
+  type        = number
+  default     = 0
+
+  validation {
+    condition     = var.task_queue_activities_per_second >= 0
+    error_message = "task_queue_activities_per_second must not be negative."
+  }
+}
+
+variable "redact" {
+  description = "Scrub addresses, tokens and TLS paths from benchmark logs and results so they can be shared externally"
+  type        = bool
+  default     = false
+}
+
+variable "address_family" {
+  description = "Address family benchmark clients dial the Temporal frontend over: auto, ipv6, ipv4 (dual-stack, preferred family first), ipv6-only or ipv4-only (e.g. for IPv6-only task networking)"
+  type        = string
+  default     = "auto"
+
+  validation {
+    condition     = contains(["auto", "ipv6", "ipv4", "ipv6-only", "ipv4-only"], var.address_family)
+    error_message = "address_family must be one of: auto, ipv6, ipv4, ipv6-only, ipv4-only."
+  }
+}
+
 variable "log_retention_days" {
   description = "CloudWatch log retention in days"
   type        = number
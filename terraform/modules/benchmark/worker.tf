@@ -5,15 +5,63 @@
 # The workers process benchmark workflows generated by the benchmark generator task.
 #
 # Key features:
-# - Runs in worker-only mode (BENCHMARK_WORKER_ONLY=true)
+# - Runs the "work" subcommand (no workflow generation)
 # - Scalable independently from the generator
 # - Uses the same benchmark image but different configuration
 # - Long-running service (not a one-shot task)
 # - Alloy sidecar for log collection to Loki
 #
+# With activity_worker_count > 0, workflow and activity tasks run in separate
+# services: this one handles workflow tasks only and the activity worker
+# service below handles activity tasks only, matching production topologies
+# where they scale independently.
+#
 # Requirements: 10.1
 # -----------------------------------------------------------------------------
 
+locals {
+  split_workers = var.activity_worker_count > 0
+
+  worker_environment = [
+    { name = "TEMPORAL_ADDRESS", value = "temporal-frontend:7233" },
+    { name = "BENCHMARK_ADDRESS_FAMILY", value = var.address_family },
+    { name = "BENCHMARK_NAMESPACE", value = "benchmark" },
+    { name = "BENCHMARK_STALE_RUNS", value = var.stale_run_action },
+    { name = "BENCHMARK_NAMESPACE_TTL", value = var.namespace_ttl },
+    { name = "BENCHMARK_DISABLE_STICKY", value = tostring(var.disable_sticky_execution) },
+    { name = "BENCHMARK_WORKFLOW_CACHE_SIZE", value = tostring(var.workflow_cache_size) },
+    { name = "BENCHMARK_WORKER_ACTIVITIES_PER_SECOND", value = tostring(var.worker_activities_per_second) },
+    { name = "BENCHMARK_TASK_QUEUE_ACTIVITIES_PER_SECOND", value = tostring(var.task_queue_activities_per_second) },
+    { name = "BENCHMARK_REDACT", value = tostring(var.redact) }
+  ]
+
+  # Shared by the worker and activity worker containers, which differ in name
+  # and BENCHMARK_WORKER_TASKS
+  worker_container = {
+    image     = var.benchmark_image != "" ? var.benchmark_image : "public.ecr.aws/amazonlinux/amazonlinux:2023-minimal"
+    essential = true
+    # Reserve CPU/memory for Alloy sidecar when enabled (init: 64 CPU, 128 MB + sidecar: 128 CPU, 256 MB = 192 CPU, 384 MB)
+    cpu    = var.alloy_worker_sidecar_container != null ? var.worker_cpu - 192 : var.worker_cpu
+    memory = var.alloy_worker_sidecar_container != null ? var.worker_memory - 384 : var.worker_memory
+
+    portMappings = [
+      {
+        containerPort = 9090
+        protocol      = "tcp"
+        name          = "metrics"
+      }
+    ]
+
+    command = ["work"]
+
+    # No log configuration - logs collected by Alloy sidecar
+
+    linuxParameters = {
+      initProcessEnabled = true
+    }
+  }
+}
+
 # -----------------------------------------------------------------------------
 # Benchmark Worker Task Definition
 # -----------------------------------------------------------------------------
@@ -45,47 +93,21 @@ resource "aws_ecs_task_definition" "benchmark_worker" {
 
   container_definitions = jsonencode(concat(
     [
-      {
-        name      = "benchmark-worker"
-        image     = var.benchmark_image != "" ? var.benchmark_image : "public.ecr.aws/amazonlinux/amazonlinux:2023-minimal"
-        essential = true
-        # Reserve CPU/memory for Alloy sidecar when enabled (init: 64 CPU, 128 MB + sidecar: 128 CPU, 256 MB = 192 CPU, 384 MB)
-        cpu    = var.alloy_worker_sidecar_container != null ? var.worker_cpu - 192 : var.worker_cpu
-        memory = var.alloy_worker_sidecar_container != null ? var.worker_memory - 384 : var.worker_memory
-
-        portMappings = [
-          {
-            containerPort = 9090
-            protocol      = "tcp"
-            name          = "metrics"
-          }
-        ]
-
-        environment = [
-          { name = "TEMPORAL_ADDRESS", value = "temporal-frontend:7233" },
-          { name = "BENCHMARK_NAMESPACE", value = "benchmark" },
-          { name = "BENCHMARK_WORKER_ONLY", value = "true" },
-          # These are not used in worker-only mode but required for config validation
-          { name = "BENCHMARK_WORKFLOW_TYPE", value = "simple" },
-          { name = "BENCHMARK_TARGET_RATE", value = "100" },
-          { name = "BENCHMARK_DURATION", value = "5m" }
-        ]
-
-        # No log configuration - logs collected by Alloy sidecar
-
-        linuxParameters = {
-          initProcessEnabled = true
-        }
-      }
+      merge(local.worker_container, {
+        name = "benchmark-worker"
+        environment = concat(local.worker_environment, [
+          { name = "BENCHMARK_WORKER_TASKS", value = local.split_workers ? "workflow" : "all" }
+        ])
+      })
     ],
     var.alloy_worker_init_container != null ? [var.alloy_worker_init_container] : [],
     var.alloy_worker_sidecar_container != null ? [var.alloy_worker_sidecar_container] : []
   ))
 
-  tags = {
+  tags = merge(var.cost_allocation_tags, {
     Name    = "${var.project_name}-benchmark-worker"
     Service = "benchmark-worker"
-  }
+  })
 }
 
 # -----------------------------------------------------------------------------
@@ -128,13 +150,108 @@ resource "aws_ecs_service" "benchmark_worker" {
   deployment_maximum_percent         = 200
   deployment_minimum_healthy_percent = 100
 
-  tags = {
+  # Tasks carry the service's tags, including the cost-allocation tags
+  propagate_tags = "SERVICE"
+
+  tags = merge(var.cost_allocation_tags, {
     Name    = "${var.project_name}-benchmark-worker"
     Service = "benchmark-worker"
-  }
+  })
 
   lifecycle {
     ignore_changes = [desired_count]
   }
 }
 
+
+# -----------------------------------------------------------------------------
+# Benchmark Activity Worker (separate activity workers only)
+# -----------------------------------------------------------------------------
+
+resource "aws_ecs_task_definition" "benchmark_activity_worker" {
+  count = local.split_workers ? 1 : 0
+
+  family                   = "${var.project_name}-benchmark-activity-worker"
+  requires_compatibilities = ["EC2"]
+  network_mode             = "awsvpc"
+  cpu                      = var.worker_cpu
+  memory                   = var.worker_memory
+  execution_role_arn       = var.execution_role_arn
+  task_role_arn            = aws_iam_role.benchmark_task.arn
+
+  runtime_platform {
+    operating_system_family = "LINUX"
+    cpu_architecture        = "ARM64"
+  }
+
+  volume {
+    name      = "docker-socket"
+    host_path = "/var/run/docker.sock"
+  }
+
+  volume {
+    name = "alloy-config"
+  }
+
+  container_definitions = jsonencode(concat(
+    [
+      merge(local.worker_container, {
+        name = "benchmark-activity-worker"
+        environment = concat(local.worker_environment, [
+          { name = "BENCHMARK_WORKER_TASKS", value = "activity" }
+        ])
+      })
+    ],
+    var.alloy_worker_init_container != null ? [var.alloy_worker_init_container] : [],
+    var.alloy_worker_sidecar_container != null ? [var.alloy_worker_sidecar_container] : []
+  ))
+
+  tags = merge(var.cost_allocation_tags, {
+    Name    = "${var.project_name}-benchmark-activity-worker"
+    Service = "benchmark-activity-worker"
+  })
+}
+
+resource "aws_ecs_service" "benchmark_activity_worker" {
+  count = local.split_workers ? 1 : 0
+
+  name            = "${var.project_name}-benchmark-activity-worker"
+  cluster         = var.cluster_id
+  task_definition = aws_ecs_task_definition.benchmark_activity_worker[0].arn
+  desired_count   = var.activity_worker_count
+
+  capacity_provider_strategy {
+    capacity_provider = aws_ecs_capacity_provider.benchmark.name
+    weight            = 1
+    base              = 0
+  }
+
+  force_new_deployment = true
+
+  enable_execute_command = true
+
+  network_configuration {
+    subnets          = var.subnet_ids
+    security_groups  = [var.instance_security_group_id, aws_security_group.benchmark.id]
+    assign_public_ip = false
+  }
+
+  service_connect_configuration {
+    enabled   = true
+    namespace = var.service_connect_namespace_arn
+  }
+
+  deployment_maximum_percent         = 200
+  deployment_minimum_healthy_percent = 100
+
+  propagate_tags = "SERVICE"
+
+  tags = merge(var.cost_allocation_tags, {
+    Name    = "${var.project_name}-benchmark-activity-worker"
+    Service = "benchmark-activity-worker"
+  })
+
+  lifecycle {
+    ignore_changes = [desired_count]
+  }
+}